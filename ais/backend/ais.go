@@ -5,6 +5,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -79,6 +80,13 @@ func (r *remAis) String() string {
 	return fmt.Sprintf("remote cluster (%s, %q, %q, %s)", r.url, alias, r.smap.UUID, r.smap)
 }
 
+// base params that also carry the request ID, if any, for the remote cluster to log
+func (r *remAis) reqBP(ctx context.Context) api.BaseParams {
+	bp := r.bp
+	bp.ReqID = nlog.ReqID(ctx)
+	return bp
+}
+
 func unsetUUID(bck *cmn.Bck) { bck.Ns.UUID = "" }
 
 func extractErrCode(e error, uuid string) (int, error) {
@@ -516,7 +524,7 @@ func (m *AISBackendProvider) blist(uuid string, qbck cmn.QueryBcks) (bcks cmn.Bc
 // in part including apc.Flt* location specifier.
 // Here, and elsewhere down below, we hardcode (the default) `apc.FltPresent` to, eesentially,
 // keep HeadObj() consistent across backends.
-func (m *AISBackendProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
+func (m *AISBackendProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
 	var (
 		remAis    *remAis
 		op        *cmn.ObjectProps
@@ -526,7 +534,7 @@ func (m *AISBackendProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs,
 		return
 	}
 	unsetUUID(&remoteBck)
-	if op, err = api.HeadObject(remAis.bp, remoteBck, lom.ObjName, apc.FltPresent); err != nil {
+	if op, err = api.HeadObject(remAis.reqBP(ctx), remoteBck, lom.ObjName, apc.FltPresent); err != nil {
 		errCode, err = extractErrCode(err, remAis.uuid)
 		return
 	}
@@ -536,7 +544,7 @@ func (m *AISBackendProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs,
 	return
 }
 
-func (m *AISBackendProvider) GetObj(_ ctx, lom *cluster.LOM, owt cmn.OWT) (errCode int, err error) {
+func (m *AISBackendProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (errCode int, err error) {
 	var (
 		remAis    *remAis
 		r         io.ReadCloser
@@ -546,7 +554,7 @@ func (m *AISBackendProvider) GetObj(_ ctx, lom *cluster.LOM, owt cmn.OWT) (errCo
 		return
 	}
 	unsetUUID(&remoteBck)
-	if r, err = api.GetObjectReader(remAis.bp, remoteBck, lom.ObjName, nil /*api.GetArgs*/); err != nil {
+	if r, err = api.GetObjectReader(remAis.reqBP(ctx), remoteBck, lom.ObjName, nil /*api.GetArgs*/); err != nil {
 		return extractErrCode(err, remAis.uuid)
 	}
 	params := cluster.AllocPutObjParams()
@@ -561,7 +569,7 @@ func (m *AISBackendProvider) GetObj(_ ctx, lom *cluster.LOM, owt cmn.OWT) (errCo
 	return extractErrCode(err, remAis.uuid)
 }
 
-func (m *AISBackendProvider) GetObjReader(_ ctx, lom *cluster.LOM) (r io.ReadCloser, expCksum *cos.Cksum, errCode int, err error) {
+func (m *AISBackendProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.ReadCloser, expCksum *cos.Cksum, errCode int, err error) {
 	var (
 		remAis    *remAis
 		op        *cmn.ObjectProps
@@ -571,7 +579,7 @@ func (m *AISBackendProvider) GetObjReader(_ ctx, lom *cluster.LOM) (r io.ReadClo
 		return
 	}
 	unsetUUID(&remoteBck)
-	if op, err = api.HeadObject(remAis.bp, remoteBck, lom.ObjName, apc.FltPresent); err != nil {
+	if op, err = api.HeadObject(remAis.reqBP(ctx), remoteBck, lom.ObjName, apc.FltPresent); err != nil {
 		errCode, err = extractErrCode(err, remAis.uuid)
 		return
 	}
@@ -581,12 +589,12 @@ func (m *AISBackendProvider) GetObjReader(_ ctx, lom *cluster.LOM) (r io.ReadClo
 	expCksum = oa.Cksum
 	lom.SetCksum(nil)
 	// reader
-	r, err = api.GetObjectReader(remAis.bp, remoteBck, lom.ObjName, nil /*api.GetArgs*/)
+	r, err = api.GetObjectReader(remAis.reqBP(ctx), remoteBck, lom.ObjName, nil /*api.GetArgs*/)
 	errCode, err = extractErrCode(err, remAis.uuid)
 	return
 }
 
-func (m *AISBackendProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
+func (m *AISBackendProvider) PutObj(ctx context.Context, r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
 	var (
 		oah       api.ObjAttrs
 		remAis    *remAis
@@ -598,7 +606,7 @@ func (m *AISBackendProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode
 	}
 	unsetUUID(&remoteBck)
 	args := api.PutArgs{
-		BaseParams: remAis.bp,
+		BaseParams: remAis.reqBP(ctx),
 		Bck:        remoteBck,
 		ObjName:    lom.ObjName,
 		Cksum:      lom.Checksum(),
@@ -617,7 +625,7 @@ func (m *AISBackendProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode
 	return
 }
 
-func (m *AISBackendProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
+func (m *AISBackendProvider) DeleteObj(ctx context.Context, lom *cluster.LOM) (errCode int, err error) {
 	var (
 		remAis    *remAis
 		remoteBck = lom.Bck().Clone()
@@ -626,6 +634,6 @@ func (m *AISBackendProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error
 		return
 	}
 	unsetUUID(&remoteBck)
-	err = api.DeleteObject(remAis.bp, remoteBck, lom.ObjName)
+	err = api.DeleteObject(remAis.reqBP(ctx), remoteBck, lom.ObjName)
 	return extractErrCode(err, remAis.uuid)
 }
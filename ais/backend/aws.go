@@ -29,6 +29,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -115,6 +116,10 @@ func (*awsProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCo
 	}
 	region = *svc.Config.Region
 	debug.Assert(region != "")
+	preset := getPreset(cloudBck)
+	if preset != nil {
+		region = cloudBck.Props.Extra.AWS.CloudRegion // (configured; the one above is used to sign requests)
+	}
 
 	// NOTE: return a few assorted fields, specifically to fill-in vendor-specific `cmn.ExtraProps`
 	bckProps = make(cos.StrKVs, 4)
@@ -124,6 +129,9 @@ func (*awsProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCo
 	if bck.Props != nil {
 		bckProps[apc.HdrS3Endpoint] = bck.Props.Extra.AWS.Endpoint
 	}
+	if preset != nil {
+		bckProps[apc.HdrS3Preset] = cloudBck.Props.Extra.AWS.Preset
+	}
 	versioned, errV := getBucketVersioning(svc, cloudBck)
 	if errV != nil {
 		errCode, err = awsErrorToAISError(errV, cloudBck)
@@ -145,6 +153,7 @@ func (awsp *awsProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 		svc        *s3.S3
 		h          = cmn.BackendHelpers.Amazon
 		cloudBck   = bck.RemoteBck()
+		preset     = getPreset(cloudBck)
 		versioning bool
 	)
 	svc, _, err = newClient(sessConf{bck: cloudBck}, "[list_objects]")
@@ -180,9 +189,16 @@ func (awsp *awsProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 	for i := len(lst.Entries); i < l; i++ {
 		lst.Entries = append(lst.Entries, &cmn.LsoEntry{})
 	}
-	var custom = cos.StrKVs{}
-	for i, key := range resp.Contents {
+	var (
+		custom = cos.StrKVs{}
+		i      int
+	)
+	for _, key := range resp.Contents {
+		if preset != nil && preset.isPlaceholder(*key.Key, *key.Size) {
+			continue
+		}
 		entry := lst.Entries[i]
+		i++
 		entry.Name = *key.Key
 		entry.Size = *key.Size
 		if v, ok := h.EncodeCksum(key.ETag); ok {
@@ -195,7 +211,7 @@ func (awsp *awsProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 			entry.Custom = cmn.CustomMD2S(custom)
 		}
 	}
-	lst.Entries = lst.Entries[:l]
+	lst.Entries = lst.Entries[:i]
 
 	if *resp.IsTruncated {
 		lst.ContinuationToken = *resp.NextContinuationToken
@@ -272,7 +288,7 @@ func (*awsProvider) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err
 // HEAD OBJECT //
 /////////////////
 
-func (*awsProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
+func (*awsProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
 	var (
 		headOutput *s3.HeadObjectOutput
 		svc        *s3.S3
@@ -283,10 +299,10 @@ func (*awsProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode
 	if err != nil && verbose {
 		nlog.Warningln(err)
 	}
-	headOutput, err = svc.HeadObject(&s3.HeadObjectInput{
+	headOutput, err = svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
 		Bucket: aws.String(cloudBck.Name),
 		Key:    aws.String(lom.ObjName),
-	})
+	}, reqOpts(ctx)...)
 	if err != nil {
 		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
@@ -319,7 +335,7 @@ func (*awsProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode
 		oa.SetCustomKey(cmn.LastModified, fmtTime(mtime))
 	}
 	if superVerbose {
-		nlog.Infof("[head_object] %s", cloudBck.Cname(lom.ObjName))
+		nlog.InfofCtx(ctx, "[head_object] %s", cloudBck.Cname(lom.ObjName))
 	}
 	return
 }
@@ -370,7 +386,7 @@ func (*awsProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.Re
 	obj, err = svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(cloudBck.Name),
 		Key:    aws.String(lom.ObjName),
-	})
+	}, reqOpts(ctx)...)
 	if err != nil {
 		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
@@ -410,15 +426,21 @@ func getobjCustom(lom *cluster.LOM, obj *s3.GetObjectOutput) (expCksum *cos.Cksu
 // PUT OBJECT //
 ////////////////
 
-func (*awsProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
+func (*awsProvider) PutObj(ctx context.Context, r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
 	var (
 		svc                   *s3.S3
 		uploadOutput          *s3manager.UploadOutput
 		h                     = cmn.BackendHelpers.Amazon
 		cksumType, cksumValue = lom.Checksum().Get()
 		cloudBck              = lom.Bck().RemoteBck()
+		preset                = getPreset(cloudBck)
 		md                    = make(map[string]*string, 2)
 	)
+	if preset != nil && lom.SizeBytes() > preset.maxObjSize {
+		cos.Close(r)
+		return http.StatusRequestEntityTooLarge, fmt.Errorf("%s: size %s exceeds %q max (%s)", lom,
+			cos.ToSizeIEC(lom.SizeBytes(), 2), cloudBck.Props.Extra.AWS.Preset, cos.ToSizeIEC(preset.maxObjSize, 0))
+	}
 
 	svc, _, err = newClient(sessConf{bck: cloudBck}, "[put_object]")
 	if err != nil && superVerbose {
@@ -429,12 +451,19 @@ func (*awsProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err
 	md[cos.S3MetadataChecksumVal] = aws.String(cksumValue)
 
 	uploader := s3manager.NewUploaderWithClient(svc)
-	uploadOutput, err = uploader.Upload(&s3manager.UploadInput{
+	if preset != nil {
+		uploader.PartSize = preset.partSize(lom.SizeBytes())
+	}
+	input := &s3manager.UploadInput{
 		Bucket:   aws.String(cloudBck.Name),
 		Key:      aws.String(lom.ObjName),
 		Body:     r,
 		Metadata: md,
-	})
+	}
+	if ctype, ok := lom.GetCustomKey(cos.HdrContentType); ok {
+		input.ContentType = aws.String(ctype)
+	}
+	uploadOutput, err = uploader.UploadWithContext(ctx, input, s3manager.WithUploaderRequestOptions(reqOpts(ctx)...))
 	if err != nil {
 		errCode, err = awsErrorToAISError(err, cloudBck)
 		cos.Close(r)
@@ -453,7 +482,7 @@ func (*awsProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err
 		}
 	}
 	if superVerbose {
-		nlog.Infof("[put_object] %s", lom)
+		nlog.InfofCtx(ctx, "[put_object] %s", lom)
 	}
 	cos.Close(r)
 	return
@@ -463,7 +492,7 @@ func (*awsProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err
 // DELETE OBJECT //
 ///////////////////
 
-func (*awsProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
+func (*awsProvider) DeleteObj(ctx context.Context, lom *cluster.LOM) (errCode int, err error) {
 	var (
 		svc      *s3.S3
 		cloudBck = lom.Bck().RemoteBck()
@@ -472,16 +501,16 @@ func (*awsProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
 	if err != nil && verbose {
 		nlog.Warningln(err)
 	}
-	_, err = svc.DeleteObject(&s3.DeleteObjectInput{
+	_, err = svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(cloudBck.Name),
 		Key:    aws.String(lom.ObjName),
-	})
+	}, reqOpts(ctx)...)
 	if err != nil {
 		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
 	}
 	if superVerbose {
-		nlog.Infof("[delete_object] %s", lom)
+		nlog.InfofCtx(ctx, "[delete_object] %s", lom)
 	}
 	return
 }
@@ -490,6 +519,14 @@ func (*awsProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
 // static helpers
 //
 
+// request ID (if any) => S3 request header (signed along with the rest)
+func reqOpts(ctx context.Context) []request.Option {
+	if rid := nlog.ReqID(ctx); rid != "" {
+		return []request.Option{request.WithSetRequestHeaders(map[string]string{apc.HdrReqID: rid})}
+	}
+	return nil
+}
+
 // newClient creates new S3 client on a per-region basis or, more precisely,
 // per (region, endpoint) pair - and note that s3 endpoint is per-bucket configurable.
 // If the client already exists newClient simply returns it.
@@ -514,6 +551,9 @@ func newClient(conf sessConf, tag string) (svc *s3.S3, region string, err error)
 		if conf.bck.Props.Extra.AWS.Profile != "" {
 			profile = conf.bck.Props.Extra.AWS.Profile
 		}
+		if preset := getPreset(conf.bck); preset != nil {
+			endpoint, region = preset.resolve(conf.bck.Props.Extra.AWS.Endpoint, region)
+		}
 	}
 	cid := _cid(profile, region, endpoint)
 
@@ -0,0 +1,79 @@
+//go:build aws
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// S3-compatible backends (bucket property `extra.aws.preset`) differ from Amazon S3 in:
+// - endpoints: derived from the bucket's region unless explicitly configured
+//   (global S3_ENDPOINT does not apply);
+// - regions: Spaces' region is part of the endpoint - requests are signed with "us-east-1";
+//   GetBucketLocation is never used - the bucket's region must be configured;
+// - listing: web consoles create zero-size "folder" placeholders (and B2 - ".bzEmpty" files);
+// - uploads: part size and max object size (both with the same 10K parts limit as S3).
+
+type s3preset struct {
+	endpoint    string // format: region => endpoint
+	signRegion  string // SigV4 region (when the region is part of the endpoint)
+	placeholder string // "folder" placeholder object, if any
+	minPartSize int64
+	maxObjSize  int64
+}
+
+var s3presets = map[string]*s3preset{
+	apc.S3PresetSpaces: {
+		endpoint:    "https://%s.digitaloceanspaces.com",
+		signRegion:  endpoints.UsEast1RegionID,
+		minPartSize: s3manager.MinUploadPartSize,
+		maxObjSize:  5 * cos.TiB,
+	},
+	apc.S3PresetB2: {
+		endpoint:    "https://s3.%s.backblazeb2.com",
+		placeholder: ".bzEmpty",
+		minPartSize: 100 * cos.MB, // recommended
+		maxObjSize:  10 * cos.TB,
+	},
+}
+
+func getPreset(bck *cmn.Bck) *s3preset {
+	if bck == nil || bck.Props == nil {
+		return nil
+	}
+	return s3presets[bck.Props.Extra.AWS.Preset]
+}
+
+// returns endpoint and signing region
+func (p *s3preset) resolve(endpoint, region string) (string, string) {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(p.endpoint, region)
+	}
+	if p.signRegion != "" {
+		region = p.signRegion
+	}
+	return endpoint, region
+}
+
+func (p *s3preset) isPlaceholder(key string, size int64) bool {
+	if size != 0 {
+		return false
+	}
+	return strings.HasSuffix(key, "/") || (p.placeholder != "" && path.Base(key) == p.placeholder)
+}
+
+func (p *s3preset) partSize(size int64) int64 {
+	return max(cos.DivCeil(size, s3manager.MaxUploadParts), p.minPartSize)
+}
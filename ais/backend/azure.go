@@ -17,6 +17,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-pipeline-go/pipeline"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -56,6 +57,9 @@ const (
 	// Object lease time for PUT/DEL operations, in seconds.
 	// Must be within 15..60 range or -1(infinity).
 	leaseTime = 60
+
+	// request ID (see nlog.ReqID) is sent as Azure client request ID, to show up in Azure logs
+	azureHdrReqID = "x-ms-client-request-id"
 )
 
 var (
@@ -142,7 +146,7 @@ func NewAzure(t cluster.TargetPut) (cluster.BackendProvider, error) {
 	}
 
 	azctx = context.Background()
-	p := azblob.NewPipeline(creds, azblob.PipelineOptions{})
+	p := azurePipeline(creds)
 	return &azureProvider{
 		t: t,
 		u: path,
@@ -151,6 +155,30 @@ func NewAzure(t cluster.TargetPut) (cluster.BackendProvider, error) {
 	}, nil
 }
 
+// same as azblob.NewPipeline with default options, plus the request ID policy that must
+// precede both the (default) unique request ID policy and shared-key signing
+func azurePipeline(creds azblob.Credential) pipeline.Pipeline {
+	f := []pipeline.Factory{
+		azblob.NewTelemetryPolicyFactory(azblob.TelemetryOptions{}),
+		pipeline.FactoryFunc(azureReqID),
+		azblob.NewUniqueRequestIDPolicyFactory(),
+		azblob.NewRetryPolicyFactory(azblob.RetryOptions{}),
+		creds,
+		azblob.NewRequestLogPolicyFactory(azblob.RequestLogOptions{}),
+		pipeline.MethodFactoryMarker(),
+	}
+	return pipeline.NewPipeline(f, pipeline.Options{})
+}
+
+func azureReqID(next pipeline.Policy, _ *pipeline.PolicyOptions) pipeline.PolicyFunc {
+	return func(ctx context.Context, req pipeline.Request) (pipeline.Response, error) {
+		if rid := nlog.ReqID(ctx); rid != "" {
+			req.Header.Set(azureHdrReqID, rid)
+		}
+		return next.Do(ctx, req)
+	}
+}
+
 func azureErrorToAISError(azureError error, bck *cmn.Bck, objName string) (int, error) {
 	bckNotFound, status, err := _toErr(azureError, bck, objName)
 	if bckNotFound {
@@ -430,7 +458,7 @@ func (ap *azureProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (re
 // PUT OBJECT //
 ////////////////
 
-func (ap *azureProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error) {
+func (ap *azureProvider) PutObj(ctx context.Context, r io.ReadCloser, lom *cluster.LOM) (int, error) {
 	defer cos.Close(r)
 
 	var (
@@ -442,10 +470,10 @@ func (ap *azureProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error)
 		cond     = azblob.ModifiedAccessConditions{}
 	)
 	// Try to lease: if object does not exist, leasing fails with NotFound
-	acqResp, err := blobURL.AcquireLease(azctx, "", leaseTime, cond)
+	acqResp, err := blobURL.AcquireLease(ctx, "", leaseTime, cond)
 	if err == nil {
 		leaseID = acqResp.LeaseID()
-		defer blobURL.ReleaseLease(azctx, acqResp.LeaseID(), cond)
+		defer blobURL.ReleaseLease(ctx, acqResp.LeaseID(), cond)
 	}
 	if err != nil {
 		code, errLease := azureErrorToAISError(err, cloudBck, lom.ObjName)
@@ -465,7 +493,7 @@ func (ap *azureProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error)
 			LeaseAccessConditions: azblob.LeaseAccessConditions{LeaseID: leaseID},
 		}
 	}
-	putResp, err := azblob.UploadStreamToBlockBlob(azctx, r, blobURL, opts)
+	putResp, err := azblob.UploadStreamToBlockBlob(ctx, r, blobURL, opts)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return status, err
@@ -482,7 +510,7 @@ func (ap *azureProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error)
 		lom.SetVersion(v)
 	}
 	if superVerbose {
-		nlog.Infof("[put_object] %s", lom)
+		nlog.InfofCtx(ctx, "[put_object] %s", lom)
 	}
 	return http.StatusOK, nil
 }
@@ -493,7 +521,7 @@ func (ap *azureProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error)
 
 // Delete looks complex because according to docs, it needs acquiring
 // an object beforehand and releasing the lease after
-func (ap *azureProvider) DeleteObj(lom *cluster.LOM) (int, error) {
+func (ap *azureProvider) DeleteObj(ctx context.Context, lom *cluster.LOM) (int, error) {
 	var (
 		cloudBck = lom.Bck().RemoteBck()
 		cntURL   = ap.s.NewContainerURL(lom.Bck().Name)
@@ -501,7 +529,7 @@ func (ap *azureProvider) DeleteObj(lom *cluster.LOM) (int, error) {
 		cond     = azblob.ModifiedAccessConditions{}
 	)
 
-	acqResp, err := blobURL.AcquireLease(azctx, "", leaseTime, cond)
+	acqResp, err := blobURL.AcquireLease(ctx, "", leaseTime, cond)
 	if err != nil {
 		return azureErrorToAISError(err, cloudBck, lom.ObjName)
 	}
@@ -514,8 +542,8 @@ func (ap *azureProvider) DeleteObj(lom *cluster.LOM) (int, error) {
 	delCond := azblob.BlobAccessConditions{
 		LeaseAccessConditions: azblob.LeaseAccessConditions{LeaseID: acqResp.LeaseID()},
 	}
-	defer blobURL.ReleaseLease(azctx, acqResp.LeaseID(), cond)
-	delResp, err := blobURL.Delete(azctx, azblob.DeleteSnapshotsOptionInclude, delCond)
+	defer blobURL.ReleaseLease(ctx, acqResp.LeaseID(), cond)
+	delResp, err := blobURL.Delete(ctx, azblob.DeleteSnapshotsOptionInclude, delCond)
 	if err != nil {
 		return azureErrorToAISError(err, cloudBck, lom.ObjName)
 	}
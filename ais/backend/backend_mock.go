@@ -60,10 +60,10 @@ func (*mockBP) GetObjReader(context.Context, *cluster.LOM) (io.ReadCloser, *cos.
 	return nil, nil, 0, nil
 }
 
-func (*mockBP) PutObj(_ io.ReadCloser, lom *cluster.LOM) (int, error) {
+func (*mockBP) PutObj(_ ctx, _ io.ReadCloser, lom *cluster.LOM) (int, error) {
 	return http.StatusNotFound, cmn.NewErrRemoteBckNotFound(lom.Bucket())
 }
 
-func (*mockBP) DeleteObj(lom *cluster.LOM) (int, error) {
+func (*mockBP) DeleteObj(_ ctx, lom *cluster.LOM) (int, error) {
 	return http.StatusNotFound, cmn.NewErrRemoteBckNotFound(lom.Bucket())
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/googleapis/gax-go/v2/callctx"
 	jsoniter "github.com/json-iterator/go"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
@@ -33,6 +34,7 @@ import (
 
 const (
 	gcpChecksumType = "x-goog-meta-ais-cksum-type"
+	gcpAuditReqID   = "x-goog-custom-audit-" + apc.HdrReqID // request ID => GCS audit logs
 	gcpChecksumVal  = "x-goog-meta-ais-cksum-val"
 
 	projectIDField  = "project_id"
@@ -256,6 +258,7 @@ func (*gcpProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn.ObjA
 		h        = cmn.BackendHelpers.Google
 		cloudBck = lom.Bck().RemoteBck()
 	)
+	ctx = auditCtx(ctx)
 	attrs, err = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName).Attrs(ctx)
 	if err != nil {
 		errCode, err = handleObjectError(ctx, gcpClient, err, cloudBck)
@@ -326,6 +329,7 @@ func (*gcpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.Re
 		cloudBck = lom.Bck().RemoteBck()
 		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
 	)
+	ctx = auditCtx(ctx)
 	attrs, err = o.Attrs(ctx)
 	if err != nil {
 		errCode, err = gcpErrorToAISError(err, cloudBck)
@@ -376,18 +380,22 @@ func setCustomGs(lom *cluster.LOM, attrs *storage.ObjectAttrs) (expCksum *cos.Ck
 // PUT OBJECT //
 ////////////////
 
-func (gcpp *gcpProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
+func (gcpp *gcpProvider) PutObj(ctx context.Context, r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
+	ctx = auditCtx(ctx)
 	var (
 		attrs    *storage.ObjectAttrs
 		written  int64
 		cloudBck = lom.Bck().RemoteBck()
 		md       = make(cos.StrKVs, 2)
 		gcpObj   = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
-		wc       = gcpObj.NewWriter(gctx)
+		wc       = gcpObj.NewWriter(ctx)
 	)
 	md[gcpChecksumType], md[gcpChecksumVal] = lom.Checksum().Get()
 
 	wc.Metadata = md
+	if ctype, ok := lom.GetCustomKey(cos.HdrContentType); ok {
+		wc.ContentType = ctype
+	}
 	buf, slab := gcpp.t.PageMM().Alloc()
 	written, err = io.CopyBuffer(wc, r, buf)
 	slab.Free(buf)
@@ -399,14 +407,14 @@ func (gcpp *gcpProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int,
 		errCode, err = gcpErrorToAISError(err, cloudBck)
 		return
 	}
-	attrs, err = gcpObj.Attrs(gctx)
+	attrs, err = gcpObj.Attrs(ctx)
 	if err != nil {
-		errCode, err = handleObjectError(gctx, gcpClient, err, cloudBck)
+		errCode, err = handleObjectError(ctx, gcpClient, err, cloudBck)
 		return
 	}
 	_ = setCustomGs(lom, attrs)
 	if superVerbose {
-		nlog.Infof("[put_object] %s, size %d", lom, written)
+		nlog.InfofCtx(ctx, "[put_object] %s, size %d", lom, written)
 	}
 	return
 }
@@ -415,17 +423,18 @@ func (gcpp *gcpProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int,
 // DELETE OBJECT //
 ///////////////////
 
-func (*gcpProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
+func (*gcpProvider) DeleteObj(ctx context.Context, lom *cluster.LOM) (errCode int, err error) {
 	var (
 		cloudBck = lom.Bck().RemoteBck()
 		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
 	)
-	if err = o.Delete(gctx); err != nil {
-		errCode, err = handleObjectError(gctx, gcpClient, err, cloudBck)
+	ctx = auditCtx(ctx)
+	if err = o.Delete(ctx); err != nil {
+		errCode, err = handleObjectError(ctx, gcpClient, err, cloudBck)
 		return
 	}
 	if superVerbose {
-		nlog.Infof("[delete_object] %s", lom)
+		nlog.InfofCtx(ctx, "[delete_object] %s", lom)
 	}
 	return
 }
@@ -434,6 +443,14 @@ func (*gcpProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
 // static helpers
 //
 
+// request ID (if any) => custom audit header (see "x-goog-custom-audit-*" in cloud.google.com/go/storage)
+func auditCtx(ctx context.Context) context.Context {
+	if rid := nlog.ReqID(ctx); rid != "" {
+		return callctx.SetHeaders(ctx, gcpAuditReqID, rid)
+	}
+	return ctx
+}
+
 func readCredFile() (projectID string) {
 	credFile, err := os.Open(os.Getenv(credPathEnvVar))
 	if err != nil {
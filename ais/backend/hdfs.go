@@ -274,7 +274,7 @@ func (hp *hdfsProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r i
 // PUT OBJECT //
 ////////////////
 
-func (hp *hdfsProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
+func (hp *hdfsProvider) PutObj(ctx context.Context, r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
 	filePath := filepath.Join(lom.Bck().Props.Extra.HDFS.RefDirectory, lom.ObjName)
 	fw, err := hp.c.Create(filePath)
 	if err != nil {
@@ -310,7 +310,7 @@ finish:
 		return errCode, err
 	}
 	if verbose {
-		nlog.Infof("[put_object] %s", lom)
+		nlog.InfofCtx(ctx, "[put_object] %s", lom)
 	}
 
 	return 0, nil
@@ -320,14 +320,14 @@ finish:
 // DELETE OBJECT //
 ///////////////////
 
-func (hp *hdfsProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
+func (hp *hdfsProvider) DeleteObj(ctx context.Context, lom *cluster.LOM) (errCode int, err error) {
 	filePath := filepath.Join(lom.Bck().Props.Extra.HDFS.RefDirectory, lom.ObjName)
 	if err := hp.c.Remove(filePath); err != nil {
 		errCode, err = hdfsErrorToAISError(err)
 		return errCode, err
 	}
 	if verbose {
-		nlog.Infof("[delete_object] %s", lom)
+		nlog.InfofCtx(ctx, "[delete_object] %s", lom)
 	}
 	return 0, nil
 }
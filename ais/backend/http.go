@@ -193,9 +193,10 @@ func (hp *httpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r i
 	debug.AssertNoErr(err)
 
 	if verbose {
-		nlog.Infof("[HTTP CLOUD][GET] original_url: %q", origURL)
+		nlog.InfofCtx(ctx, "[HTTP CLOUD][GET] original_url: %q", origURL)
 	}
 
+	// NOTE: request ID (apc.HdrReqID) is not forwarded to third-party origins
 	resp, err := hp.client(origURL).Get(origURL) //nolint:bodyclose // is closed by the caller
 	if err != nil {
 		return nil, nil, http.StatusInternalServerError, err
@@ -217,10 +218,10 @@ func (hp *httpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r i
 	return wrapReader(ctx, resp.Body), nil, 0, nil
 }
 
-func (*httpProvider) PutObj(io.ReadCloser, *cluster.LOM) (int, error) {
+func (*httpProvider) PutObj(ctx, io.ReadCloser, *cluster.LOM) (int, error) {
 	return http.StatusBadRequest, cmn.NewErrUnsupp("PUT", " objects => HTTP backend")
 }
 
-func (*httpProvider) DeleteObj(*cluster.LOM) (int, error) {
+func (*httpProvider) DeleteObj(ctx, *cluster.LOM) (int, error) {
 	return http.StatusBadRequest, cmn.NewErrUnsupp("DELETE", " objects from HTTP backend")
 }
@@ -86,6 +86,7 @@ type (
 		propsToUpdate *cmn.BucketPropsToUpdate // update existing props
 		revertProps   *cmn.BucketPropsToUpdate // props to revert
 		setProps      *cmn.BucketProps         // new props to set
+		baseProps     *cmn.BucketProps         // props that setProps were derived from (optimistic concurrency)
 
 		wait         bool
 		needReMirror bool
@@ -541,6 +542,7 @@ func mergeRemoteBckProps(props *cmn.BucketProps, header http.Header) *cmn.Bucket
 		props.Extra.AWS.CloudRegion = header.Get(apc.HdrS3Region)
 		props.Extra.AWS.Endpoint = header.Get(apc.HdrS3Endpoint)
 		props.Extra.AWS.Profile = header.Get(apc.HdrS3Profile)
+		props.Extra.AWS.Preset = header.Get(apc.HdrS3Preset)
 	case apc.HTTP:
 		props.Extra.HTTP.OrigURLBck = header.Get(apc.HdrOrigURLBck)
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/space"
 	"github.com/NVIDIA/aistore/sys"
 	"github.com/NVIDIA/aistore/xact/xreg"
@@ -55,6 +56,8 @@ type (
 		target    struct {
 			// do not try to auto-join cluster upon startup - stand by and wait for admin request
 			standby bool
+			// join as a spare: in maintenance mode and holding no data until activated
+			spare bool
 			// allow: disk sharing by multiple mountpaths and mountpaths with no disks whatsoever
 			// (usage: testing, minikube env, etc.)
 			allowSharedDisksAndNoDisks bool
@@ -95,6 +98,7 @@ func initFlags(flset *flag.FlagSet) {
 
 	// target-only
 	flset.BoolVar(&daemon.cli.target.standby, "standby", false, "when starting up, do not try to auto-join cluster - stand by and wait for admin request (target-only)")
+	flset.BoolVar(&daemon.cli.target.spare, "spare", false, "join cluster as a spare (warm standby) that can be activated to replace a failed target (target-only)")
 	flset.BoolVar(&daemon.cli.target.allowSharedDisksAndNoDisks, "allow_shared_no_disks", false, "disk sharing by multiple mountpaths and mountpaths with no disks whatsoever (target-only)")
 	flset.BoolVar(&daemon.cli.target.useLoopbackDevs, "loopback", false, "use loopback devices (local playground, target-only)")
 	flset.BoolVar(&daemon.cli.target.startWithLostMountpath, "start_with_lost_mountpath", false, "force starting up with a lost or missing mountpath (target-only)")
@@ -220,6 +224,7 @@ func initDaemon(version, buildTime string) cos.Runner {
 	// reg more xaction factories
 	space.Xreg(config)
 	dload.Xreg()
+	res.Xreg()
 
 	t := newTarget(co)
 	t.init(config)
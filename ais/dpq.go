@@ -24,11 +24,14 @@ type dpq struct {
 	isGFN               string // ditto
 	origURL             string // ht://url->
 	appendTy, appendHdl string // APPEND { apc.AppendOp, ... }
+	writeAt             string // partial update (offset)
+	hedge               string // QparamHedge
 	owt                 string // object write transaction { OwtPut, ... }
 	fltPresence         string // QparamFltPresence
 	dontAddRemote       string // QparamDontAddRemote
 	countRemoteObjs     string // QparamCountRemoteObjs
 	etlName             string // QparamETLName
+	signature           string // QparamSignature (presigned URL)
 }
 
 var (
@@ -79,6 +82,8 @@ func (dpq *dpq) fromRawQ(rawQuery string) (err error) {
 			dpq.pid = value
 		case apc.QparamUnixTime:
 			dpq.ptime = value
+		case apc.QparamReqID:
+			// (already in the request header - see setReqID)
 		case apc.QparamUUID:
 			dpq.uuid = value
 		case apc.QparamArchpath:
@@ -101,6 +106,10 @@ func (dpq *dpq) fromRawQ(rawQuery string) (err error) {
 			if dpq.appendHdl, err = url.QueryUnescape(value); err != nil {
 				return
 			}
+		case apc.QparamWriteAt:
+			dpq.writeAt = value
+		case apc.QparamHedge:
+			dpq.hedge = value
 		case apc.QparamOWT:
 			dpq.owt = value
 		case apc.QparamFltPresence:
@@ -111,6 +120,10 @@ func (dpq *dpq) fromRawQ(rawQuery string) (err error) {
 			dpq.countRemoteObjs = value
 		case apc.QparamETLName:
 			dpq.etlName = value
+		case apc.QparamSignature:
+			dpq.signature = value
+		case apc.QparamExpires:
+			// (validated together with the signature - see checkPresigned)
 
 		case s3.QparamMptUploadID, s3.QparamMptUploads, s3.QparamMptPartNo:
 			// TODO: ignore for now
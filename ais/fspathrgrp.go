@@ -49,8 +49,8 @@ func (g *fsprungroup) enableMpath(mpath string) (enabledMi *fs.Mountpath, err er
 
 // attachMpath adds mountpath and notifies necessary runners about the change
 // if the mountpath was actually added.
-func (g *fsprungroup) attachMpath(mpath string, force bool) (addedMi *fs.Mountpath, err error) {
-	addedMi, err = fs.AddMpath(mpath, g.t.SID(), g.redistributeMD, force)
+func (g *fsprungroup) attachMpath(mpath, label, quota string, force bool) (addedMi *fs.Mountpath, err error) {
+	addedMi, err = fs.AddMpath(mpath, label, quota, g.t.SID(), g.redistributeMD, force)
 	if err != nil || addedMi == nil {
 		return
 	}
@@ -66,7 +66,7 @@ func (g *fsprungroup) _postAdd(action string, mi *fs.Mountpath) {
 	// - review all xact.IsMountpath(kind) == true
 	dsort.Managers.AbortAll(fmt.Errorf("%q %s", action, mi))
 
-	fspathsConfigAddDel(mi.Path, true /*add*/)
+	fspathsConfigAddDel(mi, true /*add*/)
 	go func() {
 		if cmn.GCO.Get().Resilver.Enabled {
 			g.t.runResilver(res.Args{}, nil /*wg*/)
@@ -82,6 +82,27 @@ func (g *fsprungroup) _postAdd(action string, mi *fs.Mountpath) {
 	}
 }
 
+// setReadOnly sets (or clears) mountpath's read-only mode (see fs.SetReadOnly);
+// when cleared, resilvers to relocate content written in the meantime
+func (g *fsprungroup) setReadOnly(mpath string, readOnly bool) (mi *fs.Mountpath, err error) {
+	mi, err = fs.SetReadOnly(mpath, readOnly, g.redistributeMD)
+	if err != nil || mi == nil {
+		return
+	}
+	action := apc.ActMountpathRO
+	if !readOnly {
+		action = apc.ActMountpathRW
+	}
+	// NOTE: changes HRW mountpath selection (same as above)
+	dsort.Managers.AbortAll(fmt.Errorf("%q %s", action, mi))
+	mi.EvictLomCache()
+
+	if !readOnly && cmn.GCO.Get().Resilver.Enabled {
+		go g.t.runResilver(res.Args{}, nil /*wg*/)
+	}
+	return
+}
+
 //
 // remove | disable
 //
@@ -174,7 +195,7 @@ func (g *fsprungroup) postDD(rmi *fs.Mountpath, action string, xres *xs.Resilver
 		nlog.Errorln(err)
 		return
 	}
-	fspathsConfigAddDel(rmi.Path, false /*add*/)
+	fspathsConfigAddDel(rmi, false /*add*/)
 	nlog.Infof("%s: %s %q %s done", g.t, rmi, action, xres)
 
 	// 3. the case of multiple overlapping detach _or_ disable operations
@@ -195,14 +216,14 @@ func (g *fsprungroup) postDD(rmi *fs.Mountpath, action string, xres *xs.Resilver
 			nlog.Errorln(err)
 			return
 		}
-		fspathsConfigAddDel(mi.Path, false /*add*/)
+		fspathsConfigAddDel(mi, false /*add*/)
 		nlog.Infof("%s: %s %s %s was previously aborted and now done", g.t, action, mi, xres)
 	}
 }
 
 // store updated fspaths locally as part of the 'OverrideConfigFname'
 // and commit new version of the config
-func fspathsConfigAddDel(mpath string, add bool) {
+func fspathsConfigAddDel(mi *fs.Mountpath, add bool) {
 	config := cmn.GCO.Get()
 	if config.TestingEnv() { // since testing fspaths are counted, not enumerated
 		return
@@ -210,9 +231,9 @@ func fspathsConfigAddDel(mpath string, add bool) {
 	config = cmn.GCO.BeginUpdate()
 	localConfig := &config.LocalConfig
 	if add {
-		localConfig.AddPath(mpath)
+		localConfig.AddPath(mi.Path, mi.Label, mi.Quota)
 	} else {
-		localConfig.DelPath(mpath)
+		localConfig.DelPath(mi.Path)
 	}
 	if err := localConfig.FSP.Validate(config); err != nil {
 		debug.AssertNoErr(err)
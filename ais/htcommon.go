@@ -29,6 +29,8 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/tinylib/msgp/msgp"
@@ -210,8 +212,10 @@ type (
 	netServer struct {
 		sync.Mutex
 		s             *http.Server
+		h3            transport.QUICServer // HTTP/3 (QUIC) - intra-cluster streams only
 		muxers        httpMuxers
 		sndRcvBufSize int
+		requireCert   bool // mutual TLS: dedicated intra-data network (see cmn.IntraServerTLS)
 	}
 
 	nlogWriter struct{}
@@ -267,9 +271,11 @@ var allHTTPverbs = []string{
 
 var (
 	errRebalanceDisabled = errors.New("rebalance is disabled")
+	errRebBckScope       = errors.New("rebalance is cluster-wide: bucket-scoped rebalance is not supported")
 	errForwarded         = errors.New("forwarded")
 	errSendingResp       = errors.New("err-sending-resp")
 	errFastKalive        = errors.New("cannot fast-keepalive")
+	errPropsChanged      = errors.New("bucket props changed concurrently (hint: retry)")
 )
 
 // BMD uuid errs
@@ -440,9 +446,17 @@ type (
 	cresEM struct{} // -> etl.CPUMemUsed
 	cresIC struct{} // -> icBundle
 	cresBM struct{} // -> bucketMD
+	cresNS struct{} // -> stats.Node
+	cresDM struct{} // -> apc.DeleteMultiResult
+	cresCS struct{} // -> apc.ClientStats
+	cresAA struct{} // -> apc.AuthAlerts
+	cresSS struct{} // -> apc.StreamStats
+	cresSR struct{} // -> sampleRes
+	cresBI struct{} // -> cmn.BckInfos
 
 	cresLso   struct{} // -> cmn.LsoResult
 	cresBsumm struct{} // -> cmn.AllBsummResults
+	cresBE    struct{} // -> apc.BckEvents
 )
 
 var (
@@ -456,7 +470,15 @@ var (
 	_ cresv = cresEM{}
 	_ cresv = cresIC{}
 	_ cresv = cresBM{}
+	_ cresv = cresNS{}
+	_ cresv = cresDM{}
+	_ cresv = cresCS{}
+	_ cresv = cresAA{}
+	_ cresv = cresSS{}
 	_ cresv = cresBsumm{}
+	_ cresv = cresBE{}
+	_ cresv = cresSR{}
+	_ cresv = cresBI{}
 )
 
 func (res *callResult) read(body io.Reader)  { res.bytes, res.err = io.ReadAll(body) }
@@ -482,6 +504,21 @@ func (c cresSM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresND) newV() any                              { return &meta.Snode{} }
 func (c cresND) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresNS) newV() any                              { return &stats.Node{} }
+func (c cresNS) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresDM) newV() any                              { return &apc.DeleteMultiResult{} }
+func (c cresDM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresCS) newV() any                              { return &apc.ClientStats{} }
+func (c cresCS) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresAA) newV() any                              { return &apc.AuthAlerts{} }
+func (c cresAA) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresSS) newV() any                              { return &apc.StreamStats{} }
+func (c cresSS) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 func (cresBA) newV() any                              { return &cluster.Remotes{} }
 func (c cresBA) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
@@ -503,6 +540,15 @@ func (c cresBM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresBsumm) newV() any                              { return &cmn.AllBsummResults{} }
 func (c cresBsumm) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresBE) newV() any                              { return &apc.BckEvents{} }
+func (c cresBE) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresSR) newV() any                              { return &sampleRes{} }
+func (c cresSR) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
+func (cresBI) newV() any                              { return &cmn.BckInfos{} }
+func (c cresBI) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 ////////////////
 // nlogWriter //
 ////////////////
@@ -586,12 +632,16 @@ func (server *netServer) listen(addr string, logger *log.Logger) (err error) {
 	server.Lock()
 	server.s = &http.Server{
 		Addr:     addr,
-		Handler:  httpHandler,
+		Handler:  transport.ServerHandler(httpHandler), // (gRPC build)
 		ErrorLog: logger,
 	}
 	if server.sndRcvBufSize > 0 && !config.Net.HTTP.UseHTTPS {
 		server.s.ConnState = server.connStateListener // setsockopt; see also cmn.NewTransport
 	}
+	if server.s.TLSConfig, err = cmn.IntraServerTLS(&config.Net.HTTP, server.requireCert); err != nil {
+		server.Unlock()
+		return
+	}
 	server.Unlock()
 retry:
 	if config.Net.HTTP.UseHTTPS {
@@ -613,6 +663,18 @@ retry:
 	return
 }
 
+// HTTP/3 (QUIC): same port, same handlers (see transport/quic.go)
+func (server *netServer) listenQUIC(addr string) error {
+	h3, err := transport.NewQUICServer(addr, server.muxers)
+	if err != nil {
+		return err
+	}
+	server.Lock()
+	server.h3 = h3
+	server.Unlock()
+	return h3.ListenAndServe()
+}
+
 func (server *netServer) connStateListener(c net.Conn, cs http.ConnState) {
 	if cs != http.StateNew {
 		return
@@ -627,6 +689,9 @@ func (server *netServer) connStateListener(c net.Conn, cs http.ConnState) {
 func (server *netServer) shutdown() {
 	server.Lock()
 	defer server.Unlock()
+	if server.h3 != nil {
+		server.h3.Close()
+	}
 	if server.s == nil {
 		return
 	}
@@ -656,6 +721,10 @@ func newMuxers() httpMuxers {
 // ServeHTTP dispatches the request to the handler whose
 // pattern most closely matches the request URL.
 func (m httpMuxers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if rid := reqID(r); rid != "" {
+		w.Header().Set(apc.HdrReqID, rid)
+		r = r.WithContext(nlog.WithReqID(r.Context(), rid))
+	}
 	if sm, ok := m[r.Method]; ok {
 		sm.ServeHTTP(w, r)
 		return
@@ -663,6 +732,32 @@ func (m httpMuxers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusBadRequest)
 }
 
+// request ID: use the one provided by the client, the redirecting proxy, or the (intra-cluster) caller;
+// otherwise, generate a new one for client requests only - intra-cluster traffic that doesn't carry
+// any (keepalive, metasync, transport sessions, etc.) goes without.
+// The ID is then carried in the request context (see nlog.WithReqID) and request header (for reverse proxying).
+func reqID(r *http.Request) (rid string) {
+	if rid = r.Header.Get(apc.HdrReqID); rid != "" {
+		return
+	}
+	if strings.Contains(r.URL.RawQuery, apc.QparamReqID+"=") {
+		rid = r.URL.Query().Get(apc.QparamReqID)
+	}
+	if rid == "" {
+		hdr := r.Header
+		if hdr.Get(apc.HdrCallerID) != "" || hdr.Get(apc.HdrT2TPutterID) != "" || hdr.Get(apc.HdrSessID) != "" {
+			return
+		}
+		rid = cos.GenUUID()
+	}
+	r.Header.Set(apc.HdrReqID, rid)
+	return
+}
+
+// request-scoped context for the backend and intra-cluster calls: carries the request ID
+// but not the request's cancellation (e.g., cold GET completes even if the client goes away)
+func reqCtx(r *http.Request) context.Context { return context.WithoutCancel(r.Context()) }
+
 /////////////////
 // clusterInfo //
 /////////////////
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/openapi"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cluster/meta"
 	"github.com/NVIDIA/aistore/cmn"
@@ -264,12 +265,16 @@ func (h *htrun) init(config *cmn.Config) {
 		defaultControlWriteBufferSize = 16 * cos.KiB // for more defaults see cmn/network.go
 		defaultControlReadBufferSize  = 16 * cos.KiB
 	)
+	if err := config.Net.HTTP.ValidateMTLS(); err != nil {
+		cos.ExitLog(err)
+	}
 	h.client.control = cmn.NewClient(cmn.TransportArgs{
 		Timeout:         config.Client.Timeout.D(),
 		WriteBufferSize: defaultControlWriteBufferSize,
 		ReadBufferSize:  defaultControlReadBufferSize,
 		UseHTTPS:        config.Net.HTTP.UseHTTPS,
 		SkipVerify:      config.Net.HTTP.SkipVerify,
+		TLS:             cmn.IntraClientTLS(&config.Net.HTTP),
 	})
 	wbuf, rbuf := config.Net.HTTP.WriteBufferSize, config.Net.HTTP.ReadBufferSize
 	// NOTE: when not configured use AIS defaults (to override the usual 4KB)
@@ -285,6 +290,7 @@ func (h *htrun) init(config *cmn.Config) {
 		ReadBufferSize:  rbuf,
 		UseHTTPS:        config.Net.HTTP.UseHTTPS,
 		SkipVerify:      config.Net.HTTP.SkipVerify,
+		TLS:             cmn.IntraClientTLS(&config.Net.HTTP),
 	})
 
 	tcpbuf := config.Net.L4.SndRcvBufSize
@@ -304,7 +310,7 @@ func (h *htrun) init(config *cmn.Config) {
 	h.netServ.data = h.netServ.control // if not configured, intra-data net is intra-control
 	if config.HostNet.UseIntraData {
 		muxers = newMuxers()
-		h.netServ.data = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf}
+		h.netServ.data = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf, requireCert: true}
 	}
 
 	h.owner.smap = newSmapOwner(config)
@@ -483,6 +489,9 @@ func (h *htrun) run() error {
 
 	// A wrapper to log http.Server errors
 	logger := log.New(&nlogWriter{}, "net/http err: ", 0)
+	if config.Net.HTTP.UseQUIC && h.si.IsTarget() {
+		h.runQUIC(config)
+	}
 	if config.HostNet.UseIntraControl || config.HostNet.UseIntraData {
 		var errCh chan error
 		if config.HostNet.UseIntraControl && config.HostNet.UseIntraData {
@@ -513,6 +522,20 @@ func (h *htrun) run() error {
 	return h.netServ.pub.listen(addr, logger)
 }
 
+// HTTP/3 (QUIC) listener to receive intra-cluster streams: UDP, same port
+// as the network that carries the streams (intra-data, if configured)
+func (h *htrun) runQUIC(config *cmn.Config) {
+	server, addr := h.netServ.pub, h.pubListeningAddr(config)
+	if config.HostNet.UseIntraData {
+		server, addr = h.netServ.data, h.si.DataNet.TCPEndpoint()
+	}
+	go func() {
+		if err := server.listenQUIC(addr); err != nil {
+			cos.ExitLogf("%s: HTTP/3 (QUIC) listener terminated: %v", h.si, err)
+		}
+	}()
+}
+
 // testing environment excluding Kubernetes: listen on `host:port`
 // otherwise (including production):         listen on `*:port`
 func (h *htrun) pubListeningAddr(config *cmn.Config) string {
@@ -1014,6 +1037,19 @@ func _parseNCopies(value any) (copies int64, err error) {
 	return
 }
 
+// (empty label: all mountpaths)
+func _parseLabel(value any) (label string, err error) {
+	switch v := value.(type) {
+	case string:
+		label = v
+		err = cmn.ValidateMpathLabel(label)
+	case nil:
+	default:
+		err = fmt.Errorf("failed to parse mountpath label (%v, %T) - unexpected type", value, value)
+	}
+	return
+}
+
 func _checkAction(msg *apc.ActMsg, expectedActions ...string) (err error) {
 	found := false
 	for _, action := range expectedActions {
@@ -1704,6 +1740,7 @@ func (h *htrun) extractRevokedTokenList(payload msPayload, caller string) (*toke
 //   - config.Proxy.PrimaryURL   ("primary_url")
 //   - config.Proxy.DiscoveryURL ("discovery_url")
 //   - config.Proxy.OriginalURL  ("original_url")
+//   - config.Proxy.Discovery    ("discovery") - resolved via DNS SRV, K8s, etc. (see cmn.Discover)
 //   - if these fails we try the candidates provided by the caller.
 //
 // ================================== Background =========================================
@@ -1740,6 +1777,15 @@ func (h *htrun) join(query url.Values, htext htext, contactURLs ...string) (res
 	}
 	sleep := cos.MaxDuration(2*time.Second, cmn.Timeout.MaxKeepalive())
 	for i := 0; i < 4; i++ {
+		if config.Proxy.Discovery != "" { // (re)resolve every time
+			urls, err := cmn.Discover(config.Proxy.Discovery, config.Net.HTTP.UseHTTPS, config.Timeout.CplaneOperation.D())
+			if err != nil {
+				nlog.Warningln(h.String()+":", err)
+			}
+			for _, u := range urls {
+				addCandidate(u)
+			}
+		}
 		for _, candidateURL := range candidates {
 			if daemon.stopping.Load() {
 				return
@@ -2062,6 +2108,23 @@ func (h *htrun) uptime2hdr(hdr http.Header) {
 	hdr.Set(apc.HdrClusterUptime, strconv.FormatInt(now-h.startup.cluster.Load(), 10))
 }
 
+// GET /v1/openapi: OpenAPI 3 description of the REST API (generated once, see api/openapi)
+func (h *htrun) openapiHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		cmn.WriteErr405(w, r, http.MethodGet)
+		return
+	}
+	if _, err := h.parseURL(w, r, 0, false, apc.URLPathOpenAPI.L); err != nil {
+		return
+	}
+	b := openapi.JSON()
+	w.Header().Set(cos.HdrContentType, cos.ContentJSONCharsetUTF)
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(b)))
+	if _, err := w.Write(b); err != nil {
+		h.logerr("openapi", apc.OpenAPI, err)
+	}
+}
+
 // NOTE: not checking vs Smap (yet)
 func isT2TPut(hdr http.Header) bool { return hdr != nil && hdr.Get(apc.HdrT2TPutterID) != "" }
 
@@ -2157,6 +2220,8 @@ func readJSON(w http.ResponseWriter, r *http.Request, out any) (err error) {
 func (h *htrun) _status(smap *smapX) (daeStatus string) {
 	self := smap.GetNode(h.si.ID()) // updated flags
 	switch {
+	case self.Flags.IsSet(meta.SnodeSpare):
+		daeStatus = apc.NodeSpare
 	case self.Flags.IsSet(meta.SnodeMaint):
 		daeStatus = apc.NodeMaintenance
 	case self.Flags.IsSet(meta.SnodeDecomm):
@@ -17,6 +17,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -78,6 +79,9 @@ type (
 		qm         lsobjMem
 		rproxy     reverseProxy
 		notifs     notifs
+		capreb     capReb
+		clients    clientStats
+		audit      authAudit
 		reg        struct {
 			pool nodeRegPool
 			mu   sync.RWMutex
@@ -206,6 +210,9 @@ func (p *proxy) Run() error {
 	p.notifs.init(p)
 	p.ic.init(p)
 	p.qm.init()
+	p.capreb.init(p)
+	p.clients.init(p)
+	p.audit.init(p)
 
 	//
 	// REST API: register proxy handlers and start listening
@@ -214,22 +221,28 @@ func (p *proxy) Run() error {
 		{r: apc.Reverse, h: p.reverseHandler, net: accessNetPublic},
 
 		// pubnet handlers: cluster must be started
-		{r: apc.Buckets, h: p.bucketHandler, net: accessNetPublic},
-		{r: apc.Objects, h: p.objectHandler, net: accessNetPublic},
-		{r: apc.Download, h: p.downloadHandler, net: accessNetPublic},
-		{r: apc.ETL, h: p.etlHandler, net: accessNetPublic},
-		{r: apc.Sort, h: p.dsortHandler, net: accessNetPublic},
+		// (user-facing handlers are wrapped to negotiate API revision - see prxapiver.go)
+		{r: apc.Buckets, h: p.apiVer(p.bucketHandler), net: accessNetPublic},
+		{r: apc.Objects, h: p.apiVer(p.objectHandler), net: accessNetPublic},
+		{r: apc.Download, h: p.apiVer(p.downloadHandler), net: accessNetPublic},
+		{r: apc.ETL, h: p.apiVer(p.etlHandler), net: accessNetPublic},
+		{r: apc.Sort, h: p.apiVer(p.dsortHandler), net: accessNetPublic},
 
 		{r: apc.IC, h: p.ic.handler, net: accessNetIntraControl},
-		{r: apc.Daemon, h: p.daemonHandler, net: accessNetPublicControl},
-		{r: apc.Cluster, h: p.clusterHandler, net: accessNetPublicControl},
-		{r: apc.Tokens, h: p.tokenHandler, net: accessNetPublic},
+		{r: apc.Daemon, h: p.apiVer(p.daemonHandler), net: accessNetPublicControl},
+		{r: apc.Cluster, h: p.apiVer(p.clusterHandler), net: accessNetPublicControl},
+		{r: apc.Tokens, h: p.apiVer(p.tokenHandler), net: accessNetPublic},
 
 		{r: apc.Metasync, h: p.metasyncHandler, net: accessNetIntraControl},
 		{r: apc.Health, h: p.healthHandler, net: accessNetPublicControl},
 		{r: apc.Vote, h: p.voteHandler, net: accessNetIntraControl},
+		{r: apc.OpenAPI, h: p.openapiHandler, net: accessNetPublic},
 
 		{r: apc.Notifs, h: p.notifs.handler, net: accessNetIntraControl},
+		{r: apc.Tunnel, h: p.tunnelHandler, net: accessControlData},
+
+		// "/v2/..." alias
+		{r: "/" + apc.VersionV2 + "/", h: p.apiV2Handler, net: accessNetPublic},
 
 		// S3 compatibility
 		{r: "/" + apc.S3, h: p.s3Handler, net: accessNetPublic},
@@ -374,6 +387,7 @@ func (p *proxy) _parseReqTry(w http.ResponseWriter, r *http.Request, bckArgs *bc
 
 // verb /v1/buckets/
 func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
+	defer p.clients.account(r)
 	if !p.cluStartedWithRetry() {
 		w.WriteHeader(http.StatusServiceUnavailable)
 		return
@@ -407,6 +421,7 @@ func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
 
 // verb /v1/objects/
 func (p *proxy) objectHandler(w http.ResponseWriter, r *http.Request) {
+	defer p.clients.account(r)
 	switch r.Method {
 	case http.MethodGet:
 		p.httpobjget(w, r)
@@ -557,6 +572,46 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		p.bucketSummary(w, r, qbck, msg, dpq)
 		return
 	}
+	// batch HEAD
+	if msg.Action == apc.ActHeadMultiObjs {
+		p.headMulti(w, r, qbck, msg, dpq)
+		return
+	}
+	// bucket change notifications
+	if msg.Action == apc.ActWatchBck {
+		p.watchBucket(w, r, qbck, msg, dpq)
+		return
+	}
+	// batch GET
+	if msg.Action == apc.ActGetBatch {
+		p.getBatch(w, r, qbck, msg, dpq)
+		return
+	}
+	// random sample
+	if msg.Action == apc.ActSample {
+		p.sample(w, r, qbck, msg, dpq)
+		return
+	}
+	// object provenance
+	if msg.Action == apc.ActObjProvenance {
+		p.objProvenance(w, r, qbck, msg, dpq)
+		return
+	}
+	// list buckets with filters and usage
+	if msg.Action == apc.ActListBckInfo {
+		if qbck.Name != "" && qbck.Name != msg.Name {
+			p.writeErrf(w, r, "bad %q request: %q vs %q", msg.Action, qbck.Name, msg.Name)
+			return
+		}
+		qbck.Name = msg.Name
+		if qbck.IsRemoteAIS() {
+			qbck.Ns.UUID = p.a2u(qbck.Ns.UUID)
+		}
+		if err := p.checkAccess(w, r, nil, apc.AceListBuckets); err == nil {
+			p.listBckInfo(w, r, qbck, msg, dpq)
+		}
+		return
+	}
 	// invalid action
 	if msg.Action != apc.ActList {
 		p.writeErrAct(w, r, msg.Action)
@@ -646,13 +701,19 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 
 	// 3. redirect
 	smap := p.owner.smap.get()
+	if bck.Props.Union.IsSet() {
+		if p.redirectUnion(w, r, bck, objName, smap, cmn.NetIntraData, http.StatusMovedPermanently) {
+			p.statsT.Inc(stats.GetCount)
+		}
+		return
+	}
 	tsi, err := cluster.HrwTarget(bck.MakeUname(objName), &smap.Smap)
 	if err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
 	if cmn.FastV(5, cos.SmoduleAIS) {
-		nlog.Infoln("GET " + bck.Cname(objName) + " => " + tsi.String())
+		nlog.InfofCtx(r.Context(), "GET %s => %s", bck.Cname(objName), tsi)
 	}
 	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraData)
 	http.Redirect(w, r, redirectURL, http.StatusMovedPermanently)
@@ -730,7 +791,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		if bck.Props.Mirror.Enabled {
 			s = " (put-mirror)"
 		}
-		nlog.Infof("%s %s => %s%s", verb, bck.Cname(objName), tsi, s)
+		nlog.InfofCtx(r.Context(), "%s %s => %s%s", verb, bck.Cname(objName), tsi, s)
 	}
 
 	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData)
@@ -765,7 +826,7 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if cmn.FastV(5, cos.SmoduleAIS) {
-		nlog.Infoln("DELETE " + bck.Cname(objName) + " => " + tsi.String())
+		nlog.InfofCtx(r.Context(), "DELETE %s => %s", bck.Cname(objName), tsi)
 	}
 	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
@@ -784,7 +845,7 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 		return
 	}
 	perms := apc.AceDestroyBucket
-	if msg.Action == apc.ActDeleteObjects || msg.Action == apc.ActEvictObjects {
+	if msg.Action == apc.ActDeleteObjects || msg.Action == apc.ActEvictObjects || msg.Action == apc.ActDeleteMultiObjs {
 		perms = apc.AceObjDELETE
 	}
 
@@ -844,7 +905,7 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 		}
 		if err := p.destroyBucket(msg, bck); err != nil {
 			if cmn.IsErrBckNotFound(err) {
-				nlog.Infof("%s: %s already %q-ed, nothing to do", p, bck, msg.Action)
+				nlog.InfofCtx(r.Context(), "%s: %s already %q-ed, nothing to do", p, bck, msg.Action)
 			} else {
 				p.writeErr(w, r, err)
 			}
@@ -861,6 +922,22 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 		}
 		w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xid)))
 		w.Write([]byte(xid))
+	case apc.ActDeleteMultiObjs:
+		lrMsg := &apc.ListRange{}
+		if err := cos.MorphMarshal(msg.Value, lrMsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if !lrMsg.IsList() {
+			p.writeErrf(w, r, "%s: %q expects a list of object names", p.si, msg.Action)
+			return
+		}
+		out, err := p.deleteMulti(bck, lrMsg.ObjNames, apireq.query)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeJSON(w, r, out, msg.Action)
 	default:
 		p.writeErrAct(w, r, msg.Action)
 	}
@@ -1167,7 +1244,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 		if err := p.checkAccess(w, r, nil, apc.AceMoveBucket); err != nil {
 			return
 		}
-		nlog.Infof("%s bucket %s => %s", msg.Action, bckFrom, bckTo)
+		nlog.InfofCtx(r.Context(), "%s bucket %s => %s", msg.Action, bckFrom, bckTo)
 		if xid, err = p.renameBucket(bckFrom, bckTo, msg); err != nil {
 			p.writeErr(w, r, err)
 			return
@@ -1194,6 +1271,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 				return
 			}
+			if err := tcbmsg.Validate(false); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
 		}
 		bckTo, err = newBckFromQuname(query, true /*required*/)
 		if err != nil {
@@ -1216,7 +1297,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
 				return
 			}
-			nlog.Warningf("%s: dst %s doesn't exist and will be created with the src (%s) props", p, bckTo, bck)
+			nlog.WarningfCtx(r.Context(), "%s: dst %s doesn't exist and will be created with the src (%s) props", p, bckTo, bck)
 		}
 
 		// start x-tcb or x-tco
@@ -1239,7 +1320,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			}
 			xid, err = lstcx.do()
 		} else {
-			nlog.Infof("%s: %s => %s", msg.Action, bck, bckTo)
+			nlog.InfofCtx(r.Context(), "%s: %s => %s", msg.Action, bck, bckTo)
 			xid, err = p.tcb(bck, bckTo, msg, tcbmsg.DryRun)
 		}
 		if err != nil {
@@ -1257,11 +1338,16 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if tcomsg.Xform != "" {
+			p.writeErrf(w, r, "%s: cross-object transforms (%q) are supported bucket-to-bucket only",
+				msg.Action, tcomsg.Xform)
+			return
+		}
 		bckTo = meta.CloneBck(&tcomsg.ToBck)
 
 		if bck.Equal(bckTo, true, true) {
 			eq = true
-			nlog.Warningf("multi-obj %s within the same bucket %q", msg.Action, bck)
+			nlog.WarningfCtx(r.Context(), "multi-obj %s within the same bucket %q", msg.Action, bck)
 		}
 		if bckTo.IsHTTP() {
 			p.writeErrf(w, r, "cannot %s to HTTP bucket %q", msg.Action, bckTo)
@@ -1279,11 +1365,11 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
 					return
 				}
-				nlog.Warningf("%s: dst %s doesn't exist and will be created with src %s props", p, bck, bckTo)
+				nlog.WarningfCtx(r.Context(), "%s: dst %s doesn't exist and will be created with src %s props", p, bck, bckTo)
 			}
 		}
 
-		nlog.Infof("multi-obj %s %s => %s", msg.Action, bck, bckTo)
+		nlog.InfofCtx(r.Context(), "multi-obj %s %s => %s", msg.Action, bck, bckTo)
 		if xid, err = p.tcobjs(bck, bckTo, msg, tcomsg.TCBMsg.CopyBckMsg.DryRun); err != nil {
 			p.writeErr(w, r, err)
 			return
@@ -1309,11 +1395,35 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 	case apc.ActInvalListCache:
 		p.qm.c.invalidate(bck.Bucket())
 		return
+	case apc.ActTrainDict:
+		if p.forwardCP(w, r, msg, bucket) {
+			return
+		}
+		if err := p.checkAccess(w, r, bck, apc.AcePATCH); err != nil {
+			return
+		}
+		version, err := p.trainDict(msg, bck)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		s := strconv.Itoa(version)
+		w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(s)))
+		w.Write([]byte(s))
+		return
 	case apc.ActMakeNCopies:
 		if xid, err = p.makeNCopies(msg, bck); err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActTransitionBck:
+		if err := p.checkAccess(w, r, bck, apc.AcePATCH); err != nil {
+			return
+		}
+		if xid, err = p.transitionBck(msg, bck); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 	case apc.ActECEncode:
 		if xid, err = p.ecEncode(bck, msg); err != nil {
 			p.writeErr(w, r, err)
@@ -1432,6 +1542,10 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 			return
 		}
 		bck.Props = nprops
+		if err := p.initUnionProp(bck, nprops); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		if backend := bck.Backend(); backend != nil {
 			if err := backend.Validate(); err != nil {
 				p.writeErrf(w, r, "cannot create %s: invalid backend %s, err: %v", bck, backend, err)
@@ -1490,6 +1604,18 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	if bck.IsHTTP() || lsmsg.IsFlagSet(apc.LsArchDir) {
 		lsmsg.SetFlag(apc.LsObjCached)
 	}
+	// ditto: tags are stored in-cluster
+	if lsmsg.Tags != "" {
+		if _, err := cmn.ParseTagFilter(lsmsg.Tags); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		lsmsg.SetFlag(apc.LsObjCached)
+	}
+	if bck.Props.Union.IsSet() {
+		p.listUnion(w, r, bck, lsmsg, beg)
+		return
+	}
 
 	var (
 		nl                         nl.Listener
@@ -1547,7 +1673,7 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 			if lsmsg.SID != "" {
 				s += " via " + tsi.StringEx()
 			}
-			nlog.Infof("%s[%s] %s%s", amsg.Action, lsmsg.UUID, bck.Cname(""), s)
+			nlog.InfofCtx(r.Context(), "%s[%s] %s%s", amsg.Action, lsmsg.UUID, bck.Cname(""), s)
 		}
 
 		lst, err = p.lsObjsR(bck, lsmsg, smap, tsi, wantOnlyRemote)
@@ -1649,7 +1775,9 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	if err != nil {
 		return
 	}
-	if msg.Action == apc.ActRenameObject {
+	switch msg.Action {
+	case apc.ActRenameObject, apc.ActUndeleteObject, apc.ActPresign, apc.ActCopyObjRemote,
+		apc.ActAcquireLease, apc.ActRenewLease, apc.ActReleaseLease:
 		apireq.after = 2
 	}
 	if err := p.parseReq(w, r, apireq); err != nil {
@@ -1677,6 +1805,31 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		p.objMv(w, r, bck, apireq.items[1], msg)
 		return
+	case apc.ActUndeleteObject:
+		if err := p.checkAccess(w, r, bck, apc.AcePUT); err != nil {
+			return
+		}
+		if bck.IsRemote() {
+			p.writeErrActf(w, r, msg.Action, "not supported for remote buckets (%s)", bck)
+			return
+		}
+		p.redirectObjAction(w, r, bck, apireq.items[1])
+		return
+	case apc.ActPresign:
+		p.presign(w, r, bck, apireq.items[1], msg)
+		return
+	case apc.ActCopyObjRemote:
+		if err := p.checkAccess(w, r, bck, apc.AceGET); err != nil {
+			return
+		}
+		p.copyObjRemote(w, r, bck, apireq.items[1], apireq.query)
+		return
+	case apc.ActAcquireLease, apc.ActRenewLease, apc.ActReleaseLease:
+		if err := p.checkAccess(w, r, bck, apc.AcePUT); err != nil {
+			return
+		}
+		p.redirectLease(w, r, bck)
+		return
 	case apc.ActPromote:
 		if err := p.checkAccess(w, r, bck, apc.AcePromote); err != nil {
 			return
@@ -1847,10 +2000,15 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 		return
 	}
 	// make and validate new props
+	base := bck.Props
 	if nprops, err = p.makeNewBckProps(bck, &propsToUpdate); err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
+	if err = p.initUnionProp(bck, nprops); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
 	if !nprops.BackendBck.IsEmpty() {
 		// backend must exist
 		backendBck := meta.CloneBck(&nprops.BackendBck)
@@ -1865,8 +2023,21 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
-	if xid, err = p.setBucketProps(msg, bck, nprops); err != nil {
-		p.writeErr(w, r, err)
+	if msg.Action != apc.ActSetBprops {
+		xid, err = p.setBucketProps(msg, bck, nprops)
+	} else {
+		xid, err = p.setBucketProps(msg, bck, nprops, base) // all or nothing
+	}
+	if err != nil {
+		if errors.Is(err, errPropsChanged) {
+			p.writeErr(w, r, err, http.StatusConflict)
+		} else {
+			p.writeErr(w, r, err)
+		}
+		return
+	}
+	if msg.Action == apc.ActSetBprops && cos.IsParseBool(apireq.query.Get(apc.QparamPropsDiff)) {
+		p.writeJSON(w, r, &cmn.BpropsUpdateResult{Xid: xid, Diff: base.Diff(nprops)}, "set-bprops")
 		return
 	}
 	w.Write([]byte(xid))
@@ -1890,13 +2061,17 @@ func (p *proxy) httpobjhead(w http.ResponseWriter, r *http.Request, origURLBck .
 		return
 	}
 	smap := p.owner.smap.get()
+	if bck.Props.Union.IsSet() {
+		p.redirectUnion(w, r, bck, objName, smap, cmn.NetIntraControl, http.StatusTemporaryRedirect)
+		return
+	}
 	si, err := cluster.HrwTarget(bck.MakeUname(objName), &smap.Smap)
 	if err != nil {
 		p.writeErr(w, r, err, http.StatusInternalServerError)
 		return
 	}
 	if cmn.FastV(5, cos.SmoduleAIS) {
-		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si.StringEx())
+		nlog.InfofCtx(r.Context(), "%s %s => %s", r.Method, bck.Cname(objName), si.StringEx())
 	}
 	redirectURL := p.redirectURL(r, si, time.Now() /*started*/, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
@@ -1924,7 +2099,7 @@ func (p *proxy) httpobjpatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if cmn.FastV(5, cos.SmoduleAIS) {
-		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si.StringEx())
+		nlog.InfofCtx(r.Context(), "%s %s => %s", r.Method, bck.Cname(objName), si.StringEx())
 	}
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
@@ -2184,6 +2359,9 @@ func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netNa
 
 	query.Set(apc.QparamProxyID, p.SID())
 	query.Set(apc.QparamUnixTime, cos.UnixNano2S(ts.UnixNano()))
+	if rid := nlog.ReqID(r.Context()); rid != "" && !strings.Contains(r.URL.RawQuery, apc.QparamReqID+"=") {
+		query.Set(apc.QparamReqID, rid)
+	}
 	redirect += query.Encode()
 	return
 }
@@ -2375,6 +2553,62 @@ func (p *proxy) objMv(w http.ResponseWriter, r *http.Request, bck *meta.Bck, obj
 	p.statsT.Inc(stats.RenameCount)
 }
 
+// source object's target streams the object directly to the remote cluster
+// (via remote AIS backend); the destination is ais://@uuid-or-alias/bucket
+func (p *proxy) copyObjRemote(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, query url.Values) {
+	started := time.Now()
+	bckTo, err := newBckFromQuname(query, true /*required*/)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if !bckTo.IsRemoteAIS() {
+		p.writeErrf(w, r, "%s: destination %s is not a remote AIS bucket (expecting ais://@uuid-or-alias/bucket)",
+			apc.ActCopyObjRemote, bckTo)
+		return
+	}
+	bckTo.Ns.UUID = p.a2u(bckTo.Ns.UUID)
+	if bckTo, _, err = p.initBckTo(w, r, query, bckTo); err != nil {
+		return
+	}
+	smap := p.owner.smap.get()
+	si, err := cluster.HrwTarget(bck.MakeUname(objName), &smap.Smap)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	query.Set(apc.QparamBckTo, bckTo.MakeUname(""))
+	r.URL.RawQuery = query.Encode()
+	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
+// all leases of a given bucket are kept by the bucket's HRW target (see tgtlease.go)
+func (p *proxy) redirectLease(w http.ResponseWriter, r *http.Request, bck *meta.Bck) {
+	started := time.Now()
+	smap := p.owner.smap.get()
+	si, err := cluster.HrwTarget(bck.MakeUname(""), &smap.Smap)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
+// redirect to the object's (HRW) target
+func (p *proxy) redirectObjAction(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	started := time.Now()
+	smap := p.owner.smap.get()
+	si, err := cluster.HrwTarget(bck.MakeUname(objName), &smap.Smap)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
 func (p *proxy) listrange(method, bucket string, msg *apc.ActMsg, query url.Values) (xid string, err error) {
 	var (
 		smap   = p.owner.smap.get()
@@ -2403,6 +2637,262 @@ func (p *proxy) listrange(method, bucket string, msg *apc.ActMsg, query url.Valu
 	return
 }
 
+// synchronous multi-object delete (compare with asynchronous `listrange` above):
+// send each target the names of the objects it owns and collect per-object results
+func (p *proxy) deleteMulti(bck *meta.Bck, objNames []string, query url.Values) (*apc.DeleteMultiResult, error) {
+	var (
+		smap  = p.owner.smap.get()
+		names = make(map[*meta.Snode][]string, smap.CountActiveTs())
+		out   = &apc.DeleteMultiResult{Deleted: make([]string, 0, len(objNames))}
+		path  = apc.URLPathBuckets.Join(bck.Name)
+		mu    = &sync.Mutex{}
+		wg    = &sync.WaitGroup{}
+	)
+	for _, name := range objNames {
+		tsi, err := cluster.HrwTarget(bck.MakeUname(name), &smap.Smap)
+		if err != nil {
+			return nil, err
+		}
+		names[tsi] = append(names[tsi], name)
+	}
+	for tsi, tnames := range names {
+		wg.Add(1)
+		go func(tsi *meta.Snode, tnames []string) {
+			body := cos.MustMarshal(p.newAmsgActVal(apc.ActDeleteMultiObjs, &apc.ListRange{ObjNames: tnames}))
+			cargs := allocCargs()
+			{
+				cargs.si = tsi
+				cargs.req = cmn.HreqArgs{Method: http.MethodDelete, Path: path, Query: query, Body: body}
+				cargs.timeout = apc.LongTimeout
+				cargs.cresv = cresDM{}
+			}
+			res := p.call(cargs, smap)
+			freeCargs(cargs)
+			mu.Lock()
+			if res.err != nil {
+				status := res.status
+				if status == 0 {
+					status = http.StatusInternalServerError
+				}
+				for _, name := range tnames {
+					out.Failed = append(out.Failed, apc.DeleteMultiErr{ObjName: name, Message: res.err.Error(), Status: status})
+				}
+			} else {
+				out.Merge(res.v.(*apc.DeleteMultiResult))
+			}
+			mu.Unlock()
+			freeCR(res)
+			wg.Done()
+		}(tsi, tnames)
+	}
+	wg.Wait()
+	return out, nil
+}
+
+// batch HEAD: properties of the named objects (in-cluster only) in the order of the request;
+// objects that are not present are returned with no properties and `EntryIsCached` bit not set
+func (p *proxy) headMulti(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	if !qbck.IsBucket() {
+		p.writeErrf(w, r, "bad %q request: %q is not a bucket", msg.Action, qbck)
+		return
+	}
+	lrMsg := &apc.ListRange{}
+	if err := cos.MorphMarshal(msg.Value, lrMsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if !lrMsg.IsList() {
+		p.writeErrf(w, r, "%s: %q expects a list of object names", p.si, msg.Action)
+		return
+	}
+	bckArgs := bckInitArgs{p: p, w: w, r: r, msg: msg, perms: apc.AceObjHEAD, bck: (*meta.Bck)(qbck), dpq: dpq}
+	bckArgs.createAIS = false
+	bckArgs.dontHeadRemote = true
+	bck, err := bckArgs.initAndTry()
+	if err != nil {
+		return
+	}
+	var (
+		smap  = p.owner.smap.get()
+		names = make(map[*meta.Snode][]string, smap.CountActiveTs())
+		order = make(map[string]int, len(lrMsg.ObjNames))
+		lst   = &cmn.LsoResult{Entries: make([]*cmn.LsoEntry, 0, len(lrMsg.ObjNames))}
+		path  = apc.URLPathBuckets.Join(bck.Name)
+		query = bck.AddToQuery(nil)
+		mu    = &sync.Mutex{}
+		wg    = &sync.WaitGroup{}
+	)
+	for _, name := range lrMsg.ObjNames {
+		if _, ok := order[name]; ok {
+			continue
+		}
+		order[name] = len(order)
+		tsi, err := cluster.HrwTarget(bck.MakeUname(name), &smap.Smap)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		names[tsi] = append(names[tsi], name)
+	}
+	for tsi, tnames := range names {
+		wg.Add(1)
+		go func(tsi *meta.Snode, tnames []string) {
+			body := cos.MustMarshal(p.newAmsgActVal(apc.ActHeadMultiObjs, &apc.ListRange{ObjNames: tnames}))
+			cargs := allocCargs()
+			{
+				cargs.si = tsi
+				cargs.req = cmn.HreqArgs{Method: http.MethodGet, Path: path, Query: query, Body: body}
+				cargs.timeout = apc.LongTimeout
+				cargs.cresv = cresLso{} // -> cmn.LsoResult
+			}
+			res := p.call(cargs, smap)
+			freeCargs(cargs)
+			mu.Lock()
+			if res.err != nil {
+				if err == nil {
+					err = res.toErr()
+				}
+			} else {
+				lst.Entries = append(lst.Entries, res.v.(*cmn.LsoResult).Entries...)
+			}
+			mu.Unlock()
+			freeCR(res)
+			wg.Done()
+		}(tsi, tnames)
+	}
+	wg.Wait()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	sort.Slice(lst.Entries, func(i, j int) bool { return order[lst.Entries[i].Name] < order[lst.Entries[j].Name] })
+
+	if strings.Contains(r.Header.Get(cos.HdrAccept), cos.ContentMsgPack) {
+		p.writeMsgPack(w, lst, msg.Action)
+	} else {
+		p.writeJS(w, r, lst, msg.Action)
+	}
+}
+
+// GET /v1/buckets/bucket-name (apc.ActGetBatch)
+// redirect to the target that stores the most of the requested objects - the one that
+// will then assemble the archive, reading the rest from its peers (see tgtbatch.go)
+func (p *proxy) getBatch(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	if !qbck.IsBucket() {
+		p.writeErrf(w, r, "bad %q request: %q is not a bucket", msg.Action, qbck)
+		return
+	}
+	gbmsg := &apc.GetBatchMsg{}
+	if err := cos.MorphMarshal(msg.Value, gbmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if len(gbmsg.ObjNames) == 0 {
+		p.writeErrf(w, r, "%s: %q expects a list of object names", p.si, msg.Action)
+		return
+	}
+	if _, err := batchMime(gbmsg.Mime); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	bckArgs := bckInitArgs{p: p, w: w, r: r, msg: msg, perms: apc.AceGET, bck: (*meta.Bck)(qbck), dpq: dpq}
+	bckArgs.createAIS = false
+	bck, err := bckArgs.initAndTry()
+	if err != nil {
+		return
+	}
+	var (
+		tsi     *meta.Snode
+		started = time.Now()
+		smap    = p.owner.smap.get()
+		counts  = make(map[*meta.Snode]int, smap.CountActiveTs())
+	)
+	for _, name := range gbmsg.ObjNames {
+		si, err := cluster.HrwTarget(bck.MakeUname(name), &smap.Smap)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		counts[si]++
+		if tsi == nil || counts[si] > counts[tsi] {
+			tsi = si
+		}
+	}
+	// NOTE: 307 is the only way to http-redirect with the original JSON payload
+	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
+// GET /v1/buckets/bucket-name (apc.ActSample)
+// redirect to a random target that will then collect samples from all targets
+// and assemble the archive (see tgtsample.go)
+func (p *proxy) sample(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	if !qbck.IsBucket() {
+		p.writeErrf(w, r, "bad %q request: %q is not a bucket", msg.Action, qbck)
+		return
+	}
+	smsg := &apc.SampleMsg{}
+	if err := cos.MorphMarshal(msg.Value, smsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if smsg.NumObjs <= 0 || smsg.NumObjs > maxSampleObjs {
+		p.writeErrf(w, r, "%s: invalid number of objects to sample %d (expecting (0, %d])", p.si, smsg.NumObjs, maxSampleObjs)
+		return
+	}
+	if smsg.Length < 0 {
+		p.writeErrf(w, r, "%s: invalid sample length %d", p.si, smsg.Length)
+		return
+	}
+	if _, err := batchMime(smsg.Mime); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	bckArgs := bckInitArgs{p: p, w: w, r: r, msg: msg, perms: apc.AceGET | apc.AceObjLIST, bck: (*meta.Bck)(qbck), dpq: dpq}
+	bckArgs.createAIS = false
+	if _, err := bckArgs.initAndTry(); err != nil {
+		return
+	}
+	started := time.Now()
+	smap := p.owner.smap.get()
+	tsi, err := smap.GetRandTarget()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	// NOTE: 307 is the only way to http-redirect with the original JSON payload
+	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
+// GET { apc.ActObjProvenance } /v1/buckets/bucket-name
+// redirects to the target that (as per HRW) stores the named object (msg.Name)
+func (p *proxy) objProvenance(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	if !qbck.IsBucket() {
+		p.writeErrf(w, r, "bad %q request: %q is not a bucket", msg.Action, qbck)
+		return
+	}
+	if msg.Name == "" {
+		p.writeErrf(w, r, "%s: %q expects object name", p.si, msg.Action)
+		return
+	}
+	bckArgs := bckInitArgs{p: p, w: w, r: r, msg: msg, perms: apc.AceObjHEAD, bck: (*meta.Bck)(qbck), dpq: dpq}
+	bckArgs.createAIS = false
+	bck, err := bckArgs.initAndTry()
+	if err != nil {
+		return
+	}
+	started := time.Now()
+	smap := p.owner.smap.get()
+	tsi, err := cluster.HrwTarget(bck.MakeUname(msg.Name), &smap.Smap)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraControl)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
 func (p *proxy) reverseHandler(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := p.parseURL(w, r, 1, false, apc.URLPathReverse.L)
 	if err != nil {
@@ -2422,6 +2912,8 @@ func (p *proxy) reverseHandler(w http.ResponseWriter, r *http.Request) {
 	if si != nil && si.InMaintOrDecomm() {
 		daeStatus := "inactive"
 		switch {
+		case si.Flags.IsSet(meta.SnodeSpare):
+			daeStatus = apc.NodeSpare
 		case si.Flags.IsSet(meta.SnodeMaint):
 			daeStatus = apc.NodeMaintenance
 		case si.Flags.IsSet(meta.SnodeDecomm):
@@ -2485,6 +2977,38 @@ func (p *proxy) reverseHandler(w http.ResponseWriter, r *http.Request) {
 	p.reverseRequest(w, r, nodeID, parsedURL)
 }
 
+// PUT /v1/tunnel/<target-id>/objstream/<trname>
+// relays intra-cluster stream when the sending target fails to connect directly (see transport/relay.go)
+func (p *proxy) tunnelHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		cmn.WriteErr405(w, r, http.MethodPut)
+		return
+	}
+	apiItems, err := p.parseURL(w, r, 3, false, apc.URLPathTunnel.L)
+	if err != nil {
+		return
+	}
+	tid, endp, trname := apiItems[0], apiItems[1], apiItems[2]
+	if endp != apc.ObjStream && endp != apc.MsgStream {
+		p.writeErrURL(w, r)
+		return
+	}
+	smap := p.owner.smap.get()
+	tsi := smap.GetTarget(tid)
+	if tsi == nil {
+		err = &errNodeNotFound{"cannot relay " + trname + " to", tid, p.si, smap}
+		p.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	parsedURL, err := url.Parse(tsi.URL(cmn.NetIntraData))
+	debug.AssertNoErr(err)
+	if cmn.FastV(4, cos.SmoduleAIS) {
+		nlog.Infof("%s: relaying %s => %s", p, trname, tsi.StringEx())
+	}
+	r.URL.Path = cos.JoinWords(apc.Version, endp, trname)
+	p.reverseRequest(w, r, apc.Tunnel+"-"+tid, parsedURL)
+}
+
 ///////////////////////////
 // http /daemon handlers //
 ///////////////////////////
@@ -2552,6 +3076,10 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 	case apc.WhatSysInfo:
 		p.writeJSON(w, r, apc.GetMemCPU(), what)
+	case apc.WhatClientStats:
+		p.writeJSON(w, r, p.clients.get(), what)
+	case apc.WhatAuthAlerts:
+		p.writeJSON(w, r, p.audit.get(), what)
 	case apc.WhatSmap:
 		const max = 16
 		var (
@@ -0,0 +1,161 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/stats"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// API version negotiation (see apc.APIVersion for the revision history):
+// - user requests are versioned via apc.HdrAPIVersion or "/v2/..." URL paths; none means
+//   the current revision (so that unversioned clients - curl, SDKs - are never flagged);
+// - requests that explicitly state an older (deprecated) revision are upgraded in place by
+//   the compatibility shims (below) prior to being handled as usual - intra-cluster
+//   calls and redirects then carry the current revision;
+// - the shims only ever modify control-plane action messages (apc.ActMsg) - never object data;
+// - deprecated usage is counted (stats.APIDeprecatedCount and, per client, apc.ClientUsage)
+//   and reported back to the client via "Deprecation" and "Warning" response headers.
+
+const apiShimMaxBody = cos.MiB // (action messages only)
+
+type apiShim struct {
+	name  string
+	below int // applies to API revisions below this one
+	fn    func(msg map[string]any) bool
+}
+
+var (
+	apiVersionCur = strconv.Itoa(apc.APIVersion)
+
+	// none so far: revision 2 is a superset of revision 1 (see apc.APIVersion)
+	apiShims []apiShim
+)
+
+// wraps user-facing handlers
+func (p *proxy) apiVer(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(apc.HdrAPIVersion, apiVersionCur)
+		if r.Header.Get(apc.HdrCallerID) != "" {
+			h(w, r)
+			return
+		}
+		ver, err := reqAPIVersion(r.Header)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if ver < apc.APIVersion {
+			if err := shimReq(r, ver); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
+			p.statsT.Inc(stats.APIDeprecatedCount)
+			p.clients.deprecated(r)
+			w.Header().Set(apc.HdrDeprecation, "true")
+			w.Header().Set(apc.HdrWarning,
+				fmt.Sprintf("299 - \"API revision %d is deprecated, please upgrade to %d\"", ver, apc.APIVersion))
+		}
+		h(w, r)
+	}
+}
+
+// "/v2/..." => "/v1/..." with apc.HdrAPIVersion (unless the latter is specified explicitly)
+func (p *proxy) apiV2Handler(w http.ResponseWriter, r *http.Request) {
+	apiV2toV1(r)
+	p.netServ.pub.muxers.ServeHTTP(w, r)
+}
+
+func apiV2toV1(r *http.Request) {
+	const (
+		v1 = "/" + apc.Version
+		v2 = "/" + apc.VersionV2
+	)
+	r.URL.Path = v1 + strings.TrimPrefix(r.URL.Path, v2)
+	if r.URL.RawPath != "" {
+		r.URL.RawPath = v1 + strings.TrimPrefix(r.URL.RawPath, v2)
+	}
+	if r.Header.Get(apc.HdrAPIVersion) == "" {
+		r.Header.Set(apc.HdrAPIVersion, "2")
+	}
+}
+
+func reqAPIVersion(hdr http.Header) (int, error) {
+	s := hdr.Get(apc.HdrAPIVersion)
+	if s == "" {
+		return apc.APIVersion, nil
+	}
+	ver, err := strconv.Atoi(s)
+	if err != nil || ver < apc.APIVersionMin || ver > apc.APIVersion {
+		return 0, fmt.Errorf("unsupported API revision %q (supported: %d through %d)", s, apc.APIVersionMin, apc.APIVersion)
+	}
+	return ver, nil
+}
+
+// apply the shims to the request's action message, if any, and mark the request as upgraded
+func shimReq(r *http.Request, ver int) error {
+	r.Header.Set(apc.HdrAPIVersion, apiVersionCur)
+	var shims []apiShim
+	for _, shim := range apiShims {
+		if ver < shim.below {
+			shims = append(shims, shim)
+		}
+	}
+	if len(shims) == 0 || !isActMsgReq(r) {
+		return nil
+	}
+	b, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return err
+	}
+	var (
+		msg      map[string]any
+		modified bool
+	)
+	if jsoniter.Unmarshal(b, &msg) == nil {
+		if _, ok := msg["action"]; ok {
+			for _, shim := range shims {
+				if shim.fn(msg) {
+					if cmn.FastV(4, cos.SmoduleAIS) {
+						nlog.Infof("API revision %d: %s %s: applied %q", ver, r.Method, r.URL.Path, shim.name)
+					}
+					modified = true
+				}
+			}
+		}
+	}
+	if modified {
+		b = cos.MustMarshal(msg)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	r.ContentLength = int64(len(b))
+	return nil
+}
+
+// (JSON-encoded) control-plane action message, as opposed to object data
+func isActMsgReq(r *http.Request) bool {
+	if r.Body == nil || r.ContentLength <= 0 || r.ContentLength > apiShimMaxBody {
+		return false
+	}
+	if !strings.HasPrefix(r.Header.Get(cos.HdrContentType), cos.ContentJSON) {
+		return false
+	}
+	if strings.HasPrefix(r.URL.Path, apc.URLPathObjects.S) {
+		return r.Method == http.MethodPost || r.Method == http.MethodDelete
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReqAPIVersion(t *testing.T) {
+	tests := []struct {
+		hdr string
+		ver int
+		err bool
+	}{
+		{"", apc.APIVersion, false},
+		{"1", 1, false},
+		{strconv.Itoa(apc.APIVersion), apc.APIVersion, false},
+		{"0", 0, true},
+		{strconv.Itoa(apc.APIVersion + 1), 0, true},
+		{"v2", 0, true},
+	}
+	for _, test := range tests {
+		hdr := http.Header{}
+		if test.hdr != "" {
+			hdr.Set(apc.HdrAPIVersion, test.hdr)
+		}
+		ver, err := reqAPIVersion(hdr)
+		if test.err {
+			tassert.Errorf(t, err != nil, "%q: expected error", test.hdr)
+			continue
+		}
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, ver == test.ver, "%q: expected revision %d, got %d", test.hdr, test.ver, ver)
+	}
+}
+
+func TestAPIV2toV1(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/v2/buckets/abc?props=name", http.NoBody)
+	apiV2toV1(r)
+	tassert.Errorf(t, r.URL.Path == "/v1/buckets/abc", "unexpected path %q", r.URL.Path)
+	tassert.Errorf(t, r.URL.RawQuery == "props=name", "unexpected query %q", r.URL.RawQuery)
+	tassert.Errorf(t, r.Header.Get(apc.HdrAPIVersion) == "2", "expected revision 2, got %q", r.Header.Get(apc.HdrAPIVersion))
+
+	// escaped path; explicitly stated revision takes precedence
+	r = httptest.NewRequest(http.MethodGet, "/v2/objects/abc/a%2Fb", http.NoBody)
+	r.Header.Set(apc.HdrAPIVersion, "1")
+	apiV2toV1(r)
+	tassert.Errorf(t, r.URL.RawPath == "/v1/objects/abc/a%2Fb", "unexpected raw path %q", r.URL.RawPath)
+	tassert.Errorf(t, r.Header.Get(apc.HdrAPIVersion) == "1", "expected revision 1, got %q", r.Header.Get(apc.HdrAPIVersion))
+}
+
+func TestShimReq(t *testing.T) {
+	saved := apiShims
+	apiShims = []apiShim{{
+		name:  "test",
+		below: apc.APIVersion,
+		fn: func(msg map[string]any) bool {
+			value, ok := msg["value"].(map[string]any)
+			if ok {
+				value["shimmed"] = true
+			}
+			return ok
+		},
+	}}
+	t.Cleanup(func() { apiShims = saved })
+
+	const body = `{"action": "delete-listrange", "value": {"template": "shard-{1..2}.tar"}}`
+	newReq := func(method, path, ctype, body string) *http.Request {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		if ctype != "" {
+			r.Header.Set(cos.HdrContentType, ctype)
+		}
+		r.Header.Set(apc.HdrAPIVersion, "1")
+		return r
+	}
+	tests := []struct {
+		r       *http.Request
+		ver     int
+		shimmed bool
+	}{
+		{newReq(http.MethodDelete, apc.URLPathBuckets.S+"/abc", cos.ContentJSON, body), 1, true},
+		{newReq(http.MethodPost, apc.URLPathObjects.S+"/abc/obj", cos.ContentJSON, body), 1, true},
+		// current revision
+		{newReq(http.MethodDelete, apc.URLPathBuckets.S+"/abc", cos.ContentJSON, body), apc.APIVersion, false},
+		// object data
+		{newReq(http.MethodPut, apc.URLPathObjects.S+"/abc/obj.json", cos.ContentJSON, body), 1, false},
+		// not JSON
+		{newReq(http.MethodDelete, apc.URLPathBuckets.S+"/abc", "", body), 1, false},
+		// not an action message
+		{newReq(http.MethodPost, apc.URLPathBuckets.S+"/abc", cos.ContentJSON, `{"value": {}}`), 1, false},
+	}
+	for i, test := range tests {
+		err := shimReq(test.r, test.ver)
+		tassert.CheckFatal(t, err)
+		b, err := io.ReadAll(test.r.Body)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, test.r.ContentLength == int64(len(b)), "%d: content length %d vs %d", i, test.r.ContentLength, len(b))
+		shimmed := strings.Contains(string(b), "shimmed")
+		tassert.Errorf(t, shimmed == test.shimmed, "%d: expected shimmed=%t, got %q", i, test.shimmed, b)
+		tassert.Errorf(t, test.r.Header.Get(apc.HdrAPIVersion) == apiVersionCur,
+			"%d: expected upgraded revision, got %q", i, test.r.Header.Get(apc.HdrAPIVersion))
+	}
+}
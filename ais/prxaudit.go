@@ -0,0 +1,219 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/OneOfOne/xxhash"
+)
+
+// AuthN audit (auth.audit): each authenticated user request is logged along with
+// the (digest of the) token it carries, and the token's "span" - its first and last use,
+// number of requests, and client IP ranges - is logged once the token goes idle.
+// In addition, the following anomalies are detected and reported (apc.AuthAlert):
+// - token used from a client IP range (/24 for IPv4, /64 for IPv6) not seen for this user before;
+// - more than auth.delete_spike DELETE requests by a single user within `auditIval`.
+// Alerts are logged, counted (stats.AuthAlertCount), and retained (the most recent
+// `auditMaxAlerts`) to be queried via apc.WhatAuthAlerts.
+
+const (
+	auditName       = "authn-audit" + hk.NameSuffix
+	auditIval       = time.Minute
+	auditIdle       = time.Hour // token span gets logged (and forgotten) when idle for that long
+	auditMaxAlerts  = 256
+	auditMaxRanges  = 64 // per user
+	auditMaxTokens  = 4096
+	dfltDeleteSpike = 1000 // (auth.delete_spike = 0)
+	auditRangeIPv4  = 24
+	auditRangeIPv6  = 64
+)
+
+type (
+	tokSpan struct {
+		user   string
+		ranges cos.StrSet
+		first  int64
+		last   int64
+		reqs   int64
+	}
+	userAudit struct {
+		ranges  map[string]int64 // IP range => last seen
+		dels    int64            // DELETE requests in the current interval
+		alerted bool             // (delete spike) - once per interval
+	}
+	authAudit struct {
+		p      *proxy
+		tokens map[string]*tokSpan   // token digest => span
+		users  map[string]*userAudit // user ID => history
+		alerts apc.AuthAlerts
+		mu     sync.Mutex
+	}
+)
+
+func (a *authAudit) init(p *proxy) {
+	a.p = p
+	a.tokens = make(map[string]*tokSpan, 16)
+	a.users = make(map[string]*userAudit, 16)
+	hk.Reg(auditName, a.housekeep, auditIval)
+}
+
+// (called upon each user request - see clientStats.account)
+func (a *authAudit) record(r *http.Request, user, token string, config *cmn.Config) {
+	if !config.Auth.Audit || user == "" {
+		return
+	}
+	var (
+		now       = time.Now().UnixNano()
+		tid       = strconv.FormatUint(xxhash.ChecksumString64S(token, cos.MLCG32), 36)
+		addr, rng = ipRange(r.RemoteAddr)
+		alerts    []*apc.AuthAlert
+	)
+	nlog.Infoln("audit:", user, "token", tid, "from", addr, r.Method, r.URL.Path)
+
+	a.mu.Lock()
+	// 1. token span
+	span, ok := a.tokens[tid]
+	if !ok {
+		if len(a.tokens) >= auditMaxTokens {
+			a._evictOldest()
+		}
+		span = &tokSpan{user: user, ranges: make(cos.StrSet, 1), first: now}
+		a.tokens[tid] = span
+	}
+	span.last = now
+	span.reqs++
+	span.ranges.Set(rng)
+
+	// 2. user history: new IP range
+	ua, ok := a.users[user]
+	if !ok {
+		ua = &userAudit{ranges: make(map[string]int64, 2)}
+		a.users[user] = ua
+	}
+	if _, ok := ua.ranges[rng]; !ok && len(ua.ranges) > 0 {
+		alerts = append(alerts, &apc.AuthAlert{Kind: apc.AlertNewIPRange, User: user, Token: tid, Addr: addr,
+			Detail: fmt.Sprintf("new client IP range %s (previously seen: %d)", rng, len(ua.ranges)), Time: now})
+	}
+	if len(ua.ranges) >= auditMaxRanges {
+		_evictRange(ua)
+	}
+	ua.ranges[rng] = now
+
+	// 3. delete spike
+	if r.Method == http.MethodDelete {
+		ua.dels++
+		limit := config.Auth.DeleteSpike
+		if limit == 0 {
+			limit = dfltDeleteSpike
+		}
+		if limit > 0 && ua.dels > limit && !ua.alerted {
+			ua.alerted = true
+			alerts = append(alerts, &apc.AuthAlert{Kind: apc.AlertDeleteSpike, User: user, Token: tid, Addr: addr,
+				Detail: fmt.Sprintf("delete requests: %d in the last %v", ua.dels, auditIval), Time: now})
+		}
+	}
+	for _, alert := range alerts {
+		a._raise(alert)
+	}
+	a.mu.Unlock()
+}
+
+// under lock
+func (a *authAudit) _raise(alert *apc.AuthAlert) {
+	nlog.Warningf("%s: audit alert %q: user %q, token %s, from %s: %s", a.p, alert.Kind, alert.User, alert.Token,
+		alert.Addr, alert.Detail)
+	a.p.statsT.Inc(stats.AuthAlertCount)
+	if len(a.alerts) >= auditMaxAlerts {
+		copy(a.alerts, a.alerts[1:])
+		a.alerts = a.alerts[:auditMaxAlerts-1]
+	}
+	a.alerts = append(a.alerts, alert)
+}
+
+// under lock
+func (a *authAudit) _evictOldest() {
+	var (
+		oldest string
+		last   int64
+	)
+	for tid, span := range a.tokens {
+		if oldest == "" || span.last < last {
+			oldest, last = tid, span.last
+		}
+	}
+	a._logSpan(oldest, a.tokens[oldest])
+	delete(a.tokens, oldest)
+}
+
+func _evictRange(ua *userAudit) {
+	var (
+		oldest string
+		last   int64
+	)
+	for rng, seen := range ua.ranges {
+		if oldest == "" || seen < last {
+			oldest, last = rng, seen
+		}
+	}
+	delete(ua.ranges, oldest)
+}
+
+func (*authAudit) _logSpan(tid string, span *tokSpan) {
+	nlog.Infof("audit: user %q, token %s: %d request%s during [%s, %s], from %v", span.user, tid, span.reqs,
+		cos.Plural(int(span.reqs)), cos.FormatNanoTime(span.first, cos.StampMicro),
+		cos.FormatNanoTime(span.last, cos.StampMicro), span.ranges.ToSlice())
+}
+
+func (a *authAudit) housekeep() time.Duration {
+	now := time.Now().UnixNano()
+	a.mu.Lock()
+	for tid, span := range a.tokens {
+		if time.Duration(now-span.last) > auditIdle {
+			a._logSpan(tid, span)
+			delete(a.tokens, tid)
+		}
+	}
+	for _, ua := range a.users {
+		ua.dels, ua.alerted = 0, false
+	}
+	a.mu.Unlock()
+	return auditIval
+}
+
+func (a *authAudit) get() apc.AuthAlerts {
+	a.mu.Lock()
+	out := make(apc.AuthAlerts, len(a.alerts))
+	copy(out, a.alerts)
+	a.mu.Unlock()
+	return out
+}
+
+// returns client IP and its range (network address and prefix length)
+func ipRange(remoteAddr string) (addr, rng string) {
+	addr = remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		addr = host
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return addr, addr
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return addr, ip4.Mask(net.CIDRMask(auditRangeIPv4, 32)).String() + "/" + strconv.Itoa(auditRangeIPv4)
+	}
+	return addr, ip.Mask(net.CIDRMask(auditRangeIPv6, 128)).String() + "/" + strconv.Itoa(auditRangeIPv6)
+}
@@ -7,6 +7,7 @@ package ais
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -118,6 +119,16 @@ func (a *authManager) validateToken(token string) (tk *tok.Token, err error) {
 	return
 }
 
+// user ID of an already validated (and cached) token, or empty string
+func (a *authManager) userID(token string) (uid string) {
+	a.Lock()
+	if tk, ok := a.tkList[token]; ok && tk != nil {
+		uid = tk.UserID
+	}
+	a.Unlock()
+	return
+}
+
 // Decrypts and validates token. Adds it to authManager.token if not found. Removes if expired.
 // Must be called under lock.
 func (a *authManager) validateAddRm(token string, now time.Time) (*tok.Token, error) {
@@ -236,6 +247,59 @@ func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	return
 }
 
+// presigned (time-limited) object URL: valid signature substitutes AuthN token
+// (while bucket ACL still applies); returns (false, nil) if the request is not presigned
+func (p *proxy) accessPresigned(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (bool, error) {
+	if !strings.Contains(r.URL.RawQuery, apc.QparamSignature+"=") {
+		return false, nil
+	}
+	if ace != apc.AceGET && ace != apc.AcePUT {
+		return true, fmt.Errorf("%s: presigned URL cannot be used to %s %s", p, r.Method, r.URL.Path)
+	}
+	if _, err := cmn.CheckPresigned(cmn.GCO.Get().Auth.Secret, r.Method, r.URL.Path, r.URL.Query()); err != nil {
+		return true, err
+	}
+	return true, bck.Allow(ace)
+}
+
+// POST {action: presign} /v1/objects/bucket-name/object-name
+func (p *proxy) presign(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, msg *apc.ActMsg) {
+	var (
+		args   = &apc.PresignMsg{}
+		secret = cmn.GCO.Get().Auth.Secret
+	)
+	if err := cos.MorphMarshal(msg.Value, args); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	ace := apc.AceGET
+	switch {
+	case args.Method == http.MethodPut:
+		ace = apc.AcePUT
+	case args.Method != http.MethodGet:
+		p.writeErrActf(w, r, msg.Action, "invalid method %q (expecting GET or PUT)", args.Method)
+		return
+	}
+	if args.Expiry <= 0 || args.Expiry.D() > cmn.MaxPresignExpiry {
+		p.writeErrActf(w, r, msg.Action, "invalid expiry %v (expecting (0, %v])", args.Expiry, cmn.MaxPresignExpiry)
+		return
+	}
+	// (the caller must have the permission that the URL grants)
+	if err := p.checkAccess(w, r, bck, ace); err != nil {
+		return
+	}
+	if secret == "" {
+		p.writeErrActf(w, r, msg.Action, "%s: auth.secret is not configured", p)
+		return
+	}
+	var (
+		path    = apc.URLPathObjects.Join(bck.Name, objName)
+		expires = time.Now().Add(args.Expiry.D()).Unix()
+		q       = cmn.PresignQuery(secret, args.Method, path, r.URL.Query(), expires)
+	)
+	w.Write([]byte(q.Encode()))
+}
+
 func aceErrToCode(err error) (status int) {
 	switch err {
 	case nil:
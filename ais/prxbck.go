@@ -145,6 +145,9 @@ func (args *bckInitArgs) init() (errCode int, err error) {
 		}
 		args.perms = dtor.Access
 	}
+	if bck.Props.Union.IsSet() && args.perms&unionDeny != 0 {
+		return http.StatusMethodNotAllowed, errUnionRO(bck)
+	}
 	errCode, err = args.access(bck)
 	return
 }
@@ -182,6 +185,13 @@ func (args *bckInitArgs) _requiresPermission(perm apc.AccessAttrs) bool {
 }
 
 func (args *bckInitArgs) access(bck *meta.Bck) (errCode int, err error) {
+	var presigned bool
+	if presigned, err = args.p.accessPresigned(args.r, bck, args.perms); presigned {
+		if err != nil {
+			errCode = http.StatusForbidden
+		}
+		return
+	}
 	err = args.p.access(args.r.Header, bck, args.perms)
 	errCode = aceErrToCode(err)
 	return
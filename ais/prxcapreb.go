@@ -0,0 +1,145 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Capacity-driven rebalance (see `rebalance.cap_diff_pct` and `rebalance.cap_diff_time`).
+// Primary periodically collects targets' used capacity and starts global rebalance
+// when the max-min difference exceeds the configured threshold for the configured duration.
+// The hysteresis (and the fact that any other rebalance resets it) prevents
+// rebalancing back and forth in response to transient spikes.
+// NOTE: rebalance is always cluster-wide - bucket-scoped rebalance is not supported
+// (and is explicitly rejected, see errRebBckScope).
+
+const (
+	capRebName = "cap-rebalance" + hk.NameSuffix
+	capRebIval = time.Minute
+)
+
+type capReb struct {
+	p     *proxy
+	since int64 // mono-time when imbalance was first observed (0 - balanced)
+	rmdv  int64 // rmd version at the time
+}
+
+func (cr *capReb) init(p *proxy) {
+	cr.p = p
+	hk.Reg(capRebName, cr.housekeep, capRebIval)
+}
+
+func (cr *capReb) reset() { cr.since, cr.rmdv = 0, 0 }
+
+func (cr *capReb) housekeep() time.Duration {
+	var (
+		p      = cr.p
+		config = cmn.GCO.Get()
+		smap   = p.owner.smap.get()
+	)
+	if config.Rebalance.CapDiffPct == 0 || !smap.IsPrimary(p.si) || smap.CountActiveTs() < 2 {
+		cr.reset()
+		return capRebIval
+	}
+	if err := p.canRebalance(); err != nil {
+		cr.reset()
+		return capRebIval
+	}
+	minPct, maxPct, ok := cr.collect(smap)
+	if !ok {
+		cr.reset()
+		return capRebIval
+	}
+	// any rebalance that runs in the meantime resets the hysteresis
+	rmdv := p.owner.rmd.get().version()
+	start, first := cr.update(maxPct-minPct, rmdv, &config.Rebalance, mono.NanoTime())
+	if first {
+		nlog.Infof("%s: capacity imbalance %d%% (min %d%%, max %d%%) - waiting for %v", p, maxPct-minPct,
+			minPct, maxPct, config.Rebalance.CapDiffTime)
+	}
+	if !start {
+		return capRebIval
+	}
+	onl := true
+	if nl := p.notifs.find(nlFilter{Kind: apc.ActRebalance, OnlyRunning: &onl}); nl != nil {
+		return capRebIval
+	}
+	rmdCtx := &rmdModifier{
+		pre:     rmdInc,
+		final:   rmdSync,
+		p:       p,
+		smapCtx: &smapModifier{smap: smap},
+	}
+	if _, err := p.owner.rmd.modify(rmdCtx); err != nil {
+		nlog.Errorf("%s: failed to start capacity-driven rebalance: %v", p, err)
+	} else {
+		nlog.Warningf("%s: capacity imbalance %d%% (min %d%%, max %d%%) for over %v - started rebalance[%s]",
+			p, maxPct-minPct, minPct, maxPct, config.Rebalance.CapDiffTime, rmdCtx.rebID)
+	}
+	cr.reset()
+	return capRebIval
+}
+
+// hysteresis: given the current max-min difference (percentage points) returns
+// whether it's time to start rebalance and whether the imbalance is newly observed
+func (cr *capReb) update(diff int32, rmdv int64, conf *cmn.RebalanceConf, now int64) (start, first bool) {
+	if diff <= int32(conf.CapDiffPct) {
+		cr.reset()
+		return
+	}
+	if cr.since == 0 || cr.rmdv != rmdv {
+		cr.since, cr.rmdv = now, rmdv
+		first = true
+		return
+	}
+	start = time.Duration(now-cr.since) >= conf.CapDiffTime.D()
+	return
+}
+
+// returns min and max used capacity (%) across active targets
+func (cr *capReb) collect(smap *smapX) (minPct, maxPct int32, ok bool) {
+	p := cr.p
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathDae.S,
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatNodeStats}},
+	}
+	args.smap = smap
+	args.to = cluster.Targets
+	args.cresv = cresNS{} // -> stats.Node
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	minPct = 101
+	for _, res := range results {
+		if res.err != nil {
+			freeBcastRes(results)
+			return 0, 0, false
+		}
+		ns := res.v.(*stats.Node)
+		if ns.TargetCDF.CsErr != "" || len(ns.TargetCDF.Mountpaths) == 0 {
+			continue // (OOS and the like is handled elsewhere)
+		}
+		pct := ns.TargetCDF.PctAvg
+		minPct = cos.MinI32(minPct, pct)
+		maxPct = cos.MaxI32(maxPct, pct)
+	}
+	freeBcastRes(results)
+	ok = minPct <= maxPct
+	return
+}
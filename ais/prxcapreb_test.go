@@ -0,0 +1,66 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestCapRebHysteresis(t *testing.T) {
+	var (
+		cr     capReb
+		conf   = cmn.RebalanceConf{CapDiffPct: 10, CapDiffTime: cos.Duration(time.Hour)}
+		now    = int64(time.Hour)
+		minute = int64(time.Minute)
+	)
+	// balanced
+	start, first := cr.update(10, 1, &conf, now)
+	tassert.Errorf(t, !start && !first, "balanced: expected no rebalance")
+
+	// imbalance observed
+	start, first = cr.update(11, 1, &conf, now)
+	tassert.Errorf(t, !start && first, "expected imbalance to be newly observed")
+	start, first = cr.update(30, 1, &conf, now+30*minute)
+	tassert.Errorf(t, !start && !first, "expected to keep waiting")
+	start, _ = cr.update(30, 1, &conf, now+60*minute)
+	tassert.Errorf(t, start, "expected rebalance upon hysteresis expiration")
+
+	// transient spike
+	cr.reset()
+	cr.update(30, 1, &conf, now)
+	cr.update(5, 1, &conf, now+30*minute)
+	start, first = cr.update(30, 1, &conf, now+60*minute)
+	tassert.Errorf(t, !start && first, "transient spike: expected waiting period to restart")
+
+	// any other rebalance restarts the waiting period
+	cr.reset()
+	cr.update(30, 1, &conf, now)
+	start, first = cr.update(30, 2, &conf, now+60*minute)
+	tassert.Errorf(t, !start && first, "expected waiting period to restart upon rebalance")
+	start, _ = cr.update(30, 2, &conf, now+120*minute)
+	tassert.Errorf(t, start, "expected rebalance")
+}
+
+func TestValidateRebArgs(t *testing.T) {
+	tests := []struct {
+		xargs xact.ArgsMsg
+		err   bool
+	}{
+		{xact.ArgsMsg{Kind: apc.ActRebalance}, false},
+		{xact.ArgsMsg{Kind: apc.ActRebalance, Bck: cmn.Bck{Name: "abc", Provider: apc.AIS}}, true},
+		{xact.ArgsMsg{Kind: apc.ActRebalance, Buckets: []cmn.Bck{{Name: "abc", Provider: apc.AIS}}}, true},
+	}
+	for i, test := range tests {
+		err := validateRebArgs(&test.xargs)
+		tassert.Errorf(t, (err != nil) == test.err, "%d: expected error=%t, got %v", i, test.err, err)
+	}
+}
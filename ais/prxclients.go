@@ -0,0 +1,141 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Per-client usage accounting (apc.WhatClientStats): user requests are counted
+// by (AuthN user, User-Agent) and rolled up every `clientsIval`; the proxy keeps
+// the most recent `clientsMaxRollups` rollups.
+// The number of distinct clients (per interval) is limited - the rest is accounted
+// under `clientsOther` User-Agent.
+
+const (
+	clientsName       = "client-stats" + hk.NameSuffix
+	clientsIval       = time.Hour
+	clientsMaxRollups = 24
+	clientsMaxNum     = 1024
+	clientsOther      = "(other)"
+)
+
+type clientStats struct {
+	p       *proxy
+	cur     map[string]*apc.ClientUsage
+	rollups []*apc.ClientRollup
+	started int64
+	mu      sync.Mutex
+}
+
+func (cs *clientStats) init(p *proxy) {
+	cs.p = p
+	cs.cur = make(map[string]*apc.ClientUsage, 16)
+	cs.started = time.Now().UnixNano()
+	hk.Reg(clientsName, cs.housekeep, clientsIval)
+}
+
+// account user request (skipping intra-cluster calls)
+func (cs *clientStats) account(r *http.Request) {
+	if r.Header.Get(apc.HdrCallerID) != "" {
+		return
+	}
+	cu, token := cs.usage(r)
+	cs.p.audit.record(r, cu.User, token, cmn.GCO.Get())
+	cs.mu.Lock()
+	entry := cs._entry(cu)
+	entry.Reqs++
+	switch r.Method {
+	case http.MethodGet:
+		entry.GetReqs++
+	case http.MethodPut:
+		entry.PutReqs++
+	case http.MethodDelete:
+		entry.DelReqs++
+	}
+	if r.ContentLength > 0 {
+		entry.Bytes += r.ContentLength
+	}
+	cs.mu.Unlock()
+}
+
+// user request that uses a deprecated API revision (see prxapiver.go)
+func (cs *clientStats) deprecated(r *http.Request) {
+	cu, _ := cs.usage(r)
+	cs.mu.Lock()
+	cs._entry(cu).DeprecatedReqs++
+	cs.mu.Unlock()
+}
+
+func (cs *clientStats) usage(r *http.Request) (cu apc.ClientUsage, token string) {
+	cu.UserAgent = r.Header.Get(cos.HdrUserAgent)
+	var err error
+	if token, err = tok.ExtractToken(r.Header); err == nil {
+		cu.User = cs.p.authn.userID(token)
+	}
+	return
+}
+
+// under lock
+func (cs *clientStats) _entry(cu apc.ClientUsage) *apc.ClientUsage {
+	key := cu.Key()
+	entry, ok := cs.cur[key]
+	if !ok {
+		if len(cs.cur) >= clientsMaxNum {
+			cu.UserAgent = clientsOther
+			key = cu.Key()
+			entry, ok = cs.cur[key]
+		}
+		if !ok {
+			entry = &apc.ClientUsage{User: cu.User, UserAgent: cu.UserAgent}
+			cs.cur[key] = entry
+		}
+	}
+	return entry
+}
+
+func (cs *clientStats) housekeep() time.Duration {
+	now := time.Now().UnixNano()
+	cs.mu.Lock()
+	rollup := cs._current(now)
+	cs.cur = make(map[string]*apc.ClientUsage, len(cs.cur))
+	cs.started = now
+	if len(cs.rollups) >= clientsMaxRollups {
+		copy(cs.rollups, cs.rollups[1:])
+		cs.rollups = cs.rollups[:clientsMaxRollups-1]
+	}
+	cs.rollups = append(cs.rollups, rollup)
+	cs.mu.Unlock()
+	return clientsIval
+}
+
+func (cs *clientStats) get() *apc.ClientStats {
+	cs.mu.Lock()
+	out := &apc.ClientStats{
+		Current: cs._current(time.Now().UnixNano()),
+		Rollups: make([]*apc.ClientRollup, len(cs.rollups)),
+	}
+	copy(out.Rollups, cs.rollups)
+	cs.mu.Unlock()
+	return out
+}
+
+// under lock
+func (cs *clientStats) _current(now int64) *apc.ClientRollup {
+	rollup := &apc.ClientRollup{Usage: make([]*apc.ClientUsage, 0, len(cs.cur)), Start: cs.started, End: now}
+	for _, cu := range cs.cur {
+		clone := *cu
+		rollup.Usage = append(rollup.Usage, &clone)
+	}
+	return rollup
+}
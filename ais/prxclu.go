@@ -73,12 +73,22 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.xquery(w, r, what, query)
 	case apc.WhatAllRunningXacts:
 		p.xgetRunning(w, r, what, query)
+	case apc.WhatXactHistory:
+		p.xhistory(w, r, what, query)
+	case apc.WhatRebVerify:
+		p.rebVerifyReport(w, r, what, query)
 	case apc.WhatNodeStats:
 		p.qcluStats(w, r, what, query)
 	case apc.WhatSysInfo:
 		p.qcluSysinfo(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatClientStats:
+		p.qcluClients(w, r, what, query)
+	case apc.WhatAuthAlerts:
+		p.qcluAuthAlerts(w, r, what, query)
+	case apc.WhatStreams:
+		p.qcluStreams(w, r, what, query)
 	case apc.WhatRemoteAIS:
 		all, err := p.getRemAises(true /*refresh*/)
 		if err != nil {
@@ -162,6 +172,94 @@ func (p *proxy) xquery(w http.ResponseWriter, r *http.Request, what string, quer
 	p.writeJSON(w, r, resRaw, what)
 }
 
+// apc.WhatXactHistory
+func (p *proxy) xhistory(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	var msg xact.HistoryMsg
+	if err := cmn.ReadJSON(w, r, &msg); err != nil {
+		return
+	}
+	if err := msg.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if msg.Kind != "" {
+		kind, _ := xact.GetKindName(msg.Kind) // convert display name => kind
+		if !xact.IsValidKind(kind) {
+			p.writeErrf(w, r, "invalid xaction kind %q", msg.Kind)
+			return
+		}
+		msg.Kind = kind
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathXactions.S, Body: cos.MustMarshal(msg), Query: query}
+	args.to = cluster.Targets
+	args.timeout = cmn.GCO.Get().Client.TimeoutLong.D()
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	resRaw, erred := p._tresRaw(w, r, results)
+	if erred {
+		return
+	}
+	p.writeJSON(w, r, resRaw, what)
+}
+
+// apc.WhatRebVerify: collect post-rebalance verification results from all targets
+// and generate the (signed) end-state report
+func (p *proxy) rebVerifyReport(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	xid := query.Get(apc.QparamUUID)
+	if xid == "" {
+		p.writeErrf(w, r, "%s: missing %q query parameter (verification xaction ID)", p, apc.QparamUUID)
+		return
+	}
+	var (
+		smap = p.owner.smap.get()
+		bmd  = p.owner.bmd.get()
+		rep  = &apc.RebVerifyReport{
+			ID:          xid,
+			Targets:     make(map[string]*apc.RebVerifyRes, smap.CountActiveTs()),
+			SmapVersion: smap.Version,
+			BMDVersion:  bmd.Version,
+			OK:          true,
+		}
+	)
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathDae.S,
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatRebVerify}, apc.QparamUUID: []string{xid}},
+	}
+	args.smap = smap
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		tres := &apc.RebVerifyRes{}
+		if err := jsoniter.Unmarshal(res.bytes, tres); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrUnmarshal, p, "verification result", cos.BHead(res.bytes), err)
+			freeBcastRes(results)
+			return
+		}
+		if tres.Running {
+			p.writeErrf(w, r, "%s: %s[%s] is still running on %s", p, apc.ActRebVerify, xid, res.si.StringEx())
+			freeBcastRes(results)
+			return
+		}
+		rep.Targets[res.si.ID()] = tres
+		rep.Total.Add(&tres.RebVerifyCnt)
+		rep.OK = rep.OK && !tres.Aborted
+	}
+	freeBcastRes(results)
+
+	rep.OK = rep.OK && len(rep.Targets) > 0 && rep.Total.Errs() == 0
+	rep.Time = time.Now().UnixNano()
+	cmn.SignRebVerify(cmn.GCO.Get().Auth.Secret, rep)
+	p.writeJSON(w, r, rep, what)
+}
+
 // apc.WhatAllRunningXacts
 func (p *proxy) xgetRunning(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
 	var xactMsg xact.QueryMsg
@@ -276,6 +374,74 @@ func (p *proxy) _sysinfo(r *http.Request, timeout time.Duration, to int, query u
 	return sysInfoMap, nil
 }
 
+// per-client usage accounting: this proxy and all other proxies (see prxclients.go)
+func (p *proxy) qcluClients(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.timeout = cmn.GCO.Get().Client.Timeout.D()
+	args.to = cluster.Proxies
+	args.cresv = cresCS{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	out := make(apc.ClientStatsAll, len(results)+1)
+	out[p.SID()] = p.clients.get()
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		out[res.si.ID()] = res.v.(*apc.ClientStats)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, what)
+}
+
+// AuthN audit alerts: all proxies
+func (p *proxy) qcluAuthAlerts(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.timeout = cmn.GCO.Get().Client.Timeout.D()
+	args.to = cluster.Proxies
+	args.cresv = cresAA{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	out := make(apc.AuthAlertsAll, len(results)+1)
+	out[p.SID()] = p.audit.get()
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		out[res.si.ID()] = *res.v.(*apc.AuthAlerts)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, what)
+}
+
+// intra-cluster streams and stream bundles: all targets
+func (p *proxy) qcluStreams(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.timeout = cmn.GCO.Get().Client.Timeout.D()
+	args.to = cluster.Targets
+	args.cresv = cresSS{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	out := make(apc.StreamStatsAll, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		out[res.si.ID()] = res.v.(*apc.StreamStats)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, what)
+}
+
 func (p *proxy) qcluStats(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
 	targetStats, erred := p._queryTs(w, r, query)
 	if targetStats == nil || erred {
@@ -300,6 +466,61 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 
 // helper methods for querying targets
 
+// dry-run admin join: collect and aggregate data placement impact from all targets;
+// the node itself is not affected and remains in its current (e.g., standby) state
+func (p *proxy) joinImpact(w http.ResponseWriter, r *http.Request, nsi *meta.Snode) {
+	var (
+		smap = p.owner.smap.get()
+		out  = &apc.JoinImpact{
+			DaemonID: nsi.ID(),
+			Buckets:  make(map[string]*apc.JoinImpactCnt, 4),
+			Targets:  make(map[string]*apc.JoinImpactCnt, smap.CountActiveTs()),
+		}
+	)
+	if smap.GetNode(nsi.ID()) != nil {
+		p.writeErrf(w, r, "%s: %s is already a member of the cluster (%s)", p.si, nsi.StringEx(), smap)
+		return
+	}
+	if nsi.IsProxy() {
+		p.writeJSON(w, r, out, "join-impact") // no data
+		return
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathDae.S,
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatJoinImpact}, apc.QparamNodeID: []string{nsi.ID()}},
+	}
+	args.timeout = apc.LongTimeout
+	args.smap = smap
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		var ji apc.JoinImpact
+		if err := jsoniter.Unmarshal(res.bytes, &ji); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrUnmarshal, p, "join impact", cos.BHead(res.bytes), err)
+			freeBcastRes(results)
+			return
+		}
+		for cname, cnt := range ji.Buckets {
+			if out.Buckets[cname] == nil {
+				out.Buckets[cname] = &apc.JoinImpactCnt{}
+			}
+			out.Buckets[cname].Add(cnt)
+		}
+		out.Targets[res.si.ID()] = &ji.Total
+		out.Total.Add(&ji.Total)
+	}
+	freeBcastRes(results)
+	out.Estimate()
+	p.writeJSON(w, r, out, "join-impact")
+}
+
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
 	var (
 		err  error
@@ -401,6 +622,10 @@ func (p *proxy) httpclupost(w http.ResponseWriter, r *http.Request) {
 		}
 		// NOTE: node ID and 3-networks configuration is obtained from the node itself
 		*nsi = *si
+		if cos.IsParseBool(r.URL.Query().Get(apc.QparamDryRun)) {
+			p.joinImpact(w, r, nsi)
+			return
+		}
 	case apc.SelfJoin: // auto-join at node startup
 		if cmn.ReadJSON(w, r, &regReq) != nil {
 			return
@@ -448,22 +673,40 @@ func (p *proxy) httpclupost(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	var (
-		nonElectable bool
+		nonElectable, spare bool
 	)
 	if nsi.IsProxy() {
 		s := r.URL.Query().Get(apc.QparamNonElectable)
 		if nonElectable, err = cos.ParseBool(s); err != nil {
 			nlog.Errorf("%s: failed to parse %s for non-electability: %v", p, s, err)
 		}
+	} else {
+		s := r.URL.Query().Get(apc.QparamSpare)
+		if spare, err = cos.ParseBool(s); err != nil {
+			nlog.Errorf("%s: failed to parse %s for spare: %v", p, s, err)
+		}
+		// a target that's been replaced by (an activated) spare is not allowed back in
+		for _, tsi := range smap.Tmap {
+			if tsi.HrwID == nsi.ID() {
+				p.writeErrf(w, r, "%s: cannot %s %s - replaced by %s", p.si, apiOp, nsi.StringEx(), tsi.StringEx())
+				return
+			}
+		}
 	}
 	if err := validateHostname(nsi.PubNet.Hostname); err != nil {
 		p.writeErrf(w, r, "%s: failed to %s %s - (err: %v)", p.si, apiOp, nsi.StringEx(), err)
 		return
 	}
 
-	// node flags
+	// node flags (and HRW position)
 	if osi := smap.GetNode(nsi.ID()); osi != nil {
 		nsi.Flags = osi.Flags
+		nsi.SetHrwID(osi.HrwID)
+	} else {
+		nsi.SetHrwID("")
+		if spare {
+			nsi.Flags = nsi.Flags.Set(meta.SnodeMaint | meta.SnodeSpare)
+		}
 	}
 	if nonElectable {
 		nsi.Flags = nsi.Flags.Set(meta.SnodeNonElectable)
@@ -988,7 +1231,7 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 		p.decommission(msg.Action, &opts)
 	case apc.ActStartMaintenance, apc.ActDecommissionNode, apc.ActShutdownNode, apc.ActRmNodeUnsafe:
 		p.rmNode(w, r, msg)
-	case apc.ActStopMaintenance:
+	case apc.ActStopMaintenance, apc.ActActivateSpare:
 		p.stopMaintenance(w, r, msg)
 
 	case apc.ActResetStats:
@@ -1002,6 +1245,8 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 		p.xstart(w, r, msg)
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
+	case apc.ActXactLimits:
+		p.xlimits(w, r, msg)
 	case apc.ActSendOwnershipTbl:
 		p.sendOwnTbl(w, r, msg)
 	default:
@@ -1098,6 +1343,10 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 	xargs.Kind, _ = xact.GetKindName(xargs.Kind) // display name => kind
 	// rebalance
 	if xargs.Kind == apc.ActRebalance {
+		if err := validateRebArgs(&xargs); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		p.rebalanceCluster(w, r)
 		return
 	}
@@ -1179,6 +1428,60 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	freeBcastRes(results)
 }
 
+// adjust rate limits of a running xaction (msg.Name: xaction ID); targets
+// where the xaction has already finished (or never started) are ignored
+func (p *proxy) xlimits(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var limits apc.XactLimits
+	if err := cos.MorphMarshal(msg.Value, &limits); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if err := limits.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if !xact.IsValidUUID(msg.Name) {
+		p.writeErrf(w, r, "%s: invalid xaction ID %q", p, msg.Name)
+		return
+	}
+	body := cos.MustMarshal(apc.ActMsg{Action: msg.Action, Name: msg.Name, Value: limits})
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
+	args.to = cluster.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	var (
+		err    error
+		numErr int
+	)
+	for _, res := range results {
+		if res.err == nil {
+			continue
+		}
+		if res.status == http.StatusNotFound {
+			if err == nil {
+				err = res.toErr()
+			}
+			numErr++
+			continue
+		}
+		err, numErr = res.toErr(), len(results)
+		break
+	}
+	if numErr == len(results) && err != nil {
+		p.writeErr(w, r, err)
+	}
+	freeBcastRes(results)
+}
+
+// global rebalance cannot be narrowed down to a given bucket (or buckets)
+func validateRebArgs(xargs *xact.ArgsMsg) error {
+	if !xargs.Bck.IsEmpty() || len(xargs.Buckets) > 0 {
+		return errRebBckScope
+	}
+	return nil
+}
+
 func (p *proxy) rebalanceCluster(w http.ResponseWriter, r *http.Request) {
 	// note operational priority over config-disabled `errRebalanceDisabled`
 	if err := p.canRebalance(); err != nil && err != errRebalanceDisabled {
@@ -1493,6 +1796,12 @@ func (p *proxy) stopMaintenance(w http.ResponseWriter, r *http.Request, msg *apc
 		return
 	}
 	timeout := cmn.GCO.Get().Timeout.CplaneOperation.D()
+	if msg.Action == apc.ActActivateSpare {
+		if err := p.validateSpare(si, &opts, smap, timeout); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
 	if _, status, err := p.reqHealth(si, timeout, nil, smap); err != nil {
 		sleep, retries := timeout/2, 5
 		time.Sleep(sleep)
@@ -1672,6 +1981,32 @@ func (p *proxy) _remaisConf(ctx *configModifier, config *globalConfig) (bool, er
 	return true, nil
 }
 
+// a spare to be activated in place of a failed target takes over the latter's HRW position;
+// when the failed one was active (and, therefore, nothing's been rebalanced yet) the resulting
+// placement remains unchanged - hence, no rebalance
+func (p *proxy) validateSpare(si *meta.Snode, opts *apc.ActValRmNode, smap *smapX, timeout time.Duration) error {
+	if !si.IsSpare() {
+		return fmt.Errorf("%s is not a spare", si.StringEx())
+	}
+	if opts.Replace == "" {
+		return nil
+	}
+	osi := smap.GetTarget(opts.Replace)
+	if osi == nil {
+		return cos.NewErrNotFound("%s: target %s", p.si, opts.Replace)
+	}
+	if osi.ID() == si.ID() || osi.IsSpare() {
+		return fmt.Errorf("%s cannot replace %s", si.StringEx(), osi.StringEx())
+	}
+	if _, _, err := p.reqHealth(osi, timeout, nil, smap); err == nil {
+		return fmt.Errorf("cannot replace %s - the target is online (hint: decommission it first)", osi.StringEx())
+	}
+	if !osi.InMaintOrDecomm() {
+		opts.SkipRebalance = true
+	}
+	return nil
+}
+
 func (p *proxy) mcastStopMaint(msg *apc.ActMsg, opts *apc.ActValRmNode) (rebID string, err error) {
 	nlog.Infof("%s mcast-stopm: %s, %s, skip-reb=%t", p, msg, opts.DaemonID, opts.SkipRebalance)
 	ctx := &smapModifier{
@@ -1679,9 +2014,10 @@ func (p *proxy) mcastStopMaint(msg *apc.ActMsg, opts *apc.ActValRmNode) (rebID s
 		post:    p._stopMaintRMD,
 		final:   p._syncFinal,
 		sid:     opts.DaemonID,
+		replace: opts.Replace,
 		skipReb: opts.SkipRebalance,
 		msg:     msg,
-		flags:   meta.SnodeMaint | meta.SnodeMaintPostReb, // to clear node flags
+		flags:   meta.SnodeMaint | meta.SnodeMaintPostReb | meta.SnodeSpare, // to clear node flags
 	}
 	err = p.owner.smap.modify(ctx)
 	if ctx.rmdCtx != nil && ctx.rmdCtx.cur != nil {
@@ -1701,6 +2037,16 @@ func (p *proxy) _stopMaintPre(ctx *smapModifier, clone *smapX) error {
 		ctx.status = http.StatusNotFound
 		return &errNodeNotFound{fmt.Sprintf(efmt, ctx.sid), ctx.sid, p.si, clone}
 	}
+	if ctx.replace != "" {
+		osi := clone.GetTarget(ctx.replace)
+		if osi == nil {
+			ctx.status = http.StatusNotFound
+			return &errNodeNotFound{fmt.Sprintf(efmt, ctx.sid), ctx.replace, p.si, clone}
+		}
+		clone.delTarget(ctx.replace)
+		node.SetHrwID(osi.HrwOwner())
+		nlog.Infof("%s: %s replaces %s (hrw %q)", p, node.StringEx(), osi.StringEx(), node.HrwID)
+	}
 	clone.clearNodeFlags(ctx.sid, ctx.flags)
 	if node.IsProxy() {
 		clone.staffIC()
@@ -0,0 +1,86 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// POST { apc.ActTrainDict } /v1/buckets/bucket-name (primary only)
+// A randomly selected target trains the dictionary from its own objects and returns it;
+// the primary then appends it (as the next version) to the bucket's props.
+// Older versions are never removed - existing objects may still reference them.
+func (p *proxy) trainDict(msg *apc.ActMsg, bck *meta.Bck) (int /*version*/, error) {
+	tdmsg := &apc.TrainDictMsg{}
+	if err := cos.MorphMarshal(msg.Value, tdmsg); err != nil {
+		return 0, fmt.Errorf(cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+	}
+	if !bck.IsAIS() {
+		return 0, fmt.Errorf("%s: compression dictionaries are only supported for ais:// buckets (%s is not)",
+			msg.Action, bck)
+	}
+	if tdmsg.Size < 0 || tdmsg.Size > cmn.MaxBckDictSize {
+		return 0, fmt.Errorf("%s: invalid dictionary size %d (expecting (0, %s])",
+			msg.Action, tdmsg.Size, cos.ToSizeIEC(cmn.MaxBckDictSize, 0))
+	}
+	if l := len(bck.Props.Dict.Dicts); l >= cmn.MaxBckDicts {
+		return 0, fmt.Errorf("%s: %s already has the maximum number (%d) of trained dictionaries",
+			msg.Action, bck, l)
+	}
+
+	// 1. train
+	smap := p.owner.smap.get()
+	tsi, err := smap.GetRandTarget()
+	if err != nil {
+		return 0, err
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Path:   apc.URLPathBuckets.Join(bck.Name),
+			Query:  bck.AddToQuery(nil),
+			Body:   cos.MustMarshal(p.newAmsg(msg, nil)),
+		}
+		cargs.timeout = apc.LongTimeout
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	data, err := res.bytes, res.toErr()
+	freeCR(res)
+	if err != nil {
+		return 0, err
+	}
+
+	// 2. add new version
+	bprops, present := p.owner.bmd.get().Get(bck)
+	if !present {
+		return 0, cmn.NewErrBckNotFound(bck.Bucket())
+	}
+	var (
+		nprops  = bprops.Clone()
+		version = 1
+	)
+	if cur := bprops.Dict.Current(); cur != nil {
+		version = cur.Version + 1
+	}
+	nprops.Dict.Dicts = make([]cmn.BckDict, 0, len(bprops.Dict.Dicts)+1)
+	nprops.Dict.Dicts = append(nprops.Dict.Dicts, bprops.Dict.Dicts...)
+	nprops.Dict.Dicts = append(nprops.Dict.Dicts, cmn.BckDict{Data: data, Created: time.Now().UnixNano(), Version: version})
+	if _, err := p.setBucketProps(&apc.ActMsg{Action: apc.ActSetBprops}, bck, nprops); err != nil {
+		return 0, err
+	}
+	nlog.Infof("%s: %s(%s) v%d (%s) trained by %s", p, msg.Action, bck, version, cos.ToSizeIEC(int64(len(data)), 0), tsi)
+	return version, nil
+}
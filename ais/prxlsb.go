@@ -0,0 +1,143 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// GET { apc.ActListBckInfo } /v1/buckets
+// list buckets (same selection as list-buckets), filter the result (apc.LsbMsg),
+// and optionally broadcast to all targets to collect and aggregate per-bucket usage
+func (p *proxy) listBckInfo(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	lsbmsg := &apc.LsbMsg{}
+	if msg.Value != nil {
+		if err := cos.MorphMarshal(msg.Value, lsbmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+	}
+	flt, err := cmn.NewLsbFilter(lsbmsg)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	bcks, status, err := p.lsbSelect(r, qbck, dpq)
+	if err != nil {
+		p.writeErr(w, r, err, status)
+		return
+	}
+
+	var (
+		bmd     = p.owner.bmd.get()
+		infos   = make(cmn.BckInfos, 0, len(bcks))
+		present = make(cmn.Bcks, 0, len(bcks))
+	)
+	for i := range bcks {
+		bck := bcks[i]
+		bck.Props = nil
+		props, ok := bmd.Get(meta.CloneBck(&bck))
+		if !flt.Match(&bck, props) {
+			continue
+		}
+		infos = append(infos, &cmn.BckInfo{Bck: bck})
+		if ok {
+			present = append(present, bck)
+		}
+	}
+	if lsbmsg.Usage && len(present) > 0 {
+		if err := p.lsbUsage(infos, present); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	sort.Sort(infos)
+	p.writeJSON(w, r, infos, "list-bck-info")
+}
+
+// same selection as in p.listBuckets
+func (p *proxy) lsbSelect(r *http.Request, qbck *cmn.QueryBcks, dpq *dpq) (cmn.Bcks, int, error) {
+	bmd := p.owner.bmd.get()
+	if qbck.IsAIS() || qbck.IsHTTP() || qbck.IsHDFS() {
+		return bmd.Select(qbck), 0, nil
+	}
+	if dpq.fltPresence != "" {
+		if v, err := strconv.Atoi(dpq.fltPresence); err == nil && apc.IsFltPresent(v) {
+			return bmd.Select(qbck), 0, nil
+		}
+	}
+	// via random target
+	smap := p.owner.smap.get()
+	si, err := smap.GetRandTarget()
+	if err != nil {
+		return nil, 0, err
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = si
+		cargs.req = cmn.HreqArgs{
+			Method:   http.MethodGet,
+			Path:     apc.URLPathBuckets.S,
+			RawQuery: r.URL.RawQuery,
+			Body:     cos.MustMarshal(apc.ActMsg{Action: apc.ActList, Name: qbck.Name}),
+		}
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	if res.err != nil {
+		err, status := res.toErr(), res.status
+		freeCR(res)
+		return nil, status, err
+	}
+	bcks := cmn.Bcks{}
+	err = jsoniter.Unmarshal(res.bytes, &bcks)
+	freeCR(res)
+	return bcks, 0, err
+}
+
+// all targets: usage of the (present) buckets
+func (p *proxy) lsbUsage(infos cmn.BckInfos, present cmn.Bcks) error {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.S,
+		Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActListBckInfo, Value: present}),
+	}
+	args.timeout = cmn.GCO.Get().Client.TimeoutLong.D()
+	args.to = cluster.Targets
+	args.cresv = cresBI{} // -> cmn.BckInfos
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	defer freeBcastRes(results)
+
+	usage := make(map[string]*cmn.BckUsage, len(present))
+	for i := range present {
+		usage[present[i].MakeUname("")] = &cmn.BckUsage{}
+	}
+	for _, res := range results {
+		if res.err != nil {
+			return res.toErr()
+		}
+		for _, tinfo := range *res.v.(*cmn.BckInfos) {
+			if u, ok := usage[tinfo.Bck.MakeUname("")]; ok && tinfo.Usage != nil {
+				u.Aggregate(tinfo.Usage)
+			}
+		}
+	}
+	for _, info := range infos {
+		info.Usage = usage[info.Bck.MakeUname("")]
+	}
+	return nil
+}
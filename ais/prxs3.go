@@ -7,7 +7,6 @@ package ais
 import (
 	"encoding/xml"
 	"errors"
-	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -20,7 +19,6 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
-	jsoniter "github.com/json-iterator/go"
 )
 
 // TODO -- FIXME: `checkAccess` permissions (see ais/proxy.go)
@@ -32,6 +30,7 @@ var (
 
 // [METHOD] /s3
 func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
+	defer p.clients.account(r)
 	if cmn.FastV(4, cos.SmoduleAIS) {
 		nlog.Infof("S3Request: %s - %s", r.Method, r.URL)
 	}
@@ -242,41 +241,32 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		return
 	}
 
-	var (
-		msg   = apc.ActMsg{Action: apc.ActDeleteObjects}
-		lrMsg = &apc.ListRange{ObjNames: make([]string, 0, len(objList.Object))}
-	)
+	objNames := make([]string, 0, len(objList.Object))
 	for _, obj := range objList.Object {
-		lrMsg.ObjNames = append(lrMsg.ObjNames, obj.Key)
+		objNames = append(objNames, obj.Key)
 	}
-	msg.Value = lrMsg
-
-	// marshal+unmarshal to convince `p.listrange` to treat `listMsg` as `map[string]interface`
-	var (
-		msg2  apc.ActMsg
-		bt    = cos.MustMarshal(&msg)
-		query = make(url.Values, 1)
-	)
-	query.Set(apc.QparamProvider, apc.AIS)
-	if err := jsoniter.Unmarshal(bt, &msg2); err != nil {
-		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "list-range action message", cos.BHead(bt), err)
+	res, err := p.deleteMulti(bck, objNames, bck.AddToQuery(nil))
+	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	if _, err := p.listrange(http.MethodDelete, bucket, &msg2, query); err != nil {
-		s3.WriteErr(w, r, err, 0)
-	}
-	// TODO: The client wants the response containing two lists:
-	//    - Successfully deleted objects
-	//    - Failed delete calls with error message.
-	// AIS targets do not track this info. They report a single result:
-	// whether there were any errors while deleting objects.
-	// So, we fill only "Deleted successfully" response part.
 	// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjects.html
-	all := &s3.DeleteResult{Objs: make([]s3.DeletedObjInfo, 0, len(lrMsg.ObjNames))}
-	for _, name := range lrMsg.ObjNames {
+	all := &s3.DeleteResult{Objs: make([]s3.DeletedObjInfo, 0, len(res.Deleted))}
+	for _, name := range res.Deleted {
 		all.Objs = append(all.Objs, s3.DeletedObjInfo{Key: name})
 	}
+	for i := range res.Failed {
+		e := &res.Failed[i]
+		code := "InternalError"
+		switch e.Status {
+		case http.StatusNotFound: // (S3 semantics: deleting non-existing object is a success)
+			all.Objs = append(all.Objs, s3.DeletedObjInfo{Key: e.ObjName})
+			continue
+		case http.StatusForbidden:
+			code = "AccessDenied"
+		}
+		all.Errs = append(all.Errs, s3.DeleteErrInfo{Key: e.ObjName, Code: code, Message: e.Message})
+	}
 	sgl := p.gmm.NewSGL(0)
 	all.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -450,8 +440,12 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	var (
 		si   *meta.Snode
 		smap = p.owner.smap.get()
+		perm = apc.AceGET
 	)
-	if err = bck.Allow(apc.AceGET); err != nil {
+	if q.Has(s3.QparamTagging) || q.Has(s3.QparamAttributes) {
+		perm = apc.AceObjHEAD // (object metadata only)
+	}
+	if err = bck.Allow(perm); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -553,7 +547,7 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	p.reverseNodeRequest(w, r, si)
 }
 
-// DELETE /s3/<bucket-name>/<object-name>
+// DELETE /s3/<bucket-name>/<object-name>[?tagging]
 func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket := items[0]
 	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
@@ -564,8 +558,12 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	var (
 		si   *meta.Snode
 		smap = p.owner.smap.get()
+		perm = apc.AceObjDELETE
 	)
-	if err = bck.Allow(apc.AceObjDELETE); err != nil {
+	if r.URL.Query().Has(s3.QparamTagging) {
+		perm = apc.AcePUT // DeleteObjectTagging
+	}
+	if err = bck.Allow(perm); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -326,6 +326,67 @@ func (p *proxy) makeNCopies(msg *apc.ActMsg, bck *meta.Bck) (xid string, err err
 	return
 }
 
+// transition bucket: { confirm existence -- begin -- update placement label -- metasync -- commit }
+func (p *proxy) transitionBck(msg *apc.ActMsg, bck *meta.Bck) (xid string, err error) {
+	label, err := _parseLabel(msg.Value)
+	if err != nil {
+		return
+	}
+
+	// 1. confirm existence
+	props, present := p.owner.bmd.get().Get(bck)
+	if !present {
+		err = cmn.NewErrBckNotFound(bck.Bucket())
+		return
+	}
+	if props.Placement.Label == label {
+		err = fmt.Errorf("%s: bucket %s is already placed on %q mountpaths", p, bck, label)
+		return
+	}
+
+	// 2. begin
+	var (
+		waitmsync = true
+		c         = p.prepTxnClient(msg, bck, waitmsync)
+	)
+	if err = c.begin(bck); err != nil {
+		return
+	}
+
+	// 3. update BMD locally & metasync updated BMD
+	ctx := &bmdModifier{
+		pre:           bmodUpdateProps,
+		final:         p.bmodSync,
+		bcks:          []*meta.Bck{bck},
+		wait:          waitmsync,
+		msg:           &c.msg.ActMsg,
+		txnID:         c.uuid,
+		propsToUpdate: &cmn.BucketPropsToUpdate{Placement: &cmn.PlacementConfToUpdate{Label: &label}},
+	}
+	bmd, err := p.owner.bmd.modify(ctx)
+	if err != nil {
+		debug.AssertNoErr(err)
+		err = c.bcastAbort(bck, err)
+		return
+	}
+	c.msg.BMDVersion = bmd.version()
+
+	// 4. IC
+	nl := xact.NewXactNL(c.uuid, msg.Action, &c.smap.Smap, nil, bck.Bucket())
+	nl.SetOwner(equalIC)
+	p.ic.registerEqual(regIC{nl: nl, smap: c.smap, query: c.req.Query})
+
+	// 5. commit
+	xid, _, err = c.commit(bck, c.cmtTout(waitmsync))
+	debug.Assertf(xid == "" || xid == c.uuid, "committed %q vs generated %q", xid, c.uuid)
+	if err != nil {
+		// revert as a regular props update - to resilver targets that may have already started moving
+		revert := &apc.ActMsg{Action: apc.ActSetBprops}
+		p.undoUpdateCopies(revert, bck, &cmn.BucketPropsToUpdate{Placement: &cmn.PlacementConfToUpdate{Label: &props.Placement.Label}})
+	}
+	return
+}
+
 func bmodMirror(ctx *bmdModifier, clone *bucketMD) error {
 	var (
 		bck             = ctx.bcks[0]
@@ -345,12 +406,21 @@ func bmodMirror(ctx *bmdModifier, clone *bucketMD) error {
 }
 
 // set-bucket-props: { confirm existence -- begin -- apply props -- metasync -- commit }
-func (p *proxy) setBucketProps(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.BucketProps) (string /*xid*/, error) {
+// optional `base` are the props that `nprops` were derived from - the update fails with
+// errPropsChanged if, in the meantime, the bucket's props get updated by someone else
+func (p *proxy) setBucketProps(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.BucketProps,
+	base ...*cmn.BucketProps) (string /*xid*/, error) {
 	// 1. confirm existence
 	bprops, present := p.owner.bmd.get().Get(bck)
 	if !present {
 		return "", cmn.NewErrBckNotFound(bck.Bucket())
 	}
+	var baseProps *cmn.BucketProps
+	if len(base) > 0 {
+		if baseProps = base[0]; !baseProps.Equal(bprops) {
+			return "", fmt.Errorf("%s: %w", bck, errPropsChanged)
+		}
+	}
 	bck.Props = bprops
 
 	// 2. begin
@@ -372,6 +442,7 @@ func (p *proxy) setBucketProps(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bucke
 			bargs.hdr = remoteBckProps
 		}
 		nprops = defaultBckProps(bargs)
+		nprops.Dict.Dicts = bprops.Dict.Dicts // (still referenced by compressed objects)
 	default:
 		return "", fmt.Errorf(fmtErrInvaldAction, msg.Action, []string{apc.ActSetBprops, apc.ActResetBprops})
 	}
@@ -388,17 +459,18 @@ func (p *proxy) setBucketProps(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bucke
 
 	// 3. update BMD locally & metasync updated BMD
 	ctx := &bmdModifier{
-		pre:      p.bmodSetProps,
-		final:    p.bmodSync,
-		wait:     waitmsync,
-		msg:      msg,
-		txnID:    c.uuid,
-		setProps: nprops,
-		bcks:     []*meta.Bck{bck},
+		pre:       p.bmodSetProps,
+		final:     p.bmodSync,
+		wait:      waitmsync,
+		msg:       msg,
+		txnID:     c.uuid,
+		setProps:  nprops,
+		baseProps: baseProps,
+		bcks:      []*meta.Bck{bck},
 	}
 	bmd, err := p.owner.bmd.modify(ctx)
 	if err != nil {
-		debug.AssertNoErr(err)
+		debug.Assert(errors.Is(err, errPropsChanged), err)
 		err = c.bcastAbort(bck, err)
 		return "", err
 	}
@@ -429,6 +501,9 @@ func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 		bprops, present = clone.Get(bck)
 	)
 	debug.Assert(present)
+	if ctx.baseProps != nil && !ctx.baseProps.Equal(bprops) {
+		return fmt.Errorf("%s: %w", bck, errPropsChanged) // (lost the race)
+	}
 	if ctx.msg.Action == apc.ActSetBprops {
 		bck.Props = bprops
 	}
@@ -1088,6 +1163,10 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BucketPropsToU
 	} else if nprops.Mirror.Copies == 1 {
 		nprops.Mirror.Enabled = false
 	}
+	if len(creating) == 0 && bprops.Scratch.Enabled != nprops.Scratch.Enabled {
+		err = fmt.Errorf("%s: cannot change %s to (or from) scratch - can only be specified at creation time", p.si, bck)
+		return
+	}
 	if provider := nprops.BackendBck.Provider; nprops.BackendBck.Name != "" {
 		nprops.BackendBck.Provider, err = cmn.NormalizeProvider(provider)
 		if err != nil {
@@ -0,0 +1,220 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Union (merge) bucket (see cmn.UnionConf): read-only virtual view over its member buckets.
+// - GET and HEAD(object): the proxy finds the first member (in the order of precedence)
+//   that has the object and redirects to the respective target, as if the request
+//   was for the member bucket in the first place;
+// - list-objects: the proxy lists all members (page by page) and merges the results,
+//   whereby name collisions get resolved in favor of the first (leftmost) member.
+//   Remote members are listed in-cluster (apc.LsObjCached) - see listUnion below.
+
+// write access that union buckets do not provide
+const unionDeny = apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE | apc.AceObjMOVE | apc.AcePromote |
+	apc.AceDisconnectedBackend
+
+func errUnionRO(bck *meta.Bck) error {
+	return fmt.Errorf("%s is a read-only union of (%s)", bck, bck.Props.Union.Members)
+}
+
+// when creating or updating union bucket: members must exist and cannot be unions themselves
+func (p *proxy) initUnionProp(bck *meta.Bck, nprops *cmn.BucketProps) error {
+	if !nprops.Union.IsSet() {
+		return nil
+	}
+	bcks, err := nprops.Union.Bcks()
+	if err != nil {
+		return err
+	}
+	for i := range bcks {
+		mbck := meta.CloneBck(&bcks[i])
+		if mbck.Bucket().Equal(bck.Bucket()) {
+			return fmt.Errorf("union bucket %s cannot be its own member", bck)
+		}
+		if err := mbck.InitNoBackend(p.owner.bmd); err != nil {
+			return fmt.Errorf("union bucket %s: invalid member %s: %v", bck, mbck, err)
+		}
+		if mbck.Props.Union.IsSet() {
+			return fmt.Errorf("union bucket %s: member %s is itself a union (nesting is not supported)", bck, mbck)
+		}
+	}
+	return nil
+}
+
+// initialized members in the order of precedence, subject to access permissions
+func (p *proxy) unionMembers(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) ([]*meta.Bck, int, error) {
+	bcks, err := bck.Props.Union.Bcks()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	members := make([]*meta.Bck, 0, len(bcks))
+	for i := range bcks {
+		mbck := meta.CloneBck(&bcks[i])
+		if err := mbck.InitNoBackend(p.owner.bmd); err != nil {
+			return nil, http.StatusNotFound, fmt.Errorf("union bucket %s: member %s: %v", bck, mbck, err)
+		}
+		if err := p.access(hdr, mbck, ace); err != nil {
+			return nil, aceErrToCode(err), err
+		}
+		members = append(members, mbck)
+	}
+	return members, 0, nil
+}
+
+// GET and HEAD(object): redirect to the first member that has the object
+func (p *proxy) redirectUnion(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, smap *smapX,
+	netName string, status int) bool {
+	ace := apc.AceGET
+	if r.Method == http.MethodHead {
+		ace = apc.AceObjHEAD
+	}
+	members, errCode, err := p.unionMembers(r.Header, bck, ace)
+	if err != nil {
+		p.writeErr(w, r, err, errCode)
+		return false
+	}
+	for _, mbck := range members {
+		tsi, err := cluster.HrwTarget(mbck.MakeUname(objName), &smap.Smap)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return false
+		}
+		cargs := allocCargs()
+		{
+			cargs.si = tsi
+			cargs.req = cmn.HreqArgs{
+				Method: http.MethodHead,
+				Path:   apc.URLPathObjects.Join(mbck.Name, objName),
+				Query:  mbck.AddToQuery(url.Values{apc.QparamSilent: []string{"true"}}),
+			}
+			cargs.timeout = apc.DefaultTimeout
+		}
+		res := p.call(cargs, smap)
+		freeCargs(cargs)
+		errCode, err = res.status, res.err
+		freeCR(res)
+		if err != nil {
+			if errCode == http.StatusNotFound {
+				continue
+			}
+			p.writeErr(w, r, err, errCode)
+			return false
+		}
+
+		// rewrite the request as if it was for the member bucket
+		query := r.URL.Query()
+		query.Del(apc.QparamProvider)
+		query.Del(apc.QparamNamespace)
+		r.URL.RawQuery = mbck.AddToQuery(query).Encode()
+		r.URL.Path = apc.URLPathObjects.Join(mbck.Name, objName)
+		if cmn.FastV(5, cos.SmoduleAIS) {
+			nlog.Infoln(r.Method, bck.Cname(objName), "=>", mbck.Cname(objName), "=>", tsi.StringEx())
+		}
+		redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, netName)
+		http.Redirect(w, r, redirectURL, status)
+		return true
+	}
+	err = cos.NewErrNotFound("%s: object %s (union of %s)", p, bck.Cname(objName), bck.Props.Union.Members)
+	if r.Method == http.MethodHead {
+		p.writeErr(w, r, err, http.StatusNotFound, Silent)
+	} else {
+		p.writeErr(w, r, err, http.StatusNotFound)
+	}
+	return false
+}
+
+// List union bucket: list the next page of each member (continuing from the same token),
+// merge, and dedup. Only the names that are less or equal than the minimum last name
+// of the members' full pages are returned - the rest is yet to be merged with the
+// next page(s) and remains buffered (see lsObjsA and queryBuffers).
+func (p *proxy) listUnion(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lsmsg *apc.LsoMsg, beg int64) {
+	members, errCode, err := p.unionMembers(r.Header, bck, apc.AceObjLIST)
+	if err != nil {
+		p.writeErr(w, r, err, errCode)
+		return
+	}
+	if lsmsg.UUID == "" {
+		lsmsg.UUID = cos.GenUUID()
+	}
+	if lsmsg.PageSize == 0 {
+		lsmsg.PageSize = apc.DefaultPageSizeAIS
+	}
+	var (
+		limit   string
+		flags   uint32
+		entries cmn.LsoEntries
+		lsts    = make([]*cmn.LsoResult, len(members))
+		seen    = make(cos.StrSet, lsmsg.PageSize)
+	)
+	for i, mbck := range members {
+		mmsg := *lsmsg
+		mmsg.UUID = lsmsg.UUID + "-" + strconv.Itoa(i) // (stable across pages)
+		if mbck.IsRemote() {
+			mmsg.SetFlag(apc.LsObjCached)
+		}
+		lst, err := p.lsObjsA(mbck, &mmsg)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if lst.ContinuationToken != "" && (limit == "" || lst.ContinuationToken < limit) {
+			limit = lst.ContinuationToken
+		}
+		flags |= lst.Flags
+		lsts[i] = lst
+	}
+	for _, lst := range lsts {
+		for _, en := range lst.Entries {
+			if (limit != "" && en.Name > limit) || seen.Contains(en.Name) {
+				continue
+			}
+			seen.Set(en.Name)
+			entries = append(entries, en)
+		}
+	}
+	cmn.SortLso(entries)
+
+	lst := &cmn.LsoResult{UUID: lsmsg.UUID, Flags: flags}
+	if uint(len(entries)) > lsmsg.PageSize {
+		entries = entries[:lsmsg.PageSize]
+		limit = entries[len(entries)-1].Name
+	}
+	lst.Entries = entries
+	if limit != "" {
+		lst.ContinuationToken = limit
+	}
+
+	var ok bool
+	if strings.Contains(r.Header.Get(cos.HdrAccept), cos.ContentMsgPack) {
+		ok = p.writeMsgPack(w, lst, lsotag)
+	} else {
+		ok = p.writeJS(w, r, lst, lsotag)
+	}
+	if ok {
+		p.statsT.AddMany(
+			cos.NamedVal64{Name: stats.ListCount, Value: 1},
+			cos.NamedVal64{Name: stats.ListLatency, Value: mono.SinceNano(beg)},
+		)
+	}
+}
@@ -0,0 +1,133 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bufio"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Bucket change notifications (proxy side): a long-lived GET that streams
+// server-sent events (https://html.spec.whatwg.org/multipage/server-sent-events.html)
+// for as long as the client stays connected. The proxy periodically polls all
+// targets for their (prefix-filtered) events and relays them to the client
+// ordered by time. See also: tgtwatch.go and api.WatchBucket.
+
+const (
+	watchPollIval = time.Second
+	watchPingIval = 30 * time.Second
+)
+
+func (p *proxy) watchBucket(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+	if !qbck.IsBucket() {
+		p.writeErrf(w, r, "bad %q request: %q is not a bucket", msg.Action, qbck)
+		return
+	}
+	wmsg := &apc.WatchMsg{}
+	if err := cos.MorphMarshal(msg.Value, wmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	bckArgs := bckInitArgs{p: p, w: w, r: r, msg: msg, perms: apc.AceObjLIST, bck: (*meta.Bck)(qbck), dpq: dpq}
+	bckArgs.createAIS = false
+	bckArgs.dontHeadRemote = true
+	bck, err := bckArgs.initAndTry()
+	if err != nil {
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		p.writeErrf(w, r, "%s: %q requires streaming (flushable) response", p.si, msg.Action)
+		return
+	}
+
+	hdr := w.Header()
+	hdr.Set(cos.HdrContentType, cos.ContentEventStream)
+	hdr.Set(cos.HdrCacheControl, "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var (
+		bw       = bufio.NewWriter(w)
+		ticker   = time.NewTicker(watchPollIval)
+		lastSent = time.Now()
+		path     = apc.URLPathBuckets.Join(bck.Name)
+		query    = bck.AddToQuery(nil)
+	)
+	defer ticker.Stop()
+	wmsg.Since = make(map[string]uint64, 8)
+	for {
+		events, lost := p.watchPoll(path, query, wmsg)
+		if lost {
+			watchWrite(bw, &apc.BckEvent{Type: apc.BckEventLost, Time: time.Now().UnixNano()})
+		}
+		for _, ev := range events {
+			watchWrite(bw, ev)
+		}
+		switch {
+		case len(events) > 0 || lost:
+			lastSent = time.Now()
+		case time.Since(lastSent) > watchPingIval:
+			bw.WriteString(": ping\n\n")
+			lastSent = time.Now()
+		}
+		if bw.Buffered() > 0 {
+			if err := bw.Flush(); err != nil {
+				return // client went away
+			}
+			flusher.Flush()
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll all targets; update per-target seq numbers in place
+func (p *proxy) watchPoll(path string, query url.Values, wmsg *apc.WatchMsg) (events []*apc.BckEvent, lost bool) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   path,
+		Query:  query,
+		Body:   cos.MustMarshal(p.newAmsgActVal(apc.ActWatchBck, wmsg)),
+	}
+	args.timeout = apc.DefaultTimeout
+	args.cresv = cresBE{} // -> apc.BckEvents
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(p.String()+":", apc.ActWatchBck, res.toErr())
+			continue
+		}
+		tev := res.v.(*apc.BckEvents)
+		events = append(events, tev.Events...)
+		lost = lost || tev.Lost
+		wmsg.Since[res.si.ID()] = tev.Seq
+	}
+	freeBcastRes(results)
+	sort.Slice(events, func(i, j int) bool { return events[i].Time < events[j].Time })
+	return
+}
+
+func watchWrite(bw *bufio.Writer, ev *apc.BckEvent) {
+	bw.WriteString("event: ")
+	bw.WriteString(ev.Type)
+	bw.WriteString("\ndata: ")
+	bw.Write(cos.MustMarshal(ev))
+	bw.WriteString("\n\n")
+}
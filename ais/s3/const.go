@@ -17,6 +17,8 @@ const (
 	QparamContinuationToken = "continuation-token"
 	QparamStartAfter        = "start-after"
 	QparamDelimiter         = "delimiter"
+	QparamTagging           = "tagging"
+	QparamAttributes        = "attributes"
 
 	// multipart
 	QparamMptUploads        = "uploads"
@@ -36,4 +38,7 @@ const (
 
 	AISRegion = "ais"
 	AISServer = "AIStore"
+
+	// the only storage class (reported by HEAD, GetObjectAttributes, and list-objects)
+	StorageClass = "STANDARD"
 )
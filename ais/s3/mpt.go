@@ -144,6 +144,47 @@ func FinishUpload(id, fqn string, aborted bool) (exists bool) {
 	return true
 }
 
+// Is there an active upload with the given ID
+// (used by the janitor to keep the upload's part workfiles).
+func IsActive(id string) (ok bool) {
+	mu.RLock()
+	_, ok = ups[id]
+	mu.RUnlock()
+	return
+}
+
+// Abort uploads that were initiated more than `age` ago and remove their parts.
+// Returns the number of removed part workfiles and their total size.
+func AbortStale(age time.Duration) (cnt, size int64) {
+	var (
+		stale = make([]*mpt, 0, 4)
+		now   = time.Now()
+	)
+	mu.Lock()
+	for id, mpt := range ups {
+		if now.Sub(mpt.ctime) > age {
+			stale = append(stale, mpt)
+			delete(ups, id)
+			nlog.Infof("aborting stale multipart upload %q (%s/%s, initiated %v)", id, mpt.bckName, mpt.objName, mpt.ctime)
+		}
+	}
+	mu.Unlock()
+
+	for _, mpt := range stale {
+		for _, part := range mpt.parts {
+			if err := os.Remove(part.FQN); err != nil {
+				if !os.IsNotExist(err) {
+					nlog.Errorln(err)
+				}
+				continue
+			}
+			cnt++
+			size += part.Size
+		}
+	}
+	return
+}
+
 func ListUploads(bckName, idMarker string, maxUploads int) (result *ListMptUploadsResult) {
 	mu.RLock()
 	results := make([]UploadInfoResult, 0, len(ups))
@@ -0,0 +1,187 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// S3 object tagging (Put/Get/DeleteObjectTagging and the x-amz-tagging header of PutObject)
+// maps onto AIS object tags (see cmn.ObjTagPrefix), and GetObjectAttributes - onto object
+// properties. References:
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectAttributes.html
+
+// x-amz-object-attributes
+const (
+	ObjAttrETag         = "ETag"
+	ObjAttrChecksum     = "Checksum"
+	ObjAttrObjectParts  = "ObjectParts"
+	ObjAttrStorageClass = "StorageClass"
+	ObjAttrObjectSize   = "ObjectSize"
+)
+
+type (
+	Tagging struct {
+		TagSet TagSet `xml:"TagSet"`
+	}
+	TagSet struct {
+		Tags []Tag `xml:"Tag"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+
+	GetObjectAttributesResponse struct {
+		ETag         string         `xml:"ETag,omitempty"`
+		Checksum     *ObjAttrsCksum `xml:"Checksum,omitempty"`
+		ObjectParts  *ObjAttrsParts `xml:"ObjectParts,omitempty"`
+		StorageClass string         `xml:"StorageClass,omitempty"`
+		ObjectSize   *int64         `xml:"ObjectSize,omitempty"`
+	}
+	ObjAttrsCksum struct {
+		ChecksumCRC32C string `xml:"ChecksumCRC32C,omitempty"`
+	}
+	ObjAttrsParts struct {
+		TotalPartsCount int `xml:"TotalPartsCount"`
+	}
+)
+
+/////////////
+// Tagging //
+/////////////
+
+func NewTagging(tags cos.StrKVs) *Tagging {
+	tagging := &Tagging{TagSet: TagSet{Tags: make([]Tag, 0, len(tags))}}
+	for k, v := range tags {
+		tagging.TagSet.Tags = append(tagging.TagSet.Tags, Tag{Key: k, Value: v})
+	}
+	sort.Slice(tagging.TagSet.Tags, func(i, j int) bool { return tagging.TagSet.Tags[i].Key < tagging.TagSet.Tags[j].Key })
+	return tagging
+}
+
+// PutObjectTagging request body
+func DecodeTagging(r io.Reader) (cos.StrKVs, error) {
+	tagging := &Tagging{}
+	if err := xml.NewDecoder(r).Decode(tagging); err != nil {
+		return nil, fmt.Errorf("failed to parse tagging request: %v", err)
+	}
+	tags := make(cos.StrKVs, len(tagging.TagSet.Tags))
+	for _, tag := range tagging.TagSet.Tags {
+		if _, ok := tags[tag.Key]; ok {
+			return nil, fmt.Errorf("duplicate tag key %q", tag.Key)
+		}
+		tags[tag.Key] = tag.Value
+	}
+	return tags, cmn.ValidateObjTags(tags)
+}
+
+// x-amz-tagging header (URL query-encoded, e.g. "k1=v1&k2=v2")
+func ParseTaggingHdr(s string) (cos.StrKVs, error) {
+	q, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s header %q: %v", cos.S3HdrTagging, s, err)
+	}
+	tags := make(cos.StrKVs, len(q))
+	for k, vs := range q {
+		if len(vs) > 1 {
+			return nil, fmt.Errorf("invalid %s header %q: duplicate tag key %q", cos.S3HdrTagging, s, k)
+		}
+		tags[k] = vs[0]
+	}
+	return tags, cmn.ValidateObjTags(tags)
+}
+
+func (r *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+/////////////////////////////////
+// GetObjectAttributesResponse //
+/////////////////////////////////
+
+// `attrs` is the (comma-separated) value of the x-amz-object-attributes header
+func NewObjAttrsResponse(lom *cluster.LOM, attrs string) (*GetObjectAttributesResponse, error) {
+	resp := &GetObjectAttributesResponse{}
+	for _, attr := range strings.Split(attrs, ",") {
+		switch strings.TrimSpace(attr) {
+		case ObjAttrETag:
+			if etag, ok := lom.GetCustomKey(cmn.ETag); ok {
+				resp.ETag = etag // (multipart)
+			} else {
+				resp.ETag = lomMD5(lom)
+			}
+		case ObjAttrChecksum:
+			resp.Checksum = cksumAttr(lom)
+		case ObjAttrObjectParts:
+			if n := MptCount(lom); n > 0 {
+				resp.ObjectParts = &ObjAttrsParts{TotalPartsCount: n}
+			}
+		case ObjAttrStorageClass:
+			resp.StorageClass = StorageClass
+		case ObjAttrObjectSize:
+			size := lom.SizeBytes()
+			resp.ObjectSize = &size
+		case "":
+		default:
+			return nil, fmt.Errorf("invalid %s value %q", cos.S3HdrObjAttrs, attr)
+		}
+	}
+	return resp, nil
+}
+
+// S3 checksums are base64-encoded (vs. hex in AIS); CRC32C is the only one in common
+// (note that AIS "sha256" is SHA512/256)
+func cksumAttr(lom *cluster.LOM) *ObjAttrsCksum {
+	cksum := lom.Checksum()
+	if cksum == nil || cksum.Type() != cos.ChecksumCRC32C {
+		return nil
+	}
+	b, err := hex.DecodeString(cksum.Value())
+	if err != nil {
+		return nil
+	}
+	return &ObjAttrsCksum{ChecksumCRC32C: base64.StdEncoding.EncodeToString(b)}
+}
+
+func (r *GetObjectAttributesResponse) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// number of parts of a multipart-uploaded object, or zero (see ETag in completeMpt)
+func MptCount(lom *cluster.LOM) int {
+	etag, ok := lom.GetCustomKey(cmn.ETag)
+	if !ok {
+		return 0
+	}
+	i := strings.LastIndex(etag, cmn.AwsMultipartDelim)
+	if i < 0 {
+		return 0
+	}
+	n, err := strconv.Atoi(strings.Trim(etag[i+1:], "\""))
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,43 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestTagging(t *testing.T) {
+	tags := cos.StrKVs{"project": "alpha", "stage": "raw data", "empty": ""}
+
+	// encode => decode
+	sgl := memsys.PageMM().NewSGL(0)
+	defer sgl.Free()
+	NewTagging(tags).MustMarshal(sgl)
+	out, err := DecodeTagging(sgl)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(out) == len(tags), "expected %v, got %v", tags, out)
+	for k, v := range tags {
+		tassert.Errorf(t, out[k] == v, "tag %q: expected %q, got %q", k, v, out[k])
+	}
+
+	// PutObjectTagging request body
+	body := `<Tagging><TagSet><Tag><Key>a</Key><Value>1</Value></Tag><Tag><Key>a</Key><Value>2</Value></Tag></TagSet></Tagging>`
+	_, err = DecodeTagging(strings.NewReader(body))
+	tassert.Errorf(t, err != nil, "expected duplicate key error")
+
+	// x-amz-tagging header
+	out, err = ParseTaggingHdr("project=alpha&stage=raw%20data")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, out["project"] == "alpha" && out["stage"] == "raw data", "unexpected %v", out)
+	for _, s := range []string{"a=1&a=2", "=1", "a=1,2"} {
+		_, err = ParseTaggingHdr(s)
+		tassert.Errorf(t, err != nil, "expected %q to fail", s)
+	}
+}
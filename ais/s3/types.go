@@ -110,8 +110,14 @@ type (
 	DeletedObjInfo struct {
 		Key string `xml:"Key"`
 	}
+	DeleteErrInfo struct {
+		Key     string `xml:"Key"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
 	DeleteResult struct {
 		Objs []DeletedObjInfo `xml:"Deleted"`
+		Errs []DeleteErrInfo  `xml:"Error"`
 	}
 )
 
@@ -169,6 +175,7 @@ func entryToS3(entry *cmn.LsoEntry, lsmsg *apc.LsoMsg) *ObjInfo {
 		LastModified: entry.Atime,
 		ETag:         entry.Checksum,
 		Size:         entry.Size,
+		Class:        StorageClass,
 	}
 	// Some S3 clients do not tolerate empty or missing LastModified, so fill it
 	// with a zero time if the object was not accessed yet
@@ -38,11 +38,13 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/sys"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/volume"
 	"github.com/NVIDIA/aistore/xact/xreg"
@@ -69,6 +71,10 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		fdc          fdCache // see feat.CacheOpenFiles
+		hotplug      hotplug // see disk.hotplug_pattern
+		watch        bckWatch
+		leases       leaseTable
 	}
 )
 
@@ -214,11 +220,17 @@ func (t *target) init(config *cmn.Config) {
 
 	sc := transport.Init(ts, config) // init transport sub-system; new stream collector
 	daemon.rg.add(sc)
+	transport.SetRelay(t.relayURL)
 
 	fshc := health.NewFSHC(t)
 	daemon.rg.add(fshc)
 	t.fshc = fshc
 
+	// CPU-bound work offload (checksumming, EC encoding)
+	if n := config.CPU.OffloadPerCore; n > 0 {
+		cos.InitOffload(n * sys.NumCPU())
+	}
+
 	if err := ts.InitCDF(); err != nil { // goes after fs.New
 		cos.ExitLog(err)
 	}
@@ -298,6 +310,11 @@ func (t *target) Run() error {
 
 	cluster.Init(t)
 	cluster.RegLomCacheWithHK(t)
+	t.fdc.init()
+	t.leases.init()
+	t.hotplug.init(t)
+	hk.Reg(demoteHkName, t.housekeepDemote, minDemoteIval)
+	hk.Reg(trashHkName, t.housekeepTrash, minTrashIval)
 
 	// metrics, disks first
 	tstats := t.statsT.(*stats.Trunner)
@@ -319,9 +336,10 @@ func (t *target) Run() error {
 		nlog.Errorln("")
 	}
 
-	// register object type and workfile type
+	// register object, workfile, and trash types
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.TrashType, &fs.TrashContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -364,6 +382,7 @@ func (t *target) Run() error {
 	}
 
 	dload.SetDB(db)
+	xreg.SetDB(db)
 
 	archive.Init(config.Features)
 
@@ -461,6 +480,15 @@ func (t *target) endStartupStandby() (err error) {
 	return
 }
 
+// to relay streams via primary proxy (see transport/relay.go)
+func (t *target) relayURL() string {
+	smap := t.owner.smap.get()
+	if smap == nil || smap.Primary == nil {
+		return ""
+	}
+	return smap.Primary.URL(cmn.NetIntraData)
+}
+
 func (t *target) initRecvHandlers() {
 	networkHandlers := []networkHandler{
 		{r: apc.Buckets, h: t.bucketHandler, net: accessNetAll},
@@ -477,6 +505,7 @@ func (t *target) initRecvHandlers() {
 		{r: apc.Download, h: t.downloadHandler, net: accessNetIntraControl},
 		{r: apc.Sort, h: dsort.TargetHandler, net: accessControlData},
 		{r: apc.ETL, h: t.etlHandler, net: accessNetAll},
+		{r: apc.OpenAPI, h: t.openapiHandler, net: accessNetPublic},
 
 		{r: "/" + apc.S3, h: t.s3Handler, net: accessNetPublicData},
 		{r: "/", h: t.errURL, net: accessNetAll},
@@ -660,11 +689,26 @@ func (t *target) httpobjget(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	if !t.checkPresigned(w, r, apireq.dpq) {
+		return
+	}
 	lom := cluster.AllocLOM(apireq.items[1])
 	lom = t.getObject(w, r, apireq.dpq, apireq.bck, lom)
 	cluster.FreeLOM(lom)
 }
 
+// presigned URL (see api.PresignObjectURL) is validated by the proxy and, again, upon redirect
+func (t *target) checkPresigned(w http.ResponseWriter, r *http.Request, dpq *dpq) bool {
+	if dpq.signature == "" {
+		return true
+	}
+	if _, err := cmn.CheckPresigned(cmn.GCO.Get().Auth.Secret, r.Method, r.URL.Path, r.URL.Query()); err != nil {
+		t.writeErr(w, r, err, http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
 // getObject is main function to get the object. It doesn't check request origin,
 // so it must be done by the caller (if necessary).
 func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck *meta.Bck, lom *cluster.LOM) *cluster.LOM {
@@ -703,13 +747,15 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.t = t
 		goi.lom = lom
 		goi.w = w
-		goi.ctx = context.Background()
+		goi.ctx = reqCtx(r)
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
 		goi.archive = archiveQuery{
 			filename: filename,
 			mime:     dpq.archmime, // query.Get(apc.QparamArchmime)
 		}
 		goi.isGFN = cos.IsParseBool(dpq.isGFN) // query.Get(apc.QparamIsGFNRequest)
+		goi.hedge = cos.IsParseBool(dpq.hedge) // query.Get(apc.QparamHedge)
+		goi.precond.FromHeader(r.Header)
 		// goi.chunked = cmn.GCO.Get().Net.HTTP.Chunked NOTE: disabled - no need
 	}
 	if bck.IsHTTP() {
@@ -739,6 +785,9 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		t.writeErrf(w, r, "%s: %s(obj) is expected to be redirected or replicated", t.si, r.Method)
 		return
 	}
+	if !t.checkPresigned(w, r, apireq.dpq) {
+		return
+	}
 	if cs := fs.Cap(); cs.Err != nil || cs.PctMax > int32(config.Space.CleanupWM) {
 		cs = t.OOS(nil)
 		if cs.OOS {
@@ -759,6 +808,18 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	// mountpath capacity quota (see cmn.FSPConf)
+	if mi := lom.Mountpath(); mi.OverQuota() {
+		if t.OOS(nil); mi.OverQuota() { // (refreshed)
+			t.writeErr(w, r, mi.ErrOverQuota(), http.StatusInsufficientStorage)
+			return
+		}
+	}
+	// all mountpaths are read-only (see fs.SetReadOnly)
+	if mi := lom.Mountpath(); mi.IsReadOnly() {
+		t.writeErr(w, r, fmt.Errorf("%s: cannot PUT %s - %s is read-only", t, lom, mi), http.StatusInsufficientStorage)
+		return
+	}
 
 	// load (maybe)
 	var (
@@ -798,6 +859,8 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 		t.statsT.IncErr(stats.AppendCount)
+	case apireq.dpq.writeAt != "": // apc.QparamWriteAt
+		errCode, err = t.patchObj(r, lom, started, apireq.dpq)
 	default:
 		poi := allocPOI()
 		{
@@ -849,10 +912,11 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	errCode, err := t.DeleteObject(lom, evict)
+	ctx := reqCtx(r)
+	errCode, err := t.deleteObject(ctx, lom, evict)
 	if err == nil {
 		// EC cleanup if EC is enabled
-		ec.ECM.CleanupObject(lom)
+		ec.ECM.CleanupObject(ctx, lom)
 	} else {
 		if errCode == http.StatusNotFound {
 			t.writeErrSilentf(w, r, http.StatusNotFound, "%s doesn't exist", lom.Cname())
@@ -869,7 +933,31 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActRenameObject {
+	switch msg.Action {
+	case apc.ActRenameObject:
+	case apc.ActUndeleteObject:
+		if t.parseReq(w, r, apireq) != nil {
+			return
+		}
+		t.undelete(w, r, apireq.bck, apireq.items[1])
+		return
+	case apc.ActAcquireLease, apc.ActRenewLease, apc.ActReleaseLease:
+		if t.parseReq(w, r, apireq) != nil {
+			return
+		}
+		if err := apireq.bck.Init(t.owner.bmd); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.lease(w, r, apireq.bck, apireq.items[1], msg)
+		return
+	case apc.ActCopyObjRemote:
+		if t.parseReq(w, r, apireq) != nil {
+			return
+		}
+		t.copyObjRemote(w, r, apireq, msg)
+		return
+	default:
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -884,7 +972,7 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 	lom := cluster.AllocLOM(apireq.items[1])
 	err = lom.InitBck(apireq.bck.Bucket())
 	if err == nil {
-		err = t.objMv(lom, msg)
+		err = t.objMv(reqCtx(r), lom, msg)
 	}
 	if err == nil {
 		t.statsT.Inc(stats.RenameCount)
@@ -895,6 +983,36 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 	cluster.FreeLOM(lom)
 }
 
+// restore deleted object from trash (see cmn.SpaceConf.TrashRetention)
+func (t *target) undelete(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err == nil {
+		t.writeErrf(w, r, "%s already exists", lom.Cname())
+		return
+	} else if !cmn.IsObjNotExist(err) {
+		t.writeErr(w, r, err)
+		return
+	}
+	if err := lom.Undelete(); err != nil {
+		if cos.IsErrNotFound(err) {
+			t.writeErr(w, r, err, http.StatusNotFound)
+		} else {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
+	if t.watch.active() {
+		t.watch.record(lom, apc.BckEventCreate)
+	}
+}
+
 // HEAD /v1/objects/<bucket-name>/<object-name>
 func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	if err := t.parseReq(w, r, apireq); err != nil {
@@ -910,7 +1028,7 @@ func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request, apireq *api
 		}
 	}
 	lom := cluster.AllocLOM(objName)
-	errCode, err := t.objhead(w.Header(), query, bck, lom)
+	errCode, err := t.objhead(reqCtx(r), w.Header(), query, bck, lom)
 	cluster.FreeLOM(lom)
 	if err == nil {
 		return
@@ -922,7 +1040,8 @@ func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request, apireq *api
 	}
 }
 
-func (t *target) objhead(hdr http.Header, query url.Values, bck *meta.Bck, lom *cluster.LOM) (errCode int, err error) {
+func (t *target) objhead(ctx context.Context, hdr http.Header, query url.Values, bck *meta.Bck,
+	lom *cluster.LOM) (errCode int, err error) {
 	var (
 		fltPresence int
 		exists      = true
@@ -953,8 +1072,8 @@ func (t *target) objhead(hdr http.Header, query url.Values, bck *meta.Bck, lom *
 			errCode = http.StatusNotFound
 			return
 		}
-		exists = false
-		if fltPresence == apc.FltPresentCluster {
+		exists = lom.TierUp() // (see cmn.SpaceConf.FastTier)
+		if !exists && (fltPresence == apc.FltPresentCluster || fs.AnyReadOnly() || transitioning(bck)) {
 			exists = lom.RestoreToLocation()
 		}
 	}
@@ -970,6 +1089,7 @@ func (t *target) objhead(hdr http.Header, query url.Values, bck *meta.Bck, lom *
 	op := cmn.ObjectProps{Name: lom.ObjName, Bck: *lom.Bucket(), Present: exists}
 	if exists {
 		op.ObjAttrs = *lom.ObjAttrs()
+		op.ObjAttrs.Size = lom.UserSize() // (at-rest compressed and/or encrypted: original size)
 		op.Location = lom.Location()
 		op.Mirror.Copies = lom.NumCopies()
 		if lom.HasCopies() {
@@ -1000,7 +1120,7 @@ func (t *target) objhead(hdr http.Header, query url.Values, bck *meta.Bck, lom *
 	} else {
 		// cold HEAD
 		var oa *cmn.ObjAttrs
-		oa, errCode, err = t.Backend(lom.Bck()).HeadObj(context.Background(), lom)
+		oa, errCode, err = t.Backend(lom.Bck()).HeadObj(ctx, lom)
 		if err != nil {
 			if errCode != http.StatusNotFound {
 				err = cmn.NewErrFailedTo(t, "HEAD", lom, err)
@@ -1047,6 +1167,7 @@ func (t *target) objhead(hdr http.Header, query url.Values, bck *meta.Bck, lom *
 // PATCH /v1/objects/<bucket-name>/<object-name>
 // By default, adds or updates existing custom keys. Will remove all existing keys and
 // replace them with the specified ones _iff_ `apc.QparamNewCustom` is set.
+// With apc.ActSetObjTags and apc.ActDelObjTags, updates (removes) object tags - see cmn.ObjTagPrefix.
 func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	if err := t.parseReq(w, r, apireq); err != nil {
 		return
@@ -1062,10 +1183,30 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 	if err != nil {
 		return
 	}
-	custom := cos.StrKVs{}
-	if err := cos.MorphMarshal(msg.Value, &custom); err != nil {
-		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, "set-custom", msg.Value, err)
-		return
+	var (
+		custom  cos.StrKVs
+		delTags []string
+	)
+	switch msg.Action {
+	case apc.ActDelObjTags:
+		if msg.Value != nil {
+			if err := cos.MorphMarshal(msg.Value, &delTags); err != nil {
+				t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+				return
+			}
+		}
+	default:
+		custom = cos.StrKVs{}
+		if err := cos.MorphMarshal(msg.Value, &custom); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, "set-custom", msg.Value, err)
+			return
+		}
+		if msg.Action == apc.ActSetObjTags {
+			if err := cmn.ValidateObjTags(custom); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
 	}
 	lom := cluster.AllocLOM(apireq.items[1] /*objName*/)
 	defer cluster.FreeLOM(lom)
@@ -1081,12 +1222,36 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		}
 		return
 	}
-	delOldSetNew := cos.IsParseBool(apireq.query.Get(apc.QparamNewCustom))
-	if delOldSetNew {
-		lom.SetCustomMD(custom)
-	} else {
+	switch msg.Action {
+	case apc.ActSetObjTags:
+		n := len(cmn.ObjTags(lom.GetCustomMD()))
 		for key, val := range custom {
-			lom.SetCustomKey(key, val)
+			if _, ok := lom.GetCustomKey(cmn.ObjTagKey(key)); !ok {
+				n++
+			}
+			if n > cmn.MaxObjTags {
+				t.writeErrf(w, r, "%s: too many tags (max %d)", lom, cmn.MaxObjTags)
+				return
+			}
+			lom.SetCustomKey(cmn.ObjTagKey(key), val)
+		}
+	case apc.ActDelObjTags:
+		if len(delTags) == 0 {
+			for key := range cmn.ObjTags(lom.GetCustomMD()) {
+				delTags = append(delTags, key)
+			}
+		}
+		for _, key := range delTags {
+			lom.DelCustomKey(cmn.ObjTagKey(key))
+		}
+	default:
+		delOldSetNew := cos.IsParseBool(apireq.query.Get(apc.QparamNewCustom))
+		if delOldSetNew {
+			lom.SetCustomMD(custom)
+		} else {
+			for key, val := range custom {
+				lom.SetCustomKey(key, val)
+			}
 		}
 	}
 	lom.Persist()
@@ -1248,6 +1413,7 @@ func (t *target) putApndArch(r *http.Request, lom *cluster.LOM, started int64, d
 	}
 	a := &putA2I{
 		started:  started,
+		ctx:      reqCtx(r),
 		t:        t,
 		lom:      lom,
 		r:        r.Body,
@@ -1265,6 +1431,9 @@ func (t *target) putApndArch(r *http.Request, lom *cluster.LOM, started int64, d
 		a.put = true
 	} else {
 		a.put = (flags == 0)
+		if !a.put && lom.IsEncoded() {
+			return http.StatusBadRequest, cmn.NewErrUnsupp("append to at-rest compressed or encrypted", lom.Cname())
+		}
 	}
 	if s := r.Header.Get(cos.HdrContentLength); s != "" {
 		if size, err := strconv.ParseInt(s, 10, 64); err == nil {
@@ -1278,10 +1447,14 @@ func (t *target) putApndArch(r *http.Request, lom *cluster.LOM, started int64, d
 	return a.do()
 }
 
-func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (int, error) {
+	return t.deleteObject(context.Background(), lom, evict)
+}
+
+func (t *target) deleteObject(ctx context.Context, lom *cluster.LOM, evict bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
+	code, err, isback = t.delobj(ctx, lom, evict)
 	lom.Unlock(true)
 
 	// special corner-case retry (quote):
@@ -1289,9 +1462,9 @@ func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (code int, err error
 	// - aws-error[InternalError: We encountered an internal error. Please try again.]
 	if err != nil && isback {
 		if code == http.StatusServiceUnavailable || strings.Contains(err.Error(), "try again") {
-			nlog.Errorf("failed to delete %s: %v(%d) - retrying...", lom, err, code)
+			nlog.ErrorfCtx(ctx, "failed to delete %s: %v(%d) - retrying...", lom, err, code)
 			time.Sleep(time.Second)
-			code, err = t.Backend(lom.Bck()).DeleteObj(lom)
+			code, err = t.Backend(lom.Bck()).DeleteObj(ctx, lom)
 		}
 	}
 	if err == nil {
@@ -1302,7 +1475,7 @@ func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (code int, err error
 	return
 }
 
-func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
+func (t *target) delobj(ctx context.Context, lom *cluster.LOM, evict bool) (int, error, bool) {
 	var (
 		aisErr, backendErr         error
 		aisErrCode, backendErrCode int
@@ -1313,6 +1486,8 @@ func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
 		delFromAIS = true
 	} else if !cmn.IsObjNotExist(err) {
 		return 0, err, false
+	} else if lom.HasDemoted() {
+		delFromAIS = true // (lom.Remove removes demoted replica as well)
 	} else {
 		aisErrCode = http.StatusNotFound
 		if !delFromBackend {
@@ -1321,16 +1496,24 @@ func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
 	}
 
 	if delFromBackend {
-		backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(lom)
+		backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(ctx, lom)
 	}
 	if delFromAIS {
 		size := lom.SizeBytes()
-		aisErr = lom.Remove()
+		t.fdc.evictLOM(lom)
+		if !evict && !lom.Bck().IsRemote() && cmn.GCO.Get().Space.TrashRetention > 0 {
+			aisErr = lom.MoveToTrash()
+		} else {
+			aisErr = lom.Remove()
+		}
+		if aisErr == nil && !evict && t.watch.active() {
+			t.watch.record(lom, apc.BckEventDelete)
+		}
 		if aisErr != nil {
 			if !os.IsNotExist(aisErr) {
 				if backendErr != nil {
 					// unlikely
-					nlog.Errorf("double-failure to delete %s: ais err %v, backend err %v(%d)",
+					nlog.ErrorfCtx(ctx, "double-failure to delete %s: ais err %v, backend err %v(%d)",
 						lom, aisErr, backendErr, backendErrCode)
 				}
 				return 0, aisErr, false
@@ -1350,31 +1533,50 @@ func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
 }
 
 // rename obj
-func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
-	if lom.Bck().IsRemote() {
+// Rename object. When the new name maps to this same target (and the bucket is not
+// erasure coded) the object and its n-way copies, if any, get renamed in place -
+// under both write locks and without copying data (see lom.MoveTo). Otherwise,
+// it is copy (and erasure-encode) followed by delete (and EC cleanup).
+func (t *target) objMv(ctx context.Context, lom *cluster.LOM, msg *apc.ActMsg) (err error) {
+	bck := lom.Bck()
+	if bck.IsRemote() {
 		return fmt.Errorf("%s: cannot rename object %s from a remote bucket", t.si, lom)
 	}
-	if lom.Bck().Props.EC.Enabled {
-		return fmt.Errorf("%s: cannot rename erasure-coded object %s", t.si, lom)
-	}
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	tsi, err := cluster.HrwTarget(bck.MakeUname(msg.Name), t.owner.smap.Get())
+	if err != nil {
+		return err
+	}
+	var (
+		local     = tsi.ID() == t.SID()
+		ecEnabled = bck.Props.EC.Enabled
+	)
+	if local && !ecEnabled {
+		if moved, err := t.objMvLocal(lom, msg.Name); moved || err != nil {
+			return err
+		}
+	}
 
 	buf, slab := t.gmm.Alloc()
 	coi := allocCOI()
 	{
-		coi.CopyObjectParams = cluster.CopyObjectParams{BckTo: lom.Bck(), Buf: buf}
+		coi.CopyObjectParams = cluster.CopyObjectParams{BckTo: bck, Buf: buf}
 		coi.t = t
+		coi.ctx = ctx
 		coi.owt = cmn.OwtMigrate
 		coi.finalize = true
 	}
-	_, err := coi.copyObject(lom, msg.Name /* new object name */)
+	_, err = coi.copyObject(lom, msg.Name /* new object name */)
 	slab.Free(buf)
 	freeCOI(coi)
 	if err != nil {
 		return err
 	}
+	if local {
+		t.objMvFini(ctx, bck, msg.Name, ecEnabled)
+	}
 
 	// TODO: combine copy+delete under a single write lock
 	lom.Lock(true)
@@ -1382,9 +1584,146 @@ func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
 		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
 	}
 	lom.Unlock(true)
+	if ecEnabled {
+		ec.ECM.CleanupObject(ctx, lom)
+	}
+	if t.watch.active() {
+		t.watch.record(lom, apc.BckEventDelete)
+	}
 	return nil
 }
 
+// returns false (and no error) when the in-place rename is not possible, e.g.
+// when the destination exists or is busy
+func (t *target) objMvLocal(lom *cluster.LOM, objNameTo string) (moved bool, err error) {
+	dst := cluster.AllocLOM(objNameTo)
+	defer cluster.FreeLOM(dst)
+	if err = dst.InitBck(lom.Bucket()); err != nil {
+		return
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if !dst.TryLock(true) {
+		return
+	}
+	defer dst.Unlock(true)
+
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	if err = dst.Load(false /*cache it*/, true /*locked*/); err == nil {
+		return // exists - overwrite via copy
+	}
+	if !cmn.IsObjNotExist(err) {
+		return
+	}
+	t.fdc.evictLOM(lom)
+	if moved, err = lom.MoveTo(dst); err != nil {
+		if moved {
+			t.fsErr(err, dst.FQN)
+		}
+		return
+	}
+	if moved && t.watch.active() {
+		t.watch.record(lom, apc.BckEventDelete)
+		t.watch.record(dst, apc.BckEventCreate)
+	}
+	return
+}
+
+// post-copy: erasure-encode the new (local) object and notify watchers
+func (t *target) objMvFini(ctx context.Context, bck *meta.Bck, objName string, ecEnabled bool) {
+	if !ecEnabled && !t.watch.active() {
+		return
+	}
+	dst := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(dst)
+	if err := dst.InitBck(bck.Bucket()); err != nil {
+		return
+	}
+	dst.Lock(false)
+	err := dst.Load(false /*cache it*/, true /*locked*/)
+	dst.Unlock(false)
+	if err != nil {
+		return
+	}
+	if ecEnabled {
+		if err := ec.ECM.EncodeObject(ctx, dst); err != nil && err != ec.ErrorECDisabled {
+			nlog.ErrorfCtx(ctx, "%s: failed to erasure-encode renamed object %s: %v", t, dst, err)
+		}
+	}
+	if t.watch.active() {
+		t.watch.record(dst, apc.BckEventCreate)
+	}
+}
+
+// POST /v1/objects/bucket-name/object-name (apc.ActCopyObjRemote):
+// stream the object directly to a remote AIS cluster
+func (t *target) copyObjRemote(w http.ResponseWriter, r *http.Request, apireq *apiRequest, msg *apc.ActMsg) {
+	if isRedirect(apireq.query) == "" {
+		t.writeErrf(w, r, "%s: %s-%s(obj) is expected to be redirected", t.si, r.Method, msg.Action)
+		return
+	}
+	bckTo, err := newBckFromQuname(apireq.query, true /*required*/)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	for _, bck := range []*meta.Bck{apireq.bck, bckTo} {
+		if err := bck.Init(t.owner.bmd); err != nil {
+			if cmn.IsErrRemoteBckNotFound(err) {
+				t.BMDVersionFixup(r)
+				err = bck.Init(t.owner.bmd)
+			}
+			if err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
+	}
+	if !bckTo.IsRemoteAIS() {
+		t.writeErrf(w, r, "%s: %s is not a remote AIS bucket", t, bckTo)
+		return
+	}
+	objNameTo := apireq.items[1]
+	if msg.Name != "" {
+		objNameTo = msg.Name
+	}
+	var (
+		lom = cluster.AllocLOM(apireq.items[1])
+		dst = cluster.AllocLOM(objNameTo)
+	)
+	errCode, err := t._copyObjRemote(reqCtx(r), lom, dst, apireq.bck, bckTo)
+	cluster.FreeLOM(dst)
+	cluster.FreeLOM(lom)
+	if err != nil {
+		t.writeErr(w, r, err, errCode)
+	}
+}
+
+func (t *target) _copyObjRemote(ctx context.Context, lom, dst *cluster.LOM, bck, bckTo *meta.Bck) (int, error) {
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return 0, err
+	}
+	if err := dst.InitBck(bckTo.Bucket()); err != nil {
+		return 0, err
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	fh, oah, err := lom.NewUserROC() // (decompressing and/or decrypting, if need be)
+	if err != nil {
+		return 0, err
+	}
+	dst.CopyAttrs(oah, false /*skip cksum*/)
+	return t.Backend(bckTo).PutObj(ctx, fh, dst) // (closes fh)
+}
+
 func (t *target) fsErr(err error, filepath string) {
 	if !cmn.GCO.Get().FSHC.Enabled || !cos.IsIOError(err) {
 		return
@@ -1398,9 +1737,9 @@ func (t *target) fsErr(err error, filepath string) {
 		nlog.Errorf("%s: fsErr %s", t, cs.String())
 		return
 	}
-	nlog.Errorf("%s: waking up FSHC to check %q for err %v", t, filepath, err)
+	nlog.Errorf("%s: FSHC: I/O error on %q: %v", t, filepath, err)
 	keyName := mi.Path
 	// keyName is the mountpath is the fspath - counting IO errors on a per basis..
 	t.statsT.AddMany(cos.NamedVal64{Name: stats.ErrIOCount, NameSuffix: keyName, Value: 1})
-	t.fshc.OnErr(filepath)
+	t.fshc.OnErr(err, filepath)
 }
@@ -0,0 +1,228 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Batch GET (target side): the target that the proxy redirects to (see proxy.getBatch)
+// streams back a single archive containing the requested objects in the requested order.
+// Local objects are read directly; all others (and local misses in remote buckets,
+// to trigger cold GET) - via intra-cluster GET from their respective HRW targets.
+//
+// An error that occurs before the first object gets archived is returned as is. Once
+// streaming has started, however, the only way to report a failure (other than a missing
+// object with ContinueOnError) is to abort the connection - the client then gets a read
+// error instead of a truncated archive.
+
+type (
+	batchSrc struct {
+		r   io.ReadCloser
+		oah cos.OAH
+		lom *cluster.LOM // when local
+	}
+	batchArgs struct {
+		names  []string
+		mime   string
+		length int64 // when non-zero: leading bytes only (see apc.SampleMsg)
+		coer   bool  // skip missing objects
+	}
+	// (leading bytes)
+	limitedRC struct {
+		io.Reader
+		io.Closer
+	}
+)
+
+func batchMime(mime string) (string, error) {
+	switch mime {
+	case "":
+		return archive.ExtTar, nil
+	case archive.ExtTar, archive.ExtTarGz, archive.ExtTgz:
+		return mime, nil
+	default:
+		return "", fmt.Errorf("invalid batch GET format %q (expecting %s, %s, or %s)",
+			mime, archive.ExtTar, archive.ExtTarGz, archive.ExtTgz)
+	}
+}
+
+// GET /v1/buckets/bucket-name (apc.ActGetBatch)
+func (t *target) getBatch(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	if isRedirect(r.URL.Query()) == "" {
+		t.writeErrf(w, r, "%s: %s-%s(bck) is expected to be redirected", t.si, r.Method, msg.Action)
+		return
+	}
+	gbmsg := &apc.GetBatchMsg{}
+	if err := cos.MorphMarshal(msg.Value, gbmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	mime, err := batchMime(gbmsg.Mime)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.writeBatch(w, r, bck, msg.Action, &batchArgs{names: gbmsg.ObjNames, mime: mime, coer: gbmsg.ContinueOnError})
+}
+
+func (t *target) writeBatch(w http.ResponseWriter, r *http.Request, bck *meta.Bck, action string, args *batchArgs) {
+	var (
+		aw   archive.Writer
+		smap = t.owner.smap.get()
+	)
+	for _, name := range args.names {
+		src, errCode, err := t.batchOpen(r, bck, name, smap, args.length)
+		if err != nil {
+			if errCode == http.StatusNotFound && args.coer {
+				continue
+			}
+			if aw == nil {
+				t.writeErr(w, r, err, errCode)
+				return
+			}
+			nlog.Errorf("%s: aborting %s(%s): %v", t, action, bck, err)
+			panic(http.ErrAbortHandler) // (see comment on top)
+		}
+		if aw == nil {
+			ctype := cos.ContentTar
+			if args.mime != archive.ExtTar {
+				ctype = cos.ContentGzip
+			}
+			w.Header().Set(cos.HdrContentType, ctype)
+			aw = archive.NewWriter(args.mime, w, nil /*checksum*/, nil /*opts*/)
+		}
+		err = aw.Write(name, src.oah, src.r)
+		src.close()
+		if err != nil {
+			nlog.Errorf("%s: aborting %s(%s): %v", t, action, bck, err)
+			aw.Fini()
+			panic(http.ErrAbortHandler)
+		}
+	}
+	if aw == nil { // all missing
+		t.writeErrf(w, r, "%s: none of the requested objects exist in %s", t, bck)
+		return
+	}
+	aw.Fini()
+}
+
+func (t *target) batchOpen(r *http.Request, bck *meta.Bck, name string, smap *smapX, length int64) (*batchSrc, int, error) {
+	tsi, err := cluster.HrwTarget(bck.MakeUname(name), &smap.Smap)
+	if err != nil {
+		return nil, 0, err
+	}
+	if tsi.ID() == t.SID() {
+		src, errCode, err := t.batchOpenLocal(bck, name, length)
+		if err == nil || !bck.IsRemote() || errCode != http.StatusNotFound {
+			return src, errCode, err
+		}
+		// fall through (cold GET)
+	}
+	return t.batchOpenRemote(r, bck, name, tsi, length)
+}
+
+func (t *target) batchOpenLocal(bck *meta.Bck, name string, length int64) (*batchSrc, int, error) {
+	lom := cluster.AllocLOM(name)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		cluster.FreeLOM(lom)
+		return nil, 0, err
+	}
+	lom.Lock(false)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		cluster.FreeLOM(lom)
+		if cmn.IsObjNotExist(err) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, 0, err
+	}
+	roc, oah, err := lom.NewUserROC()
+	if err != nil {
+		lom.Unlock(false)
+		cluster.FreeLOM(lom)
+		if os.IsNotExist(err) {
+			return nil, http.StatusNotFound, cos.NewErrNotFound("%s: %s", t, lom.Cname())
+		}
+		t.fsErr(err, lom.FQN)
+		return nil, 0, err
+	}
+	if length > 0 && oah.SizeBytes() > length {
+		// leading bytes only (checksum and version no longer apply)
+		r := limitedRC{io.LimitReader(roc, length), roc}
+		return &batchSrc{r: r, oah: cos.SimpleOAH{Size: length, Atime: oah.AtimeUnix()}, lom: lom}, 0, nil
+	}
+	return &batchSrc{r: roc, oah: oah, lom: lom}, 0, nil
+}
+
+func (t *target) batchOpenRemote(r *http.Request, bck *meta.Bck, name string, tsi *meta.Snode, length int64) (*batchSrc, int, error) {
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Header = http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		}
+		if rid := nlog.ReqID(r.Context()); rid != "" {
+			reqArgs.Header.Set(apc.HdrReqID, rid)
+		}
+		if length > 0 {
+			reqArgs.Header.Set(cos.HdrRange, fmt.Sprintf("%s0-%d", cos.HdrRangeValPrefix, length-1))
+		}
+		reqArgs.Path = apc.URLPathObjects.Join(bck.Name, name)
+		reqArgs.Query = bck.AddToQuery(nil)
+	}
+	req, err := reqArgs.Req()
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := t.client.data.Do(req) //nolint:bodyclose // closed by batchSrc.close
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && length > 0 { // (empty)
+		resp.Body.Close()
+		return &batchSrc{r: io.NopCloser(bytes.NewReader(nil)), oah: cos.SimpleOAH{}}, 0, nil
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, resp.StatusCode, fmt.Errorf("%s: failed to GET %s from %s: %s(%d)",
+			t, bck.Cname(name), tsi.StringEx(), b, resp.StatusCode)
+	}
+	if resp.ContentLength < 0 {
+		resp.Body.Close()
+		return nil, 0, fmt.Errorf("%s: GET %s from %s: unknown size", t, bck.Cname(name), tsi.StringEx())
+	}
+	if resp.StatusCode == http.StatusPartialContent {
+		return &batchSrc{r: resp.Body, oah: cos.SimpleOAH{Size: resp.ContentLength}}, 0, nil
+	}
+	oa := &cmn.ObjAttrs{}
+	oa.FromHeader(resp.Header)
+	oa.Size = resp.ContentLength
+	return &batchSrc{r: resp.Body, oah: oa}, 0, nil
+}
+
+func (src *batchSrc) close() {
+	cos.Close(src.r)
+	if src.lom != nil {
+		src.lom.Unlock(false)
+		cluster.FreeLOM(src.lom)
+	}
+}
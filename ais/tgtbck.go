@@ -6,6 +6,7 @@ package ais
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"runtime"
@@ -20,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
@@ -121,6 +123,80 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 		t.bsumm(w, r, query, msg.Action, bck, &bsumMsg)
+	case apc.ActHeadMultiObjs:
+		bck, err := newBckFromQ(bckName, r.URL.Query(), nil)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := bck.Init(t.owner.bmd); err != nil {
+			if cmn.IsErrRemoteBckNotFound(err) {
+				t.BMDVersionFixup(r)
+				err = bck.Init(t.owner.bmd)
+			}
+			if err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
+		t.headMulti(w, r, bck, msg)
+	case apc.ActWatchBck:
+		bck, err := newBckFromQ(bckName, r.URL.Query(), nil)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := bck.Init(t.owner.bmd); err != nil {
+			if cmn.IsErrRemoteBckNotFound(err) {
+				t.BMDVersionFixup(r)
+				err = bck.Init(t.owner.bmd)
+			}
+			if err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
+		t.watchPoll(w, r, bck, msg)
+	case apc.ActGetBatch, apc.ActSample:
+		bck, err := newBckFromQ(bckName, r.URL.Query(), nil)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := bck.Init(t.owner.bmd); err != nil {
+			if cmn.IsErrRemoteBckNotFound(err) {
+				t.BMDVersionFixup(r)
+				err = bck.Init(t.owner.bmd)
+			}
+			if err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
+		if msg.Action == apc.ActSample {
+			t.sample(w, r, bck, msg)
+		} else {
+			t.getBatch(w, r, bck, msg)
+		}
+	case apc.ActObjProvenance:
+		bck, err := newBckFromQ(bckName, r.URL.Query(), nil)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := bck.Init(t.owner.bmd); err != nil {
+			if cmn.IsErrRemoteBckNotFound(err) {
+				t.BMDVersionFixup(r)
+				err = bck.Init(t.owner.bmd)
+			}
+			if err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
+		t.objProvenance(w, r, bck, msg)
+	case apc.ActListBckInfo:
+		t.bckUsage(w, r, msg)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -416,11 +492,91 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 			Xact: xctn,
 		})
 		go xctn.Run(nil)
+	case apc.ActDeleteMultiObjs:
+		t.deleteMulti(w, r, apireq.bck, &msg)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
 }
 
+// synchronous multi-object delete: objects that this target owns (see p.deleteMulti)
+func (t *target) deleteMulti(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	lrMsg := &apc.ListRange{}
+	if err := cos.MorphMarshal(msg.Value, lrMsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	var (
+		ctx = reqCtx(r)
+		out = &apc.DeleteMultiResult{Deleted: make([]string, 0, len(lrMsg.ObjNames))}
+	)
+	for _, name := range lrMsg.ObjNames {
+		var (
+			errCode int
+			err     = cmn.ValidateObjname(name)
+			lom     = cluster.AllocLOM(name)
+		)
+		if err == nil {
+			err = lom.InitBck(bck.Bucket())
+		}
+		if err == nil {
+			errCode, err = t.deleteObject(ctx, lom, false /*evict*/)
+		}
+		if err == nil {
+			ec.ECM.CleanupObject(ctx, lom)
+			out.Deleted = append(out.Deleted, name)
+		} else {
+			if errCode == 0 {
+				errCode = http.StatusBadRequest
+			}
+			out.Failed = append(out.Failed, apc.DeleteMultiErr{ObjName: name, Message: err.Error(), Status: errCode})
+		}
+		cluster.FreeLOM(lom)
+	}
+	t.writeJSON(w, r, out, msg.Action)
+}
+
+// batch HEAD: objects that this target owns (see p.headMulti)
+func (t *target) headMulti(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	lrMsg := &apc.ListRange{}
+	if err := cos.MorphMarshal(msg.Value, lrMsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	lst := &cmn.LsoResult{Entries: make([]*cmn.LsoEntry, 0, len(lrMsg.ObjNames))}
+	for _, name := range lrMsg.ObjNames {
+		var (
+			e   = &cmn.LsoEntry{Name: name}
+			lom = cluster.AllocLOM(name)
+		)
+		lst.Entries = append(lst.Entries, e)
+		if err := lom.InitBck(bck.Bucket()); err != nil {
+			cluster.FreeLOM(lom)
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+			if !cmn.IsObjNotExist(err) {
+				nlog.Warningln(t.String()+":", msg.Action, err)
+			}
+			cluster.FreeLOM(lom)
+			continue
+		}
+		e.SetPresent()
+		e.Size = lom.UserSize()
+		e.Version = lom.Version()
+		e.Checksum = lom.Checksum().Value()
+		e.Atime = cos.FormatNanoTime(lom.AtimeUnix(), "")
+		e.Location = lom.Location()
+		e.Copies = int16(lom.NumCopies())
+		if md := lom.GetCustomMD(); len(md) > 0 {
+			e.Custom = fmt.Sprintf("%+v", md)
+		}
+		cluster.FreeLOM(lom)
+	}
+	t.writeMsgPack(w, lst, msg.Action)
+}
+
 // POST /v1/buckets/bucket-name
 func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	msg, err := t.readAisMsg(w, r)
@@ -462,6 +618,8 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 		rns := xreg.RenewPrefetch(msg.UUID, t, apireq.bck, lrMsg)
 		xctn := rns.Entry.Get()
 		go xctn.Run(nil)
+	case apc.ActTrainDict:
+		t.trainDict(w, r, apireq.bck, msg)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -32,6 +32,8 @@ import (
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/transport"
+	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	jsoniter "github.com/json-iterator/go"
@@ -44,7 +46,11 @@ const (
 )
 
 func (t *target) joinCluster(action string, primaryURLs ...string) (status int, err error) {
-	res := t.join(nil, t, primaryURLs...)
+	var query url.Values
+	if daemon.cli.target.spare {
+		query = url.Values{apc.QparamSpare: []string{"true"}}
+	}
+	res := t.join(query, t, primaryURLs...)
 	defer freeCR(res)
 	if res.err != nil {
 		status, err = res.status, res.err
@@ -286,6 +292,10 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		t.writeJSON(w, r, tsysinfo, httpdaeWhat)
 	case apc.WhatMountpaths:
 		t.writeJSON(w, r, fs.MountpathsToLists(), httpdaeWhat)
+	case apc.WhatJoinImpact:
+		t.joinImpact(w, r, query)
+	case apc.WhatRebVerify:
+		t.rebVerifyRes(w, r, query)
 	case apc.WhatNodeStatsAndStatus:
 		var rebSnap *cluster.Snap
 		if entry := xreg.GetLatest(xreg.Flt{Kind: apc.ActRebalance}); entry != nil {
@@ -317,6 +327,9 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		diskStats := make(ios.AllDiskStats)
 		fs.FillDiskStats(diskStats)
 		t.writeJSON(w, r, diskStats, httpdaeWhat)
+	case apc.WhatStreams:
+		out := &apc.StreamStats{Streams: transport.AllInfo(), Bundles: bundle.AllInfo()}
+		t.writeJSON(w, r, out, httpdaeWhat)
 	case apc.WhatRemoteAIS:
 		var (
 			aisBackend = t.aisBackend()
@@ -451,6 +464,8 @@ func (t *target) handleMountpathReq(w http.ResponseWriter, r *http.Request) {
 		t.disableMpath(w, r, mpath)
 	case apc.ActMountpathDetach:
 		t.detachMpath(w, r, mpath)
+	case apc.ActMountpathRO, apc.ActMountpathRW:
+		t.setMpathReadOnly(w, r, mpath, msg.Action == apc.ActMountpathRO)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -485,8 +500,13 @@ func (t *target) enableMpath(w http.ResponseWriter, r *http.Request, mpath strin
 }
 
 func (t *target) attachMpath(w http.ResponseWriter, r *http.Request, mpath string) {
-	force := cos.IsParseBool(r.URL.Query().Get(apc.QparamForce))
-	addedMi, err := t.fsprg.attachMpath(mpath, force)
+	var (
+		query = r.URL.Query()
+		force = cos.IsParseBool(query.Get(apc.QparamForce))
+		label = query.Get(apc.QparamMpathLabel)
+		quota = query.Get(apc.QparamMpathQuota)
+	)
+	addedMi, err := t.fsprg.attachMpath(mpath, label, quota, force)
 	if err != nil {
 		t.writeErr(w, r, err)
 		return
@@ -530,6 +550,21 @@ func (t *target) detachMpath(w http.ResponseWriter, r *http.Request, mpath strin
 	}
 }
 
+func (t *target) setMpathReadOnly(w http.ResponseWriter, r *http.Request, mpath string, readOnly bool) {
+	mi, err := t.fsprg.setReadOnly(mpath, readOnly)
+	if err != nil {
+		if cmn.IsErrMountpathNotFound(err) {
+			t.writeErr(w, r, err, http.StatusNotFound)
+		} else {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
+	if mi == nil {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
 func (t *target) receiveBMD(newBMD *bucketMD, msg *aisMsg, payload msPayload, tag, caller string, silent bool) (err error) {
 	var oldVer int64
 	if msg.UUID == "" {
@@ -597,6 +632,7 @@ func (t *target) _syncBMD(newBMD *bucketMD, msg *aisMsg, payload msPayload, psi
 		createErrs  []error
 		destroyErrs []error
 		bmd         = t.owner.bmd.get()
+		relabeled   bool
 	)
 	if err = bmd.validateUUID(newBMD, t.si, psi, ""); err != nil {
 		cos.ExitLog(err) // FATAL: cluster integrity error (cie)
@@ -651,6 +687,9 @@ func (t *target) _syncBMD(newBMD *bucketMD, msg *aisMsg, payload msPayload, psi
 				flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
 				xreg.DoAbort(flt, errors.New("apply-bmd"))
 			}
+			if obck.Props.Placement.Label != nbck.Props.Placement.Label {
+				relabeled = true
+			}
 			return true
 		})
 		if !present {
@@ -665,6 +704,12 @@ func (t *target) _syncBMD(newBMD *bucketMD, msg *aisMsg, payload msPayload, psi
 		emsg = fmt.Sprintf("%s: failed to cleanup destroyed buckets: %s, old/cur %s(%t): %v",
 			t, newBMD, bmd, nilbmd, errors.Join(destroyErrs...))
 	}
+	// 4. placement label changed: move objects to (or off of) labeled mountpaths
+	// (unless transitioning - see t.transitionBck)
+	if relabeled && fs.AnyLabeled() && msg.Action != apc.ActTransitionBck {
+		nlog.Infof("%s: bucket placement changed (%s) - resilvering", t, newBMD)
+		go t.runResilver(res.Args{}, nil /*wg*/)
+	}
 	return
 }
 
@@ -0,0 +1,120 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+const (
+	dfltDictSamples = 1000
+	minDictSamples  = 8
+)
+
+type dictSampler struct {
+	bck     *meta.Bck
+	prefix  string
+	samples [][]byte
+	limit   int64 // max object size
+	quota   int   // per mountpath
+	cnt     int   // this mountpath
+}
+
+// POST { apc.ActTrainDict } /v1/buckets/bucket-name (see proxy.trainDict)
+// samples (up to the requested number of) local objects that are not compressed
+// and not larger than the bucket's DictConf.MaxSize, and trains a new dictionary;
+// responds with the dictionary itself
+func (t *target) trainDict(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	tdmsg := &apc.TrainDictMsg{}
+	if err := cos.MorphMarshal(msg.Value, tdmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	if tdmsg.NumSamples <= 0 {
+		tdmsg.NumSamples = dfltDictSamples
+	}
+	if tdmsg.Size <= 0 {
+		tdmsg.Size = cmn.DfltBckDictSize
+	}
+	var (
+		avail = fs.GetAvail()
+		ds    = &dictSampler{
+			bck:     bck,
+			prefix:  tdmsg.Prefix,
+			samples: make([][]byte, 0, tdmsg.NumSamples),
+			limit:   bck.Props.Dict.ObjSizeLimit(),
+			quota:   (tdmsg.NumSamples + len(avail) - 1) / max(len(avail), 1),
+		}
+	)
+	for _, mi := range avail {
+		opts := &fs.WalkOpts{Mi: mi, CTs: []string{fs.ObjectType}, Callback: ds.cb}
+		opts.Bck.Copy(bck.Bucket())
+		ds.cnt = 0
+		if err := fs.Walk(opts); err != nil && !cmn.IsErrAborted(err) {
+			t.writeErr(w, r, err)
+			return
+		}
+		if len(ds.samples) >= tdmsg.NumSamples {
+			break
+		}
+	}
+	if len(ds.samples) < minDictSamples {
+		t.writeErrf(w, r, "%s: %s(%s): not enough objects to train a dictionary (have %d, need at least %d)",
+			t, msg.Action, bck, len(ds.samples), minDictSamples)
+		return
+	}
+	data, err := transport.TrainDict(ds.samples, int(tdmsg.Size))
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	nlog.Infof("%s: %s(%s): trained %s dictionary from %d samples", t, msg.Action, bck,
+		cos.ToSizeIEC(int64(len(data)), 0), len(ds.samples))
+	w.Header().Set(cos.HdrContentType, cos.ContentBinary)
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
+func (ds *dictSampler) cb(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	lom := cluster.AllocLOM("")
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitFQN(fqn, ds.bck.Bucket()); err != nil {
+		return nil
+	}
+	if ds.prefix != "" && !strings.HasPrefix(lom.ObjName, ds.prefix) {
+		return nil
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return nil
+	}
+	if lom.IsEncoded() || lom.SizeBytes() == 0 || lom.SizeBytes() > ds.limit {
+		return nil
+	}
+	data, err := os.ReadFile(fqn)
+	if err != nil || int64(len(data)) != lom.SizeBytes() { // (e.g., concurrently updated)
+		return nil
+	}
+	ds.samples = append(ds.samples, data)
+	ds.cnt++
+	if ds.cnt >= ds.quota || len(ds.samples) >= cap(ds.samples) {
+		return cmn.NewErrAborted("train-dict", "enough samples", nil)
+	}
+	return nil
+}
@@ -283,7 +283,7 @@ func (t *target) headObjectETL(w http.ResponseWriter, r *http.Request) {
 	}
 
 	lom := cluster.AllocLOM(objName)
-	errCode, err := t.objhead(w.Header(), r.URL.Query(), bck, lom)
+	errCode, err := t.objhead(reqCtx(r), w.Header(), r.URL.Query(), bck, lom)
 	cluster.FreeLOM(lom)
 	if err != nil {
 		// always silent (compare w/ httpobjhead)
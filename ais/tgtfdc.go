@@ -0,0 +1,174 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"container/list"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Warm GET: bounded LRU cache of open file descriptors (feat.CacheOpenFiles).
+// Cached descriptors are shared by concurrent GETs (and therefore, are only read
+// via ReadAt). Each entry is tagged with the object's size, version, and checksum
+// at the time of opening - a GET that finds a different tag reopens the file.
+// In addition, PUT and DELETE evict the object's descriptors right away (so that
+// overwritten and deleted files don't hold disk space), and housekeeping closes
+// descriptors that have not been used for `fdcIdle`.
+
+const (
+	fdcCap  = 1024
+	fdcIdle = time.Minute
+	fdcName = "open-fd-cache" + hk.NameSuffix
+)
+
+type (
+	fdcEntry struct {
+		fh      *os.File
+		elem    *list.Element
+		fqn     string
+		tag     string
+		last    int64 // mono time of the last use
+		refs    int
+		evicted bool
+	}
+	fdCache struct {
+		m   map[string]*fdcEntry
+		lru *list.List // front: most recently used
+		num atomic.Int32
+		mu  sync.Mutex
+	}
+)
+
+func (c *fdCache) init() {
+	c.m = make(map[string]*fdcEntry, 64)
+	c.lru = list.New()
+	hk.Reg(fdcName, c.housekeep, fdcIdle)
+}
+
+// returns "" when the object's content cannot be reliably identified (no checksum)
+func fdcTag(lom *cluster.LOM) string {
+	cksum := lom.Checksum()
+	if cksum == nil || cksum.IsEmpty() {
+		return ""
+	}
+	return strconv.FormatInt(lom.SizeBytes(), 10) + "/" + lom.Version() + "/" + cksum.Value()
+}
+
+// get (ref-counted) descriptor, open if need be; the caller must call `release`
+func (c *fdCache) get(fqn string, lom *cluster.LOM) (e *fdcEntry, err error) {
+	tag := fdcTag(lom)
+	if tag == "" {
+		var fh *os.File
+		if fh, err = os.Open(fqn); err == nil {
+			e = &fdcEntry{fh: fh, fqn: fqn, refs: 1, evicted: true} // not cached
+		}
+		return
+	}
+	now := mono.NanoTime()
+	c.mu.Lock()
+	if e = c.m[fqn]; e != nil {
+		if e.tag == tag {
+			e.refs++
+			e.last = now
+			c.lru.MoveToFront(e.elem)
+			c.mu.Unlock()
+			return
+		}
+		c._evict(e)
+	}
+	c.mu.Unlock()
+
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
+	e = &fdcEntry{fh: fh, fqn: fqn, tag: tag, last: now, refs: 1}
+
+	c.mu.Lock()
+	if prev := c.m[fqn]; prev != nil { // racing with another GET
+		if prev.tag == tag {
+			prev.refs++
+			prev.last = now
+			c.lru.MoveToFront(prev.elem)
+			c.mu.Unlock()
+			cos.Close(fh)
+			return prev, nil
+		}
+		c._evict(prev)
+	}
+	for c.lru.Len() >= fdcCap {
+		c._evict(c.lru.Back().Value.(*fdcEntry))
+	}
+	e.elem = c.lru.PushFront(e)
+	c.m[fqn] = e
+	c.num.Inc()
+	c.mu.Unlock()
+	return
+}
+
+func (c *fdCache) release(e *fdcEntry) {
+	c.mu.Lock()
+	e.refs--
+	closeit := e.refs == 0 && e.evicted
+	c.mu.Unlock()
+	if closeit {
+		cos.Close(e.fh)
+	}
+}
+
+// evict the object and all its copies (PUT, DELETE)
+func (c *fdCache) evictLOM(lom *cluster.LOM) {
+	if c.num.Load() == 0 {
+		return
+	}
+	c.mu.Lock()
+	if e := c.m[lom.FQN]; e != nil {
+		c._evict(e)
+	}
+	for copyFQN := range lom.GetCopies() {
+		if e := c.m[copyFQN]; e != nil {
+			c._evict(e)
+		}
+	}
+	c.mu.Unlock()
+}
+
+// under lock
+func (c *fdCache) _evict(e *fdcEntry) {
+	delete(c.m, e.fqn)
+	c.lru.Remove(e.elem)
+	c.num.Dec()
+	e.evicted = true
+	if e.refs == 0 {
+		cos.Close(e.fh)
+	}
+}
+
+func (c *fdCache) housekeep() time.Duration {
+	if c.num.Load() == 0 {
+		return fdcIdle
+	}
+	now := mono.NanoTime()
+	c.mu.Lock()
+	for elem := c.lru.Back(); elem != nil; {
+		e := elem.Value.(*fdcEntry)
+		if time.Duration(now-e.last) < fdcIdle {
+			break // the rest is more recent
+		}
+		elem = elem.Prev()
+		c._evict(e)
+	}
+	c.mu.Unlock()
+	return fdcIdle
+}
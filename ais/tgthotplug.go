@@ -0,0 +1,104 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Hot-plug (disk.hotplug_pattern): periodically look for mount points that match
+// the configured glob pattern and attach the newly mounted ones as mountpaths -
+// which, in turn, triggers resilvering (see fsprungroup._postAdd).
+//
+// "Newly mounted" means not mounted at this path (on this device) as of the previous
+// scan. In particular, the mounts that already exist when the feature gets enabled
+// (or the target starts) are never attached, and a mountpath that was explicitly
+// detached does not get re-attached until its filesystem is remounted.
+
+const hotplugName = "hotplug" + hk.NameSuffix
+
+type hotplug struct {
+	t    *target
+	seen map[string]uint64 // mount point => device ID, as of the previous scan (nil when disabled)
+}
+
+func (hp *hotplug) init(t *target) {
+	hp.t = t
+	hk.Reg(hotplugName, hp.housekeep, cmn.DfltHotplugInterval)
+}
+
+func (hp *hotplug) housekeep() time.Duration {
+	config := cmn.GCO.Get()
+	if config.Disk.HotplugPattern == "" {
+		hp.seen = nil
+		return config.Disk.HotplugIval()
+	}
+	mounts := mountPoints(config.Disk.HotplugPattern)
+	for _, mpath := range hp.update(mounts) {
+		hp.attach(mpath)
+	}
+	return config.Disk.HotplugIval()
+}
+
+// returns mount points that are new since the previous scan
+func (hp *hotplug) update(mounts map[string]uint64) (added []string) {
+	if hp.seen != nil {
+		for mpath, dev := range mounts {
+			if prev, ok := hp.seen[mpath]; !ok || prev != dev {
+				added = append(added, mpath)
+			}
+		}
+		sort.Strings(added)
+	}
+	hp.seen = mounts
+	return
+}
+
+func (hp *hotplug) attach(mpath string) {
+	avail, disabled := fs.Get()
+	if _, ok := avail[mpath]; ok {
+		return
+	}
+	if _, ok := disabled[mpath]; ok {
+		return
+	}
+	nlog.Infof("%s: attaching newly mounted %q (hot-plug)", hp.t, mpath)
+	if _, err := hp.t.fsprg.attachMpath(mpath, "" /*label*/, "" /*quota*/, false /*force*/); err != nil {
+		nlog.Errorf("%s: failed to attach %q: %v", hp.t, mpath, err)
+	}
+}
+
+// directories that match the pattern and are mount points (i.e., reside on a device
+// different from their respective parents)
+func mountPoints(pattern string) map[string]uint64 {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		nlog.Errorln(err) // (validated)
+		return nil
+	}
+	mounts := make(map[string]uint64, len(matches))
+	for _, path := range matches {
+		if finfo, err := os.Stat(path); err != nil || !finfo.IsDir() {
+			continue
+		}
+		dev, err := fs.DevID(path)
+		if err != nil {
+			continue
+		}
+		if pdev, err := fs.DevID(filepath.Dir(path)); err != nil || pdev == dev {
+			continue
+		}
+		mounts[path] = dev
+	}
+	return mounts
+}
@@ -0,0 +1,34 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHotplugUpdate(t *testing.T) {
+	hp := &hotplug{}
+	tests := []struct {
+		mounts map[string]uint64
+		added  []string
+	}{
+		// baseline: existing mounts are never attached
+		{mounts: map[string]uint64{"/mnt/d1": 1, "/mnt/d2": 2}, added: nil},
+		{mounts: map[string]uint64{"/mnt/d1": 1, "/mnt/d2": 2}, added: nil},
+		// new disk
+		{mounts: map[string]uint64{"/mnt/d1": 1, "/mnt/d2": 2, "/mnt/d3": 3}, added: []string{"/mnt/d3"}},
+		// d2 fails and gets unmounted, and then replaced (remounted on a new device)
+		{mounts: map[string]uint64{"/mnt/d1": 1, "/mnt/d3": 3}, added: nil},
+		{mounts: map[string]uint64{"/mnt/d1": 1, "/mnt/d2": 4, "/mnt/d3": 3}, added: []string{"/mnt/d2"}},
+		// replaced in between the scans
+		{mounts: map[string]uint64{"/mnt/d1": 5, "/mnt/d2": 4, "/mnt/d3": 3}, added: []string{"/mnt/d1"}},
+	}
+	for i, test := range tests {
+		if added := hp.update(test.mounts); !reflect.DeepEqual(added, test.added) {
+			t.Errorf("%d: expected %v, got %v", i, test.added, added)
+		}
+	}
+}
@@ -66,7 +66,7 @@ func (t *target) Backend(bck *meta.Bck) cluster.BackendProvider {
 
 func (t *target) PutObject(lom *cluster.LOM, params *cluster.PutObjectParams) error {
 	debug.Assert(params.WorkTag != "" && !params.Atime.IsZero())
-	workFQN := fs.CSM.Gen(lom, fs.WorkfileType, params.WorkTag)
+	workFQN := fs.CSM.GenScratch(lom, fs.WorkfileType, params.WorkTag)
 	poi := allocPOI()
 	{
 		poi.t = t
@@ -357,3 +357,15 @@ func (t *target) DisableMpath(mpath, reason string) (err error) {
 	_, err = t.fsprg.disableMpath(mpath, true /*dont-resilver*/) // NOTE: not resilvering upon FSCH calling
 	return
 }
+
+func (t *target) EnableMpath(mpath string) (err error) {
+	nlog.Infof("Re-enabling mountpath %s", mpath)
+	_, err = t.fsprg.enableMpath(mpath)
+	return
+}
+
+func (t *target) SuspectMpath(mpath, reason string) (err error) {
+	nlog.Warningf("Making mountpath %s read-only: %s", mpath, reason)
+	_, err = t.fsprg.setReadOnly(mpath, true)
+	return
+}
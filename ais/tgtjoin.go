@@ -0,0 +1,138 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// dry-run join: walk all local objects and count those (and their sizes)
+// that would migrate to the joining target `nid`, as per the resulting Smap
+// NOTE: EC buckets are skipped - EC rebalance relocates slices and replicas, not objects
+
+type joinJogger struct {
+	t    *target
+	smap *smapX
+	nid  string
+	opts fs.WalkOpts
+	bcks map[string]*apc.JoinImpactCnt
+	err  error
+}
+
+func (t *target) joinImpact(w http.ResponseWriter, r *http.Request, query url.Values) {
+	nid := query.Get(apc.QparamNodeID)
+	if nid == "" {
+		t.writeErrf(w, r, "%s: missing %q query parameter", t, apc.QparamNodeID)
+		return
+	}
+	var (
+		smap  = t.owner.smap.get().clone()
+		nsi   = &meta.Snode{}
+		avail = fs.GetAvail()
+		jgs   = make([]*joinJogger, 0, len(avail))
+		wg    = &sync.WaitGroup{}
+	)
+	nsi.Init(nid, apc.Target)
+	smap.Tmap[nid] = nsi
+	for _, mi := range avail {
+		jg := &joinJogger{t: t, smap: smap, nid: nid, bcks: make(map[string]*apc.JoinImpactCnt, 4)}
+		jgs = append(jgs, jg)
+		wg.Add(1)
+		go jg.jog(mi, wg)
+	}
+	wg.Wait()
+
+	out := &apc.JoinImpact{DaemonID: nid, Buckets: make(map[string]*apc.JoinImpactCnt, 4)}
+	for _, jg := range jgs {
+		if jg.err != nil {
+			t.writeErr(w, r, jg.err)
+			return
+		}
+		for cname, cnt := range jg.bcks {
+			if out.Buckets[cname] == nil {
+				out.Buckets[cname] = &apc.JoinImpactCnt{}
+			}
+			out.Buckets[cname].Add(cnt)
+			out.Total.Add(cnt)
+		}
+	}
+	t.writeJSON(w, r, out, "join-impact")
+}
+
+func (jg *joinJogger) jog(mi *fs.Mountpath, wg *sync.WaitGroup) {
+	defer wg.Done()
+	jg.opts.Mi = mi
+	jg.opts.CTs = []string{fs.ObjectType}
+	jg.opts.Callback = jg.visitObj
+	bmd := jg.t.owner.bmd.get()
+	bmd.Range(nil, nil, jg.walkBck)
+}
+
+func (jg *joinJogger) walkBck(bck *meta.Bck) bool {
+	if bck.Props.EC.Enabled {
+		return false
+	}
+	jg.opts.Bck.Copy(bck.Bucket())
+	if err := fs.Walk(&jg.opts); err != nil {
+		nlog.Errorf("%s: failed to traverse %s: %v", jg.t, jg.opts.Mi, err)
+		jg.err = err
+		return true
+	}
+	return false
+}
+
+func (jg *joinJogger) visitObj(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	lom := cluster.AllocLOM(fqn)
+	err := jg._lwalk(lom, fqn)
+	cluster.FreeLOM(lom)
+	if err == cmn.ErrSkip {
+		err = nil
+	}
+	return err
+}
+
+func (jg *joinJogger) _lwalk(lom *cluster.LOM, fqn string) error {
+	if err := lom.InitFQN(fqn, nil); err != nil {
+		if cmn.IsErrBucketLevel(err) {
+			return err
+		}
+		return cmn.ErrSkip
+	}
+	if lom.Bck().Props.EC.Enabled || lom.Bck().Props.Scratch.Enabled {
+		return filepath.SkipDir
+	}
+	tsi, err := cluster.HrwTarget(lom.Uname(), &jg.smap.Smap)
+	if err != nil {
+		return err
+	}
+	if tsi.ID() != jg.nid {
+		return cmn.ErrSkip
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return cmn.ErrSkip
+	}
+	cname := lom.Bck().Cname("")
+	cnt, ok := jg.bcks[cname]
+	if !ok {
+		cnt = &apc.JoinImpactCnt{}
+		jg.bcks[cname] = cnt
+	}
+	cnt.Objs++
+	cnt.Size += lom.SizeBytes()
+	return nil
+}
@@ -0,0 +1,178 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Client-facing (advisory) leases: time-limited exclusive ownership of an object
+// name or a prefix, to coordinate distributed writers. Leases do not block regular
+// reads and writes - it is up to the cooperating clients to acquire a lease before
+// writing, and to renew it before it expires.
+//
+// All leases of a given bucket are kept by a single target (the bucket's HRW owner)
+// that can, therefore, detect object vs. prefix conflicts. Leases are in-memory
+// only and do not survive the owner's restart or a change of ownership (cluster map
+// change) - the TTL is expected to be short and the clients - to re-acquire.
+
+const (
+	leaseDfltTTL = 30 * time.Second
+	leaseMaxTTL  = time.Hour
+	leaseHkName  = "leases" + hk.NameSuffix
+	leaseHkIval  = time.Minute
+)
+
+type (
+	tlease struct {
+		apc.Lease
+		bck string // bucket uname
+	}
+	leaseTable struct {
+		m  map[string]*tlease // lease ID => lease
+		mu sync.Mutex
+	}
+)
+
+func (lt *leaseTable) init() {
+	lt.m = make(map[string]*tlease, 16)
+	hk.Reg(leaseHkName, lt.housekeep, leaseHkIval)
+}
+
+func leaseTTL(ttl cos.Duration) (time.Duration, error) {
+	switch {
+	case ttl == 0:
+		return leaseDfltTTL, nil
+	case ttl < 0 || ttl.D() > leaseMaxTTL:
+		return 0, fmt.Errorf("invalid lease TTL %v (expecting (0, %v])", ttl, leaseMaxTTL)
+	}
+	return ttl.D(), nil
+}
+
+func (l *tlease) conflicts(bck, name string, prefix bool) bool {
+	if l.bck != bck {
+		return false
+	}
+	return l.Name == name || (l.Prefix && strings.HasPrefix(name, l.Name)) || (prefix && strings.HasPrefix(l.Name, name))
+}
+
+func (lt *leaseTable) acquire(bck *meta.Bck, name, tid string, msg *apc.LeaseMsg) (*apc.Lease, int, error) {
+	ttl, err := leaseTTL(msg.TTL)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	var (
+		now   = time.Now().UnixNano()
+		uname = string(bck.MakeUname(""))
+	)
+	lt.mu.Lock()
+	for id, l := range lt.m {
+		if l.Expires <= now {
+			delete(lt.m, id)
+			continue
+		}
+		if l.conflicts(uname, name, msg.Prefix) {
+			lt.mu.Unlock()
+			what := "object"
+			if l.Prefix {
+				what = "prefix"
+			}
+			return nil, http.StatusConflict, fmt.Errorf("%s is leased (%s %q, expires in %v)",
+				bck.Cname(name), what, l.Name, time.Duration(l.Expires-now).Round(time.Second))
+		}
+	}
+	l := &tlease{bck: uname}
+	l.ID = cos.GenUUID()
+	l.Name = name
+	l.Prefix = msg.Prefix
+	l.Target = tid
+	l.Expires = now + int64(ttl)
+	lt.m[l.ID] = l
+	out := l.Lease
+	lt.mu.Unlock()
+	return &out, 0, nil
+}
+
+func (lt *leaseTable) renew(bck *meta.Bck, msg *apc.LeaseMsg) (*apc.Lease, int, error) {
+	ttl, err := leaseTTL(msg.TTL)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	now := time.Now().UnixNano()
+	lt.mu.Lock()
+	l, ok := lt.m[msg.ID]
+	if !ok || l.Expires <= now || l.bck != string(bck.MakeUname("")) {
+		lt.mu.Unlock()
+		return nil, http.StatusNotFound, fmt.Errorf("lease %q not found in %s (expired?)", msg.ID, bck)
+	}
+	l.Expires = now + int64(ttl)
+	out := l.Lease
+	lt.mu.Unlock()
+	return &out, 0, nil
+}
+
+func (lt *leaseTable) release(bck *meta.Bck, msg *apc.LeaseMsg) (int, error) {
+	now := time.Now().UnixNano()
+	lt.mu.Lock()
+	l, ok := lt.m[msg.ID]
+	if !ok || l.Expires <= now || l.bck != string(bck.MakeUname("")) {
+		lt.mu.Unlock()
+		return http.StatusNotFound, fmt.Errorf("lease %q not found in %s (expired?)", msg.ID, bck)
+	}
+	delete(lt.m, msg.ID)
+	lt.mu.Unlock()
+	return 0, nil
+}
+
+func (lt *leaseTable) housekeep() time.Duration {
+	now := time.Now().UnixNano()
+	lt.mu.Lock()
+	for id, l := range lt.m {
+		if l.Expires <= now {
+			delete(lt.m, id)
+		}
+	}
+	lt.mu.Unlock()
+	return leaseHkIval
+}
+
+// POST /v1/objects/bucket-name/object-name-or-prefix (apc.ActAcquireLease et al.)
+func (t *target) lease(w http.ResponseWriter, r *http.Request, bck *meta.Bck, name string, msg *apc.ActMsg) {
+	var (
+		lmsg    apc.LeaseMsg
+		lease   *apc.Lease
+		errCode int
+		err     error
+	)
+	if err := cos.MorphMarshal(msg.Value, &lmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	switch msg.Action {
+	case apc.ActAcquireLease:
+		lease, errCode, err = t.leases.acquire(bck, name, t.SID(), &lmsg)
+	case apc.ActRenewLease:
+		lease, errCode, err = t.leases.renew(bck, &lmsg)
+	default:
+		errCode, err = t.leases.release(bck, &lmsg)
+	}
+	if err != nil {
+		t.writeErr(w, r, err, errCode)
+		return
+	}
+	if lease != nil {
+		t.writeJSON(w, r, lease, msg.Action)
+	}
+}
@@ -0,0 +1,101 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
+)
+
+// GET { apc.ActListBckInfo } /v1/buckets (proxy => all targets, see prxlsb.go)
+// local usage of the specified (present) buckets
+func (t *target) bckUsage(w http.ResponseWriter, r *http.Request, msg *aisMsg) {
+	var bcks cmn.Bcks
+	if err := cos.MorphMarshal(msg.Value, &bcks); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	var (
+		config = cmn.GCO.Get()
+		avail  = fs.GetAvail()
+		infos  = make(cmn.BckInfos, 0, len(bcks))
+	)
+	for i := range bcks {
+		bck := meta.CloneBck(&bcks[i])
+		if err := bck.Init(t.owner.bmd); err != nil {
+			if !cmn.IsErrBckNotFound(err) && !cmn.IsErrRemoteBckNotFound(err) {
+				nlog.Warningln(t.String()+":", err)
+			}
+			continue // (e.g., destroyed in the meantime)
+		}
+		var (
+			u   = &cmn.BckUsage{}
+			mtx sync.Mutex
+			wg  sync.WaitGroup
+		)
+		for _, mi := range avail {
+			wg.Add(1)
+			go func(mi *fs.Mountpath) {
+				mu := mpathBckUsage(mi, bck.Bucket(), config.Disk.WalkWorkers)
+				mtx.Lock()
+				u.Aggregate(mu)
+				mtx.Unlock()
+				wg.Done()
+			}(mi)
+		}
+		wg.Wait()
+		infos = append(infos, &cmn.BckInfo{Bck: bck.Clone(), Usage: u})
+	}
+	t.writeJSON(w, r, infos, "list-bck-info")
+}
+
+// - size: all content types (the same `du` as in fast bucket summary)
+// - number of objects: readdir-only traversal (no metadata is loaded)
+// - last modification: directory mtimes (those get updated upon object creation and removal)
+func mpathBckUsage(mi *fs.Mountpath, bck *cmn.Bck, workers int) *cmn.BckUsage {
+	var (
+		u         = &cmn.BckUsage{}
+		cnt, last atomic.Int64
+		err       error
+	)
+	if u.Size, err = ios.DirSizeOnDisk(mi.MakePathBck(bck), false /*withNonDirPrefix*/); err != nil {
+		nlog.Warningln(err)
+	}
+	opts := &fs.WalkOpts{
+		Mi:  mi,
+		CTs: []string{fs.ObjectType},
+		Callback: func(fqn string, de fs.DirEntry) error {
+			if !de.IsDir() {
+				cnt.Inc()
+				return nil
+			}
+			if finfo, err := os.Stat(fqn); err == nil {
+				for mtime := finfo.ModTime().UnixNano(); ; {
+					prev := last.Load()
+					if mtime <= prev || last.CAS(prev, mtime) {
+						break
+					}
+				}
+			}
+			return nil
+		},
+		Workers: workers,
+	}
+	opts.Bck.Copy(bck)
+	if err := fs.Walk(opts); err != nil && !cmn.IsErrAborted(err) {
+		nlog.Warningln(err)
+	}
+	u.ObjCount, u.LastModified = uint64(cnt.Load()), last.Load()
+	return u
+}
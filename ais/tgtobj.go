@@ -6,14 +6,17 @@ package ais
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
 	"encoding"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -45,21 +48,23 @@ import (
 
 type (
 	putOI struct {
-		r          io.ReadCloser // reader that has the content
-		xctn       cluster.Xact  // xaction that puts
-		t          *target       // this
-		lom        *cluster.LOM  // obj
-		cksumToUse *cos.Cksum    // if available (not `none`), can be validated and will be stored
-		config     *cmn.Config   // (during this request)
-		resphdr    http.Header   // as implied
-		workFQN    string        // temp fqn to be renamed
-		atime      int64         // access time
-		size       int64         // aka Content-Length
-		owt        cmn.OWT       // object write transaction enum { OwtPut, ..., OwtGet* }
-		restful    bool          // being invoked via RESTful API
-		t2t        bool          // by another target
-		skipEC     bool          // do not erasure-encode when finalizing
-		skipVC     bool          // skip loading existing Version and skip comparing Checksums (skip VC)
+		r          io.ReadCloser   // reader that has the content
+		ctx        context.Context // request context (request ID), if any
+		xctn       cluster.Xact    // xaction that puts
+		t          *target         // this
+		lom        *cluster.LOM    // obj
+		cksumToUse *cos.Cksum      // if available (not `none`), can be validated and will be stored
+		config     *cmn.Config     // (during this request)
+		precond    cmn.Precond     // conditional PUT (If-Match, If-None-Match)
+		resphdr    http.Header     // as implied
+		workFQN    string          // temp fqn to be renamed
+		atime      int64           // access time
+		size       int64           // aka Content-Length
+		owt        cmn.OWT         // object write transaction enum { OwtPut, ..., OwtGet* }
+		restful    bool            // being invoked via RESTful API
+		t2t        bool            // by another target
+		skipEC     bool            // do not erasure-encode when finalizing
+		skipVC     bool            // skip loading existing Version and skip comparing Checksums (skip VC)
 	}
 
 	getOI struct {
@@ -69,8 +74,10 @@ type (
 		lom        *cluster.LOM    // obj
 		archive    archiveQuery    // archive query
 		ranges     byteRanges      // range read (see https://www.rfc-editor.org/rfc/rfc7233#section-2.1)
+		precond    cmn.Precond     // conditional GET (If-Match, If-None-Match)
 		atime      int64           // access time
 		isGFN      bool            // is GFN
+		hedge      bool            // hedged read (see hedgedOpen)
 		chunked    bool            // chunked transfer (en)coding: https://tools.ietf.org/html/rfc7230#page-36
 		unlocked   bool            // internal
 		verchanged bool            // version changed
@@ -96,7 +103,8 @@ type (
 	}
 
 	copyOI struct {
-		t *target
+		t   *target
+		ctx context.Context // request context (request ID), if any
 		cluster.CopyObjectParams
 		owt      cmn.OWT
 		finalize bool // copies and EC (as in poi.finalize())
@@ -114,14 +122,15 @@ type (
 
 	// put/append-to arch
 	putA2I struct {
-		r        io.ReadCloser // read bytes to append
-		t        *target       // this
-		lom      *cluster.LOM  // resulting shard
-		filename string        // fqn inside
-		mime     string        // format
-		started  int64         // time of receiving
-		size     int64         // aka Content-Length
-		put      bool          // overwrite
+		r        io.ReadCloser   // read bytes to append
+		ctx      context.Context // request context (request ID)
+		t        *target         // this
+		lom      *cluster.LOM    // resulting shard
+		filename string          // fqn inside
+		mime     string          // format
+		started  int64           // time of receiving
+		size     int64           // aka Content-Length
+		put      bool            // overwrite
 	}
 )
 
@@ -132,8 +141,9 @@ type (
 func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error) {
 	{
 		poi.r = r.Body
+		poi.ctx = reqCtx(r)
 		poi.resphdr = resphdr
-		poi.workFQN = fs.CSM.Gen(poi.lom, fs.WorkfileType, fs.WorkfilePut)
+		poi.workFQN = fs.CSM.GenScratch(poi.lom, fs.WorkfileType, fs.WorkfilePut)
 		poi.cksumToUse = poi.lom.ObjAttrs().FromHeader(r.Header)
 		poi.owt = cmn.OwtPut // default
 	}
@@ -144,7 +154,7 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 		// resolve cluster-wide xact "behind" this PUT (promote via a single target won't show up)
 		xctn, err := xreg.GetXact(dpq.uuid)
 		if err != nil {
-			nlog.Errorln(err)
+			nlog.ErrorlnCtx(poi.ctx, err)
 			return 0, err
 		}
 		if xctn != nil {
@@ -156,21 +166,162 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 			poi.size = size
 		}
 	}
+	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
+		if schema := &poi.lom.Bprops().Schema; schema.IsSet() {
+			if err := poi.checkSchema(schema); err != nil {
+				poi.t.statsT.IncErr(stats.PutCount)
+				return http.StatusUnprocessableEntity, err
+			}
+		}
+	}
+	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
+		if err := poi.contentType(r.Header); err != nil {
+			poi.t.statsT.IncErr(stats.PutCount)
+			return 0, err
+		}
+		poi.precond.FromHeader(r.Header)
+		if poi.precond.IsSet() {
+			if err := poi.checkPrecond(false /*locked*/); err != nil {
+				poi.t.statsT.IncErr(stats.PutCount)
+				return http.StatusPreconditionFailed, err
+			}
+		}
+	}
 	return poi.putObject()
 }
 
+// conditional PUT: evaluate the preconditions against the current (existing) object, if any;
+// done upon receiving the request and, again, under exclusive lock prior to committing the new
+// content (except remote buckets - the latter, when written remotely, is a done deal)
+func (poi *putOI) checkPrecond(locked bool) error {
+	var (
+		etag string
+		lom  = cluster.AllocLOM(poi.lom.ObjName)
+	)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(poi.lom.Bucket()); err != nil {
+		return err
+	}
+	exists := lom.Load(false /*cache it*/, locked) == nil
+	if exists {
+		etag = cmn.ObjETag(lom)
+	}
+	if poi.precond.Check(etag, exists, http.MethodPut) != 0 {
+		return cmn.NewErrPreconditionFailed(lom.Cname(), &poi.precond, etag)
+	}
+	return nil
+}
+
+// enforce bucket schema (`cmn.SchemaConf`) prior to writing; the content-based rules
+// (content type and, when not known in advance, size) are enforced while reading
+func (poi *putOI) checkSchema(schema *cmn.SchemaConf) (err error) {
+	var (
+		lom = poi.lom
+		bck = lom.Bucket()
+	)
+	if err = schema.CheckName(bck, lom.ObjName); err != nil {
+		return
+	}
+	if err = schema.CheckMD(bck, lom.ObjName, lom.GetCustomMD()); err != nil {
+		return
+	}
+	if poi.size > 0 {
+		if err = schema.CheckSize(bck, lom.ObjName, poi.size); err != nil {
+			return
+		}
+	} else if schema.MaxSize > 0 {
+		poi.r = &schemaSizeR{ReadCloser: poi.r, schema: schema, bck: bck, objName: lom.ObjName}
+	}
+	if schema.ContentTypes == "" {
+		return
+	}
+	head := make([]byte, cmn.SchemaSniffLen)
+	n, erh := io.ReadFull(poi.r, head)
+	if erh != nil && erh != io.EOF && erh != io.ErrUnexpectedEOF {
+		return erh
+	}
+	head = head[:n]
+	if err = schema.CheckContent(bck, lom.ObjName, head); err != nil {
+		return
+	}
+	poi.r = &schemaHeadR{Reader: io.MultiReader(bytes.NewReader(head), poi.r), Closer: poi.r}
+	return
+}
+
+// content type: explicit (via `Content-Type` or custom metadata) or else detected by the
+// object name's extension or, failing that, by the leading bytes of the content;
+// stored as custom metadata and returned with GET (see getOI.contentType)
+func (poi *putOI) contentType(hdr http.Header) error {
+	lom := poi.lom
+	if _, ok := lom.GetCustomKey(cos.HdrContentType); ok {
+		return nil
+	}
+	ctype := hdr.Get(cos.HdrContentType)
+	if ctype == "" || ctype == cos.ContentBinary {
+		ctype = mime.TypeByExtension(filepath.Ext(lom.ObjName))
+	}
+	if ctype == "" {
+		head := make([]byte, cmn.SchemaSniffLen)
+		n, err := io.ReadFull(poi.r, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		head = head[:n]
+		if n > 0 {
+			ctype = http.DetectContentType(head)
+		}
+		poi.r = &schemaHeadR{Reader: io.MultiReader(bytes.NewReader(head), poi.r), Closer: poi.r}
+	}
+	if ctype != "" && ctype != cos.ContentBinary {
+		lom.SetCustomKey(cos.HdrContentType, ctype)
+	}
+	return nil
+}
+
+type (
+	// put back the leading (sniffed) bytes
+	schemaHeadR struct {
+		io.Reader
+		io.Closer
+	}
+	// fail the PUT as soon as the content exceeds `schema.max_size`
+	schemaSizeR struct {
+		io.ReadCloser
+		schema  *cmn.SchemaConf
+		bck     *cmn.Bck
+		objName string
+		n       int64
+	}
+)
+
+func (r *schemaSizeR) Read(b []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(b)
+	r.n += int64(n)
+	if ers := r.schema.CheckSize(r.bck, r.objName, r.n); ers != nil {
+		err = ers
+	}
+	return
+}
+
 func (poi *putOI) putObject() (errCode int, err error) {
 	// PUT is a no-op if the checksums do match
 	if !poi.skipVC && !poi.cksumToUse.IsEmpty() {
 		if poi.lom.EqCksum(poi.cksumToUse) {
 			if poi.config.FastV(4, cos.SmoduleAIS) {
-				nlog.Infof("destination %s has identical %s: PUT is a no-op", poi.lom, poi.cksumToUse)
+				nlog.InfofCtx(poi.ctx, "destination %s has identical %s: PUT is a no-op", poi.lom, poi.cksumToUse)
 			}
 			cos.DrainReader(poi.r)
 			return 0, nil
 		}
 	}
 	if err = poi.write(); err != nil {
+		errCode = http.StatusInternalServerError
+		if cmn.IsErrSchemaViolation(err) {
+			errCode = http.StatusUnprocessableEntity
+		}
+		goto rerr
+	}
+	if err = poi.dictCompress(); err != nil {
 		errCode = http.StatusInternalServerError
 		goto rerr
 	}
@@ -213,6 +364,13 @@ rerr:
 	return
 }
 
+func (poi *putOI) context() context.Context {
+	if poi.ctx == nil {
+		return context.Background()
+	}
+	return poi.ctx
+}
+
 func (poi *putOI) loghdr() string {
 	s := poi.owt.String() + ", " + poi.lom.String()
 	if poi.xctn != nil { // may not be showing remote xaction (see doPut)
@@ -235,14 +393,14 @@ func (poi *putOI) finalize() (errCode int, err error) {
 			}
 			poi.t.fsErr(err1, poi.workFQN)
 			if err2 := cos.RemoveFile(poi.workFQN); err2 != nil {
-				nlog.Errorf(fmtNested, poi.t, err1, "remove", poi.workFQN, err2)
+				nlog.ErrorfCtx(poi.ctx, fmtNested, poi.t, err1, "remove", poi.workFQN, err2)
 			}
 		}
 		poi.lom.Uncache(true /*delDirty*/)
 		return
 	}
 	if !poi.skipEC {
-		if ecErr := ec.ECM.EncodeObject(poi.lom); ecErr != nil && ecErr != ec.ErrorECDisabled {
+		if ecErr := ec.ECM.EncodeObject(poi.context(), poi.lom); ecErr != nil && ecErr != ec.ErrorECDisabled {
 			err = ecErr
 			if cmn.IsErrCapExceeded(err) {
 				errCode = http.StatusInsufficientStorage
@@ -265,7 +423,7 @@ func (poi *putOI) fini() (errCode int, err error) {
 		errCode, err = poi.putRemote()
 		if err != nil {
 			loghdr := poi.loghdr()
-			nlog.Errorf("PUT (%s): %v(%d)", loghdr, err, errCode)
+			nlog.ErrorfCtx(poi.ctx, "PUT (%s): %v(%d)", loghdr, err, errCode)
 			if errCode != http.StatusServiceUnavailable {
 				return
 			}
@@ -275,9 +433,13 @@ func (poi *putOI) fini() (errCode int, err error) {
 			if err != nil {
 				return
 			}
-			nlog.Infof("PUT (%s): retried OK", loghdr)
+			nlog.InfofCtx(poi.ctx, "PUT (%s): retried OK", loghdr)
 		}
 	}
+	// (local copy only)
+	if err = poi.encrypt(); err != nil {
+		return http.StatusInternalServerError, err
+	}
 
 	// locking strategies: optimistic and otherwise
 	// (see GetCold() implementation and cmn.OWT enum)
@@ -287,7 +449,7 @@ func (poi *putOI) fini() (errCode int, err error) {
 	case cmn.OwtGetPrefetchLock:
 		if !lom.TryLock(true) {
 			if poi.config.FastV(4, cos.SmoduleAIS) {
-				nlog.Warningf("(%s) is busy", poi.loghdr())
+				nlog.WarningfCtx(poi.ctx, "(%s) is busy", poi.loghdr())
 			}
 			return 0, cmn.ErrSkip // e.g. prefetch can skip it and keep on going
 		}
@@ -298,6 +460,11 @@ func (poi *putOI) fini() (errCode int, err error) {
 		lom.Lock(true)
 		defer lom.Unlock(true)
 		lom.SetAtimeUnix(poi.atime)
+		if poi.precond.IsSet() && !bck.IsRemote() {
+			if err = poi.checkPrecond(true /*locked*/); err != nil {
+				return http.StatusPreconditionFailed, err
+			}
+		}
 	}
 
 	// ais versioning
@@ -308,25 +475,44 @@ func (poi *putOI) fini() (errCode int, err error) {
 				debug.Assert(err == nil)
 			} else if remSrc, ok := lom.GetCustomKey(cmn.SourceObjMD); !ok || remSrc == "" {
 				if err = lom.IncVersion(); err != nil {
-					nlog.Errorln(err)
+					nlog.ErrorlnCtx(poi.ctx, err)
 				}
 			}
 		}
 	}
 
 	// done
+	var evtyp string
+	if (poi.owt == cmn.OwtPut || poi.owt == cmn.OwtFinalize || poi.owt == cmn.OwtPromote) && poi.t.watch.active() {
+		evtyp = apc.BckEventCreate
+		if _, errV := os.Stat(lom.FQN); errV == nil {
+			evtyp = apc.BckEventUpdate
+		}
+	}
+	poi.t.fdc.evictLOM(lom)
 	if err = lom.RenameFrom(poi.workFQN); err != nil {
 		return
 	}
 	if lom.HasCopies() {
 		if errdc := lom.DelAllCopies(); errdc != nil {
-			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding to PUT anyway...", poi.loghdr(), errdc)
+			nlog.ErrorfCtx(poi.ctx, "PUT (%s): failed to delete old copies [%v], proceeding to PUT anyway...",
+				poi.loghdr(), errdc)
 		}
 	}
 	if lom.AtimeUnix() == 0 { // (is set when migrating within cluster; prefetch special case)
 		lom.SetAtimeUnix(poi.atime)
 	}
-	err = lom.PersistMain()
+	if err = lom.PersistMain(); err != nil {
+		return
+	}
+	if lom.Bprops().Durability.Get().DirSync() {
+		if err = cos.FsyncDir(filepath.Dir(lom.FQN)); err != nil {
+			return
+		}
+	}
+	if evtyp != "" {
+		poi.t.watch.record(lom, evtyp)
+	}
 	return
 }
 
@@ -345,7 +531,7 @@ func (poi *putOI) putRemote() (errCode int, err error) {
 		// some/all of those are set by the backend.PutObj()
 		lom.ObjAttrs().DelCustomKeys(cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD)
 	}
-	errCode, err = backend.PutObj(lmfh, lom)
+	errCode, err = backend.PutObj(poi.context(), lmfh, lom)
 	if err == nil && !lom.Bck().IsRemoteAIS() {
 		lom.SetCustomKey(cmn.SourceObjMD, backend.Provider())
 	}
@@ -361,6 +547,7 @@ func (poi *putOI) write() (err error) {
 		slab    *memsys.Slab
 		lmfh    *os.File
 		writer  io.Writer
+		flush   func() error // (direct I/O and io_uring writers)
 		writers = make([]io.Writer, 0, 4)
 		cksums  = struct {
 			store     *cos.CksumHash // store with LOM
@@ -370,10 +557,27 @@ func (poi *putOI) write() (err error) {
 		}{}
 		ckconf = poi.lom.CksumConf()
 	)
-	if lmfh, err = poi.lom.CreateFile(poi.workFQN); err != nil {
-		return
+	if poi.lom.Bprops().DirectIO.Get() {
+		if lmfh, err = poi.lom.CreateFileDirect(poi.workFQN); err != nil {
+			return
+		}
+		dbuf, dslab := poi.t.gmm.Alloc() // (staging buffer to align writes)
+		defer dslab.Free(dbuf)
+		dw := fs.NewDirectWriter(lmfh, dbuf)
+		writer, flush = dw, dw.Flush
+	} else {
+		if lmfh, err = poi.lom.CreateFile(poi.workFQN); err != nil {
+			return
+		}
+		if poi.size >= fs.URingMinSize && fs.URingAvail() {
+			ubuf, uslab := poi.t.gmm.AllocSize(memsys.MaxPageSlabSize)
+			defer uslab.Free(ubuf)
+			uw := fs.NewURingWriter(lmfh, ubuf)
+			writer, flush = uw, uw.Flush
+		} else {
+			writer = cos.WriterOnly{Writer: lmfh} // Hiding `ReadFrom` for `*os.File` introduced in Go1.15.
+		}
 	}
-	writer = cos.WriterOnly{Writer: lmfh} // Hiding `ReadFrom` for `*os.File` introduced in Go1.15.
 	if poi.size == 0 {
 		buf, slab = poi.t.gmm.Alloc()
 	} else {
@@ -400,7 +604,7 @@ func (poi *putOI) write() (err error) {
 	// - object migrated + `ckconf.ValidateObjMove`
 
 	cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
-	writers = append(writers, cksums.store.H)
+	writers = append(writers, cos.NewOffloadWriter(cksums.store.H, cos.PrioFg))
 	if !poi.skipVC && !poi.cksumToUse.IsEmpty() && poi.validateCksum(ckconf) {
 		cksums.expct = poi.cksumToUse
 		if poi.cksumToUse.Type() == cksums.store.Type() {
@@ -408,7 +612,7 @@ func (poi *putOI) write() (err error) {
 		} else {
 			// otherwise, compute separately
 			cksums.compt = cos.NewCksumHash(poi.cksumToUse.Type())
-			writers = append(writers, cksums.compt.H)
+			writers = append(writers, cos.NewOffloadWriter(cksums.compt.H, cos.PrioFg))
 		}
 	}
 write:
@@ -418,6 +622,9 @@ write:
 		writers = append(writers, writer)
 		written, err = io.CopyBuffer(cos.NewWriterMulti(writers...), poi.r /*reader*/, buf)
 	}
+	if err == nil && flush != nil {
+		err = flush()
+	}
 	if err != nil {
 		return
 	}
@@ -436,9 +643,10 @@ write:
 	}
 
 	// ok
-	if cmn.Features.IsSet(feat.FsyncPUT) {
-		err = lmfh.Sync() // compare w/ cos.FlushClose
-		debug.AssertNoErr(err)
+	if poi.lom.Bprops().Durability.Get().Fsync() {
+		if err = lmfh.Sync(); err != nil { // compare w/ cos.FlushClose
+			return
+		}
 	}
 	cos.Close(lmfh)
 	lmfh = nil
@@ -452,6 +660,38 @@ write:
 	return
 }
 
+// at-rest compression (see cluster.LOM.DictCompress) applies to user PUTs; objects
+// that get copied and migrated within the bucket arrive as stored
+func (poi *putOI) dictCompress() error {
+	switch poi.owt {
+	case cmn.OwtPut, cmn.OwtFinalize, cmn.OwtPromote:
+		poi.lom.DelCustomKey(cmn.DictVerObjMD)
+		poi.lom.DelCustomKey(cmn.DictSizeObjMD)
+	default:
+		return nil
+	}
+	if poi.owt != cmn.OwtPut {
+		return nil
+	}
+	_, err := poi.lom.DictCompress(poi.workFQN)
+	if err != nil {
+		cos.RemoveFile(poi.workFQN)
+	}
+	return err
+}
+
+// at-rest encryption (see cluster.LOM.Encrypt) applies to all objects landing on
+// designated mountpaths; objects that get migrated within the cluster arrive as stored
+func (poi *putOI) encrypt() error {
+	if poi.owt != cmn.OwtMigrate {
+		poi.lom.DelCustomKey(cmn.EncKeyObjMD)
+	} else if poi.lom.IsEncrypted() {
+		return nil
+	}
+	_, err := poi.lom.Encrypt(poi.workFQN)
+	return err
+}
+
 // post-write close & cleanup
 func (poi *putOI) _cleanup(buf []byte, slab *memsys.Slab, lmfh *os.File, err error) {
 	if buf != nil {
@@ -465,10 +705,10 @@ func (poi *putOI) _cleanup(buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 	// not ok
 	poi.r.Close()
 	if nerr := lmfh.Close(); nerr != nil {
-		nlog.Errorf(fmtNested, poi.t, err, "close", poi.workFQN, nerr)
+		nlog.ErrorfCtx(poi.ctx, fmtNested, poi.t, err, "close", poi.workFQN, nerr)
 	}
 	if nerr := cos.RemoveFile(poi.workFQN); nerr != nil {
-		nlog.Errorf(fmtNested, poi.t, err, "remove", poi.workFQN, nerr)
+		nlog.ErrorfCtx(poi.ctx, fmtNested, poi.t, err, "remove", poi.workFQN, nerr)
 	}
 }
 
@@ -476,6 +716,9 @@ func (poi *putOI) validateCksum(c *cmn.CksumConf) (v bool) {
 	switch poi.owt {
 	case cmn.OwtMigrate, cmn.OwtPromote, cmn.OwtFinalize:
 		v = c.ValidateObjMove
+		if poi.lom.IsEncoded() {
+			v = false // migrating at-rest compressed and/or encrypted (the checksum is that of the original content)
+		}
 	case cmn.OwtPut, cmn.OwtGetTryLock, cmn.OwtGetLock, cmn.OwtGet:
 		v = c.ValidateColdGet
 	case cmn.OwtGetPrefetchLock:
@@ -506,6 +749,7 @@ func (goi *getOI) get() (errCode int, err error) {
 		doubleCheck bool
 		retried     bool
 		cold        bool
+		promoted    bool
 	)
 do:
 	err = goi.lom.Load(true /*cache it*/, true /*locked*/)
@@ -515,6 +759,19 @@ do:
 			errCode = http.StatusInternalServerError
 			return
 		}
+		// demoted to the capacity tier (see cmn.SpaceConf.FastTier)
+		if !promoted {
+			goi.lom.Unlock(false)
+			promoted = goi.lom.TierUp()
+			goi.lom.Lock(false)
+			if promoted {
+				if cmn.FastV(4, cos.SmoduleAIS) {
+					nlog.InfofCtx(goi.ctx, "%s: %s promoted", goi.t, goi.lom)
+				}
+				goto do
+			}
+			promoted = true // (tried)
+		}
 		cs = fs.Cap()
 		if cs.OOS {
 			errCode, err = http.StatusInsufficientStorage, cs.Err
@@ -572,10 +829,10 @@ do:
 		cold, errCode, err = goi.validateRecover()
 		if err != nil {
 			if !cold {
-				nlog.Errorln(err)
+				nlog.ErrorlnCtx(goi.ctx, err)
 				return
 			}
-			nlog.Errorf("%v - proceeding to cold-GET from %s", err, goi.lom.Bck())
+			nlog.ErrorfCtx(goi.ctx, "%v - proceeding to cold-GET from %s", err, goi.lom.Bck())
 		}
 	}
 
@@ -606,11 +863,11 @@ fin:
 	if goi.retry {
 		goi.retry = false
 		if !retried {
-			nlog.Warningf("GET %s: retrying...", goi.lom)
+			nlog.WarningfCtx(goi.ctx, "GET %s: retrying...", goi.lom)
 			retried = true // only once
 			goto do
 		}
-		nlog.Warningf("GET %s: failed retrying %v(%d)", goi.lom, err, errCode)
+		nlog.WarningfCtx(goi.ctx, "GET %s: failed retrying %v(%d)", goi.lom, err, errCode)
 	}
 	return
 }
@@ -650,7 +907,7 @@ validate:
 		// TODO: mark `deleted` and postpone actual deletion
 		//
 		if erl := lom.Remove(true /*force through rlock*/); erl != nil {
-			nlog.Warningf("%s: failed to remove corrupted %s, err: %v", goi.t, lom, erl)
+			nlog.WarningfCtx(goi.ctx, "%s: failed to remove corrupted %s, err: %v", goi.t, lom, erl)
 		}
 		return
 	}
@@ -666,7 +923,7 @@ validate:
 		restored := lom.RestoreToLocation()
 		goi.lom.Lock(false)
 		if restored {
-			nlog.Warningf("%s: recovered corrupted %s from local replica", goi.t, lom)
+			nlog.WarningfCtx(goi.ctx, "%s: recovered corrupted %s from local replica", goi.t, lom)
 			code = 0
 			goto validate
 		}
@@ -678,7 +935,7 @@ validate:
 		_, code, err = goi.restoreFromAny(true /*skipLomRestore*/)
 		goi.lom.Lock(false)
 		if err == nil {
-			nlog.Warningf("%s: recovered corrupted %s from EC slices", goi.t, lom)
+			nlog.WarningfCtx(goi.ctx, "%s: recovered corrupted %s from EC slices", goi.t, lom)
 			code = 0
 			goto validate
 		}
@@ -686,11 +943,16 @@ validate:
 
 	// TODO: ditto
 	if erl := lom.Remove(true /*force through rlock*/); erl != nil {
-		nlog.Warningf("%s: failed to remove corrupted %s, err: %v", goi.t, lom, erl)
+		nlog.WarningfCtx(goi.ctx, "%s: failed to remove corrupted %s, err: %v", goi.t, lom, erl)
 	}
 	return
 }
 
+// the bucket's objects may still reside on their previous (placement label) mountpaths
+func transitioning(bck *meta.Bck) bool {
+	return xreg.GetRunning(xreg.Flt{Kind: apc.ActTransitionBck, Bck: bck}) != nil
+}
+
 // attempt to restore an object from any/all of the below:
 // 1) local copies (other FSes on this target)
 // 2) other targets (when resilvering or rebalancing is running (aka GFN))
@@ -714,15 +976,24 @@ func (goi *getOI) restoreFromAny(skipLomRestore bool) (doubleCheck bool, errCode
 			running   = resMarked.Xact != nil
 			gfnActive = goi.t.res.IsActive(3 /*interval-of-inactivity multiplier*/)
 		)
-		if resMarked.Interrupted || running || gfnActive {
+		// (or, when the object may still reside on a read-only mountpath - see fs.SetReadOnly -
+		// or on a mountpath with a different label)
+		if resMarked.Interrupted || running || gfnActive || fs.AnyReadOnly() || transitioning(goi.lom.Bck()) {
 			if goi.lom.RestoreToLocation() { // from copies
-				nlog.Infof("%s restored to location", goi.lom)
+				nlog.InfofCtx(goi.ctx, "%s restored to location", goi.lom)
 				return
 			}
 			doubleCheck = running
 		}
 	}
 
+	// scratch bucket: node-local content, nothing to look up cluster-wide
+	if goi.lom.Bprops().Scratch.Enabled {
+		err = cos.NewErrNotFound("%s: %s (scratch)", goi.t.si, goi.lom.Cname())
+		errCode = http.StatusNotFound
+		return
+	}
+
 	// when rebalancing: cluster-wide lookup (aka "get from neighbor" or GFN)
 	var (
 		gfnNode   *meta.Snode
@@ -760,7 +1031,7 @@ gfn:
 		ecErr = goi.lom.Load(true /*cache it*/, false /*locked*/) // TODO: optimize locking
 		debug.AssertNoErr(ecErr)
 		if ecErr == nil {
-			nlog.Infof("%s: EC-recovered %s", tname, goi.lom)
+			nlog.InfofCtx(goi.ctx, "%s: EC-recovered %s", tname, goi.lom)
 			return
 		}
 		err = cmn.NewErrFailedTo(tname, "load EC-recovered", goi.lom, ecErr)
@@ -792,6 +1063,9 @@ func (goi *getOI) getFromNeighbor(lom *cluster.LOM, tsi *meta.Snode) bool {
 			apc.HdrCallerID:   []string{goi.t.SID()},
 			apc.HdrCallerName: []string{goi.t.callerName()},
 		}
+		if rid := nlog.ReqID(goi.ctx); rid != "" {
+			reqArgs.Header.Set(apc.HdrReqID, rid)
+		}
 		reqArgs.Path = apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName)
 		reqArgs.Query = query
 	}
@@ -806,12 +1080,12 @@ func (goi *getOI) getFromNeighbor(lom *cluster.LOM, tsi *meta.Snode) bool {
 	resp, err := goi.t.client.data.Do(req) //nolint:bodyclose // closed by `poi.putObject`
 	cmn.FreeHra(reqArgs)
 	if err != nil {
-		nlog.Errorf("%s: gfn failure, %s %q, err: %v", goi.t, tsi, lom, err)
+		nlog.ErrorfCtx(goi.ctx, "%s: gfn failure, %s %q, err: %v", goi.t, tsi, lom, err)
 		return false
 	}
 
 	cksumToUse := lom.ObjAttrs().FromHeader(resp.Header)
-	workFQN := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileRemote)
+	workFQN := fs.CSM.GenScratch(lom, fs.WorkfileType, fs.WorkfileRemote)
 	poi := allocPOI()
 	{
 		poi.t = goi.t
@@ -827,11 +1101,11 @@ func (goi *getOI) getFromNeighbor(lom *cluster.LOM, tsi *meta.Snode) bool {
 	freePOI(poi)
 	if erp == nil {
 		if config.FastV(5, cos.SmoduleAIS) {
-			nlog.Infof("%s: gfn %s <= %s", goi.t, goi.lom, tsi)
+			nlog.InfofCtx(goi.ctx, "%s: gfn %s <= %s", goi.t, goi.lom, tsi)
 		}
 		return true
 	}
-	nlog.Errorf("%s: gfn-GET failed to PUT locally: %v(%d)", goi.t, erp, errCode)
+	nlog.ErrorfCtx(goi.ctx, "%s: gfn-GET failed to PUT locally: %v(%d)", goi.t, erp, errCode)
 	return false
 }
 
@@ -839,12 +1113,27 @@ func (goi *getOI) finalize(coldGet bool) (errCode int, err error) {
 	var (
 		lmfh *os.File
 		hrng *htrange
+		fdce *fdcEntry
 		fqn  = goi.lom.FQN
 	)
 	if !coldGet && !goi.isGFN {
 		fqn = goi.lom.LBGet() // best-effort GET load balancing (see also mirror.findLeastUtilized())
 	}
-	lmfh, err = os.Open(fqn)
+	if goi.lom.IsEncoded() && !goi.isGFN {
+		return goi.finiEncoded(coldGet)
+	}
+	switch {
+	case goi.hedge && !coldGet && !goi.isGFN && goi.ranges.Range == "" && goi.lom.NumCopies() > 1:
+		fqn, lmfh, err = goi.hedgedOpen(fqn)
+	case goi.directIO():
+		lmfh, err = fs.TryDirectOpen(fqn, os.O_RDONLY, 0)
+	case cmn.Features.IsSet(feat.CacheOpenFiles) && !coldGet && goi.archive.filename == "":
+		if fdce, err = goi.t.fdc.get(fqn, goi.lom); err == nil {
+			lmfh = fdce.fh
+		}
+	default:
+		lmfh, err = os.Open(fqn)
+	}
 	if err != nil {
 		if os.IsNotExist(err) {
 			errCode = http.StatusNotFound
@@ -858,6 +1147,11 @@ func (goi *getOI) finalize(coldGet bool) (errCode int, err error) {
 	}
 
 	hdr := goi.w.Header()
+	if goi.precond.IsSet() {
+		if errCode, err = goi.checkPrecond(hdr); errCode != 0 {
+			goto ret
+		}
+	}
 	if goi.ranges.Range != "" {
 		rsize := goi.lom.SizeBytes()
 		if goi.ranges.Size > 0 {
@@ -874,10 +1168,95 @@ func (goi *getOI) finalize(coldGet bool) (errCode int, err error) {
 	}
 	errCode, err = goi.fini(fqn, lmfh, hdr, hrng, coldGet)
 ret:
-	cos.Close(lmfh)
+	if fdce != nil {
+		goi.t.fdc.release(fdce)
+	} else {
+		cos.Close(lmfh)
+	}
 	return
 }
 
+// Hedged read of a mirrored object: concurrently read the first chunk of two copies
+// (`fqn` and another one, least utilized) and keep the one that responds first,
+// thus sidestepping a transiently slow disk; the other (slower) read cannot be
+// interrupted - it runs to completion in the background, and its file gets closed.
+// (Subsequent reads of the winner's first chunk hit the page cache.)
+func (goi *getOI) hedgedOpen(fqn string) (string, *os.File, error) {
+	type hres struct {
+		fh  *os.File
+		fqn string
+		err error
+	}
+	var (
+		other  = goi.lom.FQN
+		mpaths = fs.GetAllMpathUtils()
+		minU   = int64(101)
+		size   = min(goi.lom.SizeBytes(), int64(memsys.DefaultBufSize))
+		ch     = make(chan hres, 2)
+	)
+	for copyFQN, copyMi := range goi.lom.GetCopies() {
+		if copyFQN == fqn {
+			continue
+		}
+		if u := mpaths.Get(copyMi.Path); u < minU {
+			other, minU = copyFQN, u
+		}
+	}
+	if other == fqn {
+		lmfh, err := os.Open(fqn)
+		return fqn, lmfh, err
+	}
+	for _, hfqn := range []string{fqn, other} {
+		go func(hfqn string) {
+			fh, err := os.Open(hfqn)
+			if err == nil && size > 0 {
+				buf, slab := goi.t.gmm.AllocSize(size)
+				if _, err = fh.ReadAt(buf[:size], 0); err == io.EOF {
+					err = nil
+				}
+				slab.Free(buf)
+				if err != nil {
+					cos.Close(fh)
+				}
+			}
+			ch <- hres{fh: fh, fqn: hfqn, err: err}
+		}(hfqn)
+	}
+	first := <-ch
+	if first.err == nil {
+		go func() {
+			if loser := <-ch; loser.err == nil {
+				cos.Close(loser.fh)
+			}
+		}()
+		if first.fqn != fqn {
+			goi.t.statsT.Inc(stats.GetHedgeCount)
+		}
+		return first.fqn, first.fh, nil
+	}
+	goi.t.fsErr(first.err, first.fqn)
+	second := <-ch
+	return second.fqn, second.fh, second.err
+}
+
+// conditional GET: respond with 304 (Not Modified) or fail with 412 (Precondition Failed)
+func (goi *getOI) checkPrecond(hdr http.Header) (int, error) {
+	etag := hdr.Get(cos.HdrETag) // (s3 compat: may be already set)
+	if etag == "" {
+		etag = cmn.ObjETag(goi.lom)
+	}
+	switch code := goi.precond.Check(etag, true /*exists*/, http.MethodGet); code {
+	case 0:
+		return 0, nil
+	case http.StatusNotModified:
+		hdr.Set(cos.HdrETag, etag)
+		goi.w.WriteHeader(http.StatusNotModified)
+		return code, nil
+	default:
+		return code, cmn.NewErrPreconditionFailed(goi.lom.Cname(), &goi.precond, etag)
+	}
+}
+
 // in particular, setup reader and writer and set headers
 func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange, coldGet bool) (errCode int, err error) {
 	var (
@@ -940,18 +1319,91 @@ func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange
 				sgl.Free()
 			}()
 		}
+	case goi.directIO():
+		size = goi.lom.SizeBytes()
+		dbuf, dslab := goi.t.gmm.Alloc() // (aligned reads)
+		defer dslab.Free(dbuf)
+		reader = fs.NewDirectReader(lmfh, dbuf, size)
+	case goi.lom.SizeBytes() >= fs.URingMinSize && fs.URingAvail():
+		size = goi.lom.SizeBytes()
+		ubuf, uslab := goi.t.gmm.AllocSize(memsys.MaxPageSlabSize) // (batched reads)
+		defer uslab.Free(ubuf)
+		reader = fs.NewURingReader(lmfh, ubuf, size)
 	default:
 		size = goi.lom.SizeBytes()
+		reader = io.NewSectionReader(lmfh, 0, size) // (ReadAt: file handle may be shared - see fdCache)
 	}
 
 	hdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
-	hdr.Set(cos.HdrContentType, cos.ContentBinary)
+	hdr.Set(cos.HdrContentType, goi.contentType())
 	buf, slab := goi.t.gmm.AllocSize(size)
 	err = goi.transmit(reader, buf, fqn, coldGet)
 	slab.Free(buf)
 	return
 }
 
+// whole-object reads only (range and archived-file reads go through the page cache)
+func (goi *getOI) directIO() bool {
+	return goi.lom.Bprops().DirectIO.Get() && goi.archive.filename == "" && goi.ranges.Range == ""
+}
+
+// stored at PUT time (see putOI.contentType) or provided by remote backend
+func (goi *getOI) contentType() string {
+	if goi.archive.filename == "" {
+		if ctype, ok := goi.lom.GetCustomKey(cos.HdrContentType); ok && ctype != "" {
+			return ctype
+		}
+	}
+	return cos.ContentBinary
+}
+
+// at-rest compressed (see cluster.LOM.DictCompress) and/or encrypted (cluster.LOM.Encrypt):
+// decompress in memory, decrypt on the fly
+func (goi *getOI) finiEncoded(coldGet bool) (errCode int, err error) {
+	lom := goi.lom
+	if goi.archive.filename != "" {
+		return http.StatusBadRequest, cmn.NewErrUnsupp("read archived file from compressed or encrypted", lom.Cname())
+	}
+	roc, oah, err := lom.NewUserROC()
+	if err != nil {
+		if os.IsNotExist(err) {
+			goi.retry = true
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, err
+	}
+	defer cos.Close(roc)
+
+	hdr := goi.w.Header()
+	if goi.precond.IsSet() {
+		if errCode, err = goi.checkPrecond(hdr); errCode != 0 {
+			return
+		}
+	}
+	cmn.ToHeader(oah, hdr)
+	var (
+		reader io.Reader = roc
+		size             = oah.SizeBytes()
+	)
+	if goi.ranges.Range != "" {
+		var hrng *htrange
+		if hrng, errCode, err = goi.parseRange(hdr, size); err != nil {
+			return
+		}
+		if hrng != nil {
+			reader, size = io.NewSectionReader(roc.(io.ReaderAt), hrng.Start, hrng.Length), hrng.Length
+			hdr.Del(apc.HdrObjCksumVal)
+			hdr.Del(apc.HdrObjCksumType)
+		}
+	}
+	hdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	hdr.Set(cos.HdrContentType, goi.contentType())
+	buf, slab := goi.t.gmm.AllocSize(size)
+	err = goi.transmit(reader, buf, lom.FQN, coldGet)
+	slab.Free(buf)
+	return
+}
+
 func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string, coldGet bool) error {
 	// NOTE: hide `ReadFrom` of the `http.ResponseWriter`
 	// (in re: sendfile; see also cos.WriterOnly comment)
@@ -961,7 +1413,7 @@ func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string, coldGet bool) er
 		if !cos.IsRetriableConnErr(err) {
 			goi.t.fsErr(err, fqn)
 		}
-		nlog.Errorln(cmn.NewErrFailedTo(goi.t, "GET", fqn, err))
+		nlog.ErrorlnCtx(goi.ctx, cmn.NewErrFailedTo(goi.t, "GET", fqn, err))
 		// at this point, error is already written into the response -
 		// return special code to indicate just that
 		return errSendingResp
@@ -969,7 +1421,7 @@ func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string, coldGet bool) er
 	// GFN: atime must be already set
 	if !coldGet && !goi.isGFN {
 		if err := goi.lom.Load(false /*cache it*/, true /*locked*/); err != nil {
-			nlog.Errorf("%s: GET post-transmission failure: %v", goi.t, err)
+			nlog.ErrorfCtx(goi.ctx, "%s: GET post-transmission failure: %v", goi.t, err)
 			return errSendingResp
 		}
 		goi.lom.SetAtimeUnix(goi.atime)
@@ -1296,6 +1748,9 @@ func (coi *copyOI) putReader(lom, dst *cluster.LOM) (size int64, err error) {
 	params := cluster.AllocPutObjParams()
 	{
 		params.WorkTag = "copy-dp"
+		if _, ok := coi.DP.(*cluster.LDP); !ok {
+			params.WorkTag = fs.WorkfileETL // (see space.janitor)
+		}
 		params.Reader = reader
 		// owt: some transactions must update the object in the Cloud(iff the destination is a Cloud bucket)
 		if coi.DM != nil {
@@ -1425,6 +1880,7 @@ func (coi *copyOI) dm(lom *cluster.LOM, sargs *sendArgs) error {
 		hdr.Bck.Copy(sargs.bckTo.Bucket())
 		hdr.ObjName = sargs.objNameTo
 		hdr.ObjAttrs.CopyFrom(oa)
+		hdr.ReqID = nlog.ReqID(coi.ctx)
 	}
 	o.Callback = func(_ transport.ObjHdr, _ io.ReadCloser, _ any, _ error) {
 		cluster.FreeLOM(lom)
@@ -1441,6 +1897,9 @@ func (coi *copyOI) put(sargs *sendArgs) error {
 	)
 	cmn.ToHeader(sargs.objAttrs, hdr)
 	hdr.Set(apc.HdrT2TPutterID, coi.t.SID())
+	if rid := nlog.ReqID(coi.ctx); rid != "" {
+		hdr.Set(apc.HdrReqID, rid)
+	}
 	query.Set(apc.QparamOWT, sargs.owt.ToS())
 	if coi.Xact != nil {
 		query.Set(apc.QparamUUID, coi.Xact.ID())
@@ -1509,7 +1968,7 @@ func (a *putA2I) do() (int, error) {
 			}
 		}
 		if errV := a.lom.RenameFrom(workFQN); errV != nil {
-			nlog.Errorf(fmtNested, a.t, err, "append and rename back", workFQN, errV)
+			nlog.ErrorfCtx(a.ctx, fmtNested, a.t, err, "append and rename back", workFQN, errV)
 		}
 		return http.StatusInternalServerError, err
 	}
@@ -1603,17 +2062,23 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		debug.Assertf(finfo.Size() == size, "%d != %d", finfo.Size(), size)
 	})
 	// done
+	a.lom.SetSize(size)
+	a.lom.SetCksum(cksum)
+	a.lom.DelCustomKey(cmn.DictVerObjMD)
+	a.lom.DelCustomKey(cmn.DictSizeObjMD)
+	a.lom.DelCustomKey(cmn.EncKeyObjMD)
+	if _, err := a.lom.Encrypt(fqn); err != nil {
+		return err
+	}
 	if err := a.lom.RenameFrom(fqn); err != nil {
 		return err
 	}
-	a.lom.SetSize(size)
-	a.lom.SetCksum(cksum)
 	a.lom.SetAtimeUnix(a.started)
 	if err := a.lom.Persist(); err != nil {
 		return err
 	}
 	if a.lom.Bprops().EC.Enabled {
-		if err := ec.ECM.EncodeObject(a.lom); err != nil && err != ec.ErrorECDisabled {
+		if err := ec.ECM.EncodeObject(a.ctx, a.lom); err != nil && err != ec.ErrorECDisabled {
 			return err
 		}
 	}
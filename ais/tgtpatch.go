@@ -0,0 +1,243 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Partial update (PUT with apc.QparamWriteAt): write the request's payload into
+// an existing object at a given offset, possibly extending the object.
+// The sequence:
+//  1. receive the payload into a workfile and validate its checksum, if provided -
+//     a failed (or aborted) transfer does not touch the object;
+//  2. journal: save the original bytes in the affected range;
+//  3. write in place and fsync;
+//  4. recompute the object's checksum (a local sequential read), update size and
+//     version, and persist metadata.
+// Any failure in steps 3 and 4 rolls the object back from the journal.
+// Supported for ais:// buckets that are not erasure coded; n-way copies (if any)
+// are removed and then re-created, same as with a regular PUT.
+
+type patchOI struct {
+	t     *target
+	lom   *cluster.LOM
+	r     io.Reader
+	cksum *cos.Cksum // checksum of the payload (optional)
+	off   int64
+	size  int64
+	atime int64
+}
+
+func (t *target) patchObj(r *http.Request, lom *cluster.LOM, started int64, dpq *dpq) (int, error) {
+	off, err := strconv.ParseInt(dpq.writeAt, 10, 64)
+	if err != nil || off < 0 {
+		return http.StatusBadRequest, fmt.Errorf("invalid %q offset %q", apc.QparamWriteAt, dpq.writeAt)
+	}
+	if r.ContentLength <= 0 {
+		return http.StatusBadRequest, fmt.Errorf("partial update of %s requires non-empty payload of known size", lom.Cname())
+	}
+	bck := lom.Bck()
+	if !bck.IsAIS() {
+		return http.StatusBadRequest, fmt.Errorf("partial update is supported only for ais:// buckets (%s)", bck)
+	}
+	if bck.Props.EC.Enabled {
+		return http.StatusBadRequest, fmt.Errorf("partial update is not supported for erasure-coded buckets (%s)", bck)
+	}
+	p := &patchOI{t: t, lom: lom, r: r.Body, off: off, size: r.ContentLength, atime: started}
+	if cksumValue := r.Header.Get(apc.HdrObjCksumVal); cksumValue != "" {
+		p.cksum = cos.NewCksum(r.Header.Get(apc.HdrObjCksumType), cksumValue)
+	}
+
+	lom.Lock(true)
+	errCode, err := p.do()
+	lom.Unlock(true)
+	if err == nil {
+		t.putMirror(lom)
+	}
+	return errCode, err
+}
+
+// under write lock
+func (p *patchOI) do() (int, error) {
+	lom := p.lom
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	if lom.IsEncoded() {
+		return http.StatusBadRequest, fmt.Errorf("partial update of at-rest compressed or encrypted %s is not supported", lom.Cname())
+	}
+	osize := lom.SizeBytes()
+	if p.off > osize {
+		return http.StatusRequestedRangeNotSatisfiable,
+			fmt.Errorf("%s: offset %d is past the end of the object (size %d)", lom.Cname(), p.off, osize)
+	}
+
+	// 1. receive
+	patchFQN := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePatch)
+	if errCode, err := p.recv(patchFQN); err != nil {
+		return errCode, err
+	}
+	defer cos.RemoveFile(patchFQN)
+
+	// 2. journal
+	journalFQN := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePatchJournal)
+	if err := p.journal(journalFQN, osize); err != nil {
+		return 0, err
+	}
+	defer cos.RemoveFile(journalFQN)
+
+	// (copies, if any, are about to become stale)
+	if lom.HasCopies() {
+		if err := lom.DelAllCopies(); err != nil {
+			return 0, err
+		}
+	}
+	p.t.fdc.evictLOM(lom)
+
+	// 3. write in place
+	if err := p.apply(patchFQN); err != nil {
+		p.rollback(journalFQN, osize, err)
+		return 0, err
+	}
+
+	// 4. update metadata
+	if err := p.finalize(osize); err != nil {
+		p.rollback(journalFQN, osize, err)
+		return 0, err
+	}
+	if p.t.watch.active() {
+		p.t.watch.record(lom, apc.BckEventUpdate)
+	}
+	return 0, nil
+}
+
+func (p *patchOI) recv(patchFQN string) (int, error) {
+	fh, err := p.lom.CreateFile(patchFQN)
+	if err != nil {
+		return 0, err
+	}
+	cksumType := p.lom.CksumType()
+	if p.cksum != nil {
+		cksumType = p.cksum.Ty()
+	}
+	buf, slab := p.t.gmm.AllocSize(p.size)
+	n, cksumHash, err := cos.CopyAndChecksum(fh, io.LimitReader(p.r, p.size), buf, cksumType)
+	slab.Free(buf)
+	cos.Close(fh)
+	switch {
+	case err != nil:
+	case n != p.size:
+		err = fmt.Errorf("%s: partial update size mismatch: expected %d, received %d", p.lom.Cname(), p.size, n)
+	case p.cksum != nil && cksumHash != nil && !cksumHash.Equal(p.cksum):
+		err = cos.NewErrDataCksum(&cksumHash.Cksum, p.cksum, p.lom.Cname())
+		cos.RemoveFile(patchFQN)
+		return http.StatusBadRequest, err
+	default:
+		return 0, nil
+	}
+	cos.RemoveFile(patchFQN)
+	return 0, err
+}
+
+func (p *patchOI) journal(journalFQN string, osize int64) error {
+	src, err := os.Open(p.lom.FQN)
+	if err != nil {
+		return err
+	}
+	defer cos.Close(src)
+	jfh, err := p.lom.CreateFile(journalFQN)
+	if err != nil {
+		return err
+	}
+	var (
+		length = min(p.size, osize-p.off)
+		buf, s = p.t.gmm.AllocSize(length)
+	)
+	_, err = io.CopyBuffer(jfh, io.NewSectionReader(src, p.off, length), buf)
+	s.Free(buf)
+	if err == nil {
+		err = jfh.Sync()
+	}
+	cos.Close(jfh)
+	if err != nil {
+		cos.RemoveFile(journalFQN)
+	}
+	return err
+}
+
+func (p *patchOI) apply(patchFQN string) error {
+	return p.writeAt(patchFQN, p.off)
+}
+
+func (p *patchOI) writeAt(fromFQN string, off int64) error {
+	src, err := os.Open(fromFQN)
+	if err != nil {
+		return err
+	}
+	defer cos.Close(src)
+	dst, err := os.OpenFile(p.lom.FQN, os.O_WRONLY, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	buf, slab := p.t.gmm.Alloc()
+	_, err = io.CopyBuffer(io.NewOffsetWriter(dst, off), src, buf)
+	slab.Free(buf)
+	if err == nil {
+		err = dst.Sync()
+	}
+	if errC := dst.Close(); err == nil {
+		err = errC
+	}
+	return err
+}
+
+func (p *patchOI) finalize(osize int64) error {
+	lom := p.lom
+	lom.SetSize(max(osize, p.off+p.size))
+	if _, err := lom.ComputeSetCksum(); err != nil {
+		return err
+	}
+	if lom.VersionConf().Enabled {
+		if err := lom.IncVersion(); err != nil {
+			return err
+		}
+	}
+	lom.SetAtimeUnix(p.atime)
+	return lom.Persist()
+}
+
+// restore the original content and metadata; the object (if the rollback itself fails)
+// remains with its original checksum that no longer matches its content
+func (p *patchOI) rollback(journalFQN string, osize int64, cause error) {
+	lom := p.lom
+	err := p.writeAt(journalFQN, p.off)
+	if err == nil {
+		err = os.Truncate(lom.FQN, osize)
+	}
+	lom.Uncache(true /*delDirty*/)
+	if err != nil {
+		err = errors.Join(cause, err)
+		nlog.Errorf("%s: failed to roll back partial update: %v", lom.Cname(), err)
+		p.t.fsErr(err, lom.FQN)
+		return
+	}
+	nlog.Warningf("%s: rolled back partial update (%v)", lom.Cname(), cause)
+}
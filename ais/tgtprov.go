@@ -0,0 +1,166 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+const provJobRunning = "running"
+
+// GET { apc.ActObjProvenance } /v1/buckets/bucket-name (see proxy.objProvenance)
+// aggregates object metadata (LOM), EC metadata, and xactions - both in-memory and
+// archived (see xreg.History) - that were running at the time of the last write
+func (t *target) objProvenance(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	lom := cluster.AllocLOM(msg.Name)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	lom.Lock(false)
+	prov, err := t._provenance(lom)
+	lom.Unlock(false)
+	if err != nil {
+		if cmn.IsObjNotExist(err) {
+			t.writeErr(w, r, err, http.StatusNotFound)
+		} else {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
+	prov.Jobs = t.provJobs(bck, time.Unix(0, prov.Mtime))
+	t.writeJSON(w, r, prov, msg.Action)
+}
+
+// (under rlock)
+func (t *target) _provenance(lom *cluster.LOM) (*cmn.ObjProvenance, error) {
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil, err
+	}
+	finfo, err := os.Stat(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	prov := &cmn.ObjProvenance{
+		Target:    t.SID(),
+		Mountpath: lom.Mountpath().Path,
+		Mtime:     finfo.ModTime().UnixNano(),
+	}
+	op := &prov.ObjectProps
+	op.Name, op.Bck, op.Present = lom.ObjName, *lom.Bucket(), true
+	op.ObjAttrs = *lom.ObjAttrs()
+	op.ObjAttrs.Size = lom.UserSize()
+	op.Location = lom.Location()
+	op.Mirror.Copies = lom.NumCopies()
+	for fqn := range lom.GetCopies() {
+		if idx := strings.Index(fqn, "/@"); idx >= 0 {
+			fqn = fqn[:idx]
+		}
+		op.Mirror.Paths = append(op.Mirror.Paths, fqn)
+	}
+	if len(op.Mirror.Paths) == 0 {
+		op.Mirror.Paths = append(op.Mirror.Paths, prov.Mountpath)
+	}
+	sort.Strings(op.Mirror.Paths)
+
+	// source
+	switch {
+	case lom.Bck().IsRemote():
+		prov.Source = lom.Bck().Provider
+		if src, ok := lom.GetCustomKey(cmn.SourceObjMD); ok {
+			prov.Source = src
+		}
+	default:
+		if src, ok := lom.GetCustomKey(cmn.OrigURLObjMD); ok {
+			prov.Source = src
+		} else if src, ok := lom.GetCustomKey(cmn.SourceObjMD); ok {
+			prov.Source = src
+		}
+	}
+
+	// checksum lineage
+	if cksum := lom.Checksum(); cksum != nil && cksum.Type() != cos.ChecksumNone {
+		prov.Cksums = append(prov.Cksums, cmn.ProvCksum{Origin: cmn.ProvCksumStored, Type: cksum.Type(), Value: cksum.Value()})
+	}
+	if lom.Bck().Props.EC.Enabled {
+		if md, err := ec.ObjectMetadata(lom.Bck(), lom.ObjName); err == nil {
+			op.EC.DataSlices = md.Data
+			op.EC.ParitySlices = md.Parity
+			op.EC.IsECCopy = md.IsCopy
+			op.EC.Generation = md.Generation
+			prov.ECSlices = md.Daemons
+			if md.ObjCksum != "" {
+				prov.Cksums = append(prov.Cksums, cmn.ProvCksum{Origin: cmn.ProvCksumEC, Type: md.CksumType, Value: md.ObjCksum})
+			}
+		} else if !os.IsNotExist(err) {
+			nlog.Warningln(t.String()+": failed to load EC metadata", lom.Cname(), err)
+		}
+	}
+	for _, ty := range []string{cmn.ETag, cmn.MD5ObjMD, cmn.CRC32CObjMD} {
+		if v, ok := lom.GetCustomKey(ty); ok {
+			prov.Cksums = append(prov.Cksums, cmn.ProvCksum{Origin: cmn.ProvCksumBackend, Type: ty, Value: v})
+		}
+	}
+	return prov, nil
+}
+
+// xactions that were running (on this target) at the time of the last write, the latest first:
+// those that operate on the bucket (including copy and transform to the bucket), and rebalance
+// and resilver
+func (t *target) provJobs(bck *meta.Bck, mtime time.Time) (jobs []cmn.ProvJob) {
+	var (
+		hmsg = &xact.HistoryMsg{Bck: *bck.Bucket()}
+		ids  = make(cos.StrSet, 8)
+		add  = func(snap *cluster.Snap) {
+			if ids.Contains(snap.ID) || snap.StartTime.After(mtime) {
+				return
+			}
+			if !snap.EndTime.IsZero() && snap.EndTime.Before(mtime) {
+				return
+			}
+			if !hmsg.Match(snap) && snap.Kind != apc.ActRebalance && snap.Kind != apc.ActResilver {
+				return
+			}
+			ids.Set(snap.ID)
+			job := cmn.ProvJob{StartTime: snap.StartTime, EndTime: snap.EndTime, ID: snap.ID, Kind: snap.Kind}
+			if snap.Running() {
+				job.Status = provJobRunning
+			} else {
+				job.Status = xact.HistStatus(snap)
+			}
+			jobs = append(jobs, job)
+		}
+	)
+	if snaps, err := xreg.GetSnap(xreg.Flt{}); err == nil {
+		for _, snap := range snaps {
+			add(snap)
+		}
+	}
+	// archived (and finished after the write)
+	snaps, err := xreg.History(&xact.HistoryMsg{Since: mtime})
+	if err != nil {
+		nlog.Warningln(t.String()+": failed to query xaction history:", err)
+	}
+	for _, snap := range snaps {
+		add(snap)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].StartTime.After(jobs[j].StartTime) })
+	return jobs
+}
@@ -5,7 +5,6 @@
 package ais
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -38,9 +37,12 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		t.putCopyMpt(w, r, apiItems)
 	case http.MethodDelete:
 		q := r.URL.Query()
-		if q.Has(s3.QparamMptUploadID) {
+		switch {
+		case q.Has(s3.QparamMptUploadID):
 			t.abortMptUpload(w, r, apiItems, q)
-		} else {
+		case q.Has(s3.QparamTagging) && len(apiItems) > 1:
+			t.delObjTaggingS3(w, r, apiItems)
+		default:
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
@@ -51,7 +53,7 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 }
 
 // PUT /s3/<bucket-name>/<object-name>
-// [switch] mpt | put | copy
+// [switch] tagging | mpt | put | copy
 func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, items []string) {
 	if cs := fs.Cap(); cs.OOS {
 		s3.WriteErr(w, r, cs.Err, http.StatusInsufficientStorage)
@@ -64,6 +66,12 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, items []stri
 	}
 	q := r.URL.Query()
 	switch {
+	case q.Has(s3.QparamTagging):
+		if len(items) < 2 {
+			s3.WriteErr(w, r, errS3Obj, 0)
+			return
+		}
+		t.putObjTaggingS3(w, r, items, bck)
 	case q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID):
 		if r.Header.Get(cos.S3HdrObjSrc) != "" {
 			t.putMptCopy(w, r, items)
@@ -127,6 +135,7 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	coi := allocCOI()
 	{
 		coi.t = t
+		coi.ctx = reqCtx(r)
 		coi.BckTo = bckDst
 		coi.owt = cmn.OwtMigrate
 	}
@@ -171,6 +180,16 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, items []string
 			return
 		}
 	}
+	if v := r.Header.Get(cos.S3HdrTagging); v != "" {
+		tags, err := s3.ParseTaggingHdr(v)
+		if err != nil {
+			s3.WriteErr(w, r, err, http.StatusBadRequest)
+			return
+		}
+		for key, val := range tags {
+			lom.SetCustomKey(cmn.ObjTagKey(key), val)
+		}
+	}
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
@@ -228,6 +247,14 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		t.listMptParts(w, r, bck, objName, q)
 		return
 	}
+	if q.Has(s3.QparamTagging) {
+		t.getObjTaggingS3(w, r, bck, objName)
+		return
+	}
+	if q.Has(s3.QparamAttributes) {
+		t.getObjAttrsS3(w, r, bck, objName)
+		return
+	}
 
 	dpq := dpqAlloc()
 	if err := dpq.fromRawQ(r.URL.RawQuery); err != nil {
@@ -242,7 +269,7 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 	dpqFree(dpq)
 }
 
-// HEAD /s3/<bucket-name>/<object-name> (TODO: s3.HdrMptCnt)
+// HEAD /s3/<bucket-name>/<object-name>
 // See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html
 func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	if len(items) < 2 {
@@ -283,7 +310,7 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		op.ObjAttrs = *lom.ObjAttrs()
 	} else {
 		// cold HEAD
-		objAttrs, errCode, err := t.Backend(lom.Bck()).HeadObj(context.Background(), lom)
+		objAttrs, errCode, err := t.Backend(lom.Bck()).HeadObj(reqCtx(r), lom)
 		if err != nil {
 			s3.WriteErr(w, r, err, errCode)
 			return
@@ -305,6 +332,14 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	// (compare w/ `p.listObjectsS3()`
 	lastModified := cos.FormatNanoTime(op.Atime, cos.RFC1123GMT)
 	hdr.Set(cos.S3LastModified, lastModified)
+	hdr.Set(cos.HdrAcceptRanges, "bytes")
+	hdr.Set(cos.S3HdrStorageClass, s3.StorageClass)
+	if n := s3.MptCount(lom); n > 0 {
+		hdr.Set(cos.S3HdrMptCnt, strconv.Itoa(n))
+	}
+	if n := len(cmn.ObjTags(custom)); n > 0 {
+		hdr.Set(cos.S3HdrTaggingCnt, strconv.Itoa(n))
+	}
 
 	// TODO: lom.Checksum() via apc.HeaderPrefix+apc.HdrObjCksumType/Val via
 	// s3 obj Metadata map[string]*string
@@ -328,7 +363,8 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	errCode, err = t.DeleteObject(lom, false)
+	ctx := reqCtx(r)
+	errCode, err = t.deleteObject(ctx, lom, false)
 	if err != nil {
 		name := lom.Cname()
 		if errCode == http.StatusNotFound {
@@ -339,7 +375,7 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		return
 	}
 	// EC cleanup if EC is enabled
-	ec.ECM.CleanupObject(lom)
+	ec.ECM.CleanupObject(ctx, lom)
 }
 
 // POST /s3/<bucket-name>/<object-name>
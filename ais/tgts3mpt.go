@@ -375,13 +375,17 @@ func (t *target) getMptPart(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	if err != nil {
 		s3.WriteErr(w, r, err, status)
 	}
-	fh, err := os.Open(lom.FQN)
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	fh, _, err := lom.NewUserROC() // (decrypting, if need be)
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
 	buf, slab := t.gmm.AllocSize(size)
-	reader := io.NewSectionReader(fh, off, size)
+	reader := io.NewSectionReader(fh.(io.ReaderAt), off, size)
 	if _, err := io.CopyBuffer(w, reader, buf); err != nil {
 		s3.WriteErr(w, r, err, 0)
 	}
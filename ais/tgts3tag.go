@@ -0,0 +1,127 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// S3 object tagging and GetObjectAttributes (see ais/s3/tagging.go)
+
+// PUT /s3/<bucket-name>/<object-name>?tagging
+// (replaces the entire tag set)
+func (t *target) putObjTaggingS3(w http.ResponseWriter, r *http.Request, items []string, bck *meta.Bck) {
+	tags, err := s3.DecodeTagging(r.Body)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	t.updObjTagsS3(w, r, items, bck, tags)
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?tagging
+func (t *target) delObjTaggingS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, err, errCode := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if t.updObjTagsS3(w, r, items, bck, nil) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (t *target) updObjTagsS3(w http.ResponseWriter, r *http.Request, items []string, bck *meta.Bck, tags cos.StrKVs) bool {
+	lom := cluster.AllocLOM(s3.ObjName(items))
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		s3.WriteErr(w, r, err, errCodeS3(err))
+		return false
+	}
+	for key := range cmn.ObjTags(lom.GetCustomMD()) {
+		lom.DelCustomKey(cmn.ObjTagKey(key))
+	}
+	for key, val := range tags {
+		lom.SetCustomKey(cmn.ObjTagKey(key), val)
+	}
+	if err := lom.Persist(); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
+// GET /s3/<bucket-name>/<object-name>?tagging
+func (t *target) getObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := t.loadLomS3(w, r, bck, objName)
+	if lom == nil {
+		return
+	}
+	result := s3.NewTagging(cmn.ObjTags(lom.GetCustomMD()))
+	cluster.FreeLOM(lom)
+
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// GET /s3/<bucket-name>/<object-name>?attributes
+func (t *target) getObjAttrsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := t.loadLomS3(w, r, bck, objName)
+	if lom == nil {
+		return
+	}
+	defer cluster.FreeLOM(lom)
+	result, err := s3.NewObjAttrsResponse(lom, r.Header.Get(cos.S3HdrObjAttrs))
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	hdr := w.Header()
+	hdr.Set(cos.S3LastModified, cos.FormatNanoTime(lom.AtimeUnix(), cos.RFC1123GMT))
+	hdr.Set(cos.HdrContentType, cos.ContentXML)
+
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// returns nil (having written the error) if the object is not present
+func (t *target) loadLomS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) *cluster.LOM {
+	lom := cluster.AllocLOM(objName)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		cluster.FreeLOM(lom)
+		s3.WriteErr(w, r, err, 0)
+		return nil
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		cluster.FreeLOM(lom)
+		s3.WriteErr(w, r, err, errCodeS3(err))
+		return nil
+	}
+	return lom
+}
+
+func errCodeS3(err error) int {
+	if cmn.IsObjNotExist(err) {
+		return http.StatusNotFound
+	}
+	return 0
+}
@@ -0,0 +1,210 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Random sample (apc.ActSample): the target that the proxy redirects to (see proxy.sample)
+// asks all other targets for their respective samples, merges them, and streams back the
+// resulting archive the same way batch GET does (see tgtbatch.go).
+//
+// Each target walks its objects once, counting those that match the prefix and keeping
+// a uniformly random subset (reservoir) of up to NumObjs names. Merging is then a weighted
+// draw without replacement: the next name comes from a given target with probability
+// proportional to the number of its objects not drawn yet - which makes the result
+// a uniformly random sample of all matching objects in the cluster.
+
+const maxSampleObjs = 100_000
+
+type (
+	// per-target sample
+	sampleRes struct {
+		Names []string `json:"names"` // (shuffled)
+		Count int64    `json:"count"` // total number of matching objects
+	}
+	sampler struct {
+		bck    *meta.Bck
+		rnd    *rand.Rand
+		prefix string
+		res    sampleRes
+		n      int
+	}
+)
+
+// GET /v1/buckets/bucket-name (apc.ActSample)
+func (t *target) sample(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	smsg := &apc.SampleMsg{}
+	if err := cos.MorphMarshal(msg.Value, smsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	// intra-cluster: sample local objects
+	if isRedirect(r.URL.Query()) == "" {
+		if r.Header.Get(apc.HdrCallerID) == "" {
+			t.writeErrf(w, r, "%s: %s-%s(bck) is expected to be redirected", t.si, r.Method, msg.Action)
+			return
+		}
+		res, err := t.sampleLocal(bck, smsg)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.writeJSON(w, r, res, msg.Action)
+		return
+	}
+
+	// redirected by the proxy: collect, merge, and stream
+	mime, err := batchMime(smsg.Mime)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if smsg.Seed == 0 {
+		smsg.Seed = mono.NanoTime()
+	}
+	names, err := t.sampleAll(bck, msg, smsg)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if len(names) == 0 {
+		t.writeErr(w, r, cos.NewErrNotFound("%s: no objects to sample in %s", t, bck.Cname(smsg.Prefix)), http.StatusNotFound)
+		return
+	}
+	// (objects deleted in the meantime are skipped)
+	t.writeBatch(w, r, bck, msg.Action, &batchArgs{names: names, mime: mime, length: smsg.Length, coer: true})
+}
+
+func (t *target) sampleAll(bck *meta.Bck, msg *aisMsg, smsg *apc.SampleMsg) ([]string, error) {
+	res, err := t.sampleLocal(bck, smsg)
+	if err != nil {
+		return nil, err
+	}
+	all := []*sampleRes{res}
+
+	amsg := *msg
+	amsg.Value = smsg // (with seed)
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Header: http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		},
+		Path:  apc.URLPathBuckets.Join(bck.Name),
+		Query: bck.AddToQuery(nil),
+		Body:  cos.MustMarshal(&amsg),
+	}
+	args.timeout = apc.LongTimeout
+	args.cresv = cresSR{} // -> sampleRes
+	results := t.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			return nil, err
+		}
+		all = append(all, res.v.(*sampleRes))
+	}
+	freeBcastRes(results)
+
+	rnd := rand.New(rand.NewSource(smsg.Seed)) //nolint:gosec // (not for security)
+	return drawSample(all, smsg.NumObjs, rnd), nil
+}
+
+// weighted draw without replacement (see comment on top)
+func drawSample(all []*sampleRes, n int, rnd *rand.Rand) []string {
+	var (
+		total int64
+		next  = make([]int, len(all))
+	)
+	for _, res := range all {
+		total += res.Count
+	}
+	names := make([]string, 0, min(int64(n), total))
+	for len(names) < n && total > 0 {
+		x := rnd.Int63n(total)
+		for i, res := range all {
+			if x >= res.Count {
+				x -= res.Count
+				continue
+			}
+			names = append(names, res.Names[next[i]])
+			next[i]++
+			res.Count--
+			total--
+			break
+		}
+	}
+	return names
+}
+
+func (t *target) sampleLocal(bck *meta.Bck, smsg *apc.SampleMsg) (*sampleRes, error) {
+	var (
+		avail  = fs.GetAvail()
+		mpaths = make([]string, 0, len(avail))
+		s      = &sampler{
+			bck:    bck,
+			rnd:    rand.New(rand.NewSource(smsg.Seed ^ int64(t.si.Digest()))), //nolint:gosec // (not for security)
+			prefix: smsg.Prefix,
+			n:      smsg.NumObjs,
+		}
+	)
+	s.res.Names = make([]string, 0, min(smsg.NumObjs, 1024))
+	// same seed, same objects => same sample
+	for mpath := range avail {
+		mpaths = append(mpaths, mpath)
+	}
+	sort.Strings(mpaths)
+	for _, mpath := range mpaths {
+		opts := &fs.WalkOpts{Mi: avail[mpath], CTs: []string{fs.ObjectType}, Callback: s.cb, Sorted: true}
+		opts.Bck.Copy(bck.Bucket())
+		if err := fs.Walk(opts); err != nil {
+			return nil, err
+		}
+	}
+	// NOTE: reservoir order is not random - shuffle for drawSample to pick the leading names
+	s.rnd.Shuffle(len(s.res.Names), func(i, j int) { s.res.Names[i], s.res.Names[j] = s.res.Names[j], s.res.Names[i] })
+	return &s.res, nil
+}
+
+// reservoir sampling (Algorithm R)
+func (s *sampler) cb(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	lom := cluster.AllocLOM("")
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitFQN(fqn, s.bck.Bucket()); err != nil {
+		return nil
+	}
+	if !lom.IsHRW() { // skip copies
+		return nil
+	}
+	if s.prefix != "" && !strings.HasPrefix(lom.ObjName, s.prefix) {
+		return nil
+	}
+	s.res.Count++
+	if len(s.res.Names) < s.n {
+		s.res.Names = append(s.res.Names, lom.ObjName)
+	} else if i := s.rnd.Int63n(s.res.Count); i < int64(s.n) {
+		s.res.Names[i] = lom.ObjName
+	}
+	return nil
+}
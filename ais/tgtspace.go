@@ -8,8 +8,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -17,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/space"
@@ -28,6 +31,16 @@ const (
 	// - note that an API call (e.g. CLI) will go through anyway
 	// - compare with cmn/cos/oom.go
 	minAutoDetectInterval = 10 * time.Minute
+
+	// tier demotion: runs every space.demote_age / demoteFactor (but not more often than minDemoteIval)
+	demoteHkName  = "tier-demote" + hk.NameSuffix
+	demoteFactor  = 4
+	minDemoteIval = 10 * time.Minute
+
+	// trash: purged every space.trash_retention / trashFactor (ditto)
+	trashHkName  = "trash" + hk.NameSuffix
+	trashFactor  = 4
+	minTrashIval = 10 * time.Minute
 )
 
 var (
@@ -46,7 +59,7 @@ func (t *target) OOS(csRefreshed *fs.CapStatus) (cs fs.CapStatus) {
 			return
 		}
 	}
-	if cs.Err == nil {
+	if cs.Err == nil && !cs.OverQuota {
 		return // unlikely; nothing to do
 	}
 	if prev := lastTrigOOS.Load(); mono.Since(prev) < minAutoDetectInterval {
@@ -62,11 +75,30 @@ func (t *target) OOS(csRefreshed *fs.CapStatus) (cs fs.CapStatus) {
 		if cs.Err != nil {
 			nlog.Warningln(t.String(), "still OOS, running LRU eviction now...", cs.String())
 			t.runLRU("" /*uuid*/, nil /*wg*/, false)
+		} else if cs.OverQuota {
+			nlog.Warningln(t.String(), "exceeded mountpath quota, running LRU eviction now...", cs.String())
+			t.runLRU("" /*uuid*/, nil /*wg*/, false)
+		} else if bck := t.lruBckHWM(cs.PctMax); bck != nil {
+			nlog.Warningln(t.String(), "exceeded", bck.String(), "high watermark, running LRU eviction now...", cs.String())
+			t.runLRU("" /*uuid*/, nil /*wg*/, false)
 		}
 	}()
 	return
 }
 
+// returns LRU-enabled bucket (if any) with the bucket-level high watermark (lru.highwm) below `pct`
+func (t *target) lruBckHWM(pct int32) (bck *meta.Bck) {
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil, nil, func(b *meta.Bck) bool {
+		lru := &b.Props.LRU
+		if lru.Enabled && lru.HighWM > 0 && int64(pct) > lru.HighWM {
+			bck = b
+		}
+		return bck != nil
+	})
+	return
+}
+
 func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.Bck) {
 	regToIC := id == ""
 	if regToIC {
@@ -139,3 +171,96 @@ func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck)
 	})
 	return space.RunCleanup(&ini)
 }
+
+func (t *target) runJanitor(id string, wg *sync.WaitGroup) {
+	regToIC := id == ""
+	if regToIC {
+		id = cos.GenUUID()
+	}
+	rns := xreg.RenewJanitor(id)
+	if rns.Err != nil || rns.IsRunning() {
+		debug.Assert(rns.Err == nil || cmn.IsErrXactUsePrev(rns.Err))
+		if wg != nil {
+			wg.Done()
+		}
+		return
+	}
+	xjan := rns.Entry.Get()
+	if regToIC && xjan.ID() == id {
+		// pre-existing UUID: notify IC members
+		regMsg := xactRegMsg{UUID: id, Kind: apc.ActJanitor, Srcs: []string{t.SID()}}
+		msg := t.newAmsgActVal(apc.ActRegGlobalXaction, regMsg)
+		t.bcastAsyncIC(msg)
+	}
+	ini := space.IniJanitor{
+		T:           t,
+		Xaction:     xjan.(*space.XactJanitor),
+		Config:      cmn.GCO.Get(),
+		StatsT:      t.statsT,
+		AbortMpt:    s3.AbortStale,
+		IsActiveMpt: s3.IsActive,
+		WG:          wg,
+	}
+	xjan.AddNotif(&xact.NotifXact{
+		Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xjan,
+	})
+	space.RunJanitor(&ini)
+}
+
+// tiered mountpaths: periodically demote cold objects (see cmn.SpaceConf.FastTier)
+func (t *target) housekeepDemote() time.Duration {
+	config := cmn.GCO.Get()
+	if config.Space.FastTier == "" || !fs.AnyLabeled() || t.regstate.disabled.Load() {
+		return minDemoteIval
+	}
+	if err := t.runTierDemote(""); err != nil {
+		nlog.Warningln(t.String()+":", err)
+	}
+	if ival := config.Space.DemoteAge.D() / demoteFactor; ival > minDemoteIval {
+		return ival
+	}
+	return minDemoteIval
+}
+
+// trash bin: periodically run janitor to remove expired deleted objects
+// (see cmn.SpaceConf.TrashRetention)
+func (t *target) housekeepTrash() time.Duration {
+	config := cmn.GCO.Get()
+	retention := config.Space.TrashRetention.D()
+	if retention == 0 || t.regstate.disabled.Load() {
+		return minTrashIval
+	}
+	go t.runJanitor("" /*uuid*/, nil /*wg*/)
+	if ival := retention / trashFactor; ival > minTrashIval {
+		return ival
+	}
+	return minTrashIval
+}
+
+func (t *target) runTierDemote(id string) error {
+	regToIC := id == ""
+	if regToIC {
+		id = cos.GenUUID()
+	}
+	rns := xreg.RenewTierDemote(t, id)
+	if rns.Err != nil || rns.IsRunning() {
+		if cmn.IsErrXactUsePrev(rns.Err) {
+			return nil
+		}
+		return rns.Err
+	}
+	xctn := rns.Entry.Get()
+	if regToIC && xctn.ID() == id {
+		// pre-existing UUID: notify IC members
+		regMsg := xactRegMsg{UUID: id, Kind: apc.ActTierDemote, Srcs: []string{t.SID()}}
+		msg := t.newAmsgActVal(apc.ActRegGlobalXaction, regMsg)
+		t.bcastAsyncIC(msg)
+	}
+	xctn.AddNotif(&xact.NotifXact{
+		Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	})
+	go xctn.Run(nil)
+	return nil
+}
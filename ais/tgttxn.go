@@ -95,6 +95,8 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 		err = t.createBucket(c)
 	case apc.ActMakeNCopies:
 		xid, err = t.makeNCopies(c)
+	case apc.ActTransitionBck:
+		xid, err = t.transitionBck(c)
 	case apc.ActSetBprops, apc.ActResetBprops:
 		xid, err = t.setBucketProps(c)
 	case apc.ActMoveBck:
@@ -285,6 +287,65 @@ func (t *target) validateMakeNCopies(bck *meta.Bck, msg *aisMsg) (curCopies, new
 	return
 }
 
+//
+// transitionBck
+//
+
+func (t *target) transitionBck(c *txnServerCtx) (string, error) {
+	if err := c.bck.Init(t.owner.bmd); err != nil {
+		return "", err
+	}
+	switch c.phase {
+	case apc.ActBegin:
+		newLabel, err := _parseLabel(c.msg.Value)
+		if err == nil {
+			err = xreg.LimitedCoexistence(t.si, c.bck, c.msg.Action)
+		}
+		if err != nil {
+			return "", err
+		}
+		if cs := fs.Cap(); cs.Err != nil {
+			return "", cs.Err
+		}
+		nlp := newBckNLP(c.bck)
+		if !nlp.TryLock(c.timeout.netw / 2) {
+			return "", cmn.NewErrBckIsBusy(c.bck.Bucket())
+		}
+		txn := newTxnTransitionBck(c, c.bck.Props.Placement.Label, newLabel)
+		if err := t.transactions.begin(txn); err != nil {
+			nlp.Unlock()
+			return "", err
+		}
+		txn.nlps = []cluster.NLP{nlp}
+	case apc.ActAbort:
+		t.transactions.find(c.uuid, apc.ActAbort)
+	case apc.ActCommit:
+		txn, err := t.transactions.find(c.uuid, "")
+		if err != nil {
+			return "", err
+		}
+		// wait for newBMD w/timeout
+		if err = t.transactions.wait(txn, c.timeout.netw, c.timeout.host); err != nil {
+			return "", cmn.NewErrFailedTo(t, "commit", txn, err)
+		}
+		if err = c.bck.Init(t.owner.bmd); err != nil { // (updated label)
+			return "", err
+		}
+		rns := xreg.RenewBckTransition(t, c.bck, c.uuid)
+		if rns.Err != nil {
+			return "", fmt.Errorf("%s %s: %v", t, txn, rns.Err)
+		}
+		xctn := rns.Entry.Get()
+		c.addNotif(xctn) // notify upon completion
+		xact.GoRunW(xctn)
+
+		return xctn.ID(), nil
+	default:
+		debug.Assert(false)
+	}
+	return "", nil
+}
+
 //
 // setBucketProps
 //
@@ -0,0 +1,107 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Bucket change notifications (target side): a bounded ring of recent
+// object create/update/delete events. The ring is populated only while
+// there's at least one active watcher, i.e., only when some proxy has polled
+// it within the last `watchActive` interval. Each event carries a (target-local)
+// monotonic sequence number; proxies poll with the sequence number of the last
+// delivered event and get back everything that followed - or "lost" if the
+// ring has wrapped around in the meantime.
+
+const (
+	watchRingCap = 4096
+	watchActive  = time.Minute
+)
+
+type (
+	watchEvent struct {
+		bck cmn.Bck
+		ev  apc.BckEvent
+		seq uint64
+	}
+	bckWatch struct {
+		ring     [watchRingCap]watchEvent
+		seq      uint64 // seq of the most recently recorded event
+		lastPoll atomic.Int64
+		mu       sync.Mutex
+	}
+)
+
+func (bw *bckWatch) active() bool {
+	last := bw.lastPoll.Load()
+	return last != 0 && mono.Since(last) < watchActive
+}
+
+// NOTE: callers check `active()` first
+func (bw *bckWatch) record(lom *cluster.LOM, typ string) {
+	bw.mu.Lock()
+	bw.seq++
+	we := &bw.ring[bw.seq%watchRingCap]
+	we.seq = bw.seq
+	we.bck = *lom.Bucket()
+	we.ev = apc.BckEvent{Type: typ, Name: lom.ObjName, Time: time.Now().UnixNano()}
+	if typ != apc.BckEventDelete {
+		we.ev.Size = lom.SizeBytes()
+	}
+	bw.mu.Unlock()
+}
+
+// events that follow `since` (baseline: return the current seq and no events)
+func (bw *bckWatch) poll(bck *cmn.Bck, prefix, tid string, since uint64, baseline bool) (out *apc.BckEvents) {
+	bw.lastPoll.Store(mono.NanoTime())
+	out = &apc.BckEvents{}
+	bw.mu.Lock()
+	out.Seq = bw.seq
+	if baseline || since >= bw.seq {
+		out.Lost = since > bw.seq // e.g., restarted
+		bw.mu.Unlock()
+		return
+	}
+	from := since + 1
+	if bw.seq-since > watchRingCap {
+		from = bw.seq - watchRingCap + 1
+		out.Lost = true
+	}
+	for seq := from; seq <= bw.seq; seq++ {
+		we := &bw.ring[seq%watchRingCap]
+		if !we.bck.Equal(bck) || !strings.HasPrefix(we.ev.Name, prefix) {
+			continue
+		}
+		ev := we.ev
+		ev.Target = tid
+		out.Events = append(out.Events, &ev)
+	}
+	bw.mu.Unlock()
+	return
+}
+
+// GET /v1/buckets/bucket-name (apc.ActWatchBck)
+func (t *target) watchPoll(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *aisMsg) {
+	wmsg := &apc.WatchMsg{}
+	if err := cos.MorphMarshal(msg.Value, wmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	since, ok := wmsg.Since[t.SID()]
+	out := t.watch.poll(bck.Bucket(), wmsg.Prefix, t.SID(), since, !ok /*baseline*/)
+	t.writeJSON(w, r, out, msg.Action)
+}
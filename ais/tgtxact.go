@@ -7,6 +7,7 @@ package ais
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -50,6 +51,10 @@ func (t *target) httpxget(w http.ResponseWriter, r *http.Request) {
 		t.xget(w, r, what, uuid)
 		return
 	}
+	if what == apc.WhatXactHistory {
+		t.xhistory(w, r, what)
+		return
+	}
 	if cmn.ReadJSON(w, r, &xactMsg) != nil {
 		return
 	}
@@ -83,6 +88,19 @@ func (t *target) httpxget(w http.ResponseWriter, r *http.Request) {
 	t.xquery(w, r, what, xactQuery)
 }
 
+func (t *target) xhistory(w http.ResponseWriter, r *http.Request, what string) {
+	var msg xact.HistoryMsg
+	if cmn.ReadJSON(w, r, &msg) != nil {
+		return
+	}
+	snaps, err := xreg.History(&msg)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.writeJSON(w, r, snaps, what)
+}
+
 func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 	var (
 		xargs xact.ArgsMsg
@@ -122,6 +140,28 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 		}
 		flt := xreg.Flt{ID: xargs.ID, Kind: xargs.Kind, Bck: bck}
 		xreg.DoAbort(flt, err)
+	case apc.ActXactLimits:
+		var limits apc.XactLimits
+		if err := cos.MorphMarshal(msg.Value, &limits); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		xctn, err := xreg.GetXact(msg.Name)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if xctn == nil || xctn.Finished() {
+			err := cmn.NewErrXactNotFoundError("[" + msg.Name + "]")
+			t.writeErr(w, r, err, http.StatusNotFound, Silent)
+			return
+		}
+		xlim, ok := xctn.(xact.Limited)
+		if !ok {
+			t.writeErrf(w, r, "%s: %s does not support rate limits", t, xctn)
+			return
+		}
+		xlim.SetLimits(&limits)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -158,6 +198,22 @@ func (t *target) xquery(w http.ResponseWriter, r *http.Request, what string, xac
 	}
 }
 
+// apc.WhatRebVerify (see also proxy.rebVerifyReport)
+func (t *target) rebVerifyRes(w http.ResponseWriter, r *http.Request, query url.Values) {
+	uuid := query.Get(apc.QparamUUID)
+	xctn, err := xreg.GetXact(uuid)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if xctn == nil || xctn.Kind() != apc.ActRebVerify {
+		err = cmn.NewErrXactNotFoundError(apc.ActRebVerify + "[" + uuid + "]")
+		t.writeErr(w, r, err, http.StatusNotFound, Silent)
+		return
+	}
+	t.writeJSON(w, r, xctn.Snap().Ext, apc.WhatRebVerify)
+}
+
 func (t *target) xstart(r *http.Request, args *xact.ArgsMsg, bck *meta.Bck) error {
 	const erfmb = "global xaction %q does not require bucket (%s) - ignoring it and proceeding to start"
 	const erfmn = "xaction %q requires a bucket to start"
@@ -185,6 +241,35 @@ func (t *target) xstart(r *http.Request, args *xact.ArgsMsg, bck *meta.Bck) erro
 		wg.Add(1)
 		go t.runStoreCleanup(args.ID, wg, args.Buckets...)
 		wg.Wait()
+	case apc.ActJanitor:
+		if bck != nil {
+			nlog.Errorf(erfmb, args.Kind, bck)
+		}
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go t.runJanitor(args.ID, wg)
+		wg.Wait()
+	case apc.ActTierDemote:
+		if bck != nil {
+			nlog.Errorf(erfmb, args.Kind, bck)
+		}
+		if err := t.runTierDemote(args.ID); err != nil {
+			return err
+		}
+	case apc.ActRebVerify:
+		if bck != nil {
+			nlog.Errorf(erfmb, args.Kind, bck)
+		}
+		rns := xreg.RenewRebVerify(t, args.ID)
+		if rns.Err != nil {
+			return rns.Err
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+			Xact: xctn,
+		})
+		go xctn.Run(nil)
 	case apc.ActResilver:
 		if bck != nil {
 			nlog.Errorf(erfmb, args.Kind, bck)
@@ -92,6 +92,11 @@ type (
 		curCopies int64
 		newCopies int64
 	}
+	txnTransitionBck struct {
+		txnBckBase
+		curLabel string
+		newLabel string
+	}
 	txnSetBucketProps struct {
 		bprops *cmn.BucketProps
 		nprops *cmn.BucketProps
@@ -135,6 +140,7 @@ var (
 	_ txn = (*txnBckBase)(nil)
 	_ txn = (*txnCreateBucket)(nil)
 	_ txn = (*txnMakeNCopies)(nil)
+	_ txn = (*txnTransitionBck)(nil)
 	_ txn = (*txnSetBucketProps)(nil)
 	_ txn = (*txnRenameBucket)(nil)
 	_ txn = (*txnTCB)(nil)
@@ -495,6 +501,22 @@ func (txn *txnMakeNCopies) String() string {
 	return fmt.Sprintf("%s-copies(%d=>%d)", s, txn.curCopies, txn.newCopies)
 }
 
+//////////////////////
+// txnTransitionBck //
+//////////////////////
+
+func newTxnTransitionBck(c *txnServerCtx, curLabel, newLabel string) (txn *txnTransitionBck) {
+	txn = &txnTransitionBck{curLabel: curLabel, newLabel: newLabel}
+	txn.init(c.bck)
+	txn.fillFromCtx(c)
+	return
+}
+
+func (txn *txnTransitionBck) String() string {
+	s := txn.txnBckBase.String()
+	return fmt.Sprintf("%s-label(%q=>%q)", s, txn.curLabel, txn.newLabel)
+}
+
 ///////////////////////
 // txnSetBucketProps //
 ///////////////////////
@@ -6,6 +6,7 @@ package apc
 
 import (
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	jsoniter "github.com/json-iterator/go"
@@ -37,7 +38,10 @@ const (
 	ActMakeNCopies = "make-n-copies"
 	ActPutCopies   = "put-copies"
 
+	ActTransitionBck = "transition-bck" // move bucket's objects to (or off of) labeled mountpaths, see cmn.PlacementConf
+
 	ActRebalance = "rebalance"
+	ActRebVerify = "rebalance-verify" // post-rebalance verification (see RebVerifyReport)
 	ActMoveBck   = "move-bck"
 
 	ActResilver = "resilver"
@@ -46,14 +50,24 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActJanitor      = "janitor"     // remove old workfiles, stale multipart uploads, orphaned ETL outputs, and expired trash
+	ActTierDemote   = "tier-demote" // move cold objects from fast to capacity mountpaths (see cmn.SpaceConf)
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActInvalListCache = "inval-listobj-cache"
 	ActList           = "list"
 	ActLoadLomCache   = "load-lom-cache"
 	ActNewPrimary     = "new-primary"
+	ActPresign        = "presign" // generate presigned (time-limited) object URL
 	ActPromote        = "promote"
 	ActRenameObject   = "rename-obj"
+	ActUndeleteObject = "undelete-obj"
+	ActCopyObjRemote  = "copy-obj-remote" // copy object to a remote AIS cluster (see QparamBckTo)
+
+	// (advisory) object and prefix leases, see LeaseMsg
+	ActAcquireLease = "acquire-lease"
+	ActRenewLease   = "renew-lease"
+	ActReleaseLease = "release-lease"
 
 	ActResetStats  = "reset-stats"
 	ActResetConfig = "reset-config"
@@ -69,6 +83,20 @@ const (
 	ActPrefetchObjects = "prefetch-listrange"
 	ActArchive         = "archive" // see ArchiveMsg
 
+	ActDeleteMultiObjs = "delete-multi"  // synchronous (no xaction) multi-object delete, see DeleteMultiResult
+	ActHeadMultiObjs   = "head-multi"    // batch HEAD: properties of multiple (named) objects in a single call
+	ActGetBatch        = "get-batch"     // batch GET: multiple (named) objects as a single TAR stream, see GetBatchMsg
+	ActTrainDict       = "train-dict"    // train bucket's compression dictionary, see TrainDictMsg and cmn.DictConf
+	ActObjProvenance   = "provenance"    // object's location(s), last write and job(s), checksum lineage, and source, see cmn.ObjProvenance
+	ActSample          = "sample"        // random sample of objects as a single TAR stream, see SampleMsg
+	ActListBckInfo     = "list-bck-info" // list buckets with filters and (optional) usage, see LsbMsg
+
+	// object tags (PATCH /v1/objects), see cmn.ObjTagPrefix
+	ActSetObjTags = "set-tags"    // add new or update existing tags
+	ActDelObjTags = "delete-tags" // remove the specified tags (all tags when none specified)
+
+	ActWatchBck = "watch" // stream of bucket change notifications, see WatchMsg and BckEvent
+
 	ActAttachRemAis = "attach"
 	ActDetachRemAis = "detach"
 
@@ -77,6 +105,7 @@ const (
 	ActStopMaintenance  = "stop-maintenance"  // cancel maintenance state
 	ActShutdownNode     = "shutdown-node"     // shutdown node
 	ActDecommissionNode = "decommission-node" // start rebalance and, when done, remove node from Smap
+	ActActivateSpare    = "activate-spare"    // take spare target out of maintenance, optionally in place of a failed one
 
 	ActDecommissionCluster = "decommission" // decommission all nodes in the cluster (cleanup system data)
 
@@ -108,10 +137,13 @@ const (
 	ActMountpathEnable  = "enable-mp"
 	ActMountpathDetach  = "detach-mp"
 	ActMountpathDisable = "disable-mp"
+	ActMountpathRO      = "readonly-mp"  // (keeps serving reads; new writes go elsewhere)
+	ActMountpathRW      = "readwrite-mp" // (undo the above)
 
 	// Actions on xactions
-	ActXactStop  = Stop
-	ActXactStart = Start
+	ActXactStop   = Stop
+	ActXactStart  = Start
+	ActXactLimits = "set-limits" // adjust rate limits of a running xaction, see XactLimits
 
 	// auxiliary
 	ActTransient = "transient" // transient - in-memory only
@@ -127,6 +159,7 @@ const (
 const (
 	NodeMaintenance  = "maintenance"
 	NodeDecommission = "decommission"
+	NodeSpare        = "spare"
 )
 
 const (
@@ -146,6 +179,7 @@ type (
 		RmUserData        bool   `json:"rm_user_data"`        // decommission-only
 		KeepInitialConfig bool   `json:"keep_initial_config"` // ditto (to be able to restart a node from scratch)
 		NoShutdown        bool   `json:"no_shutdown"`
+		Replace           string `json:"replace,omitempty"` // activate-spare: ID of the (failed) target to replace
 	}
 )
 
@@ -154,8 +188,30 @@ type (
 		DaemonID    string `json:"daemon_id"`
 		RebalanceID string `json:"rebalance_id"`
 	}
+
+	// dry-run join (QparamDryRun): objects and bytes that'd migrate to the joining node
+	JoinImpactCnt struct {
+		Objs int64 `json:"objs"`
+		Size int64 `json:"size"`
+	}
+	JoinImpact struct {
+		DaemonID    string                    `json:"daemon_id"`    // joining node
+		Buckets     map[string]*JoinImpactCnt `json:"buckets"`      // bucket (cname) => counters
+		Targets     map[string]*JoinImpactCnt `json:"targets"`      // source target ID => counters
+		Total       JoinImpactCnt             `json:"total"`        // all buckets, all targets
+		EstDuration time.Duration             `json:"est_duration"` // rough estimate (see JoinThroughput)
+	}
 )
 
+// assumed rebalancing throughput of the joining target - used to estimate JoinImpact.EstDuration
+const JoinThroughput = 256 * cos.MiB // bytes per second
+
+func (c *JoinImpactCnt) Add(o *JoinImpactCnt) { c.Objs += o.Objs; c.Size += o.Size }
+
+func (ji *JoinImpact) Estimate() {
+	ji.EstDuration = time.Duration(ji.Total.Size) * time.Second / JoinThroughput
+}
+
 // MountpathList contains two lists:
 //   - Available - list of local mountpaths available to the storage target
 //   - WaitingDD - waiting for resilvering completion to be detached or disabled (moved to `Disabled`)
@@ -163,9 +219,11 @@ type (
 //     IO errors followed by (FSHC) health check, etc.
 type (
 	MountpathList struct {
-		Available []string `json:"available"`
-		WaitingDD []string `json:"waiting_dd"`
-		Disabled  []string `json:"disabled"`
+		Available []string          `json:"available"`
+		WaitingDD []string          `json:"waiting_dd"`
+		Disabled  []string          `json:"disabled"`
+		ReadOnly  []string          `json:"read_only,omitempty"` // (subset of available)
+		Labels    map[string]string `json:"labels,omitempty"`    // mountpath => label (see cmn.FSPConf)
 	}
 )
 
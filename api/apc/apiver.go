@@ -0,0 +1,24 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// API revisions. Client states the revision it was written against via HdrAPIVersion
+// or, alternatively, by using "/v2/..." (instead of "/v1/...") URL paths.
+// A request that does neither is treated as the current revision.
+//
+// Revision history:
+//   - 1: legacy;
+//   - 2: list-range templates support comma-separated alternatives, letter sequences,
+//     and brace-enclosed character classes (cos.ParseBashTemplate) - a superset of revision 1.
+//
+// Gateways keep compatibility shims (if any) for all revisions in the [APIVersionMin, APIVersion]
+// range, count requests that explicitly state an older revision (see ClientUsage.DeprecatedReqs),
+// and reject revisions outside this range.
+const (
+	APIVersion    = 2
+	APIVersionMin = 1
+
+	VersionV2 = "v2" // URL path alias: "/v2/..." is "/v1/..." with HdrAPIVersion set to 2
+)
@@ -0,0 +1,40 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "sort"
+
+// AuthN audit at AIS gateways (proxies), see WhatAuthAlerts and cmn.AuthConf:
+// authenticated requests are correlated with the AuthN tokens they carry, and
+// the following anomalies are reported:
+const (
+	AlertNewIPRange  = "new-ip-range" // user's token used from a previously unseen client IP range
+	AlertDeleteSpike = "delete-spike" // user exceeded auth.delete_spike DELETE requests per minute
+)
+
+type (
+	AuthAlert struct {
+		Kind   string `json:"kind"`
+		User   string `json:"user"`
+		Token  string `json:"token"`  // token ID (a short digest of the token, not the token itself)
+		Addr   string `json:"addr"`   // client IP address
+		Detail string `json:"detail"` // e.g., "delete requests: 1001 in the last 1m"
+		Time   int64  `json:"time"`   // Unix nanoseconds
+	}
+	AuthAlerts []*AuthAlert
+
+	// cluster-wide: proxy ID => alerts (oldest first)
+	AuthAlertsAll map[string]AuthAlerts
+)
+
+// Merged returns all alerts from all gateways sorted by time
+func (all AuthAlertsAll) Merged() AuthAlerts {
+	var out AuthAlerts
+	for _, alerts := range all {
+		out = append(out, alerts...)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Time < out[j].Time })
+	return out
+}
@@ -0,0 +1,81 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// Per-client usage accounting at AIS gateways (proxies), see WhatClientStats.
+// Client is identified by the authenticated principal (AuthN user ID, if AuthN
+// is enabled) and the User-Agent.
+// NOTE: bytes are counted as per request Content-Length (e.g., PUT) - the
+// payload of redirected GETs goes directly from targets and is not seen by proxies.
+
+type (
+	ClientUsage struct {
+		User      string `json:"user,omitempty"` // empty when AuthN is disabled or the request carries no token
+		UserAgent string `json:"user_agent"`
+		Reqs      int64  `json:"reqs"`
+		GetReqs   int64  `json:"get_reqs"`
+		PutReqs   int64  `json:"put_reqs"`
+		DelReqs   int64  `json:"del_reqs"`
+		Bytes     int64  `json:"bytes"`
+		// requests that use a deprecated API revision (see APIVersion)
+		DeprecatedReqs int64 `json:"deprecated_reqs,omitempty"`
+	}
+	// usage over the [Start, End) time interval (Unix nanoseconds)
+	ClientRollup struct {
+		Usage []*ClientUsage `json:"usage"`
+		Start int64          `json:"start"`
+		End   int64          `json:"end"`
+	}
+	// a single gateway: the current (not yet rolled-up) interval followed by
+	// the most recent rollups (oldest first)
+	ClientStats struct {
+		Current *ClientRollup   `json:"current"`
+		Rollups []*ClientRollup `json:"rollups"`
+	}
+	// cluster-wide: proxy ID => ClientStats
+	ClientStatsAll map[string]*ClientStats
+)
+
+func (cu *ClientUsage) Key() string { return cu.User + "\x00" + cu.UserAgent }
+
+func (cu *ClientUsage) Add(other *ClientUsage) {
+	cu.Reqs += other.Reqs
+	cu.GetReqs += other.GetReqs
+	cu.PutReqs += other.PutReqs
+	cu.DelReqs += other.DelReqs
+	cu.Bytes += other.Bytes
+	cu.DeprecatedReqs += other.DeprecatedReqs
+}
+
+// Totals returns per-client usage summed up over all gateways and all (current and rolled-up) intervals
+func (all ClientStatsAll) Totals() []*ClientUsage {
+	var (
+		out = make([]*ClientUsage, 0, 16)
+		idx = make(map[string]*ClientUsage, 16)
+	)
+	add := func(rollup *ClientRollup) {
+		if rollup == nil {
+			return
+		}
+		for _, cu := range rollup.Usage {
+			key := cu.Key()
+			if tot, ok := idx[key]; ok {
+				tot.Add(cu)
+				continue
+			}
+			tot := &ClientUsage{User: cu.User, UserAgent: cu.UserAgent}
+			tot.Add(cu)
+			idx[key] = tot
+			out = append(out, tot)
+		}
+	}
+	for _, cs := range all {
+		add(cs.Current)
+		for _, rollup := range cs.Rollups {
+			add(rollup)
+		}
+	}
+	return out
+}
@@ -13,14 +13,18 @@ import (
 
 // Compression enum
 const (
-	CompressAlways = "always"
-	CompressNever  = "never"
+	CompressAlways   = "always"
+	CompressNever    = "never"
+	CompressAdaptive = "adaptive" // only when the network is the bottleneck (see transport.link_capacity)
 )
 
 // sent via req.Header.Set(apc.HdrCompress, LZ4Compression)
 // (alternative to lz4 compressions upon popular request)
-const LZ4Compression = "lz4"
+const (
+	LZ4Compression  = "lz4"
+	ZstdCompression = "zstd" // optionally, with session-level dictionary (see transport.Extra.CompressDict)
+)
 
-var SupportedCompression = []string{CompressNever, CompressAlways}
+var SupportedCompression = []string{CompressNever, CompressAlways, CompressAdaptive}
 
 func IsValidCompression(c string) bool { return c == "" || cos.StringInSlice(c, SupportedCompression) }
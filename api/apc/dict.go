@@ -0,0 +1,15 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ActTrainDict: train (a new version of) the bucket's compression dictionary that is then used
+// to compress small objects at rest (see cmn.DictConf). A randomly selected target samples up
+// to NumSamples of its objects (with names starting with Prefix, if specified) and trains
+// a zstd dictionary of up to Size bytes.
+type TrainDictMsg struct {
+	Prefix     string `json:"prefix,omitempty"`
+	NumSamples int    `json:"num_samples,omitempty"` // zero: default (1000)
+	Size       int64  `json:"size,omitempty"`        // zero: default (64KiB)
+}
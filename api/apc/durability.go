@@ -0,0 +1,32 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "fmt"
+
+// durability policy (enum and accessors) applied when finalizing PUT(object)
+// (bucket-configurable, see cmn.DurabilityConf)
+type Durability string
+
+const (
+	DurabilityNone    = Durability("none")          // leave it to the OS to flush dirty pages (default)
+	DurabilityFsync   = Durability("fsync")         // fsync object's content and metadata prior to rename
+	DurabilityDirSync = Durability("fsync+dirsync") // in addition, fsync the parent directory upon rename
+
+	DurabilityDefault = Durability("") // same as `DurabilityNone`
+)
+
+var SupportedDurability = []string{string(DurabilityNone), string(DurabilityFsync), string(DurabilityDirSync)}
+
+func (d Durability) Validate() error {
+	switch d {
+	case DurabilityDefault, DurabilityNone, DurabilityFsync, DurabilityDirSync:
+		return nil
+	}
+	return fmt.Errorf("invalid durability %q (expecting one of %v)", d, SupportedDurability)
+}
+
+func (d Durability) Fsync() bool   { return d == DurabilityFsync || d == DurabilityDirSync }
+func (d Durability) DirSync() bool { return d == DurabilityDirSync }
@@ -25,6 +25,7 @@ const (
 	HdrS3Region   = HeaderPrefix + "cloud_region"
 	HdrS3Endpoint = HeaderPrefix + "endpoint"
 	HdrS3Profile  = HeaderPrefix + "profile"
+	HdrS3Preset   = HeaderPrefix + "s3-preset"
 
 	// including BucketProps.Extra.HTTP
 	HdrOrigURLBck = HeaderPrefix + "original-url"
@@ -63,6 +64,13 @@ const (
 	// uptimes, respectively
 	HdrNodeUptime    = HeaderPrefix + "node-uptime"
 	HdrClusterUptime = HeaderPrefix + "cluster-uptime"
+
+	// API revision: requested by the client and, in the response, supported by the cluster (see APIVersion)
+	HdrAPIVersion = HeaderPrefix + "api-version"
+
+	// RFC 9745 and RFC 9111 (sec. 5.5), respectively: set in responses to requests that use a deprecated API revision
+	HdrDeprecation = "Deprecation"
+	HdrWarning     = "Warning"
 )
 
 // AuthN consts
@@ -80,9 +88,18 @@ const (
 
 	HdrXactionID = HeaderPrefix + "xaction-id"
 
+	// Request ID: provided by the client or else generated by the first node that receives the request;
+	// propagated via redirects and intra-cluster calls, logged with errors, and returned in the response.
+	HdrReqID = HeaderPrefix + "request-id"
+
 	// Stream related headers.
-	HdrSessID   = HeaderPrefix + "session-id"
-	HdrCompress = HeaderPrefix + "compress" // LZ4Compression, etc.
+	HdrSessID           = HeaderPrefix + "session-id"
+	HdrCompress         = HeaderPrefix + "compress"           // LZ4Compression, etc.
+	HdrCompressDict     = HeaderPrefix + "compress-dict"      // ID of the session-level zstd dictionary
+	HdrCompressDictSize = HeaderPrefix + "compress-dict-size" // size of the zstd dictionary that precedes session data (if any)
+	HdrPDUCksum         = HeaderPrefix + "pdu-cksum"          // PDU checksum type (cos.ChecksumCRC32C or cos.ChecksumXXHash)
+	HdrPDUNack          = HeaderPrefix + "pdu-nack"           // (response) objects that failed PDU checksum verification
+	HdrTransportVer     = HeaderPrefix + "transport-ver"      // request and response: transport protocol version (see transport.ProtoVersion)
 
 	// Promote(dir)
 	HdrPromoteNamesHash = HeaderPrefix + "promote-names-hash"
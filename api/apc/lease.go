@@ -0,0 +1,23 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "github.com/NVIDIA/aistore/cmn/cos"
+
+type (
+	// ActAcquireLease, ActRenewLease, and ActReleaseLease (control message)
+	LeaseMsg struct {
+		ID     string       `json:"id,omitempty"`     // renew and release only
+		TTL    cos.Duration `json:"ttl,omitempty"`    // acquire and renew (zero: default)
+		Prefix bool         `json:"prefix,omitempty"` // acquire only: lease all object names with a given prefix
+	}
+	Lease struct {
+		ID      string `json:"id"`
+		Name    string `json:"name"` // object name or prefix
+		Target  string `json:"target"`
+		Expires int64  `json:"expires"` // Unix nanoseconds
+		Prefix  bool   `json:"prefix,omitempty"`
+	}
+)
@@ -0,0 +1,19 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ActListBckInfo: list buckets (same query and presence filter as ActList) and, in addition,
+// filter the result by name and properties and, optionally, include per-bucket usage
+// (see cmn.BckInfo) - all in a single call.
+type LsbMsg struct {
+	// bucket name must match (Go regular expression)
+	NameRegex string `json:"name_regex,omitempty"`
+	// bucket props predicates: property name (as in `ais bucket props show`) => expected value,
+	// e.g. {"mirror.enabled": "true", "ec.enabled": "false"};
+	// buckets that are not present in the cluster do not match any predicate
+	Props map[string]string `json:"props,omitempty"`
+	// include usage (present buckets only)
+	Usage bool `json:"usage,omitempty"`
+}
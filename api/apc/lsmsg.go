@@ -80,6 +80,11 @@ const (
 	// - update AIS CLI to support non-recursive list-objects operation
 	// - when listing remote bucket, call backend (`Backend()`) to list non-recursively
 	LsNoRecursion
+
+	// Upon serving each page, load metadata of the listed (and locally stored) objects into
+	// memory - in the background. The intended usage: listing followed by GET-ing all (or most) of
+	// the listed objects, whereby the latter won't need to read object metadata from disk.
+	LsPrefetchMD
 )
 
 // List objects default page size
@@ -145,6 +150,7 @@ type LsoMsg struct {
 	SID               string `json:"target"`             // selected target to solely execute backend.list-objects
 	Flags             uint64 `json:"flags,string"`       // enum {LsObjCached, ...} - "LsoMsg flags" above
 	PageSize          uint   `json:"pagesize"`           // max entries returned by list objects call
+	Tags              string `json:"tags,omitempty"`     // tag filter, e.g. "split=train,reviewed" (in-cluster objects only, see cmn.TagFilter)
 }
 
 ////////////
@@ -37,6 +37,25 @@ type (
 		TCBMsg
 		ContinueOnError bool `json:"coer"` // ditto; TODO above
 	}
+
+	// ActGetBatch: multiple (named) objects in a single archived (.tar or .tar.gz) response
+	// where each object is stored under its own name
+	GetBatchMsg struct {
+		ObjNames        []string `json:"objnames"`
+		Mime            string   `json:"mime,omitempty"` // ".tar" (default), ".tar.gz", or ".tgz"
+		ContinueOnError bool     `json:"coer"`           // skip missing objects
+	}
+
+	// ActDeleteMultiObjs: per-object status report
+	DeleteMultiResult struct {
+		Deleted []string         `json:"deleted"`
+		Failed  []DeleteMultiErr `json:"failed,omitempty"`
+	}
+	DeleteMultiErr struct {
+		ObjName string `json:"name"`
+		Message string `json:"message"`
+		Status  int    `json:"status"` // e.g., http.StatusNotFound
+	}
 )
 
 ///////////////
@@ -47,3 +66,12 @@ type (
 
 func (lrm *ListRange) IsList() bool      { return len(lrm.ObjNames) > 0 }
 func (lrm *ListRange) HasTemplate() bool { return lrm.Template != "" }
+
+///////////////////////
+// DeleteMultiResult //
+///////////////////////
+
+func (res *DeleteMultiResult) Merge(other *DeleteMultiResult) {
+	res.Deleted = append(res.Deleted, other.Deleted...)
+	res.Failed = append(res.Failed, other.Failed...)
+}
@@ -0,0 +1,13 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "github.com/NVIDIA/aistore/cmn/cos"
+
+// ActPresign (control message)
+type PresignMsg struct {
+	Method string       `json:"method"` // GET or PUT
+	Expiry cos.Duration `json:"expiry"` // URL validity (from now)
+}
@@ -35,10 +35,21 @@ const (
 	AISScheme     = "ais"
 )
 
+// S3-compatible backends: provider presets (bucket property `extra.aws.preset`)
+// that take care of the respective endpoints and API quirks
+const (
+	S3PresetSpaces = "spaces" // DigitalOcean Spaces
+	S3PresetB2     = "b2"     // Backblaze B2
+
+	AllS3Presets = "spaces (DigitalOcean), b2 (Backblaze)"
+)
+
 var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HDFS, HTTP)
 
 func IsProvider(p string) bool { return Providers.Contains(p) }
 
+func IsS3Preset(p string) bool { return p == S3PresetSpaces || p == S3PresetB2 }
+
 func IsCloudProvider(p string) bool {
 	return p == AWS || p == GCP || p == Azure
 }
@@ -11,6 +11,9 @@ const (
 
 	QparamProps = "props" // e.g. "checksum, size"|"atime, size"|"cached"|"bucket, size"| ...
 
+	// set bucket props: respond with cmn.BpropsUpdateResult (xaction ID and what's changed)
+	QparamPropsDiff = "props_diff"
+
 	QparamUUID    = "uuid"     // xaction
 	QparamJobID   = "jobid"    // job
 	QparamETLName = "etl_name" // etl
@@ -63,6 +66,8 @@ const (
 	// Object related query params.
 	QparamAppendType   = "append_type"
 	QparamAppendHandle = "append_handle"
+	QparamWriteAt      = "write_at" // partial update: write the request's payload at a given offset
+	QparamHedge        = "hedge"    // GET mirrored object: read two copies, serve the first to respond
 
 	// HTTP bucket support.
 	QparamOrigURL = "original_url"
@@ -88,6 +93,10 @@ const (
 	// - shutdown the primary and the entire cluster
 	// - attach invalid mountpath
 	QparamForce = "frc"
+
+	// dry-run: compute and return the impact of the operation without actually executing it
+	// (e.g., the data placement impact of a node that is about to join the cluster)
+	QparamDryRun = "dry_run"
 )
 
 // QparamFltPresence enum.
@@ -136,12 +145,21 @@ const (
 	QparamPrimaryReadyReb = "prr"       // true: check whether primary is ready to start rebalancing cluster
 )
 
+// Presigned object URL (see api.PresignObjectURL).
+const (
+	QparamExpires   = "x-ais-expires"   // Unix time (seconds) when the URL expires
+	QparamSignature = "x-ais-signature" // HMAC signature of the (method, path, bucket, expiration)
+)
+
 // Internal query params.
 const (
 	QparamProxyID          = "pid" // ID of the redirecting proxy.
+	QparamReqID            = "rid" // Request ID (see HdrReqID) - propagated via redirect.
 	QparamPrimaryCandidate = "can" // ID of the candidate for the primary proxy.
 	QparamPrepare          = "prp" // true: request belongs to the "prepare" phase of the primary proxy election
 	QparamNonElectable     = "nel" // true: proxy is non-electable for the primary role
+	QparamSpare            = "spr" // true: target joins as a spare (see ActActivateSpare)
+	QparamNodeID           = "nid" // ID of the node that is about to join (dry-run)
 	QparamUnixTime         = "utm" // Unix time since 01/01/70 UTC (nanoseconds)
 	QparamIsGFNRequest     = "gfn" // true if the request is a Get-From-Neighbor
 	QparamSilent           = "sln" // true: destination should not log errors (HEAD request)
@@ -152,6 +170,8 @@ const (
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
 
 	QparamDontResilver = "dntres" // true: do not resilver data off of mountpaths that are being disabled/detached
+	QparamMpathLabel   = "mpl"    // label of the mountpath that is being attached (see cmn.FSPConf)
+	QparamMpathQuota   = "mpq"    // capacity quota of the mountpath that is being attached (ditto)
 
 	// dsort
 	QparamTotalCompressedSize       = "tcs"
@@ -185,11 +205,14 @@ const (
 	WhatMetricNames        = "metrics"
 	WhatDiskStats          = "disk"
 	// assorted
-	WhatMountpaths = "mountpaths"
-	WhatRemoteAIS  = "remote"
-	WhatSmapVote   = "smapvote"
-	WhatSysInfo    = "sysinfo"
-	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatMountpaths  = "mountpaths"
+	WhatRemoteAIS   = "remote"
+	WhatSmapVote    = "smapvote"
+	WhatSysInfo     = "sysinfo"
+	WhatTargetIPs   = "target_ips"   // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatClientStats = "client_stats" // per-client (AuthN user, User-Agent) usage accounting at proxies
+	WhatAuthAlerts  = "auth_alerts"  // AuthN audit anomalies detected by proxies (see AuthAlert)
+	WhatStreams     = "streams"      // intra-cluster streams and stream bundles (see StreamStats)
 	// log
 	WhatLog = "log"
 	// xactions
@@ -198,9 +221,12 @@ const (
 	WhatXactStats       = "getxstats"   // stats: xaction by uuid
 	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
+	WhatXactHistory     = "xhistory"    // persistent history of finished xactions (see xact.HistoryMsg)
+	WhatRebVerify       = "reb_verify"  // post-rebalance verification report (see RebVerifyReport)
 	// internal
-	WhatSnode    = "snode"
-	WhatICBundle = "ic_bundle"
+	WhatSnode      = "snode"
+	WhatICBundle   = "ic_bundle"
+	WhatJoinImpact = "join_impact" // dry-run join: what'd migrate to the joining target
 )
 
 // QparamLogSev enum.
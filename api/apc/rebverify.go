@@ -0,0 +1,55 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ActRebVerify: each target walks all its objects (scratch buckets excluded) and confirms that
+// every object is located on its HRW target and mountpath and has the required number of copies
+// (mirrored buckets) or EC metadata (erasure-coded buckets). The primary then aggregates per-target
+// results into an end-state report signed with the cluster's secret (see cmn.SignRebVerify).
+// Runs automatically upon successful rebalance when config.rebalance.verify is true.
+
+// (max number of offending objects listed by a target)
+const RebVerifyMaxSamples = 16
+
+type (
+	RebVerifyCnt struct {
+		Objs           int64    `json:"objs"`            // verified objects
+		Size           int64    `json:"size"`            // their total size (bytes)
+		MisplacedT     int64    `json:"misplaced_t"`     // not on their respective HRW targets
+		MisplacedMpath int64    `json:"misplaced_mpath"` // on the right target but not on the HRW mountpath
+		MissingCopies  int64    `json:"missing_copies"`  // mirrored buckets: fewer copies than required
+		MissingEC      int64    `json:"missing_ec"`      // erasure-coded buckets: no EC metadata
+		Samples        []string `json:"samples,omitempty"`
+	}
+	// per-target result
+	RebVerifyRes struct {
+		RebVerifyCnt
+		Running bool `json:"running,omitempty"`
+		Aborted bool `json:"aborted,omitempty"`
+	}
+	RebVerifyReport struct {
+		ID          string                   `json:"id"`           // xaction ID
+		Targets     map[string]*RebVerifyRes `json:"targets"`      // target ID => result
+		Total       RebVerifyCnt             `json:"total"`        // all targets (no samples)
+		SmapVersion int64                    `json:"smap_version"` // as of report generation
+		BMDVersion  int64                    `json:"bmd_version"`  // ditto
+		Time        int64                    `json:"time"`         // generated (Unix nano)
+		OK          bool                     `json:"ok"`           // none aborted, nothing to report
+		Signature   string                   `json:"signature,omitempty"`
+	}
+)
+
+func (c *RebVerifyCnt) Add(o *RebVerifyCnt) {
+	c.Objs += o.Objs
+	c.Size += o.Size
+	c.MisplacedT += o.MisplacedT
+	c.MisplacedMpath += o.MisplacedMpath
+	c.MissingCopies += o.MissingCopies
+	c.MissingEC += o.MissingEC
+}
+
+func (c *RebVerifyCnt) Errs() int64 {
+	return c.MisplacedT + c.MisplacedMpath + c.MissingCopies + c.MissingEC
+}
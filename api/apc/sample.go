@@ -0,0 +1,17 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// ActSample: uniformly random sample of (up to) NumObjs objects with names starting with Prefix,
+// computed cluster-side (each target samples its own objects, see ais/tgtsample.go) and returned
+// as a single archive - same format as batch GET (see GetBatchMsg).
+// Only objects present in the cluster are sampled (in remote buckets - only cached ones).
+type SampleMsg struct {
+	Prefix  string `json:"prefix,omitempty"`
+	Mime    string `json:"mime,omitempty"`   // ".tar" (default), ".tar.gz", or ".tgz"
+	NumObjs int    `json:"num_objs"`         // (required)
+	Length  int64  `json:"length,omitempty"` // when non-zero: at most so many (leading) bytes of each object
+	Seed    int64  `json:"seed,omitempty"`   // non-zero: same sample (given the same objects and cluster map)
+}
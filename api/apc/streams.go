@@ -0,0 +1,73 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "time"
+
+// Intra-cluster (object) streams and stream bundles at a given target, see WhatStreams.
+// All counters are cumulative over the lifetime of a stream; a bundle aggregates
+// the counters of its current streams.
+
+type (
+	StreamInfo struct {
+		ID             string        `json:"id"` // e.g. "s-rebalance[124]=>t[Kjht8081]"
+		Trname         string        `json:"trname"`
+		DstID          string        `json:"dst_id"`
+		Num            int64         `json:"num"`    // objects sent
+		Size           int64         `json:"size"`   // bytes sent (object payload)
+		Offset         int64         `json:"offset"` // stream offset, including transport headers
+		CompressedSize int64         `json:"compressed_size,omitempty"`
+		CmprOffset     int64         `json:"compressed_offset,omitempty"` // (uncompressed) offset of the compressed sessions
+		CmprRatio      float64       `json:"compression_ratio,omitempty"`
+		Compression    string        `json:"compression,omitempty"` // adaptive compression: currently CompressAlways or CompressNever
+		InFlight       int64         `json:"in_flight"`             // posted via Send but not yet completed
+		Errors         int64         `json:"errors"`                // completed with error
+		Redelivered    int64         `json:"redelivered,omitempty"` // handed over by the failed predecessor
+		Idle           time.Duration `json:"idle"`                  // since the last Send
+		Active         bool          `json:"active"`                // TCP/HTTP session is currently established
+		Terminated     bool          `json:"terminated,omitempty"`
+		TermReason     string        `json:"term_reason,omitempty"`
+	}
+	BundleInfo struct {
+		ID             string        `json:"id"` // e.g. "sb[Kjht8081-intra-data-rebalance]"
+		Trname         string        `json:"trname"`
+		Network        string        `json:"network"`
+		Streams        []string      `json:"streams"` // see StreamInfo.ID
+		Num            int64         `json:"num"`
+		Size           int64         `json:"size"`
+		Offset         int64         `json:"offset"`
+		CompressedSize int64         `json:"compressed_size,omitempty"`
+		CmprOffset     int64         `json:"compressed_offset,omitempty"`
+		CmprRatio      float64       `json:"compression_ratio,omitempty"`
+		InFlight       int64         `json:"in_flight"`
+		Errors         int64         `json:"errors"`
+		Redelivered    int64         `json:"redelivered,omitempty"`
+		Idle           time.Duration `json:"idle"` // the least idle stream
+	}
+	StreamStats struct {
+		Streams []*StreamInfo `json:"streams"`
+		Bundles []*BundleInfo `json:"bundles"`
+	}
+	// cluster-wide: target ID => StreamStats
+	StreamStatsAll map[string]*StreamStats
+)
+
+func (bi *BundleInfo) Add(si *StreamInfo) {
+	if len(bi.Streams) == 0 || si.Idle < bi.Idle {
+		bi.Idle = si.Idle
+	}
+	bi.Streams = append(bi.Streams, si.ID)
+	bi.Num += si.Num
+	bi.Size += si.Size
+	bi.Offset += si.Offset
+	bi.InFlight += si.InFlight
+	bi.Errors += si.Errors
+	bi.Redelivered += si.Redelivered
+	if si.CompressedSize > 0 {
+		bi.CompressedSize += si.CompressedSize
+		bi.CmprOffset += si.CmprOffset
+		bi.CmprRatio = float64(bi.CmprOffset) / float64(bi.CompressedSize)
+	}
+}
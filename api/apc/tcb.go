@@ -6,11 +6,25 @@ package apc
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
+// cross-object transforms
+const (
+	XformMany2One = "many-to-one" // transform a group of objects into one (e.g., per-sample files => record)
+	XformOne2Many = "one-to-many" // transform one object into several (e.g., split)
+
+	// out-name template placeholders
+	XformKey   = "{key}"
+	XformEntry = "{entry}"
+	XformIdx   = "{idx}"
+)
+
 // copy & (offline) transform bucket to bucket
 type (
 	CopyBckMsg struct {
@@ -18,10 +32,27 @@ type (
 		Prefix  string `json:"prefix"`  // prefix to select matching _source_ objects or virtual directories
 		DryRun  bool   `json:"dry_run"` // visit all source objects, don't make any modifications
 		Force   bool   `json:"force"`   // force running in presence of "limited coexistence" conflict
+		// bucket-to-bucket only (not supported by multi-object copy and transform)
+		Limits XactLimits `json:"limits"`
 	}
 	Transform struct {
 		Name    string       `json:"id,omitempty"`
 		Timeout cos.Duration `json:"request_timeout,omitempty"`
+		// cross-object transforms (bucket-to-bucket only): enum { XformMany2One, XformOne2Many };
+		// empty (default) - one-to-one
+		Xform string `json:"xform,omitempty"`
+		// many-to-one: regex to extract the group key from the source object name (the first
+		// submatch if there's one, the entire match otherwise); objects that do not match are skipped;
+		// empty (default) - the key is the object name without extension(s): "a/b/sample1.seg.png" => "a/b/sample1"
+		GroupBy string `json:"group_by,omitempty"`
+		// cross-object transforms: destination naming template (see XformName below)
+		OutName string `json:"out_name,omitempty"`
+	}
+	// per-job rate limits: cluster-wide totals (evenly divided between targets); zero - unlimited
+	// (can be changed at runtime via ActXactLimits)
+	XactLimits struct {
+		ObjsPerSec  int64 `json:"objs_per_sec,omitempty"`
+		BytesPerSec int64 `json:"bytes_per_sec,omitempty"`
 	}
 	TCBMsg struct {
 		// NOTE: resulting object names will have this extension, if specified.
@@ -35,6 +66,34 @@ type (
 	}
 )
 
+////////////////
+// XactLimits //
+////////////////
+
+func (l *XactLimits) IsSet() bool { return l.ObjsPerSec > 0 || l.BytesPerSec > 0 }
+
+func (l *XactLimits) Validate() error {
+	if l.ObjsPerSec < 0 || l.BytesPerSec < 0 {
+		return fmt.Errorf("invalid rate limits %+v: expecting non-negative values", *l)
+	}
+	return nil
+}
+
+// this target's share, given the number of active targets
+func (l *XactLimits) Share(numTargets int) (objsPerSec, bytesPerSec int64) {
+	n := int64(numTargets)
+	if n <= 0 {
+		n = 1
+	}
+	if l.ObjsPerSec > 0 {
+		objsPerSec = (l.ObjsPerSec + n - 1) / n
+	}
+	if l.BytesPerSec > 0 {
+		bytesPerSec = (l.BytesPerSec + n - 1) / n
+	}
+	return
+}
+
 ////////////
 // TCBMsg //
 ////////////
@@ -42,8 +101,48 @@ type (
 func (msg *TCBMsg) Validate(isEtl bool) (err error) {
 	if isEtl && msg.Transform.Name == "" {
 		err = errors.New("ETL name can't be empty")
+		return
 	}
-	return
+	if err = msg.validateXform(isEtl); err != nil {
+		return
+	}
+	return msg.Limits.Validate()
+}
+
+func (msg *TCBMsg) validateXform(isEtl bool) error {
+	switch msg.Xform {
+	case "":
+		if msg.GroupBy != "" || msg.OutName != "" {
+			return errors.New("group-by and out-name require cross-object transform")
+		}
+		return nil
+	case XformMany2One, XformOne2Many:
+		if !isEtl {
+			return fmt.Errorf("%q transform requires ETL", msg.Xform)
+		}
+	default:
+		return fmt.Errorf("invalid cross-object transform %q (expecting %q or %q)", msg.Xform, XformMany2One, XformOne2Many)
+	}
+	if msg.GroupBy != "" {
+		if msg.Xform != XformMany2One {
+			return fmt.Errorf("group-by is not supported with %q transform", msg.Xform)
+		}
+		if _, err := regexp.Compile(msg.GroupBy); err != nil {
+			return fmt.Errorf("invalid group-by regex %q: %v", msg.GroupBy, err)
+		}
+	}
+	if msg.OutName != "" {
+		if msg.Xform == XformMany2One && (strings.Contains(msg.OutName, XformEntry) || strings.Contains(msg.OutName, XformIdx)) {
+			return fmt.Errorf("out-name %q: %s and %s are not supported with %q transform",
+				msg.OutName, XformEntry, XformIdx, msg.Xform)
+		}
+		if !strings.Contains(msg.OutName, XformKey) && !strings.Contains(msg.OutName, XformEntry) &&
+			!strings.Contains(msg.OutName, XformIdx) {
+			return fmt.Errorf("out-name %q must contain (at least) one of: %s, %s, %s",
+				msg.OutName, XformKey, XformEntry, XformIdx)
+		}
+	}
+	return nil
 }
 
 // Replace extension and add suffix if provided.
@@ -61,3 +160,23 @@ func (msg *TCBMsg) ToName(name string) string {
 	}
 	return name
 }
+
+// XformName is the naming callback of cross-object transforms:
+// - many-to-one: `key` is the group key (see GroupBy), `entry` is empty;
+// - one-to-many: `key` is the source object name without extension(s), and `entry` is the name
+// of the respective (output) entry in the TAR-formatted result of the transformation;
+// the default templates are XformKey and XformKey + "/" + XformEntry, respectively.
+// In both cases, the result is prefixed with `Prepend`, if specified.
+func (msg *TCBMsg) XformName(key, entry string, idx int) (name string) {
+	tmpl := msg.OutName
+	if tmpl == "" {
+		tmpl = XformKey
+		if msg.Xform == XformOne2Many {
+			tmpl = XformKey + "/" + XformEntry
+		}
+	}
+	name = strings.ReplaceAll(tmpl, XformKey, key)
+	name = strings.ReplaceAll(name, XformEntry, entry)
+	name = strings.ReplaceAll(name, XformIdx, strconv.Itoa(idx))
+	return msg.Prepend + name
+}
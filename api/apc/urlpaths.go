@@ -24,6 +24,7 @@ const (
 	ObjStream = "objstream"
 	MsgStream = "msgstream"
 	Reverse   = "reverse"
+	Tunnel    = "tunnel" // relaying intra-cluster streams via proxy (see transport/relay.go)
 	Rebalance = "rebalance"
 	Xactions  = "xactions"
 	S3        = "s3"
@@ -33,6 +34,7 @@ const (
 	Clusters  = "clusters" // AuthN
 	Roles     = "roles"    // AuthN
 	IC        = "ic"       // information center
+	OpenAPI   = "openapi"  // REST API spec (see api/openapi)
 
 	// l3 ---
 
@@ -108,6 +110,7 @@ var (
 	URLPathHealth    = urlpath(Version, Health)
 	URLPathMetasync  = urlpath(Version, Metasync)
 	URLPathRebalance = urlpath(Version, Rebalance)
+	URLPathOpenAPI   = urlpath(Version, OpenAPI)
 
 	URLPathClu        = urlpath(Version, Cluster)
 	URLPathCluProxy   = urlpath(Version, Cluster, Proxy)
@@ -127,6 +130,8 @@ var (
 	URLPathReverse    = urlpath(Version, Reverse)
 	URLPathReverseDae = urlpath(Version, Reverse, Daemon)
 
+	URLPathTunnel = urlpath(Version, Tunnel)
+
 	URLPathVote        = urlpath(Version, Vote)
 	URLPathVoteInit    = urlpath(Version, Vote, Init)
 	URLPathVoteProxy   = urlpath(Version, Vote, Proxy)
@@ -0,0 +1,39 @@
+// Package apc: API messages and constants
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// bucket change notifications (see api.WatchBucket)
+
+const (
+	BckEventCreate = "create"
+	BckEventUpdate = "update"
+	BckEventDelete = "delete"
+
+	// special (synthetic) event: some number of events was not delivered
+	// because the watcher (or the cluster) did not keep up
+	BckEventLost = "lost"
+)
+
+type (
+	// ActWatchBck control message
+	WatchMsg struct {
+		Prefix string `json:"prefix,omitempty"`
+		// (internal use) per-target sequence numbers of the last delivered events
+		Since map[string]uint64 `json:"since,omitempty"`
+	}
+	BckEvent struct {
+		Type   string `json:"type"`
+		Name   string `json:"name,omitempty"`
+		Target string `json:"target,omitempty"`
+		Size   int64  `json:"size,omitempty"`
+		Time   int64  `json:"time"` // Unix nanoseconds
+	}
+	// (internal use) target => proxy
+	BckEvents struct {
+		Events []*BckEvent `json:"events,omitempty"`
+		Seq    uint64      `json:"seq"`
+		Lost   bool        `json:"lost,omitempty"`
+	}
+)
@@ -23,6 +23,7 @@ type (
 		Net          NetConf       `json:"net"`
 		Server       ServerConf    `json:"auth"`
 		Timeout      TimeoutConf   `json:"timeout"`
+		LDAP         LDAPConf      `json:"ldap"`
 	}
 	LogConf struct {
 		Dir   string `json:"dir"`
@@ -44,6 +45,21 @@ type (
 	TimeoutConf struct {
 		Default cos.Duration `json:"default_timeout"`
 	}
+	// LDAP (or Active Directory) users log in with their directory credentials;
+	// the groups they belong to (directly or via nested groups) map to AuthN roles
+	LDAPConf struct {
+		URL          string              `json:"url"`           // ldap://host:389 or ldaps://host:636
+		BindDN       string              `json:"bind_dn"`       // service account to look up users and groups (empty: anonymous)
+		BindPassword string              `json:"bind_password"` // ditto
+		UserBaseDN   string              `json:"user_base_dn"`  // e.g. "ou=people,dc=example,dc=com"
+		UserAttr     string              `json:"user_attr"`     // login name attribute: "uid" (default), "sAMAccountName", etc.
+		GroupAttr    string              `json:"group_attr"`    // group membership attribute (default "memberOf")
+		GroupRoles   map[string][]string `json:"group_roles"`   // group DN or CN => AuthN role(s)
+		CacheTTL     cos.Duration        `json:"group_cache_ttl"`
+		NestedDepth  int                 `json:"nested_depth"` // levels of nested groups to resolve (0: direct membership only)
+		SkipVerify   bool                `json:"skip_verify"`  // ldaps: skip server certificate verification
+		Enabled      bool                `json:"enabled"`
+	}
 	ConfigToUpdate struct {
 		Server *ServerConfToUpdate `json:"auth"`
 	}
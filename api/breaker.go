@@ -0,0 +1,193 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Circuit breaker: prevents retry storms against a gateway that is down. Shared by
+// all requests made with BaseParams.Breaker, it tracks each endpoint (BaseParams.URL)
+// separately:
+//   - closed: requests go through; `threshold` consecutive connection failures (including
+//     retries) open the circuit;
+//   - open: requests fail immediately with ErrCircuitOpen (or, with SmartClient,
+//     fail over to the next gateway) for the duration of `cooldown`;
+//   - half-open: after the cooldown, a single probe request goes through - success closes
+//     the circuit, failure opens it again.
+//
+// Only connection-level failures (the endpoint is unreachable) count - HTTP errors do not.
+//
+// Usage:
+//
+//	bp := api.BaseParams{Client: client, URL: endpoint, Breaker: api.NewCircuitBreaker(0, 0)}
+//	...
+//	for _, st := range bp.Breaker.Status() { ... }
+
+const (
+	cbDfltThreshold = 5
+	cbDfltCooldown  = 10 * time.Second
+)
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+type (
+	CircuitState int
+
+	CircuitBreaker struct {
+		eps       map[string]*cbEndpoint
+		cooldown  time.Duration
+		threshold int
+		mu        sync.Mutex
+	}
+	cbEndpoint struct {
+		opened   int64 // mono-time
+		failures int   // consecutive
+		state    CircuitState
+		probing  bool
+	}
+
+	// CircuitStatus is a point-in-time state of a given endpoint
+	CircuitStatus struct {
+		URL      string
+		State    CircuitState
+		Failures int           // consecutive
+		RetryIn  time.Duration // when open: time until the next (half-open) probe
+	}
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// NewCircuitBreaker returns a new breaker; zero `threshold` and `cooldown` mean
+// the defaults (5 consecutive failures and 10s, respectively).
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		threshold = cbDfltThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = cbDfltCooldown
+	}
+	return &CircuitBreaker{eps: make(map[string]*cbEndpoint, 4), threshold: threshold, cooldown: cooldown}
+}
+
+// State returns the current state of a given endpoint.
+func (cb *CircuitBreaker) State(u string) CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if ep, ok := cb.eps[u]; ok {
+		return cb._state(ep, mono.NanoTime())
+	}
+	return CircuitClosed
+}
+
+// Status returns the states of all tracked endpoints, sorted by URL.
+func (cb *CircuitBreaker) Status() []CircuitStatus {
+	now := mono.NanoTime()
+	cb.mu.Lock()
+	out := make([]CircuitStatus, 0, len(cb.eps))
+	for u, ep := range cb.eps {
+		st := CircuitStatus{URL: u, State: cb._state(ep, now), Failures: ep.failures}
+		if st.State == CircuitOpen {
+			st.RetryIn = cb.cooldown - time.Duration(now-ep.opened)
+		}
+		out = append(out, st)
+	}
+	cb.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].URL < out[j].URL })
+	return out
+}
+
+// Reset closes all circuits.
+func (cb *CircuitBreaker) Reset() {
+	cb.mu.Lock()
+	cb.eps = make(map[string]*cbEndpoint, 4)
+	cb.mu.Unlock()
+}
+
+// under lock
+func (cb *CircuitBreaker) _state(ep *cbEndpoint, now int64) CircuitState {
+	if ep.state == CircuitOpen && (ep.probing || time.Duration(now-ep.opened) >= cb.cooldown) {
+		return CircuitHalfOpen
+	}
+	return ep.state
+}
+
+// called prior to sending each request (and each retry)
+func (cb *CircuitBreaker) allow(u string) error {
+	now := mono.NanoTime()
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	ep, ok := cb.eps[u]
+	if !ok {
+		return nil
+	}
+	switch cb._state(ep, now) {
+	case CircuitClosed:
+		return nil
+	case CircuitHalfOpen:
+		if ep.probing {
+			return fmt.Errorf("%w: %s (probing)", ErrCircuitOpen, u)
+		}
+		ep.probing = true
+		return nil
+	default:
+		return fmt.Errorf("%w: %s (retry in %v)", ErrCircuitOpen, u, cb.cooldown-time.Duration(now-ep.opened))
+	}
+}
+
+// called upon each completed request (and each retry); `failed`: the endpoint is unreachable
+func (cb *CircuitBreaker) done(u string, failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	ep, ok := cb.eps[u]
+	if !failed {
+		delete(cb.eps, u) // (closed)
+		return
+	}
+	if !ok {
+		ep = &cbEndpoint{}
+		cb.eps[u] = ep
+	}
+	ep.failures++
+	if ep.probing || ep.failures >= cb.threshold {
+		ep.state, ep.probing, ep.opened = CircuitOpen, false, mono.NanoTime()
+	}
+}
+
+// inconclusive (e.g., canceled) request: let the next one probe
+func (cb *CircuitBreaker) abort(u string) {
+	cb.mu.Lock()
+	if ep, ok := cb.eps[u]; ok {
+		ep.probing = false
+	}
+	cb.mu.Unlock()
+}
+
+//////////////////
+// CircuitState //
+//////////////////
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
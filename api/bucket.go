@@ -30,6 +30,29 @@ func SetBucketProps(bp BaseParams, bck cmn.Bck, props *cmn.BucketPropsToUpdate)
 	return patchBprops(bp, bck, b)
 }
 
+// SetBucketPropsDiff is SetBucketProps that also returns the properties that have actually
+// changed, each with its before and after values.
+// All specified properties are applied at once (or not at all) and validated as a whole;
+// an update that races with another update of the same bucket fails with http.StatusConflict.
+func SetBucketPropsDiff(bp BaseParams, bck cmn.Bck, props *cmn.BucketPropsToUpdate) (*cmn.BpropsUpdateResult, error) {
+	bp.Method = http.MethodPatch
+	res := &cmn.BpropsUpdateResult{}
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSetBprops, Value: props})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(url.Values{apc.QparamPropsDiff: []string{"true"}})
+	}
+	_, err := reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // ResetBucketProps resets the properties of a bucket to the global configuration.
 func ResetBucketProps(bp BaseParams, bck cmn.Bck) (string, error) {
 	b := cos.MustMarshal(apc.ActMsg{Action: apc.ActResetBprops})
@@ -52,6 +75,25 @@ func patchBprops(bp BaseParams, bck cmn.Bck, body []byte) (xid string, err error
 	return
 }
 
+// TransitionBucket sets the bucket's placement label (empty label: all mountpaths)
+// and starts an extended action (xaction) to move the bucket's existing objects,
+// along with their copies and EC slices, if any, to the mountpaths labeled accordingly.
+// Returns xaction ID if successful, an error otherwise.
+func TransitionBucket(bp BaseParams, bck cmn.Bck, label string) (xid string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActTransitionBck, Value: label})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
 // HEAD(bucket): apc.HdrBucketProps => cmn.BucketProps{} and apc.HdrBucketInfo => BucketInfo{}
 //
 // Converts the string type fields returned from the HEAD request to their
@@ -336,3 +378,26 @@ func ECEncodeBucket(bp BaseParams, bck cmn.Bck, data, parity int) (xid string, e
 	FreeRp(reqParams)
 	return
 }
+
+// TrainBucketDict trains a new version of the bucket's compression dictionary
+// (see cmn.DictConf) from a sample of the bucket's (small) objects.
+// To start compressing newly written objects, enable the feature via `dict.enabled`.
+// Returns the new dictionary version.
+func TrainBucketDict(bp BaseParams, bck cmn.Bck, msg *apc.TrainDictMsg) (version int, err error) {
+	var s string
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActTrainDict, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	_, err = reqParams.doReqStr(&s)
+	FreeRp(reqParams)
+	if err == nil {
+		version, err = strconv.Atoi(s)
+	}
+	return
+}
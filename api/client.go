@@ -6,21 +6,28 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/tinylib/msgp/msgp"
 )
 
+// API revision this package is written against (see apc.APIVersion)
+var apiVersion = strconv.Itoa(apc.APIVersion)
+
 const (
 	errNilCksum     = "nil checksum"
 	errNilCksumType = "checksum is empty (checksum type %q) - cannot validate"
@@ -28,11 +35,28 @@ const (
 
 type (
 	BaseParams struct {
+		// optional: when set, used to cancel in-flight requests (and polling waits, e.g. WaitForXactionIC);
+		// nil is treated as context.Background()
+		Ctx    context.Context
 		Client *http.Client
 		URL    string
 		Method string
 		Token  string
 		UA     string
+
+		// optional: request ID (apc.HdrReqID) to send with each request,
+		// to correlate client-side and cluster logs
+		ReqID string
+
+		// optional: client-side throttling (requests and bytes per second) shared by all
+		// requests made with these (and copied) params; see RateLimiter
+		RateLim *RateLimiter
+
+		// optional: per-endpoint circuit breaker shared by all requests made with these
+		// (and copied) params; see CircuitBreaker
+		Breaker *CircuitBreaker
+
+		sc *SmartClient // when non-nil: load balancing and failover (see SmartClient.BP)
 	}
 
 	// ReqParams is used in constructing client-side API requests to aistore.
@@ -53,6 +77,9 @@ type (
 
 		// mem-pool (when cos.HdrContentType = cos.ContentMsgPack)
 		buf []byte
+
+		// optional; see GetArgs.Progress
+		progress ProgressFunc
 	}
 )
 
@@ -84,13 +111,42 @@ func HTTPStatus(err error) int {
 	return -1 // invalid
 }
 
+// WithContext returns a copy of the base params that will use the provided context.
+func (bp BaseParams) WithContext(ctx context.Context) BaseParams {
+	bp.Ctx = ctx
+	return bp
+}
+
+func (bp *BaseParams) ctx() context.Context {
+	if bp.Ctx == nil {
+		return context.Background()
+	}
+	return bp.Ctx
+}
+
+// sleep unless the context is done in the meantime
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		return ctx.Err()
+	}
+}
+
 func SetAuxHeaders(r *http.Request, bp *BaseParams) {
+	r.Header.Set(apc.HdrAPIVersion, apiVersion)
 	if bp.Token != "" {
 		r.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+bp.Token)
 	}
 	if bp.UA != "" {
 		r.Header.Set(cos.HdrUserAgent, bp.UA)
 	}
+	if bp.ReqID != "" {
+		r.Header.Set(apc.HdrReqID, bp.ReqID)
+	}
 }
 
 func GetWhatRawQuery(getWhat, getProps string) string {
@@ -179,38 +235,77 @@ func (reqParams *ReqParams) doReader() (io.ReadCloser, error) {
 		resp.Body.Close()
 		return nil, err
 	}
-	return resp.Body, nil
+	return newProgressR(resp.Body, resp.ContentLength, reqParams.progress), nil
 }
 
 // makes HTTP request, retries on connection-refused and reset errors, and returns the response
+// (with SmartClient: fails over to the next available gateway)
 func (reqParams *ReqParams) do() (resp *http.Response, err error) {
-	var reqBody io.Reader
-	if reqParams.Body != nil {
-		reqBody = bytes.NewBuffer(reqParams.Body)
-	}
-	urlPath := reqParams.BaseParams.URL + reqParams.Path
-	req, errR := http.NewRequest(reqParams.BaseParams.Method, urlPath, reqBody)
-	if errR != nil {
-		return nil, fmt.Errorf("failed to create http request: %w", errR)
-	}
-	reqParams.setRequestOptParams(req)
-	SetAuxHeaders(req, &reqParams.BaseParams)
-
-	rr := reqResp{client: reqParams.BaseParams.Client, req: req}
-	err = cmn.NetworkCallWithRetry(&cmn.RetryArgs{
-		Call:      rr.call,
-		Verbosity: cmn.RetryLogOff,
-		SoftErr:   httpMaxRetries,
-		Sleep:     httpRetrySleep,
-		BackOff:   true,
-		IsClient:  true,
-	})
-	resp = rr.resp
+	var (
+		ctx     = reqParams.BaseParams.ctx()
+		sc      = reqParams.BaseParams.sc
+		softErr = uint(httpMaxRetries)
+		req     *http.Request
+	)
+	if sc != nil {
+		softErr = 1 // failing over sooner
+	}
+	rl := reqParams.BaseParams.RateLim
+	if rl != nil {
+		if err = rl.waitReq(ctx, int64(len(reqParams.Body))); err != nil {
+			return nil, err
+		}
+	}
+	for {
+		var reqBody io.Reader
+		if reqParams.Body != nil {
+			reqBody = bytes.NewBuffer(reqParams.Body)
+		}
+		urlPath := reqParams.BaseParams.URL + reqParams.Path
+		req, err = http.NewRequestWithContext(ctx, reqParams.BaseParams.Method, urlPath, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create http request: %w", err)
+		}
+		reqParams.setRequestOptParams(req)
+		SetAuxHeaders(req, &reqParams.BaseParams)
+
+		rr := reqResp{client: reqParams.BaseParams.Client, req: req}
+		call := rr.call
+		if cb := reqParams.BaseParams.Breaker; cb != nil {
+			call = func() (int, error) { return rr.callCB(cb, reqParams.BaseParams.URL) }
+		}
+		err = cmn.NetworkCallWithRetry(&cmn.RetryArgs{
+			Call:      call,
+			Verbosity: cmn.RetryLogOff,
+			SoftErr:   softErr,
+			Sleep:     httpRetrySleep,
+			BackOff:   true,
+			IsClient:  true,
+			IsFatal: func(err error) bool {
+				return ctx.Err() != nil || errors.Is(err, ErrCircuitOpen) // canceled, deadline exceeded, or circuit open
+			},
+		})
+		resp = rr.resp
+		if err == nil || sc == nil || ctx.Err() != nil {
+			break
+		}
+		if !smartFailover(err, resp) && !errors.Is(err, ErrCircuitOpen) {
+			break
+		}
+		next := sc.failover(reqParams.BaseParams.URL)
+		if next == "" {
+			break
+		}
+		reqParams.BaseParams.URL = next
+	}
 	if err != nil && resp != nil {
 		herr := cmn.NewErrHTTP(req, err, resp.StatusCode)
 		herr.Method, herr.URLPath = reqParams.BaseParams.Method, reqParams.Path
 		err = herr
 	}
+	if err == nil && rl != nil {
+		resp.Body = rl.reader(ctx, resp.Body)
+	}
 	return
 }
 
@@ -276,7 +371,9 @@ func (reqParams *ReqParams) rwResp(resp *http.Response, w io.Writer) (*wrappedRe
 		return nil, err
 	}
 	wresp := &wrappedResp{Response: resp}
-	n, err := io.Copy(w, resp.Body)
+	buf, slab := allocBuf()
+	n, err := io.CopyBuffer(w, newProgressR(resp.Body, resp.ContentLength, reqParams.progress), buf)
+	freeBuf(buf, slab)
 	if err != nil {
 		return nil, err
 	}
@@ -295,7 +392,10 @@ func (reqParams *ReqParams) readValidate(resp *http.Response, w io.Writer) (*wra
 	if err := reqParams.checkResp(resp); err != nil {
 		return nil, err
 	}
-	n, cksum, err := cos.CopyAndChecksum(w, resp.Body, nil, cksumType)
+	body := newProgressR(resp.Body, resp.ContentLength, reqParams.progress)
+	buf, slab := allocBuf()
+	n, cksum, err := cos.CopyAndChecksum(w, body, buf, cksumType)
+	freeBuf(buf, slab)
 	if err != nil {
 		return nil, err
 	}
@@ -319,19 +419,27 @@ func (reqParams *ReqParams) readValidate(resp *http.Response, w io.Writer) (*wra
 }
 
 func (reqParams *ReqParams) checkResp(resp *http.Response) error {
+	if resp.StatusCode == http.StatusNotModified { // conditional GET (If-None-Match)
+		return typedErr(&cmn.ErrHTTP{
+			Message: http.StatusText(http.StatusNotModified) + " (ETag " + resp.Header.Get(cos.HdrETag) + ")",
+			Status:  resp.StatusCode,
+			Method:  reqParams.BaseParams.Method,
+			URLPath: reqParams.Path,
+		})
+	}
 	if resp.StatusCode < http.StatusBadRequest {
 		return nil
 	}
 	if reqParams.BaseParams.Method == http.MethodHead {
 		// HEAD request does not return body
 		if msg := resp.Header.Get(apc.HdrError); msg != "" {
-			return &cmn.ErrHTTP{
+			return typedErr(&cmn.ErrHTTP{
 				TypeCode: cmn.TypeCodeHTTPErr(msg),
 				Message:  msg,
 				Status:   resp.StatusCode,
 				Method:   reqParams.BaseParams.Method,
 				URLPath:  reqParams.Path,
-			}
+			})
 		}
 	}
 
@@ -339,29 +447,29 @@ func (reqParams *ReqParams) checkResp(resp *http.Response) error {
 	if len(b) == 0 {
 		if resp.StatusCode == http.StatusServiceUnavailable {
 			msg := fmt.Sprintf("[%s]: starting up, please try again later...", http.StatusText(http.StatusServiceUnavailable))
-			return &cmn.ErrHTTP{Message: msg, Status: resp.StatusCode}
+			return typedErr(&cmn.ErrHTTP{Message: msg, Status: resp.StatusCode})
 		}
-		return &cmn.ErrHTTP{
+		return typedErr(&cmn.ErrHTTP{
 			Message: "failed to execute " + reqParams.BaseParams.Method + " request",
 			Status:  resp.StatusCode,
 			Method:  reqParams.BaseParams.Method,
 			URLPath: reqParams.Path,
-		}
+		})
 	}
 
 	herr := &cmn.ErrHTTP{}
 	if err := jsoniter.Unmarshal(b, herr); err == nil {
-		return herr
+		return typedErr(herr)
 	}
 	// otherwise, recreate
 	msg := string(b)
-	return &cmn.ErrHTTP{
+	return typedErr(&cmn.ErrHTTP{
 		TypeCode: cmn.TypeCodeHTTPErr(msg),
 		Message:  msg,
 		Status:   resp.StatusCode,
 		Method:   reqParams.BaseParams.Method,
 		URLPath:  reqParams.Path,
-	}
+	})
 }
 
 /////////////
@@ -376,6 +484,23 @@ func (rr *reqResp) call() (status int, err error) {
 	return
 }
 
+// same as above, via circuit breaker
+func (rr *reqResp) callCB(cb *CircuitBreaker, u string) (int, error) {
+	if err := cb.allow(u); err != nil {
+		return 0, err
+	}
+	status, err := rr.call()
+	switch {
+	case err == nil:
+		cb.done(u, false)
+	case rr.req.Context().Err() != nil:
+		cb.abort(u)
+	default:
+		cb.done(u, smartFailover(err, rr.resp))
+	}
+	return status, err
+}
+
 //
 // mem-pools
 //
@@ -385,8 +510,21 @@ var (
 	reqParams0   ReqParams
 
 	msgpPool sync.Pool
+
+	// optional (see Init)
+	gmm *memsys.MMSA
 )
 
+// Init registers memory manager (MMSA) for the client to allocate its buffers from, namely:
+// copying (and checksumming) GET responses, computing PUT checksums, and decoding msgpack
+// (list-objects) - to reduce GC pressure in high-QPS data-loading jobs.
+// Optional; must be called once, prior to any other API. Example:
+//
+//	mm, err := memsys.NewMMSA("client", true /*silent*/)
+//	...
+//	api.Init(mm)
+func Init(mm *memsys.MMSA) { gmm = mm }
+
 func AllocRp() *ReqParams {
 	if v := reqParamPool.Get(); v != nil {
 		return v.(*ReqParams)
@@ -400,6 +538,10 @@ func FreeRp(reqParams *ReqParams) {
 }
 
 func allocMbuf() (buf []byte) {
+	if gmm != nil {
+		buf, _ = gmm.AllocSize(msgpBufSize)
+		return
+	}
 	if v := msgpPool.Get(); v != nil {
 		buf = *(v.(*[]byte))
 	} else {
@@ -408,4 +550,24 @@ func allocMbuf() (buf []byte) {
 	return
 }
 
-func freeMbuf(buf []byte) { msgpPool.Put(&buf) }
+func freeMbuf(buf []byte) {
+	if gmm != nil {
+		gmm.Free(buf)
+		return
+	}
+	msgpPool.Put(&buf)
+}
+
+// copy buffer: nil (io.Copy and cos.CopyAndChecksum allocate) unless Init
+func allocBuf() ([]byte, *memsys.Slab) {
+	if gmm == nil {
+		return nil, nil
+	}
+	return gmm.AllocSize(memsys.DefaultBufSize)
+}
+
+func freeBuf(buf []byte, slab *memsys.Slab) {
+	if slab != nil {
+		slab.Free(buf)
+	}
+}
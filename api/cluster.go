@@ -10,6 +10,7 @@ import (
 	"net/url"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/openapi"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cluster/meta"
 	"github.com/NVIDIA/aistore/cmn"
@@ -196,6 +197,50 @@ func ResetClusterStats(bp BaseParams, errorsOnly bool) (err error) {
 	return
 }
 
+// GetClientStats returns per-client (AuthN user, User-Agent) usage accounted by all proxies
+// (use apc.ClientStatsAll.Totals() to aggregate)
+func GetClientStats(bp BaseParams) (out apc.ClientStatsAll, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatClientStats}}
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return
+}
+
+// GetAuthAlerts returns AuthN audit alerts (anomalies) detected by all proxies
+// (see cmn.AuthConf and apc.AuthAlert; use apc.AuthAlertsAll.Merged() to combine)
+func GetAuthAlerts(bp BaseParams) (out apc.AuthAlertsAll, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatAuthAlerts}}
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return
+}
+
+// GetClusterStreamStats returns counters of intra-cluster streams and stream bundles of all targets
+func GetClusterStreamStats(bp BaseParams) (out apc.StreamStatsAll, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatStreams}}
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return
+}
+
 func GetRemoteAIS(bp BaseParams) (remais cluster.Remotes, err error) {
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
@@ -226,6 +271,27 @@ func JoinCluster(bp BaseParams, nodeInfo *meta.Snode) (rebID, sid string, err er
 	return info.RebalanceID, info.DaemonID, err
 }
 
+// JoinClusterDryRun computes the impact of the node joining the cluster -
+// objects and bytes that'd migrate to it, per bucket and per source target,
+// and the expected rebalancing duration.
+// The node itself is not affected and stays outside the cluster (standby)
+// until (and unless) the caller confirms by calling JoinCluster.
+func JoinClusterDryRun(bp BaseParams, nodeInfo *meta.Snode) (ji *apc.JoinImpact, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathCluUserReg.S
+		reqParams.Body = cos.MustMarshal(nodeInfo)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = url.Values{apc.QparamDryRun: []string{"true"}}
+	}
+	ji = &apc.JoinImpact{}
+	_, err = reqParams.DoReqAny(ji)
+	FreeRp(reqParams)
+	return
+}
+
 // SetPrimaryProxy given a daemonID sets that corresponding proxy as the
 // primary proxy of the cluster.
 func SetPrimaryProxy(bp BaseParams, newPrimaryID string, force bool) error {
@@ -429,6 +495,26 @@ func StopMaintenance(bp BaseParams, actValue *apc.ActValRmNode) (xid string, err
 	return xid, err
 }
 
+// ActivateSpare takes a spare target (see `aisnode -spare`) out of maintenance,
+// optionally in place of a failed target (`actValue.Replace`)
+func ActivateSpare(bp BaseParams, actValue *apc.ActValRmNode) (xid string, err error) {
+	msg := apc.ActMsg{
+		Action: apc.ActActivateSpare,
+		Value:  actValue,
+	}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return xid, err
+}
+
 // ShutdownCluster shuts down the whole cluster
 func ShutdownCluster(bp BaseParams) error {
 	msg := apc.ActMsg{Action: apc.ActShutdownCluster}
@@ -507,3 +593,17 @@ func RemoveNodeUnsafe(bp BaseParams, sid string) error {
 	FreeRp(reqParams)
 	return err
 }
+
+// GetOpenAPI retrieves OpenAPI 3 description of the AIS REST API - e.g., to generate
+// a client in another language (see also: api/openapi)
+func GetOpenAPI(bp BaseParams) (doc *openapi.Document, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathOpenAPI.S
+	}
+	_, err = reqParams.DoReqAny(&doc)
+	FreeRp(reqParams)
+	return
+}
@@ -0,0 +1,105 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Client-side connection tuning.
+//
+// Object reads and writes are redirected by the gateway to the target that stores
+// (or will store) the object, so that a client with N outstanding requests ends up
+// talking to all the nodes in the cluster, up to N connections each. Go's default
+// transport keeps only 2 idle connections per host - the rest get closed and
+// re-established (including TLS handshake) over and over again.
+//
+// Usage:
+//
+//	smap, err := api.GetClusterMap(api.BaseParams{Client: http.DefaultClient, URL: endpoint})
+//	...
+//	client := api.NewTunedClient(smap, 64 /*concurrency*/, cmn.TransportArgs{UseHTTPS: https})
+//	bp := api.BaseParams{Client: client, URL: endpoint, Token: token}
+//	err = api.WarmUpConns(bp, smap, 64)
+
+// TuneTransportArgs sizes connection pools for up to `concurrency` outstanding requests
+// against a cluster with `numNodes` nodes and, for HTTPS, enables HTTP/2.
+// Non-zero values that are already large enough are not changed.
+func TuneTransportArgs(args *cmn.TransportArgs, numNodes, concurrency int) {
+	numNodes = cos.Max(numNodes, 1)
+	concurrency = cos.Max(concurrency, 1)
+	if args.IdleConnsPerHost < concurrency {
+		args.IdleConnsPerHost = concurrency
+	}
+	if maxIdle := concurrency * numNodes; args.MaxIdleConns < maxIdle {
+		args.MaxIdleConns = maxIdle
+	}
+	if args.WriteBufferSize == 0 {
+		args.WriteBufferSize = cmn.DefaultWriteBufferSize
+	}
+	if args.ReadBufferSize == 0 {
+		args.ReadBufferSize = cmn.DefaultReadBufferSize
+	}
+	if args.UseHTTPS {
+		args.UseHTTP2 = true
+	}
+}
+
+// NewTunedClient returns HTTP client tuned for a given cluster map and concurrency;
+// see TuneTransportArgs.
+func NewTunedClient(smap *meta.Smap, concurrency int, args cmn.TransportArgs) *http.Client {
+	TuneTransportArgs(&args, smap.CountActivePs()+smap.CountActiveTs(), concurrency)
+	return cmn.NewClient(args)
+}
+
+// WarmUpConns pre-establishes (up to) `connsPerNode` connections to each active node
+// in the cluster map (nil: fetch the current one) by executing concurrent health checks.
+// The connections remain in the client's idle pool - subject to the transport's
+// idle-connection timeout. Returns the first error, if any.
+func WarmUpConns(bp BaseParams, smap *meta.Smap, connsPerNode int) (err error) {
+	if smap == nil {
+		if smap, err = GetClusterMap(bp); err != nil {
+			return
+		}
+	}
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		nodes = make([]*meta.Snode, 0, smap.Count())
+	)
+	for _, nm := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+		for _, si := range nm {
+			if !si.InMaintOrDecomm() {
+				nodes = append(nodes, si)
+			}
+		}
+	}
+	connsPerNode = cos.Max(connsPerNode, 1)
+	for _, si := range nodes {
+		nbp := bp
+		nbp.URL = si.URL(cmn.NetPublic)
+		nbp.sc = nil
+		for i := 0; i < connsPerNode; i++ {
+			wg.Add(1)
+			go func(nbp BaseParams) {
+				if errH := Health(nbp); errH != nil {
+					mu.Lock()
+					if err == nil {
+						err = errH
+					}
+					mu.Unlock()
+				}
+				wg.Done()
+			}(nbp)
+		}
+	}
+	wg.Wait()
+	return
+}
@@ -44,7 +44,8 @@ func GetMountpaths(bp BaseParams, node *meta.Snode) (mpl *apc.MountpathList, err
 }
 
 // TODO: rewrite tests that come here with `force`
-func AttachMountpath(bp BaseParams, node *meta.Snode, mountpath string, force bool) error {
+// (optional label and quota, in that order: see cmn.FSPConf and cmn.PlacementConf)
+func AttachMountpath(bp BaseParams, node *meta.Snode, mountpath string, force bool, opts ...string) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
 	{
@@ -57,6 +58,12 @@ func AttachMountpath(bp BaseParams, node *meta.Snode, mountpath string, force bo
 			cos.HdrContentType: []string{cos.ContentJSON},
 		}
 		reqParams.Query = url.Values{apc.QparamForce: []string{strconv.FormatBool(force)}}
+		if len(opts) > 0 && opts[0] != "" {
+			reqParams.Query.Set(apc.QparamMpathLabel, opts[0])
+		}
+		if len(opts) > 1 && opts[1] != "" {
+			reqParams.Query.Set(apc.QparamMpathQuota, opts[1])
+		}
 	}
 	err := reqParams.DoRequest()
 	FreeRp(reqParams)
@@ -125,6 +132,29 @@ func DisableMountpath(bp BaseParams, node *meta.Snode, mountpath string, dontRes
 	return err
 }
 
+// SetMountpathReadOnly sets (or clears) read-only mode of the mountpath: read-only mountpath
+// keeps serving existing content while new writes go to the remaining (writable) mountpaths
+func SetMountpathReadOnly(bp BaseParams, node *meta.Snode, mountpath string, readOnly bool) error {
+	action := apc.ActMountpathRW
+	if readOnly {
+		action = apc.ActMountpathRO
+	}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.Join(apc.Mountpaths)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: action, Value: mountpath})
+		reqParams.Header = http.Header{
+			apc.HdrNodeID:      []string{node.ID()},
+			cos.HdrContentType: []string{cos.ContentJSON},
+		}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // GetDaemonConfig returns the configuration of a specific daemon in a cluster.
 // (compare with `api.GetClusterConfig`)
 func GetDaemonConfig(bp BaseParams, node *meta.Snode) (config *cmn.Config, err error) {
@@ -222,6 +252,21 @@ func GetDiskStats(bp BaseParams, tid string) (res ios.AllDiskStats, err error) {
 	return
 }
 
+// GetStreamStats returns counters of the target's intra-cluster streams and stream bundles
+func GetStreamStats(bp BaseParams, tid string) (res *apc.StreamStats, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatStreams}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{tid}}
+	}
+	_, err = reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	return
+}
+
 // Returns both node's stats and extended status
 func GetStatsAndStatus(bp BaseParams, node *meta.Snode) (daeStatus *stats.NodeStatus, err error) {
 	bp.Method = http.MethodGet
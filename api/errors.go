@@ -0,0 +1,83 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Typed (sentinel) errors: API calls return `*cmn.ErrHTTP` that wraps one of the following
+// (when recognized), so that the callers can use `errors.Is` - e.g.:
+//
+//	if _, err := api.HeadObject(bp, bck, objName, 0); errors.Is(err, api.ErrObjectNotFound) {
+//		...
+//	}
+//
+// and `errors.As` to get the details (status, message, etc.) from `cmn.ErrHTTP`.
+var (
+	ErrBucketNotFound      = errors.New("bucket does not exist")
+	ErrBucketAlreadyExists = errors.New("bucket already exists")
+	ErrObjectNotFound      = errors.New("object does not exist")
+	ErrAccessDenied        = errors.New("access denied")
+	ErrSchemaViolation     = errors.New("rejected by bucket schema")
+	ErrInsufficientStorage = errors.New("insufficient storage")
+	ErrNotImplemented      = errors.New("not implemented")
+	ErrUnavailable         = errors.New("service unavailable")
+
+	// conditional GET and PUT (see GetArgs and PutArgs: IfMatch, IfNoneMatch)
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrNotModified        = errors.New("not modified")
+)
+
+func typedErr(herr *cmn.ErrHTTP) *cmn.ErrHTTP {
+	herr.SetCause(_sentinel(herr))
+	return herr
+}
+
+func _sentinel(herr *cmn.ErrHTTP) error {
+	// 1. type code, if provided
+	switch herr.TypeCode {
+	case "ErrBckNotFound", "ErrRemoteBckNotFound":
+		return ErrBucketNotFound
+	case "ErrBucketAlreadyExists":
+		return ErrBucketAlreadyExists
+	case "ErrBucketAccessDenied", "ErrObjectAccessDenied":
+		return ErrAccessDenied
+	case "ErrSchemaViolation":
+		return ErrSchemaViolation
+	case "ErrPreconditionFailed":
+		return ErrPreconditionFailed
+	}
+	// 2. otherwise, status
+	switch herr.Status {
+	case http.StatusNotFound:
+		switch {
+		case strings.HasPrefix(herr.URLPath, apc.URLPathObjects.S):
+			return ErrObjectNotFound
+		case strings.HasPrefix(herr.URLPath, apc.URLPathBuckets.S):
+			return ErrBucketNotFound
+		}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrAccessDenied
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+	case http.StatusNotModified:
+		return ErrNotModified
+	case http.StatusUnprocessableEntity:
+		return ErrSchemaViolation
+	case http.StatusInsufficientStorage:
+		return ErrInsufficientStorage
+	case http.StatusNotImplemented:
+		return ErrNotImplemented
+	case http.StatusServiceUnavailable:
+		return ErrUnavailable
+	}
+	return nil
+}
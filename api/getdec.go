@@ -0,0 +1,109 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v3"
+)
+
+// GET conveniences (see also GetArgs.Decompress):
+// - detect and decompress compressed objects;
+// - read archived files (members) from archives (shards).
+
+var (
+	magicGzip = []byte{0x1f, 0x8b}
+	magicZstd = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	magicLz4  = []byte{0x04, 0x22, 0x4d, 0x18}
+)
+
+type decR struct {
+	io.Reader
+	body  io.ReadCloser
+	close func()
+}
+
+// GetArchMember returns reader of the archived file (member) `archpath` that's stored
+// in the archive (shard) `objName` - TAR, TGZ, ZIP, etc. (see cmn/archive for supported formats).
+// Optionally, GetArgs.Query may carry apc.QparamArchmime to specify the archive's format
+// (when it cannot be determined from the object's name or content).
+// Caller is responsible for closing the reader.
+func GetArchMember(bp BaseParams, bck cmn.Bck, objName, archpath string, args *GetArgs) (io.ReadCloser, error) {
+	var (
+		q    = make(url.Values, 4)
+		argz GetArgs
+	)
+	if args != nil {
+		argz = *args
+		for k, v := range args.Query {
+			q[k] = v
+		}
+	}
+	q.Set(apc.QparamArchpath, archpath)
+	argz.Query = q
+	return GetObjectReader(bp, bck, objName, &argz)
+}
+
+// same as doReader with decompression
+func (reqParams *ReqParams) doDecoder() (io.ReadCloser, error) {
+	resp, err := reqParams.do()
+	if err != nil {
+		return nil, err
+	}
+	if err := reqParams.checkResp(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	body := newProgressR(resp.Body, resp.ContentLength, reqParams.progress)
+	r, err := newDecR(resp.Header, body)
+	if err != nil {
+		body.Close()
+	}
+	return r, err
+}
+
+func newDecR(hdr http.Header, body io.ReadCloser) (io.ReadCloser, error) {
+	var (
+		br       = bufio.NewReader(body)
+		magic, _ = br.Peek(len(magicZstd)) // (short read is fine)
+		enc      = strings.ToLower(hdr.Get(cos.HdrContentEncoding))
+	)
+	switch {
+	case enc == "gzip" || enc == "x-gzip" || bytes.HasPrefix(magic, magicGzip):
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return &decR{Reader: gzr, body: body, close: func() { gzr.Close() }}, nil
+	case enc == "zstd" || bytes.HasPrefix(magic, magicZstd):
+		zr, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return &decR{Reader: zr, body: body, close: zr.Close}, nil
+	case enc == apc.LZ4Compression || enc == "x-lz4" || bytes.HasPrefix(magic, magicLz4):
+		return &decR{Reader: lz4.NewReader(br), body: body}, nil
+	default:
+		return &decR{Reader: br, body: body}, nil
+	}
+}
+
+func (r *decR) Close() error {
+	if r.close != nil {
+		r.close()
+	}
+	return r.body.Close()
+}
@@ -0,0 +1,223 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"golang.org/x/sync/errgroup"
+)
+
+// Parallel (ranged) GET (see GetArgs.NumWorkers):
+// - the first range request goes through the proxy and gets redirected to the target;
+// - its response provides the object size (Content-Range) and the target's URL;
+// - the remaining ranges are then read directly from the target and written at their
+//   respective offsets.
+
+const DfltGetChunkSize = 8 * cos.MiB
+
+type (
+	pgetCtx struct {
+		bp       BaseParams
+		wa       io.WriterAt
+		progress ProgressFunc
+		hdr      http.Header // user-specified, if any
+		url      string      // target URL (upon redirect)
+		path     string
+		size     int64
+		n        atomic.Int64
+	}
+	pgetR struct {
+		r   io.Reader
+		ctx *pgetCtx
+	}
+)
+
+// returns `io.WriterAt` iff parallel GET is requested and applicable
+func (args *GetArgs) parallel() (io.WriterAt, bool) {
+	if args == nil || args.NumWorkers <= 1 {
+		return nil, false
+	}
+	if args.Header.Get(cos.HdrRange) != "" || args.Query.Get(apc.QparamETLName) != "" {
+		return nil, false
+	}
+	wa, ok := args.Writer.(io.WriterAt)
+	return wa, ok
+}
+
+func getParallel(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs, wa io.WriterAt) (oah ObjAttrs, err error) {
+	var (
+		resp      *http.Response
+		chunkSize = args.ChunkSize
+		pctx      = &pgetCtx{bp: bp, wa: wa, progress: args.Progress}
+	)
+	_, _, pctx.hdr = args.ret()
+	if chunkSize <= 0 {
+		chunkSize = DfltGetChunkSize
+	}
+	pctx.path = apc.URLPathObjects.Join(bck.Name, objName)
+
+	// 1. first chunk via proxy
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = pctx.path
+		reqParams.Query = bck.AddToQuery(args.Query)
+		reqParams.Header = pctx.rangeHdr(0, chunkSize)
+	}
+	resp, err = reqParams.do()
+	if err == nil {
+		if err = reqParams.checkResp(resp); err != nil {
+			cos.DrainReader(resp.Body)
+			resp.Body.Close()
+		}
+	}
+	FreeRp(reqParams)
+	if err != nil {
+		return
+	}
+	if resp.StatusCode != http.StatusPartialContent {
+		// (no range support) - read it all as is
+		pctx.size = resp.ContentLength
+		err = pctx.write(resp.Body, 0)
+		resp.Body.Close()
+		oah.wrespHeader, oah.n = resp.Header, pctx.n.Load()
+		return
+	}
+	pctx.size, err = parseContentRangeSize(resp.Header.Get(cos.HdrContentRange))
+	if err != nil {
+		resp.Body.Close()
+		return
+	}
+	pctx.url = resp.Request.URL.String()
+	// the remaining chunks must come from the same object (version)
+	if etag := resp.Header.Get(cos.HdrETag); etag != "" && pctx.hdr.Get(cos.HdrIfMatch) == "" {
+		pctx.hdr = pctx.hdr.Clone()
+		if pctx.hdr == nil {
+			pctx.hdr = make(http.Header, 1)
+		}
+		pctx.hdr.Set(cos.HdrIfMatch, etag)
+	}
+	err = pctx.write(resp.Body, 0)
+	resp.Body.Close()
+	if err != nil {
+		return
+	}
+
+	// 2. the rest directly from the target
+	if chunkSize < pctx.size {
+		group, ctx := errgroup.WithContext(bp.ctx())
+		group.SetLimit(args.NumWorkers)
+		for off := chunkSize; off < pctx.size; off += chunkSize {
+			off := off
+			group.Go(func() error { return pctx.chunk(ctx, off, cos.MinI64(chunkSize, pctx.size-off)) })
+		}
+		if err = group.Wait(); err != nil {
+			return
+		}
+	}
+
+	hdr := resp.Header.Clone()
+	hdr.Del(cos.HdrContentRange)
+	hdr.Set(cos.HdrContentLength, strconv.FormatInt(pctx.size, 10))
+	oah.wrespHeader, oah.n = hdr, pctx.size
+	return
+}
+
+// "bytes 0-1023/4096" => 4096
+func parseContentRangeSize(s string) (int64, error) {
+	if i := strings.LastIndexByte(s, '/'); i > 0 && strings.HasPrefix(s, cos.HdrContentRangeValPrefix) {
+		if size, err := strconv.ParseInt(s[i+1:], 10, 64); err == nil {
+			return size, nil
+		}
+	}
+	return 0, fmt.Errorf("parallel GET: invalid %s %q", cos.HdrContentRange, s)
+}
+
+/////////////
+// pgetCtx //
+/////////////
+
+func (pctx *pgetCtx) rangeHdr(off, length int64) http.Header {
+	hdr := make(http.Header, len(pctx.hdr)+1)
+	for k, v := range pctx.hdr {
+		hdr[k] = v
+	}
+	hdr.Set(cos.HdrRange, fmt.Sprintf("bytes=%d-%d", off, off+length-1))
+	return hdr
+}
+
+// read a given range from the target (with limited retry)
+func (pctx *pgetCtx) chunk(ctx context.Context, off, length int64) (err error) {
+	for i := 0; i < httpMaxRetries; i++ {
+		if err = pctx._chunk(ctx, off, length); err == nil || ctx.Err() != nil {
+			return
+		}
+		if err = sleepCtx(ctx, httpRetrySleep); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (pctx *pgetCtx) _chunk(ctx context.Context, off, length int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pctx.url, http.NoBody)
+	if err != nil {
+		return newErrCreateHTTPRequest(err)
+	}
+	req.Header = pctx.rangeHdr(off, length)
+	SetAuxHeaders(req, &pctx.bp)
+	resp, err := pctx.bp.Client.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	reqParams := &ReqParams{BaseParams: pctx.bp, Path: pctx.path}
+	reqParams.BaseParams.Method = http.MethodGet
+	if err := reqParams.checkResp(resp); err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusPartialContent || resp.ContentLength != length {
+		return fmt.Errorf("parallel GET %s: unexpected response (status %d, size %d) for range [%d, %d)",
+			pctx.path, resp.StatusCode, resp.ContentLength, off, off+length)
+	}
+	return pctx.write(resp.Body, off)
+}
+
+func (pctx *pgetCtx) write(body io.Reader, off int64) error {
+	buf, slab := allocBuf()
+	n, err := io.CopyBuffer(io.NewOffsetWriter(pctx.wa, off), &pgetR{r: body, ctx: pctx}, buf)
+	freeBuf(buf, slab)
+	if err != nil {
+		pctx.n.Add(-n) // (to be retried)
+	}
+	return err
+}
+
+///////////
+// pgetR //
+///////////
+
+func (r *pgetR) Read(b []byte) (n int, err error) {
+	n, err = r.r.Read(b)
+	if n > 0 {
+		total := r.ctx.n.Add(int64(n))
+		if r.ctx.progress != nil {
+			r.ctx.progress(total, r.ctx.size)
+		}
+	}
+	return
+}
@@ -0,0 +1,65 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Leases are advisory: a lease grants its holder time-limited exclusive ownership
+// of an object name (or, with `prefix` set, of all names that start with it) vis-a-vis
+// other lease holders - regular reads and writes are not affected. Acquiring a lease
+// that conflicts with an existing (non-expired) one fails with http.StatusConflict.
+// Zero TTL means the default (30s); max TTL is one hour.
+//
+// Usage:
+//
+//	lease, err := api.AcquireLease(bp, bck, "shard-000042.tar", 10*time.Second, false)
+//	... // write the object, periodically calling api.RenewLease
+//	err = api.ReleaseLease(bp, bck, lease)
+
+func AcquireLease(bp BaseParams, bck cmn.Bck, name string, ttl time.Duration, prefix bool) (*apc.Lease, error) {
+	msg := apc.LeaseMsg{TTL: cos.Duration(ttl), Prefix: prefix}
+	return doLease(bp, bck, name, apc.ActAcquireLease, msg)
+}
+
+// RenewLease extends the lease by (another) `ttl`, starting now.
+func RenewLease(bp BaseParams, bck cmn.Bck, lease *apc.Lease, ttl time.Duration) (*apc.Lease, error) {
+	msg := apc.LeaseMsg{ID: lease.ID, TTL: cos.Duration(ttl)}
+	return doLease(bp, bck, lease.Name, apc.ActRenewLease, msg)
+}
+
+func ReleaseLease(bp BaseParams, bck cmn.Bck, lease *apc.Lease) error {
+	msg := apc.LeaseMsg{ID: lease.ID}
+	_, err := doLease(bp, bck, lease.Name, apc.ActReleaseLease, msg)
+	return err
+}
+
+func doLease(bp BaseParams, bck cmn.Bck, name, action string, msg apc.LeaseMsg) (lease *apc.Lease, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: action, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	if action == apc.ActReleaseLease {
+		err = reqParams.DoRequest()
+	} else {
+		lease = &apc.Lease{}
+		if _, err = reqParams.DoReqAny(lease); err != nil {
+			lease = nil
+		}
+	}
+	FreeRp(reqParams)
+	return
+}
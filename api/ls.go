@@ -57,6 +57,34 @@ func ListBuckets(bp BaseParams, qbck cmn.QueryBcks, fltPresence int) (cmn.Bcks,
 	return bcks, nil
 }
 
+// ListBucketsInfo is ListBuckets with (optional) filtering by name and bucket properties,
+// and (optional) per-bucket usage: number of objects, used bytes, and time of the last
+// modification - to avoid the round trips of listing buckets and then calling HeadBucket
+// and GetBucketSummary for each.
+// See also: apc.LsbMsg, cmn.BckInfo
+func ListBucketsInfo(bp BaseParams, qbck cmn.QueryBcks, fltPresence int, msg *apc.LsbMsg) (cmn.BckInfos, error) {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamFltPresence, strconv.Itoa(fltPresence))
+	q = qbck.AddToQuery(q)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.S
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActListBckInfo, Name: qbck.Name, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	infos := cmn.BckInfos{}
+	_, err := reqParams.DoReqAny(&infos)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
 // QueryBuckets is a little convenience helper. It returns true if the selection contains
 // at least one bucket that satisfies the (qbck) criteria.
 // - `fltPresence` - as per QparamFltPresence enum (see api/apc/query.go)
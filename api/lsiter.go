@@ -0,0 +1,71 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// ObjectIterator lists bucket objects one page at a time (see `ListObjectsPage`),
+// transparently handling continuation tokens. Usage:
+//
+//	it := api.NewObjectIterator(bp, bck, &apc.LsoMsg{Props: apc.GetPropsSize})
+//	for it.Next() {
+//		en := it.Entry()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// Page size is controlled by `msg.PageSize` (zero - cluster default).
+type ObjectIterator struct {
+	bp    BaseParams
+	bck   cmn.Bck
+	msg   apc.LsoMsg // (private copy)
+	err   error
+	page  cmn.LsoEntries
+	entry *cmn.LsoEntry
+	idx   int
+	done  bool // listed all pages
+}
+
+// NewObjectIterator does not issue any requests - the first page is fetched by the first `Next()`.
+// The caller's `msg` (if any) is not modified.
+func NewObjectIterator(bp BaseParams, bck cmn.Bck, msg *apc.LsoMsg) *ObjectIterator {
+	it := &ObjectIterator{bp: bp, bck: bck}
+	if msg != nil {
+		it.msg = *msg
+	}
+	return it
+}
+
+// Next advances to the next object and returns false when there are no more objects
+// or upon failure (see `Err`).
+func (it *ObjectIterator) Next() bool {
+	for it.idx >= len(it.page) {
+		if it.done || it.err != nil {
+			it.entry = nil
+			return false
+		}
+		lst, err := ListObjectsPage(it.bp, it.bck, &it.msg) // (updates continuation token)
+		if err != nil {
+			it.err, it.entry = err, nil
+			return false
+		}
+		it.page, it.idx = lst.Entries, 0
+		it.done = lst.ContinuationToken == ""
+	}
+	it.entry = it.page[it.idx]
+	it.idx++
+	return true
+}
+
+// Entry returns the current object (valid after `Next()` returns true).
+func (it *ObjectIterator) Entry() *cmn.LsoEntry { return it.entry }
+
+// Err returns the first error encountered, if any.
+func (it *ObjectIterator) Err() error { return it.err }
@@ -0,0 +1,157 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Multipart upload: large objects can be uploaded in (parallel) parts, whereby
+// each part gets retried independently (see DoWithRetry) - as opposed to a single
+// streaming PUT that must restart from zero upon failure.
+// The sequence is:
+//   1. CreateMultipartUpload => upload ID
+//   2. UploadPart (any number of times, in any order, concurrently) => part's ETag
+//   3. CompleteMultipartUpload (or AbortMultipartUpload)
+// Implementation-wise, the APIs utilize AIS (S3-compatible) /s3 endpoint.
+
+type (
+	UploadPartArgs struct {
+		Reader     cos.ReadOpenCloser // part's content (reopened upon retry)
+		BaseParams BaseParams
+		Bck        cmn.Bck
+		ObjName    string
+		UploadID   string
+		PartNumber int    // [1, s3.MaxPartsPerUpload]
+		Size       uint64 // optional
+	}
+	// completed (uploaded) part
+	MptPart struct {
+		ETag       string
+		PartNumber int
+	}
+)
+
+// CreateMultipartUpload initiates multipart upload and returns its ID.
+func CreateMultipartUpload(bp BaseParams, bck cmn.Bck, objName string) (uploadID string, err error) {
+	var (
+		result s3.InitiateMptUploadResult
+		q      = url.Values{s3.QparamMptUploads: []string{""}}
+	)
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objName)
+		reqParams.Query = q
+	}
+	err = reqParams.doXML(&result)
+	FreeRp(reqParams)
+	return result.UploadID, err
+}
+
+// UploadPart uploads a single part of the multipart upload and returns its ETag
+// (to be used with CompleteMultipartUpload).
+func UploadPart(args *UploadPartArgs) (etag string, err error) {
+	var (
+		resp *http.Response
+		q    = make(url.Values, 2)
+	)
+	q.Set(s3.QparamMptUploadID, args.UploadID)
+	q.Set(s3.QparamMptPartNo, strconv.Itoa(args.PartNumber))
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathS3.Join(args.Bck.Name, args.ObjName)
+		reqArgs.Query = q
+		reqArgs.BodyR = args.Reader
+	}
+	resp, err = DoWithRetry(args.BaseParams.Client, args.put, reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	if err == nil {
+		etag = resp.Header.Get(cos.S3CksumHeader)
+	}
+	return
+}
+
+func (args *UploadPartArgs) getBody() (io.ReadCloser, error) { return args.Reader.Open() }
+
+func (args *UploadPartArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
+	req, err := reqArgs.Req()
+	if err != nil {
+		return nil, newErrCreateHTTPRequest(err)
+	}
+	req = req.WithContext(args.BaseParams.ctx())
+	req.GetBody = args.getBody
+	if args.Size != 0 {
+		req.ContentLength = int64(args.Size)
+	}
+	SetAuxHeaders(req, &args.BaseParams)
+	return req, nil
+}
+
+// CompleteMultipartUpload assembles the object from its uploaded parts
+// (in the order of part numbers) and returns the resulting object's ETag.
+func CompleteMultipartUpload(bp BaseParams, bck cmn.Bck, objName, uploadID string, parts []MptPart) (etag string, err error) {
+	var (
+		result s3.CompleteMptUploadResult
+		body   = &s3.CompleteMptUpload{Parts: make([]*s3.PartInfo, 0, len(parts))}
+	)
+	for _, part := range parts {
+		body.Parts = append(body.Parts, &s3.PartInfo{ETag: part.ETag, PartNumber: int64(part.PartNumber)})
+	}
+	b, err := xml.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objName)
+		reqParams.Query = url.Values{s3.QparamMptUploadID: []string{uploadID}}
+		reqParams.Body = b
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentXML}}
+	}
+	err = reqParams.doXML(&result)
+	FreeRp(reqParams)
+	return result.ETag, err
+}
+
+// AbortMultipartUpload aborts the upload and removes all its uploaded parts.
+func AbortMultipartUpload(bp BaseParams, bck cmn.Bck, objName, uploadID string) error {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objName)
+		reqParams.Query = url.Values{s3.QparamMptUploadID: []string{uploadID}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// decode XML response body (S3 API)
+func (reqParams *ReqParams) doXML(out any) error {
+	body, err := reqParams.doReader()
+	if err != nil {
+		return err
+	}
+	err = xml.NewDecoder(body).Decode(out)
+	cos.DrainReader(body)
+	body.Close()
+	return err
+}
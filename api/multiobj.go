@@ -5,6 +5,7 @@
 package api
 
 import (
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -55,6 +56,110 @@ func DeleteList(bp BaseParams, bck cmn.Bck, filesList []string) (string, error)
 	return dolr(bp, bck, apc.ActDeleteObjects, msg, q)
 }
 
+// DeleteMultiObjs removes a list of objects synchronously, in a single call - the gateway
+// (proxy) fans out the names to the respective targets and returns per-object results.
+// Unlike DeleteList, there's no xaction (job) to wait for; the returned error, if any,
+// applies to the request as a whole (see apc.DeleteMultiResult.Failed for the rest).
+func DeleteMultiObjs(bp BaseParams, bck cmn.Bck, objNames []string) (*apc.DeleteMultiResult, error) {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActDeleteMultiObjs, Value: apc.ListRange{ObjNames: objNames}})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	res := &apc.DeleteMultiResult{}
+	_, err := reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	return res, err
+}
+
+// HeadObjects returns properties (size, checksum, version, atime, location, etc.) of the named
+// objects in a single round trip - the gateway (proxy) fans out the names to the respective targets.
+// The returned entries follow the order of `objNames` (with duplicates removed);
+// an object that is not present in the cluster is returned with no properties and
+// `LsoEntry.CheckExists() == false`.
+// The response is msgpack-encoded.
+func HeadObjects(bp BaseParams, bck cmn.Bck, objNames []string) (*cmn.LsoResult, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActHeadMultiObjs, Value: apc.ListRange{ObjNames: objNames}})
+		reqParams.Header = http.Header{
+			cos.HdrAccept:      []string{cos.ContentMsgPack},
+			cos.HdrContentType: []string{cos.ContentJSON},
+		}
+		reqParams.Query = bck.AddToQuery(nil)
+		reqParams.buf = allocMbuf() // mem-pool msgpack
+	}
+	lst := &cmn.LsoResult{}
+	_, err := reqParams.DoReqAny(lst)
+	freeMbuf(reqParams.buf)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return lst, nil
+}
+
+// GetBatch reads the named objects in a single round trip: the objects arrive as a TAR
+// stream (written into `w`), in the specified order and each under its own name.
+// Returns the number of bytes written.
+// See also: GetBatchArch
+func GetBatch(bp BaseParams, bck cmn.Bck, objNames []string, w io.Writer) (int64, error) {
+	return GetBatchArch(bp, bck, &apc.GetBatchMsg{ObjNames: objNames}, w)
+}
+
+// Same as above, with options to select compressed (.tar.gz) format and to skip
+// missing objects (apc.GetBatchMsg.ContinueOnError).
+// A failure in the middle of streaming is returned as a read (or archive format) error.
+func GetBatchArch(bp BaseParams, bck cmn.Bck, msg *apc.GetBatchMsg, w io.Writer) (n int64, err error) {
+	var wresp *wrappedResp
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActGetBatch, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	wresp, err = reqParams.doWriter(w)
+	FreeRp(reqParams)
+	if err == nil {
+		n = wresp.n
+	}
+	return
+}
+
+// GetSample reads a uniformly random sample of (up to) msg.NumObjs objects with names
+// starting with msg.Prefix (or, if msg.Length is specified, up to so many leading bytes
+// of each) - as a single archive written into `w`, in the same format as GetBatch.
+// The sample is computed cluster-side, without listing the bucket.
+// Returns the number of bytes written.
+func GetSample(bp BaseParams, bck cmn.Bck, msg *apc.SampleMsg, w io.Writer) (n int64, err error) {
+	var wresp *wrappedResp
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSample, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	wresp, err = reqParams.doWriter(w)
+	FreeRp(reqParams)
+	if err == nil {
+		n = wresp.n
+	}
+	return
+}
+
 // DeleteRange sends request to remove a range of objects from a bucket.
 func DeleteRange(bp BaseParams, bck cmn.Bck, rng string) (string, error) {
 	bp.Method = http.MethodDelete
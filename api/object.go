@@ -37,9 +37,10 @@ type (
 		// (in other words, with no writer the object that is being read will be discarded)
 		Writer io.Writer
 
-		// Currently, the Query field can optionally carry 2 (two) distinct values:
+		// Currently, the Query field can optionally carry 3 (three) distinct values:
 		// 1. `apc.QparamETLName`: named ETL to transform the object (i.e., perform "inline transformation")
 		// 2. `apc.QparamOrigURL`: GET from a vanilla http(s) location (`ht://` bucket with the corresponding `OrigURLBck`)
+		// 3. `apc.QparamHedge`: mirrored bucket - read two local copies and serve the first to respond
 		Query url.Values
 
 		// The field is exclusively used to facilitate Range Read.
@@ -48,6 +49,30 @@ type (
 		// For range formatting, see the spec:
 		// * https://www.rfc-editor.org/rfc/rfc7233#section-2.1
 		Header http.Header
+
+		// Optional callback to track the progress of reading the object
+		// (e.g., to render a progress bar); the total is taken from the Content-Length
+		Progress ProgressFunc
+
+		// Parallel (ranged) GET - GetObject only: when NumWorkers > 1 and the Writer implements
+		// `io.WriterAt` (e.g., *os.File), the object is read in ChunkSize ranges by NumWorkers
+		// concurrent requests that go directly to the target that has the object.
+		// ChunkSize defaults to DfltGetChunkSize. Note that, in this case, Progress gets called
+		// concurrently.
+		NumWorkers int
+		ChunkSize  int64
+
+		// GetObjectReader only: return decompressed content of a compressed (gzip, zstd, or lz4)
+		// object - the compression is detected via Content-Encoding header or else the content
+		// itself (magic bytes); non-compressed objects are returned as is
+		Decompress bool
+
+		// Conditional GET: If-Match and If-None-Match preconditions (comma-separated ETags or "*")
+		// evaluated against the object's ETag (see cmn.ObjETag and ObjAttrs.ETag below).
+		// Unsatisfied If-Match fails the request with ErrPreconditionFailed; matching
+		// If-None-Match - with ErrNotModified (which is how one can avoid re-reading unchanged objects).
+		IfMatch     string
+		IfNoneMatch string
 	}
 
 	// `ObjAttrs` represents object attributes and can be further used to retrieve
@@ -86,6 +111,17 @@ type (
 		// - we massively write a new content into a bucket, and/or
 		// - we simply don't care.
 		SkipVC bool
+
+		// Optional callback to track the progress of sending the object;
+		// the total is `Size` (or -1 when the size is not specified)
+		Progress ProgressFunc
+
+		// Conditional PUT (optimistic concurrency) fails with ErrPreconditionFailed when:
+		// - IfMatch: the object does not exist or its ETag is not listed (use the ETag
+		//   returned by a previous GET, HEAD, or PUT);
+		// - IfNoneMatch: the object exists and its ETag is listed; "*" - create-only PUT.
+		IfMatch     string
+		IfNoneMatch string
 	}
 	PromoteArgs struct {
 		BaseParams BaseParams
@@ -109,6 +145,15 @@ type (
 		Object     string
 		Handle     string
 	}
+	PatchArgs struct {
+		Reader     cos.ReadOpenCloser
+		Cksum      *cos.Cksum // checksum of the Reader's content (optional)
+		BaseParams BaseParams
+		Bck        cmn.Bck
+		Object     string
+		Offset     int64
+		Size       int64 // (required)
+	}
 )
 
 // Archive files and directories (see related: cmn.ArchiveBckMsg)
@@ -123,6 +168,13 @@ type PutApndArchArgs struct {
 // GetArgs //
 /////////////
 
+func (args *GetArgs) progress() ProgressFunc {
+	if args == nil {
+		return nil
+	}
+	return args.Progress
+}
+
 func (args *GetArgs) ret() (w io.Writer, q url.Values, hdr http.Header) {
 	w = io.Discard
 	if args == nil {
@@ -132,6 +184,18 @@ func (args *GetArgs) ret() (w io.Writer, q url.Values, hdr http.Header) {
 		w = args.Writer
 	}
 	q, hdr = args.Query, args.Header
+	if args.IfMatch != "" || args.IfNoneMatch != "" {
+		hdr = hdr.Clone()
+		if hdr == nil {
+			hdr = make(http.Header, 2)
+		}
+		if args.IfMatch != "" {
+			hdr.Set(cos.HdrIfMatch, args.IfMatch)
+		}
+		if args.IfNoneMatch != "" {
+			hdr.Set(cos.HdrIfNoneMatch, args.IfNoneMatch)
+		}
+	}
 	return
 }
 
@@ -152,6 +216,9 @@ func (oah *ObjAttrs) Attrs() (out cmn.ObjAttrs) {
 	return
 }
 
+// ETag to use with conditional GET and PUT (GetArgs and PutArgs: IfMatch, IfNoneMatch)
+func (oah *ObjAttrs) ETag() string { return oah.wrespHeader.Get(cos.HdrETag) }
+
 // e.g. usage: range read response
 func (oah *ObjAttrs) RespHeader() http.Header {
 	return oah.wrespHeader
@@ -168,6 +235,9 @@ func GetObject(bp BaseParams, bck cmn.Bck, object string, args *GetArgs) (oah Ob
 		wresp     *wrappedResp
 		w, q, hdr = args.ret()
 	)
+	if wa, ok := args.parallel(); ok {
+		return getParallel(bp, bck, object, args, wa)
+	}
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
 	{
@@ -175,6 +245,7 @@ func GetObject(bp BaseParams, bck cmn.Bck, object string, args *GetArgs) (oah Ob
 		reqParams.Path = apc.URLPathObjects.Join(bck.Name, object)
 		reqParams.Query = bck.AddToQuery(q)
 		reqParams.Header = hdr
+		reqParams.progress = args.progress()
 	}
 	wresp, err = reqParams.doWriter(w)
 	FreeRp(reqParams)
@@ -197,6 +268,7 @@ func GetObjectWithValidation(bp BaseParams, bck cmn.Bck, objName string, args *G
 		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
 		reqParams.Query = bck.AddToQuery(q)
 		reqParams.Header = hdr
+		reqParams.progress = args.progress()
 	}
 
 	var (
@@ -232,8 +304,13 @@ func GetObjectReader(bp BaseParams, bck cmn.Bck, object string, args *GetArgs) (
 		reqParams.Path = apc.URLPathObjects.Join(bck.Name, object)
 		reqParams.Query = q
 		reqParams.Header = hdr
+		reqParams.progress = args.progress()
+	}
+	if args != nil && args.Decompress {
+		r, err = reqParams.doDecoder()
+	} else {
+		r, err = reqParams.doReader()
 	}
-	r, err = reqParams.doReader()
 	FreeRp(reqParams)
 	return
 }
@@ -242,23 +319,37 @@ func GetObjectReader(bp BaseParams, bck cmn.Bck, object string, args *GetArgs) (
 // PutArgs //
 /////////////
 
-func (args *PutArgs) getBody() (io.ReadCloser, error) { return args.Reader.Open() }
+func (args *PutArgs) getBody() (io.ReadCloser, error) {
+	r, err := args.Reader.Open()
+	if err != nil {
+		return nil, err
+	}
+	return newProgressR(r, int64(args.Size), args.Progress), nil
+}
 
 func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	req, err := reqArgs.Req()
 	if err != nil {
 		return nil, newErrCreateHTTPRequest(err)
 	}
+	req = req.WithContext(args.BaseParams.ctx())
+	if args.Progress != nil && req.Body != nil {
+		req.Body = newProgressR(req.Body, int64(args.Size), args.Progress)
+	}
 	// Go http doesn't automatically set this for files, so to handle redirect we do it here.
 	req.GetBody = args.getBody
 	if args.Cksum != nil && args.Cksum.Ty() != cos.ChecksumNone {
 		req.Header.Set(apc.HdrObjCksumType, args.Cksum.Ty())
 		ckVal := args.Cksum.Value()
 		if ckVal == "" {
-			_, ckhash, err := cos.CopyAndChecksum(io.Discard, args.Reader, nil, args.Cksum.Ty())
+			ckhash := cos.NewCksumHash(args.Cksum.Ty())
+			buf, slab := allocBuf()
+			_, err := io.CopyBuffer(ckhash.H, args.Reader, buf)
+			freeBuf(buf, slab)
 			if err != nil {
 				return nil, newErrCreateHTTPRequest(err)
 			}
+			ckhash.Finalize()
 			ckVal = hex.EncodeToString(ckhash.Sum())
 		}
 		req.Header.Set(apc.HdrObjCksumVal, ckVal)
@@ -266,6 +357,12 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	if args.Size != 0 {
 		req.ContentLength = int64(args.Size) // as per https://tools.ietf.org/html/rfc7230#section-3.3.2
 	}
+	if args.IfMatch != "" {
+		req.Header.Set(cos.HdrIfMatch, args.IfMatch)
+	}
+	if args.IfNoneMatch != "" {
+		req.Header.Set(cos.HdrIfNoneMatch, args.IfNoneMatch)
+	}
 	SetAuxHeaders(req, &args.BaseParams)
 	return req, nil
 }
@@ -276,11 +373,30 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 
 func (args *AppendArgs) getBody() (io.ReadCloser, error) { return args.Reader.Open() }
 
+func (args *PatchArgs) getBody() (io.ReadCloser, error) { return args.Reader.Open() }
+
+func (args *PatchArgs) _patch(reqArgs *cmn.HreqArgs) (*http.Request, error) {
+	req, err := reqArgs.Req()
+	if err != nil {
+		return nil, newErrCreateHTTPRequest(err)
+	}
+	req = req.WithContext(args.BaseParams.ctx())
+	req.GetBody = args.getBody
+	req.ContentLength = args.Size
+	if args.Cksum != nil && args.Cksum.Ty() != cos.ChecksumNone {
+		req.Header.Set(apc.HdrObjCksumType, args.Cksum.Ty())
+		req.Header.Set(apc.HdrObjCksumVal, args.Cksum.Val())
+	}
+	SetAuxHeaders(req, &args.BaseParams)
+	return req, nil
+}
+
 func (args *AppendArgs) _append(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	req, err := reqArgs.Req()
 	if err != nil {
 		return nil, newErrCreateHTTPRequest(err)
 	}
+	req = req.WithContext(args.BaseParams.ctx())
 	// The HTTP package doesn't automatically set this for files, so it has to be done manually
 	// If it wasn't set, we would need to deal with the redirect manually.
 	req.GetBody = args.getBody
@@ -336,6 +452,29 @@ func HeadObject(bp BaseParams, bck cmn.Bck, object string, fltPresence int) (*cm
 	return op, nil
 }
 
+// GetObjectProvenance returns object's provenance: target, mountpath, and copies or EC slices
+// that store the object; the time of the last write and the job(s) that were running at
+// that time; checksum lineage; and the object's backend source.
+// NOTE: only the current version is stored in the cluster (see cmn.ObjProvenance)
+func GetObjectProvenance(bp BaseParams, bck cmn.Bck, object string) (*cmn.ObjProvenance, error) {
+	prov := &cmn.ObjProvenance{}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActObjProvenance, Name: object})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	_, err := reqParams.DoReqAny(prov)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return prov, nil
+}
+
 // Given cos.StrKVs (map[string]string) keys and values, sets object's custom properties.
 // By default, adds new or updates existing custom keys.
 // Use `setNewCustomMDFlag` to _replace_ all existing keys with the specified (new) ones.
@@ -366,6 +505,49 @@ func SetObjectCustomProps(bp BaseParams, bck cmn.Bck, object string, custom cos.
 	return err
 }
 
+// SetObjectTags adds new or updates existing object tags (user-defined key/value pairs
+// stored in the object's custom metadata, see cmn.ObjTagPrefix).
+// Tagged objects can be then selected via list-objects (apc.LsoMsg.Tags).
+func SetObjectTags(bp BaseParams, bck cmn.Bck, object string, tags cos.StrKVs) error {
+	if err := cmn.ValidateObjTags(tags); err != nil {
+		return err
+	}
+	return patchObj(bp, bck, object, apc.ActMsg{Action: apc.ActSetObjTags, Value: tags})
+}
+
+// GetObjectTags returns object tags, if any.
+func GetObjectTags(bp BaseParams, bck cmn.Bck, object string) (cos.StrKVs, error) {
+	props, err := HeadObject(bp, bck, object, apc.FltPresent)
+	if err != nil {
+		return nil, err
+	}
+	return cmn.ObjTags(props.GetCustomMD()), nil
+}
+
+// DeleteObjectTags removes the specified tags, or all object tags when none specified.
+func DeleteObjectTags(bp BaseParams, bck cmn.Bck, object string, keys ...string) error {
+	actMsg := apc.ActMsg{Action: apc.ActDelObjTags}
+	if len(keys) > 0 {
+		actMsg.Value = keys
+	}
+	return patchObj(bp, bck, object, actMsg)
+}
+
+func patchObj(bp BaseParams, bck cmn.Bck, object string, actMsg apc.ActMsg) error {
+	bp.Method = http.MethodPatch
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, object)
+		reqParams.Body = cos.MustMarshal(actMsg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // DeleteObject deletes an object specified by bucket/object.
 func DeleteObject(bp BaseParams, bck cmn.Bck, object string) error {
 	bp.Method = http.MethodDelete
@@ -517,8 +699,34 @@ func FlushObject(args FlushArgs) error {
 	return err
 }
 
+// PatchObject writes `args.Size` bytes (read from `args.Reader`) into an existing object
+// at `args.Offset`, where the offset must not exceed the current object size
+// (writing at the very end extends the object). The object's checksum and version are
+// updated accordingly. If specified, `args.Cksum` is used to validate the received bytes
+// before they get written.
+// Supported for ais:// buckets that are not erasure coded.
+func PatchObject(args *PatchArgs) error {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamWriteAt, strconv.FormatInt(args.Offset, 10))
+	q = args.Bck.AddToQuery(q)
+
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathObjects.Join(args.Bck.Name, args.Object)
+		reqArgs.Query = q
+		reqArgs.BodyR = args.Reader
+	}
+	_, err := DoWithRetry(args.BaseParams.Client, args._patch, reqArgs) //nolint:bodyclose // it's closed inside
+	cmn.FreeHra(reqArgs)
+	return err
+}
+
 // RenameObject renames object name from `oldName` to `newName`. Works only
-// across single, specified bucket.
+// across single, specified bucket (ais:// buckets, including erasure-coded).
+// When both names map to the same target the object and its n-way copies, if any,
+// are renamed in place, without copying data; otherwise - copy followed by delete.
 func RenameObject(bp BaseParams, bck cmn.Bck, oldName, newName string) error {
 	bp.Method = http.MethodPost
 	reqParams := AllocRp()
@@ -534,6 +742,88 @@ func RenameObject(bp BaseParams, bck cmn.Bck, oldName, newName string) error {
 	return err
 }
 
+// UndeleteObject restores a deleted object from the trash (ais:// buckets only;
+// requires cluster configuration `space.trash_retention` > 0 at the time of deletion)
+func UndeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActUndeleteObject})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// CopyObjectRemote copies a named object from `srcBck` (in the cluster at `srcBP`) to `dstBck`
+// in another AIS cluster (at `dstBP`) that must be attached to the source cluster
+// (see AttachRemoteAIS). The object is streamed directly from the source cluster's target
+// to the destination cluster, without passing through the client.
+// Notes:
+//   - `dstBck` can be specified in the source cluster's terms, e.g. ais://@remais/abc,
+//     or else it will be resolved via `dstBP` (in which case `dstBP` is the only call made
+//     to the destination cluster);
+//   - the destination object has the same name.
+func CopyObjectRemote(srcBP, dstBP BaseParams, srcBck, dstBck cmn.Bck, name string) error {
+	if dstBck.Ns.UUID == "" {
+		smap, err := GetClusterMap(dstBP)
+		if err != nil {
+			return err
+		}
+		dstBck.Ns.UUID = smap.UUID
+	}
+	if dstBck.Provider == "" {
+		dstBck.Provider = apc.AIS
+	}
+	q := srcBck.AddToQuery(nil)
+	_ = dstBck.AddUnameToQuery(q, apc.QparamBckTo)
+	srcBP.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = srcBP
+		reqParams.Path = apc.URLPathObjects.Join(srcBck.Name, name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActCopyObjRemote})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// PresignObjectURL returns a time-limited URL that allows to GET or PUT (`method`) the named
+// object without AuthN token - e.g., to share with users that do not have AIS accounts.
+// Notes:
+//   - the caller must have the corresponding (GET or PUT) permission;
+//   - max expiry is `cmn.MaxPresignExpiry`;
+//   - the URL is based on `bp.URL` (that is, the endpoint the caller is using).
+func PresignObjectURL(bp BaseParams, bck cmn.Bck, objName string, expiry time.Duration, method string) (string, error) {
+	var (
+		q   string
+		msg = &apc.PresignMsg{Method: method, Expiry: cos.Duration(expiry)}
+	)
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActPresign, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	_, err := reqParams.doReqStr(&q)
+	FreeRp(reqParams)
+	if err != nil {
+		return "", err
+	}
+	u := url.URL{Path: apc.URLPathObjects.Join(bck.Name, objName)}
+	return bp.URL + u.EscapedPath() + "?" + q, nil
+}
+
 // promote files and directories to ais objects
 func Promote(args *PromoteArgs) (xid string, err error) {
 	actMsg := apc.ActMsg{Action: apc.ActPromote, Name: args.SrcFQN}
@@ -582,7 +872,10 @@ func DoWithRetry(client *http.Client, cb NewRequestCB, reqArgs *cmn.HreqArgs) (r
 	// retry
 	for i := 0; i < httpMaxRetries; i++ {
 		var r io.ReadCloser
-		time.Sleep(sleep)
+		if err = sleepCtx(req.Context(), sleep); err != nil {
+			_close(resp, doErr)
+			return
+		}
 		sleep += sleep / 2
 		if r, err = reader.Open(); err != nil {
 			_close(resp, doErr)
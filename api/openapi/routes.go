@@ -0,0 +1,397 @@
+// Package openapi generates OpenAPI 3 description of the AIStore REST API
+// from the apc constants (URL paths, actions, query parameters) and API message types.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/ext/dsort"
+	"github.com/NVIDIA/aistore/ext/etl"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// user-facing REST API (proxy), one route per (path, method)
+
+type (
+	route struct {
+		body    any // request body: JSON-formatted message type or `octet`
+		resp    any // response: message type, `octet`, or `xid`
+		path    string
+		method  string
+		id      string // operationId
+		summary string
+		tag     string
+		what    []string // enumerated values of the apc.QparamWhat query
+		qparams []string // other (optional) query parameters
+		actions []action // apc.ActMsg (request body)
+		bck     bool     // bucket query parameters (provider, namespace)
+	}
+	action struct {
+		value any // ActMsg.Value (nil: none or any)
+		name  string
+	}
+	mark int
+)
+
+const (
+	octet mark = iota + 1 // raw bytes (e.g., object content)
+	xid                   // plain-text job (xaction) ID
+)
+
+// path parameters
+const (
+	pbck  = "{bucket-name}"
+	pobj  = "{object-name}"
+	pnode = "{node-id}"
+	petl  = "{etl-name}"
+)
+
+// tags
+const (
+	tagBucket  = apc.Buckets
+	tagObject  = apc.Objects
+	tagCluster = apc.Cluster
+	tagNode    = apc.Daemon
+	tagJob     = "jobs"
+	tagETL     = apc.ETL
+	tagMisc    = "misc"
+)
+
+var tags = []Tag{
+	{Name: tagBucket, Description: "buckets: create, destroy, list, copy, transform, multi-object operations"},
+	{Name: tagObject, Description: "objects: GET, PUT, HEAD, append, rename, promote"},
+	{Name: tagCluster, Description: "cluster: membership, configuration, maintenance, and xactions"},
+	{Name: tagNode, Description: "individual nodes (via proxy)"},
+	{Name: tagJob, Description: "downloader and distributed shuffle (dsort)"},
+	{Name: tagETL, Description: "ETL: init, inspect, start, stop"},
+	{Name: tagMisc, Description: "health check and this spec"},
+}
+
+var routes = []route{
+	//
+	// buckets
+	//
+	{
+		path: apc.URLPathBuckets.S, method: http.MethodGet, tag: tagBucket, bck: true,
+		id: "listBuckets", summary: "list buckets (all or those matching provider and namespace); optionally, filtered and with usage",
+		qparams: []string{apc.QparamFltPresence},
+		actions: []action{{name: apc.ActList}, {name: apc.ActListBckInfo, value: apc.LsbMsg{}}},
+		resp:    cmn.Bcks{},
+	},
+	{
+		path: apc.URLPathBuckets.Join(pbck), method: http.MethodGet, tag: tagBucket, bck: true,
+		id: "listObjects", summary: "list objects, summarize bucket, or get object provenance",
+		actions: []action{
+			{name: apc.ActList, value: apc.LsoMsg{}},
+			{name: apc.ActSummaryBck, value: apc.BsummCtrlMsg{}},
+			{name: apc.ActObjProvenance},
+		},
+		resp: cmn.LsoResult{},
+	},
+	{
+		path: apc.URLPathBuckets.Join(pbck), method: http.MethodHead, tag: tagBucket, bck: true,
+		id: "headBucket", summary: "bucket properties (in response headers)",
+		qparams: []string{apc.QparamFltPresence, apc.QparamDontAddRemote, apc.QparamCountRemoteObjs},
+	},
+	{
+		path: apc.URLPathBuckets.Join(pbck), method: http.MethodPost, tag: tagBucket, bck: true,
+		id: "postBucket", summary: "create, copy, transform, rename bucket; multi-object copy, transform, and prefetch",
+		qparams: []string{apc.QparamBckTo, apc.QparamFltPresence, apc.QparamDontHeadRemote},
+		actions: []action{
+			{name: apc.ActCreateBck, value: cmn.BucketPropsToUpdate{}},
+			{name: apc.ActCopyBck, value: apc.TCBMsg{}},
+			{name: apc.ActETLBck, value: apc.TCBMsg{}},
+			{name: apc.ActMoveBck},
+			{name: apc.ActMakeNCopies, value: 0},
+			{name: apc.ActTransitionBck, value: ""},
+			{name: apc.ActECEncode, value: cmn.ECConfToUpdate{}},
+			{name: apc.ActTrainDict, value: apc.TrainDictMsg{}},
+			{name: apc.ActInvalListCache},
+			{name: apc.ActCopyObjects, value: cmn.TCObjsMsg{}},
+			{name: apc.ActETLObjects, value: cmn.TCObjsMsg{}},
+			{name: apc.ActPrefetchObjects, value: apc.ListRange{}},
+		},
+		resp: xid,
+	},
+	{
+		path: apc.URLPathBuckets.Join(pbck), method: http.MethodPut, tag: tagBucket, bck: true,
+		id: "archiveObjects", summary: "archive multiple objects",
+		actions: []action{{name: apc.ActArchive, value: cmn.ArchiveBckMsg{}}},
+		resp:    xid,
+	},
+	{
+		path: apc.URLPathBuckets.Join(pbck), method: http.MethodPatch, tag: tagBucket, bck: true,
+		id: "patchBucket", summary: "update or reset bucket properties",
+		actions: []action{
+			{name: apc.ActSetBprops, value: cmn.BucketPropsToUpdate{}},
+			{name: apc.ActResetBprops},
+		},
+		resp: xid,
+	},
+	{
+		path: apc.URLPathBuckets.Join(pbck), method: http.MethodDelete, tag: tagBucket, bck: true,
+		id: "deleteBucket", summary: "destroy or evict bucket; delete or evict multiple objects",
+		qparams: []string{apc.QparamKeepRemote},
+		actions: []action{
+			{name: apc.ActDestroyBck},
+			{name: apc.ActEvictRemoteBck},
+			{name: apc.ActDeleteObjects, value: apc.ListRange{}},
+			{name: apc.ActEvictObjects, value: apc.ListRange{}},
+		},
+		resp: xid,
+	},
+
+	//
+	// objects
+	//
+	{
+		path: apc.URLPathObjects.Join(pbck, pobj), method: http.MethodGet, tag: tagObject, bck: true,
+		id: "getObject", summary: "read object, archived file, or transformed (ETL) content",
+		qparams: []string{apc.QparamArchpath, apc.QparamArchmime, apc.QparamETLName, apc.QparamOrigURL, apc.QparamHedge},
+		resp:    octet,
+	},
+	{
+		path: apc.URLPathObjects.Join(pbck, pobj), method: http.MethodHead, tag: tagObject, bck: true,
+		id: "headObject", summary: "object properties (in response headers)",
+		qparams: []string{apc.QparamFltPresence, apc.QparamSilent},
+	},
+	{
+		path: apc.URLPathObjects.Join(pbck, pobj), method: http.MethodPut, tag: tagObject, bck: true,
+		id: "putObject", summary: "write object, append to object or archive, or write at offset",
+		qparams: []string{apc.QparamAppendType, apc.QparamAppendHandle, apc.QparamWriteAt,
+			apc.QparamArchpath, apc.QparamArchmime, apc.QparamSkipVC},
+		body: octet,
+	},
+	{
+		path: apc.URLPathObjects.Join(pbck, pobj), method: http.MethodPost, tag: tagObject, bck: true,
+		id: "postObject", summary: "rename, copy (to remote), presign, or promote",
+		qparams: []string{apc.QparamBckTo},
+		actions: []action{
+			{name: apc.ActRenameObject},
+			{name: apc.ActCopyObjRemote},
+			{name: apc.ActPresign, value: apc.PresignMsg{}},
+			{name: apc.ActPromote, value: cluster.PromoteArgs{}},
+		},
+	},
+	{
+		path: apc.URLPathObjects.Join(pbck, pobj), method: http.MethodPatch, tag: tagObject, bck: true,
+		id: "patchObject", summary: "update custom metadata (empty action, ActMsg.Value: name-value pairs) or tags",
+		qparams: []string{apc.QparamNewCustom},
+		actions: []action{
+			{name: apc.ActSetObjTags, value: cos.StrKVs{}},
+			{name: apc.ActDelObjTags, value: []string{}},
+		},
+	},
+	{
+		path: apc.URLPathObjects.Join(pbck, pobj), method: http.MethodDelete, tag: tagObject, bck: true,
+		id: "deleteObject", summary: "delete or evict object (ActMsg is optional)",
+		actions: []action{{name: apc.ActEvictObjects}},
+	},
+
+	//
+	// cluster
+	//
+	{
+		path: apc.URLPathClu.S, method: http.MethodGet, tag: tagCluster,
+		id: "getCluster", summary: "cluster map, BMD, config, stats, xactions, and more",
+		what: []string{apc.WhatSmap, apc.WhatBMD, apc.WhatClusterConfig, apc.WhatNodeStatsAndStatus,
+			apc.WhatMountpaths, apc.WhatRemoteAIS, apc.WhatSysInfo, apc.WhatTargetIPs, apc.WhatClientStats, apc.WhatAuthAlerts,
+			apc.WhatStreams, apc.WhatOneXactStatus, apc.WhatAllXactStatus, apc.WhatQueryXactStats,
+			apc.WhatAllRunningXacts, apc.WhatXactHistory, apc.WhatJoinImpact, apc.WhatRebVerify},
+		qparams: []string{apc.QparamProps, apc.QparamDryRun, apc.QparamUUID},
+		body:    xact.QueryMsg{},
+	},
+	{
+		path: apc.URLPathClu.S, method: http.MethodPut, tag: tagCluster,
+		id: "putCluster", summary: "cluster-wide actions: start/stop xactions, configure, maintain, shut down",
+		qparams: []string{apc.QparamForce},
+		actions: []action{
+			{name: apc.ActXactStart, value: xact.ArgsMsg{}},
+			{name: apc.ActXactStop, value: xact.ArgsMsg{}},
+			{name: apc.ActXactLimits, value: apc.XactLimits{}},
+			{name: apc.ActSetConfig, value: cmn.ConfigToUpdate{}},
+			{name: apc.ActResetConfig},
+			{name: apc.ActResetStats, value: false},
+			{name: apc.ActStartMaintenance, value: apc.ActValRmNode{}},
+			{name: apc.ActStopMaintenance, value: apc.ActValRmNode{}},
+			{name: apc.ActDecommissionNode, value: apc.ActValRmNode{}},
+			{name: apc.ActShutdownNode, value: apc.ActValRmNode{}},
+			{name: apc.ActShutdownCluster},
+			{name: apc.ActDecommissionCluster, value: apc.ActValRmNode{}},
+		},
+	},
+	{
+		path: apc.URLPathCluUserReg.S, method: http.MethodPost, tag: tagCluster,
+		id: "joinNode", summary: "join node to the cluster (\"manual join\")",
+		qparams: []string{apc.QparamDryRun},
+		body:    meta.Snode{},
+		resp:    apc.JoinNodeResult{},
+	},
+	{
+		path: apc.URLPathCluProxy.Join(pnode), method: http.MethodPut, tag: tagCluster,
+		id: "setPrimary", summary: "designate new primary proxy",
+		qparams: []string{apc.QparamForce},
+	},
+	{
+		path: apc.URLPathCluSetConf.S, method: http.MethodPut, tag: tagCluster,
+		id: "setClusterConfig", summary: "update cluster config via query (name=value) parameters",
+		qparams: []string{apc.ActTransient},
+	},
+	{
+		path: apc.URLPathCluAttach.S, method: http.MethodPut, tag: tagCluster,
+		id: "attachRemoteAIS", summary: "attach remote AIS cluster (alias=URL query parameters)",
+	},
+	{
+		path: apc.URLPathCluDetach.S, method: http.MethodPut, tag: tagCluster,
+		id: "detachRemoteAIS", summary: "detach remote AIS cluster",
+	},
+
+	//
+	// node (redirected by proxy via apc.HdrNodeID)
+	//
+	{
+		path: apc.URLPathReverseDae.S, method: http.MethodGet, tag: tagNode,
+		id: "getNode", summary: "node config, stats, status, logs, and more",
+		what: []string{apc.WhatNodeConfig, apc.WhatNodeStats, apc.WhatNodeStatsAndStatus, apc.WhatMetricNames,
+			apc.WhatDiskStats, apc.WhatMountpaths, apc.WhatSmap, apc.WhatBMD, apc.WhatSnode, apc.WhatSysInfo,
+			apc.WhatLog, apc.WhatStreams},
+		qparams: []string{apc.QparamLogSev, apc.QparamLogOff, apc.QparamAllLogs},
+	},
+	{
+		path: apc.URLPathReverseDae.S, method: http.MethodPut, tag: tagNode,
+		id: "putNode", summary: "node actions: configure, reset stats, shut down",
+		actions: []action{
+			{name: apc.ActSetConfig, value: cmn.ConfigToUpdate{}},
+			{name: apc.ActResetConfig},
+			{name: apc.ActResetStats, value: false},
+			{name: apc.ActShutdownNode},
+		},
+	},
+	{
+		path: apc.URLPathReverseDae.Join(apc.Mountpaths), method: http.MethodPut, tag: tagNode,
+		id: "mountpaths", summary: "attach, detach, enable, disable, or set read-only target mountpath",
+		qparams: []string{apc.QparamForce, apc.QparamDontResilver},
+		actions: []action{
+			{name: apc.ActMountpathAttach, value: ""},
+			{name: apc.ActMountpathDetach, value: ""},
+			{name: apc.ActMountpathEnable, value: ""},
+			{name: apc.ActMountpathDisable, value: ""},
+			{name: apc.ActMountpathRO, value: ""},
+			{name: apc.ActMountpathRW, value: ""},
+		},
+	},
+	{
+		path: apc.URLPathReverseDae.Join(apc.ActSetConfig), method: http.MethodPut, tag: tagNode,
+		id: "setNodeConfig", summary: "update node config via query (name=value) parameters",
+		qparams: []string{apc.ActTransient},
+	},
+
+	//
+	// jobs
+	//
+	{
+		path: apc.URLPathDownload.S, method: http.MethodPost, tag: tagJob,
+		id: "startDownload", summary: "start download job (see ext/dload for the body variants)",
+		body: map[string]any{},
+		resp: dload.DlPostResp{},
+	},
+	{
+		path: apc.URLPathDownload.S, method: http.MethodGet, tag: tagJob,
+		id: "getDownload", summary: "download job status (by ID) or list of jobs",
+		qparams: []string{apc.QparamUUID, apc.QparamRegex, apc.QparamOnlyActive},
+		resp:    dload.StatusResp{},
+	},
+	{
+		path: apc.URLPathDownloadAbort.S, method: http.MethodDelete, tag: tagJob,
+		id: "abortDownload", qparams: []string{apc.QparamUUID},
+	},
+	{
+		path: apc.URLPathDownloadRemove.S, method: http.MethodDelete, tag: tagJob,
+		id: "removeDownload", qparams: []string{apc.QparamUUID},
+	},
+	{
+		path: apc.URLPathdSort.S, method: http.MethodPost, tag: tagJob,
+		id: "startDsort", summary: "start distributed shuffle (dsort)",
+		body: dsort.RequestSpec{},
+		resp: xid,
+	},
+	{
+		path: apc.URLPathdSort.S, method: http.MethodGet, tag: tagJob,
+		id: "getDsort", summary: "dsort metrics (by ID) or list of jobs",
+		qparams: []string{apc.QparamUUID, apc.QparamRegex, apc.QparamOnlyActive},
+		resp:    []*dsort.JobInfo{},
+	},
+	{
+		path: apc.URLPathdSort.S, method: http.MethodDelete, tag: tagJob,
+		id: "removeDsort", qparams: []string{apc.QparamUUID},
+	},
+	{
+		path: apc.URLPathdSortAbort.S, method: http.MethodDelete, tag: tagJob,
+		id: "abortDsort", qparams: []string{apc.QparamUUID},
+	},
+
+	//
+	// ETL
+	//
+	{
+		path: apc.URLPathETL.S, method: http.MethodPut, tag: tagETL,
+		id: "initETL", summary: "initialize ETL from spec or code (see ext/etl InitSpecMsg, InitCodeMsg)",
+		body: map[string]any{},
+		resp: xid,
+	},
+	{
+		path: apc.URLPathETL.S, method: http.MethodGet, tag: tagETL,
+		id: "listETL", resp: []etl.Info{},
+	},
+	{
+		path: apc.URLPathETL.Join(petl), method: http.MethodGet, tag: tagETL,
+		id: "getETL", summary: "ETL init message",
+	},
+	{
+		path: apc.URLPathETL.Join(petl), method: http.MethodDelete, tag: tagETL,
+		id: "deleteETL",
+	},
+	{
+		path: apc.URLPathETL.Join(petl, apc.ETLLogs), method: http.MethodGet, tag: tagETL,
+		id: "logsETL", resp: etl.LogsByTarget{},
+	},
+	{
+		path: apc.URLPathETL.Join(petl, apc.ETLHealth), method: http.MethodGet, tag: tagETL,
+		id: "healthETL", resp: etl.HealthByTarget{},
+	},
+	{
+		path: apc.URLPathETL.Join(petl, apc.ETLMetrics), method: http.MethodGet, tag: tagETL,
+		id: "metricsETL", resp: etl.CPUMemByTarget{},
+	},
+	{
+		path: apc.URLPathETL.Join(petl, apc.ETLStart), method: http.MethodPost, tag: tagETL,
+		id: "startETL",
+	},
+	{
+		path: apc.URLPathETL.Join(petl, apc.ETLStop), method: http.MethodPost, tag: tagETL,
+		id: "stopETL",
+	},
+
+	//
+	// misc
+	//
+	{
+		path: apc.URLPathHealth.S, method: http.MethodGet, tag: tagMisc,
+		id: "health", summary: "node health and readiness",
+		qparams: []string{apc.QparamHealthReadiness, apc.QparamAskPrimary, apc.QparamPrimaryReadyReb, apc.QparamClusterInfo},
+	},
+	{
+		path: apc.URLPathOpenAPI.S, method: http.MethodGet, tag: tagMisc,
+		id: "openapi", summary: "this document",
+		resp: map[string]any{},
+	},
+}
@@ -0,0 +1,142 @@
+// Package openapi generates OpenAPI 3 description of the AIStore REST API
+// from the apc constants (URL paths, actions, query parameters) and API message types.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// JSON schemas of the API message types, via reflection and `json` tags:
+// - named structs become components (#/components/schemas/<package>.<Type>);
+// - embedded structs are flattened, same as encoding/json does;
+// - types with custom JSON marshaling (e.g., cos.Duration) are strings.
+
+const refPrefix = "#/components/schemas/"
+
+type reflector struct {
+	schemas map[string]*Schema
+}
+
+var (
+	typTime      = reflect.TypeOf(time.Time{})
+	typDuration  = reflect.TypeOf(time.Duration(0))
+	typMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	typText      = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+	reInvalidName = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+)
+
+func newReflector() *reflector { return &reflector{schemas: make(map[string]*Schema, 64)} }
+
+// nil value => any
+func (rf *reflector) schemaOf(v any) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+	return rf.schema(reflect.TypeOf(v))
+}
+
+func (rf *reflector) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == typTime:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == typDuration:
+		return &Schema{Type: "integer", Format: "int64", Description: "nanoseconds"}
+	case t.Name() == "RawMessage":
+		return &Schema{} // (json.RawMessage, jsoniter.RawMessage)
+	case t.Implements(typMarshaler), reflect.PointerTo(t).Implements(typMarshaler),
+		t.Implements(typText), reflect.PointerTo(t).Implements(typText):
+		return &Schema{Type: "string"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: rf.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: rf.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return rf.object(t)
+		}
+		name := typeName(t)
+		if _, ok := rf.schemas[name]; !ok {
+			rf.schemas[name] = &Schema{} // placeholder (recursive types)
+			rf.schemas[name] = rf.object(t)
+		}
+		return &Schema{Ref: refPrefix + name}
+	default: // interface{}, et al.
+		return &Schema{}
+	}
+}
+
+func (rf *reflector) object(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema, t.NumField())}
+	rf.fields(t, s.Properties)
+	return s
+}
+
+func (rf *reflector) fields(t reflect.Type, props map[string]*Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		var (
+			f         = t.Field(i)
+			tag, ok   = f.Tag.Lookup("json")
+			name, opt = tag, ""
+		)
+		if ok {
+			name, opt, _ = strings.Cut(tag, ",")
+		}
+		if name == "-" && opt == "" {
+			continue
+		}
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			rf.fields(ft, props) // flatten
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if strings.Contains(opt, "string") {
+			props[name] = &Schema{Type: "string"}
+		} else {
+			props[name] = rf.schema(f.Type)
+		}
+	}
+}
+
+// e.g. "cmn.BucketProps"
+func typeName(t reflect.Type) string {
+	return reInvalidName.ReplaceAllString(path.Base(t.PkgPath())+"."+t.Name(), "_")
+}
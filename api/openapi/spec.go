@@ -0,0 +1,238 @@
+// Package openapi generates OpenAPI 3 description of the AIStore REST API
+// from the apc constants (URL paths, actions, query parameters) and API message types.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package openapi
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// The resulting document (served via GET /v1/openapi) is intended for generating typed
+// clients in other languages and for contract-testing the Go handlers. Notes:
+// - with a few exceptions, control operations carry apc.ActMsg in the request body;
+//   for each operation, "x-ais-actions" lists the supported actions and the respective
+//   schemas of the action-specific ActMsg.Value;
+// - object names may contain slashes: {object-name} is the remainder of the URL path;
+// - intra-cluster APIs and S3 compatibility are not included.
+
+const Version = "3.0.3" // OpenAPI specification
+
+type (
+	Document struct {
+		Components Components           `json:"components"`
+		Paths      map[string]*PathItem `json:"paths"`
+		OpenAPI    string               `json:"openapi"`
+		Info       Info                 `json:"info"`
+		Tags       []Tag                `json:"tags,omitempty"`
+	}
+	Info struct {
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+		Version     string `json:"version"`
+	}
+	Tag struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	}
+
+	// HTTP method (lowercase) => operation
+	PathItem map[string]*Operation
+
+	Operation struct {
+		RequestBody *RequestBody         `json:"requestBody,omitempty"`
+		Responses   map[string]*Response `json:"responses"`
+		Actions     map[string]*Schema   `json:"x-ais-actions,omitempty"` // ActMsg.Action => ActMsg.Value
+		OperationID string               `json:"operationId"`
+		Summary     string               `json:"summary,omitempty"`
+		Tags        []string             `json:"tags,omitempty"`
+		Parameters  []*Parameter         `json:"parameters,omitempty"`
+	}
+	Parameter struct {
+		Schema      *Schema `json:"schema"`
+		Name        string  `json:"name"`
+		In          string  `json:"in"` // "path" | "query"
+		Description string  `json:"description,omitempty"`
+		Required    bool    `json:"required,omitempty"`
+	}
+	RequestBody struct {
+		Content  map[string]*MediaType `json:"content"`
+		Required bool                  `json:"required,omitempty"`
+	}
+	Response struct {
+		Content     map[string]*MediaType `json:"content,omitempty"`
+		Description string                `json:"description"`
+	}
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+	Schema struct {
+		Items                *Schema            `json:"items,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+		Properties           map[string]*Schema `json:"properties,omitempty"`
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Description          string             `json:"description,omitempty"`
+		Enum                 []string           `json:"enum,omitempty"`
+	}
+)
+
+var (
+	doc     *Document
+	docJSON []byte
+	once    sync.Once
+)
+
+// Spec returns the (lazily generated and cached) document
+func Spec() *Document {
+	once.Do(func() {
+		doc = New()
+		docJSON = cos.MustMarshal(doc)
+	})
+	return doc
+}
+
+// JSON returns the same document, marshaled
+func JSON() []byte {
+	Spec()
+	return docJSON
+}
+
+// New generates the document
+func New() *Document {
+	var (
+		rf = newReflector()
+		d  = &Document{
+			OpenAPI: Version,
+			Info: Info{
+				Title:       "AIStore REST API",
+				Description: "Generated from api/apc constants and message types (see api/openapi)",
+				Version:     apiVersion(),
+			},
+			Paths: make(map[string]*PathItem, len(routes)),
+			Tags:  tags,
+		}
+	)
+	for i := range routes {
+		r := &routes[i]
+		item, ok := d.Paths[r.path]
+		if !ok {
+			item = &PathItem{}
+			d.Paths[r.path] = item
+		}
+		(*item)[strings.ToLower(r.method)] = r.operation(rf)
+	}
+	d.Components.Schemas = rf.schemas
+	return d
+}
+
+func apiVersion() string {
+	return apc.Version + "." + strconv.Itoa(apc.APIVersion) // e.g. "v1.2"
+}
+
+//
+// operation
+//
+
+const (
+	inPath  = "path"
+	inQuery = "query"
+
+	jsonContent  = cos.ContentJSON
+	octetContent = cos.ContentBinary
+	textContent  = "text/plain"
+)
+
+func (r *route) operation(rf *reflector) *Operation {
+	op := &Operation{
+		OperationID: r.id,
+		Summary:     r.summary,
+		Tags:        []string{r.tag},
+		Responses:   make(map[string]*Response, 2),
+	}
+	// parameters
+	for _, name := range pathParams(r.path) {
+		op.Parameters = append(op.Parameters, &Parameter{Name: name, In: inPath, Required: true, Schema: &Schema{Type: "string"}})
+	}
+	if r.bck {
+		op.Parameters = append(op.Parameters,
+			&Parameter{Name: apc.QparamProvider, In: inQuery, Schema: &Schema{Type: "string", Enum: providers()},
+				Description: "bucket provider (default: ais)"},
+			&Parameter{Name: apc.QparamNamespace, In: inQuery, Schema: &Schema{Type: "string"},
+				Description: "bucket namespace, e.g. @uuid#name"},
+		)
+	}
+	if len(r.what) > 0 {
+		op.Parameters = append(op.Parameters,
+			&Parameter{Name: apc.QparamWhat, In: inQuery, Required: true, Schema: &Schema{Type: "string", Enum: r.what}})
+	}
+	for _, name := range r.qparams {
+		op.Parameters = append(op.Parameters, &Parameter{Name: name, In: inQuery, Schema: &Schema{Type: "string"}})
+	}
+
+	// request
+	switch {
+	case len(r.actions) > 0:
+		op.Actions = make(map[string]*Schema, len(r.actions))
+		for _, a := range r.actions {
+			op.Actions[a.name] = rf.schemaOf(a.value)
+		}
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{jsonContent: {Schema: rf.schemaOf(apc.ActMsg{})}},
+		}
+	case r.body == octet:
+		op.RequestBody = &RequestBody{Content: map[string]*MediaType{octetContent: {Schema: &Schema{Type: "string", Format: "binary"}}}}
+	case r.body != nil:
+		op.RequestBody = &RequestBody{Required: true, Content: map[string]*MediaType{jsonContent: {Schema: rf.schemaOf(r.body)}}}
+	}
+
+	// response
+	ok := &Response{Description: "OK"}
+	switch {
+	case r.resp == octet:
+		ok.Content = map[string]*MediaType{octetContent: {Schema: &Schema{Type: "string", Format: "binary"}}}
+	case r.resp == xid:
+		ok.Description = "job (xaction) ID"
+		ok.Content = map[string]*MediaType{textContent: {Schema: &Schema{Type: "string"}}}
+	case r.resp != nil:
+		ok.Content = map[string]*MediaType{jsonContent: {Schema: rf.schemaOf(r.resp)}}
+	}
+	op.Responses["200"] = ok
+	op.Responses["default"] = &Response{
+		Description: "error",
+		Content:     map[string]*MediaType{jsonContent: {Schema: rf.schemaOf(cmn.ErrHTTP{})}},
+	}
+	return op
+}
+
+// e.g. "/v1/objects/{bucket-name}/{object-name}" => ["bucket-name", "object-name"]
+func pathParams(path string) (names []string) {
+	for {
+		i := strings.IndexByte(path, '{')
+		if i < 0 {
+			return
+		}
+		j := strings.IndexByte(path[i:], '}')
+		names = append(names, path[i+1:i+j])
+		path = path[i+j:]
+	}
+}
+
+func providers() []string {
+	ps := apc.Providers.ToSlice()
+	sort.Strings(ps)
+	return ps
+}
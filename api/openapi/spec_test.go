@@ -0,0 +1,92 @@
+// Package openapi generates OpenAPI 3 description of the AIStore REST API
+// from the apc constants (URL paths, actions, query parameters) and API message types.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package openapi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/openapi"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// user-facing proxy handlers (see ais/proxy.go)
+var handlers = cos.NewStrSet(apc.Buckets, apc.Objects, apc.Cluster, apc.Reverse, apc.Health,
+	apc.Download, apc.Sort, apc.ETL, apc.OpenAPI)
+
+func TestSpec(t *testing.T) {
+	var (
+		doc = openapi.Spec()
+		ids = cos.NewStrSet()
+	)
+	tassert.Fatalf(t, len(openapi.JSON()) > 0, "empty spec")
+	tassert.Errorf(t, doc.OpenAPI == openapi.Version, "openapi version %q", doc.OpenAPI)
+	tassert.Fatalf(t, len(doc.Paths) > 0, "no paths")
+
+	for path, item := range doc.Paths {
+		items := strings.Split(strings.TrimPrefix(path, "/"), "/")
+		tassert.Fatalf(t, len(items) >= 2, "invalid path %q", path)
+		tassert.Errorf(t, items[0] == apc.Version && handlers.Contains(items[1]), "%q: no such handler", path)
+
+		for method, op := range *item {
+			tassert.Errorf(t, method == strings.ToLower(method), "%s %q: method must be lowercase", method, path)
+			tassert.Errorf(t, !ids.Contains(op.OperationID), "duplicate operationId %q", op.OperationID)
+			ids.Add(op.OperationID)
+			tassert.Errorf(t, op.Responses["200"] != nil, "%s %q: no response", method, path)
+
+			// path parameters
+			var n int
+			for _, p := range op.Parameters {
+				if p.In == "path" {
+					n++
+					tassert.Errorf(t, strings.Contains(path, "{"+p.Name+"}"), "%s %q: unexpected %q", method, path, p.Name)
+				}
+			}
+			tassert.Errorf(t, n == strings.Count(path, "{"), "%s %q: path parameters mismatch", method, path)
+
+			// references
+			for _, a := range op.Actions {
+				checkRefs(t, doc, a)
+			}
+			if op.RequestBody != nil {
+				for _, mt := range op.RequestBody.Content {
+					checkRefs(t, doc, mt.Schema)
+				}
+			}
+			for _, resp := range op.Responses {
+				for _, mt := range resp.Content {
+					checkRefs(t, doc, mt.Schema)
+				}
+			}
+		}
+	}
+	for _, s := range doc.Components.Schemas {
+		checkRefs(t, doc, s)
+	}
+
+	// spot-check
+	lso, ok := doc.Components.Schemas["apc.LsoMsg"]
+	tassert.Fatalf(t, ok, "apc.LsoMsg not found")
+	tassert.Errorf(t, lso.Properties["prefix"] != nil && lso.Properties["prefix"].Type == "string", "apc.LsoMsg: prefix")
+}
+
+func checkRefs(t *testing.T, doc *openapi.Document, s *openapi.Schema) {
+	if s == nil {
+		return
+	}
+	if s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/components/schemas/")
+		_, ok := doc.Components.Schemas[name]
+		tassert.Errorf(t, ok, "unresolved %q", s.Ref)
+	}
+	checkRefs(t, doc, s.Items)
+	checkRefs(t, doc, s.AdditionalProperties)
+	for _, p := range s.Properties {
+		checkRefs(t, doc, p)
+	}
+}
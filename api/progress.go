@@ -4,7 +4,10 @@
  */
 package api
 
-import "time"
+import (
+	"io"
+	"time"
+)
 
 // "progress bar" control structures and context
 type (
@@ -59,3 +62,41 @@ func (ctx *ProgressContext) mustFire() bool {
 func (ctx *ProgressContext) Info() ProgressInfo {
 	return ctx.info
 }
+
+//
+// byte-level progress of a single object transfer (see GetArgs.Progress and PutArgs.Progress)
+//
+
+type (
+	// invoked as bytes flow through the response (GET) or request (PUT) body;
+	// negative `total` indicates that the size is unknown
+	ProgressFunc func(transferred, total int64)
+
+	progressR struct {
+		r     io.ReadCloser
+		cb    ProgressFunc
+		n     int64
+		total int64
+	}
+)
+
+func newProgressR(r io.ReadCloser, total int64, cb ProgressFunc) io.ReadCloser {
+	if cb == nil {
+		return r
+	}
+	if total <= 0 {
+		total = -1
+	}
+	return &progressR{r: r, cb: cb, total: total}
+}
+
+func (pr *progressR) Read(b []byte) (n int, err error) {
+	n, err = pr.r.Read(b)
+	if n > 0 {
+		pr.n += int64(n)
+		pr.cb(pr.n, pr.total)
+	}
+	return
+}
+
+func (pr *progressR) Close() error { return pr.r.Close() }
@@ -0,0 +1,132 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Client-side rate limiting: a batch job running thousands of goroutines can throttle
+// itself (instead of overwhelming a small cluster) by sharing a single RateLimiter
+// via BaseParams.RateLim.
+//
+// Requests per second are accounted (and waited for) prior to sending each request;
+// bytes per second - for request bodies (ReqParams.Body) and, as they are being read,
+// for response bodies. Both limits are token buckets that allow bursts of up to
+// one second worth of tokens; a single request larger than that is allowed to go
+// through (and the debt is then paid off by subsequent requests).
+//
+// Usage:
+//
+//	bp := api.BaseParams{Client: client, URL: endpoint, RateLim: api.NewRateLimiter(500, 200*cos.MiB)}
+
+type (
+	RateLimiter struct {
+		reqs  tokenBucket
+		bytes tokenBucket
+	}
+	tokenBucket struct {
+		last   time.Time
+		rate   float64 // tokens per second; zero: unlimited
+		tokens float64 // negative when in debt
+		mu     sync.Mutex
+	}
+	rlReader struct {
+		ctx context.Context
+		r   io.ReadCloser
+		rl  *RateLimiter
+	}
+)
+
+// zero means unlimited
+func NewRateLimiter(reqsPerSec, bytesPerSec int64) *RateLimiter {
+	rl := &RateLimiter{}
+	rl.Set(reqsPerSec, bytesPerSec)
+	return rl
+}
+
+// Set changes the limits at runtime (zero: unlimited)
+func (rl *RateLimiter) Set(reqsPerSec, bytesPerSec int64) {
+	rl.reqs.set(float64(reqsPerSec))
+	rl.bytes.set(float64(bytesPerSec))
+}
+
+// waitReq accounts for a single request with `size` bytes of payload
+func (rl *RateLimiter) waitReq(ctx context.Context, size int64) error {
+	if err := rl.reqs.wait(ctx, 1); err != nil {
+		return err
+	}
+	if size > 0 {
+		return rl.bytes.wait(ctx, float64(size))
+	}
+	return nil
+}
+
+func (rl *RateLimiter) reader(ctx context.Context, r io.ReadCloser) io.ReadCloser {
+	if rl.bytes.unlimited() {
+		return r
+	}
+	return &rlReader{ctx: ctx, r: r, rl: rl}
+}
+
+/////////////////
+// tokenBucket //
+/////////////////
+
+func (tb *tokenBucket) set(rate float64) {
+	tb.mu.Lock()
+	tb.rate, tb.tokens, tb.last = rate, rate, time.Now()
+	tb.mu.Unlock()
+}
+
+func (tb *tokenBucket) unlimited() bool {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	return tb.rate == 0
+}
+
+func (tb *tokenBucket) wait(ctx context.Context, n float64) error {
+	tb.mu.Lock()
+	if tb.rate == 0 {
+		tb.mu.Unlock()
+		return nil
+	}
+	now := time.Now()
+	tb.tokens += now.Sub(tb.last).Seconds() * tb.rate
+	if tb.tokens > tb.rate { // burst: one second worth
+		tb.tokens = tb.rate
+	}
+	tb.last = now
+	tb.tokens -= n // reserve
+	var sleep time.Duration
+	if tb.tokens < 0 {
+		sleep = time.Duration(-tb.tokens / tb.rate * float64(time.Second))
+	}
+	tb.mu.Unlock()
+
+	if sleep == 0 {
+		return nil
+	}
+	return sleepCtx(ctx, sleep)
+}
+
+//////////////
+// rlReader //
+//////////////
+
+func (r *rlReader) Read(p []byte) (n int, err error) {
+	n, err = r.r.Read(p)
+	if n > 0 {
+		if errW := r.rl.bytes.wait(r.ctx, float64(n)); errW != nil && err == nil {
+			err = errW
+		}
+	}
+	return
+}
+
+func (r *rlReader) Close() error { return r.r.Close() }
@@ -31,6 +31,9 @@ func GetObjectS3(bp BaseParams, bck cmn.Bck, objectName string, args ...GetArgs)
 		reqParams.Path = apc.URLPathS3.Join(bck.Name, objectName)
 		reqParams.Query = q
 		reqParams.Header = hdr
+		if len(args) != 0 {
+			reqParams.progress = args[0].Progress
+		}
 	}
 	wresp, err := reqParams.doWriter(w)
 	FreeRp(reqParams)
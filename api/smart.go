@@ -0,0 +1,201 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+const (
+	smartDownTime     = 30 * time.Second // a gateway that failed to respond is skipped for that long
+	smartRefreshIval  = time.Minute      // cluster map (Smap) refresh interval
+	smartMinRefreshIv = time.Second      // no more frequent refreshes upon failover
+)
+
+// SmartClient wraps BaseParams to:
+//   - cache the cluster map (Smap);
+//   - load-balance API calls across all gateways (proxies) - round-robin;
+//   - fail over transparently when a gateway (including the primary) becomes unreachable.
+//
+// Usage:
+//
+//	sc, err := api.NewSmartClient(api.BaseParams{Client: client, URL: endpoint, Token: token})
+//	...
+//	err = api.CreateBucket(sc.BP(), bck, nil)
+//
+// Each BP() call returns BaseParams pointing to the next available gateway; failover
+// applies to all API calls executed with the returned BaseParams with the exception of
+// calls that transfer user payload (PUT, APPEND) - those get retried with the same gateway.
+type SmartClient struct {
+	bp        BaseParams
+	smap      *meta.Smap
+	urls      []string         // gateway URLs, primary first
+	down      map[string]int64 // gateway URL => mono-time it was marked unreachable
+	refreshed int64            // mono-time
+	rr        atomic.Uint32
+	mu        sync.RWMutex
+}
+
+var errNoGateways = errors.New("no gateways in the cluster map")
+
+// NewSmartClient fetches the cluster map from the bp.URL endpoint (the "seed")
+// and returns a new SmartClient.
+func NewSmartClient(bp BaseParams) (*SmartClient, error) {
+	sc := &SmartClient{bp: bp, down: make(map[string]int64, 4)}
+	sc.bp.sc = nil
+	if err := sc.refresh([]string{bp.URL}); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// BP returns base params to use with any other API; see also SmartClient.
+func (sc *SmartClient) BP() BaseParams {
+	bp := sc.bp
+	bp.URL = sc.pick("")
+	bp.sc = sc
+	return bp
+}
+
+// Smap returns the cached cluster map (refreshed periodically and upon failover).
+func (sc *SmartClient) Smap() *meta.Smap {
+	sc.maybeRefresh(false)
+	sc.mu.RLock()
+	smap := sc.smap
+	sc.mu.RUnlock()
+	return smap
+}
+
+// URLs returns gateway URLs (primary first).
+func (sc *SmartClient) URLs() []string {
+	sc.mu.RLock()
+	urls := append([]string{}, sc.urls...)
+	sc.mu.RUnlock()
+	return urls
+}
+
+// Refresh (re)fetches the cluster map from any available gateway.
+func (sc *SmartClient) Refresh() error {
+	return sc.refresh(sc.ordered())
+}
+
+func (sc *SmartClient) refresh(urls []string) (err error) {
+	var smap *meta.Smap
+	for _, u := range urls {
+		bp := sc.bp
+		bp.URL = u
+		if smap, err = GetClusterMap(bp); err == nil {
+			break
+		}
+		sc.markDown(u)
+	}
+	if err != nil {
+		return err
+	}
+	gws := make([]string, 0, len(smap.Pmap))
+	if smap.Primary != nil {
+		gws = append(gws, smap.Primary.URL(cmn.NetPublic))
+	}
+	for _, psi := range smap.Pmap {
+		if psi.InMaintOrDecomm() || smap.IsPrimary(psi) {
+			continue
+		}
+		gws = append(gws, psi.URL(cmn.NetPublic))
+	}
+	if len(gws) == 0 {
+		return errNoGateways
+	}
+	sc.mu.Lock()
+	sc.smap, sc.urls, sc.refreshed = smap, gws, mono.NanoTime()
+	for u := range sc.down {
+		if !cos.StringInSlice(u, gws) {
+			delete(sc.down, u)
+		}
+	}
+	sc.mu.Unlock()
+	return nil
+}
+
+func (sc *SmartClient) maybeRefresh(failover bool) {
+	ival := smartRefreshIval
+	if failover {
+		ival = smartMinRefreshIv
+	}
+	sc.mu.RLock()
+	since := mono.Since(sc.refreshed)
+	sc.mu.RUnlock()
+	if since > ival {
+		_ = sc.Refresh() // keeping the current Smap on error
+	}
+}
+
+// round-robin across available gateways, excluding `exclude`;
+// returns the primary's URL when none is available
+func (sc *SmartClient) pick(exclude string) string {
+	sc.maybeRefresh(false)
+	now := mono.NanoTime()
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	n := len(sc.urls)
+	for i := 0; i < n; i++ {
+		u := sc.urls[int(sc.rr.Inc())%n]
+		if u == exclude {
+			continue
+		}
+		if t, ok := sc.down[u]; ok && time.Duration(now-t) < smartDownTime {
+			continue
+		}
+		return u
+	}
+	if exclude != "" {
+		return ""
+	}
+	return sc.urls[0]
+}
+
+// available gateways first
+func (sc *SmartClient) ordered() []string {
+	now := mono.NanoTime()
+	sc.mu.RLock()
+	urls := make([]string, 0, len(sc.urls))
+	down := make([]string, 0, len(sc.down))
+	for _, u := range sc.urls {
+		if t, ok := sc.down[u]; ok && time.Duration(now-t) < smartDownTime {
+			down = append(down, u)
+		} else {
+			urls = append(urls, u)
+		}
+	}
+	sc.mu.RUnlock()
+	return append(urls, down...)
+}
+
+func (sc *SmartClient) markDown(u string) {
+	sc.mu.Lock()
+	sc.down[u] = mono.NanoTime()
+	sc.mu.Unlock()
+}
+
+// called by ReqParams.do() when the gateway `u` is unreachable;
+// returns the next gateway to try, or empty string
+func (sc *SmartClient) failover(u string) string {
+	sc.markDown(u)
+	sc.maybeRefresh(true)
+	return sc.pick(u)
+}
+
+// gateway is unreachable (compare w/ cos.IsUnreachable)
+func smartFailover(err error, resp *http.Response) bool {
+	return resp == nil && (cos.IsRetriableConnErr(err) || cos.IsUnreachable(err, 0))
+}
@@ -0,0 +1,85 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// BucketWatcher reads bucket change notifications (see WatchBucket)
+type BucketWatcher struct {
+	body io.ReadCloser
+	br   *bufio.Reader
+}
+
+// WatchBucket opens a server-sent events stream that delivers create, update, and delete
+// events for the objects in a given bucket whose names start with a given prefix
+// (empty prefix: all objects). Events are delivered (at least) from the moment the stream
+// is established; apc.BckEventLost indicates that some events were dropped.
+//
+// The stream is long-lived - use `BaseParams` with no client timeout, and
+// `bp.WithContext()` and/or `BucketWatcher.Close()` to terminate.
+func WatchBucket(bp BaseParams, bck cmn.Bck, prefix string) (*BucketWatcher, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActWatchBck, Value: apc.WatchMsg{Prefix: prefix}})
+		reqParams.Header = http.Header{
+			cos.HdrAccept:      []string{cos.ContentEventStream},
+			cos.HdrContentType: []string{cos.ContentJSON},
+		}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	body, err := reqParams.doReader()
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return &BucketWatcher{body: body, br: bufio.NewReader(body)}, nil
+}
+
+// Next blocks until the next event arrives; returns io.EOF when the server
+// closes the stream
+func (bw *BucketWatcher) Next() (*apc.BckEvent, error) {
+	var data []byte
+	for {
+		line, err := bw.br.ReadBytes('\n')
+		if err != nil {
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				err = io.EOF
+			}
+			return nil, err
+		}
+		line = bytes.TrimRight(line, "\r\n")
+		switch {
+		case len(line) == 0: // end of event
+			if data == nil {
+				continue
+			}
+			ev := &apc.BckEvent{}
+			if err := jsoniter.Unmarshal(data, ev); err != nil {
+				return nil, err
+			}
+			return ev, nil
+		case line[0] == ':': // comment (keepalive)
+		case bytes.HasPrefix(line, []byte("data:")):
+			data = append(data, bytes.TrimSpace(line[len("data:"):])...)
+		}
+		// NOTE: the event type is also carried in the data itself
+	}
+}
+
+func (bw *BucketWatcher) Close() error { return bw.body.Close() }
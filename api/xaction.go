@@ -61,6 +61,23 @@ func AbortXaction(bp BaseParams, args xact.ArgsMsg) (err error) {
 	return
 }
 
+// Change rate limits of a running copy-bucket or (offline) transform-bucket job;
+// see apc.XactLimits
+func SetXactionLimits(bp BaseParams, xid string, limits apc.XactLimits) (err error) {
+	msg := apc.ActMsg{Action: apc.ActXactLimits, Name: xid, Value: limits}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return
+}
+
 //
 // querying and waiting
 //
@@ -105,6 +122,41 @@ func QueryXactionSnaps(bp BaseParams, args xact.ArgsMsg) (xs xact.MultiSnap, err
 	return
 }
 
+// GetXactionHistory queries the persistent history of finished xactions
+// (see `xact_history` config) - by kind, bucket, time range, and/or status.
+// Returns matching records by target ID, the most recently finished first.
+func GetXactionHistory(bp BaseParams, msg *xact.HistoryMsg) (xs xact.MultiSnap, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatXactHistory}}
+	}
+	_, err = reqParams.DoReqAny(&xs)
+	FreeRp(reqParams)
+	return
+}
+
+// GetRebVerifyReport returns the end-state report of the post-rebalance verification
+// (apc.ActRebVerify) identified by `xid` - once the latter finishes on all targets.
+// The report is signed with the cluster's secret (if configured) - see cmn.CheckRebVerify.
+func GetRebVerifyReport(bp BaseParams, xid string) (rep *apc.RebVerifyReport, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatRebVerify}, apc.QparamUUID: []string{xid}}
+	}
+	rep = &apc.RebVerifyReport{}
+	_, err = reqParams.DoReqAny(rep)
+	FreeRp(reqParams)
+	return
+}
+
 // GetOneXactionStatus queries one of the IC (proxy) members for status
 // of the `args`-identified xaction.
 // NOTE:
@@ -200,7 +252,9 @@ func (reqParams *ReqParams) waitBsumm(msg *apc.BsummCtrlMsg, bsumm *cmn.AllBsumm
 		if status == http.StatusOK {
 			break
 		}
-		time.Sleep(sleep)
+		if err = sleepCtx(reqParams.BaseParams.ctx(), sleep); err != nil {
+			return err
+		}
 		if sleep < xact.MaxProbingFreq {
 			sleep += sleep / 2
 		}
@@ -208,10 +262,6 @@ func (reqParams *ReqParams) waitBsumm(msg *apc.BsummCtrlMsg, bsumm *cmn.AllBsumm
 	return err
 }
 
-//
-// TODO: use `xact.IdlesBeforeFinishing` to provide a single unified wait-for API
-//
-
 type consIdle struct {
 	xid string
 	cnt int
@@ -254,6 +304,136 @@ func WaitForXactionNode(bp BaseParams, args xact.ArgsMsg, fn func(xact.MultiSnap
 	return err
 }
 
+// terminal states (see WaitForXaction)
+const (
+	XactFinished XactState = iota + 1
+	XactAborted
+	XactErrored // finished with errors
+)
+
+type (
+	XactState int
+
+	// how and why a given xaction ended
+	XactResult struct {
+		Cause    string // abort cause (XactAborted) or the first error (XactErrored)
+		State    XactState
+		ErrCount int // number of targets that reported errors (XactErrored)
+	}
+
+	XactWaitOpts struct {
+		// optional: called with each polled snapshot (all targets)
+		Progress func(xact.MultiSnap)
+		// optional: same as above via channel - the send does not block (a slow reader skips snapshots),
+		// and the channel is not closed upon return
+		ProgressCh chan<- xact.MultiSnap
+	}
+)
+
+func (s XactState) String() string {
+	switch s {
+	case XactFinished:
+		return "finished"
+	case XactAborted:
+		return "aborted"
+	case XactErrored:
+		return "errored"
+	default:
+		return "unknown"
+	}
+}
+
+func (res *XactResult) String() string {
+	switch res.State {
+	case XactAborted:
+		return "aborted: " + res.Cause
+	case XactErrored:
+		return fmt.Sprintf("errored (%d): %s", res.ErrCount, res.Cause)
+	default:
+		return res.State.String()
+	}
+}
+
+// WaitForXaction waits for a given xaction to reach its terminal state and returns the latter,
+// while optionally streaming progress (see XactWaitOpts).
+// Works with all xaction kinds - for those that idle before finishing (`xact.IdlesBeforeFinishing`),
+// a consistently idle xaction is considered finished.
+// Returned error indicates failure to wait (e.g., timeout or canceled context) -
+// not how the xaction itself ended.
+func WaitForXaction(bp BaseParams, args xact.ArgsMsg, opts *XactWaitOpts) (res *XactResult, err error) {
+	var ci *consIdle
+	if xact.IdlesBeforeFinishing(args.Kind) {
+		ci = &consIdle{xid: args.ID}
+	}
+	fn := func(snaps xact.MultiSnap) (done, resetProbeFreq bool) {
+		opts.notify(snaps)
+		if res = xactResult(snaps, args.ID); res != nil {
+			return true, false
+		}
+		if ci != nil {
+			if done, resetProbeFreq = ci.check(snaps); done {
+				res = &XactResult{State: XactFinished}
+			}
+		}
+		return
+	}
+	_, err = _waitx(bp, args, fn)
+	return
+}
+
+func (opts *XactWaitOpts) notify(snaps xact.MultiSnap) {
+	if opts == nil {
+		return
+	}
+	if opts.Progress != nil {
+		opts.Progress(snaps)
+	}
+	if opts.ProgressCh != nil {
+		select {
+		case opts.ProgressCh <- snaps:
+		default:
+		}
+	}
+}
+
+// returns nil if the xaction is still running (on any target) or not found
+func xactResult(snaps xact.MultiSnap, xid string) (res *XactResult) {
+	var (
+		found   bool
+		aborted string
+		errs    []string
+	)
+	for _, tsnaps := range snaps {
+		for _, xsnap := range tsnaps {
+			if xid != "" && xsnap.ID != xid {
+				continue
+			}
+			found = true
+			switch {
+			case xsnap.IsAborted():
+				if aborted == "" {
+					aborted = cos.Either(xsnap.AbortErr, "aborted")
+				}
+			case !xsnap.Finished():
+				return nil // still running
+			case xsnap.Err != "":
+				errs = append(errs, xsnap.Err)
+			}
+		}
+	}
+	switch {
+	case !found:
+		return nil
+	case aborted != "":
+		res = &XactResult{State: XactAborted, Cause: aborted}
+	case len(errs) > 0:
+		res = &XactResult{State: XactErrored, Cause: errs[0], ErrCount: len(errs)}
+	default:
+		res = &XactResult{State: XactFinished}
+	}
+	return
+}
+
 // TODO: `status` is currently always nil when we wait with a (`fn`) callback
 // TODO: un-defer cancel()
 func _waitx(bp BaseParams, args xact.ArgsMsg, fn func(xact.MultiSnap) (bool, bool)) (status *nl.Status, err error) {
@@ -282,10 +462,15 @@ func _waitx(bp BaseParams, args xact.ArgsMsg, fn func(xact.MultiSnap) (bool, boo
 			}
 		}
 		canRetry := err == nil || cos.IsRetriableConnErr(err) || cmn.IsStatusServiceUnavailable(err)
+		if !done && bp.Ctx != nil && bp.Ctx.Err() != nil {
+			return status, bp.Ctx.Err() // canceled or deadline exceeded
+		}
 		if done || !canRetry /*fail*/ {
 			return
 		}
-		time.Sleep(sleep)
+		if err = sleepCtx(bp.ctx(), sleep); err != nil {
+			return
+		}
 		sleep = cos.MinDuration(maxSleep, sleep+sleep/2)
 
 		if elapsed = mono.Since(begin); elapsed >= total {
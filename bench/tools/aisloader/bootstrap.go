@@ -99,6 +99,9 @@ const (
 	opPut = iota
 	opGet
 	opConfig
+	opList
+
+	opCnt
 
 	myName           = "loader"
 	randomObjNameLen = 32
@@ -137,6 +140,7 @@ type (
 		statsdPort        int
 		statsShowInterval int
 		putPct            int // % of puts, rest are gets
+		listPct           int // % of list-objects requests (scenario phases only)
 		numWorkers        int
 		batchSize         int // batch is used for bootstraping(list) and delete
 		loaderIDHashLen   uint
@@ -165,6 +169,8 @@ type (
 		readLenStr           string // read length
 		subDir               string
 		tokenFile            string
+		scenarioPath         string // declarative scenario (JSON), see scenario.go
+		scenarioReport       string // machine-readable scenario report (JSON)
 
 		etlName     string // name of a ETL to apply to each object. Omitted when etlSpecPath specified.
 		etlSpecPath string // Path to a ETL spec to apply to each object.
@@ -189,6 +195,7 @@ type (
 		put       stats.HTTPReq
 		get       stats.HTTPReq
 		getConfig stats.HTTPReq
+		list      stats.HTTPReq
 		statsd    stats.Metrics
 	}
 
@@ -248,6 +255,8 @@ var (
 	s3Profile  string
 
 	loggedUserToken string
+
+	scen *scenario // when running `-scenario`
 )
 
 var _version, _buildtime string
@@ -261,6 +270,8 @@ func (wo *workOrder) String() string {
 		opName = http.MethodPut
 	case opConfig:
 		opName = "CONFIG"
+	case opList:
+		opName = "LIST"
 	}
 
 	if wo.err != nil {
@@ -368,6 +379,11 @@ func parseCmdLine() (params, error) {
 	f.BoolVar(&p.dryRun, "dry-run", false, "true: show the configuration and parameters that aisloader will use for benchmark")
 	f.BoolVar(&p.traceHTTP, "trace-http", false, "true: trace HTTP latencies") // see httpLatencies
 	f.StringVar(&p.cksumType, "cksum-type", cos.ChecksumXXHash, "cksum type to use for put object requests")
+	f.StringVar(&p.scenarioPath, "scenario", "",
+		"JSON file with a declarative scenario: phases of mixed GET/PUT/list-objects workloads with optional ramps and latency SLOs\n"+
+			"(overrides -duration, -pctput, -minsize, -maxsize, and -numworkers; see docs/aisloader.md for details)")
+	f.StringVar(&p.scenarioReport, "scenario-report", "",
+		"filename to write machine-readable (JSON) scenario report including latency percentiles and SLO pass/fail status")
 
 	// ETL
 	f.StringVar(&p.etlName, "etl", "", "name of an ETL applied to each object on GET request. One of '', 'tar2tf', 'md5', 'echo'")
@@ -437,7 +453,7 @@ func parseCmdLine() (params, error) {
 		p.maxSize = cos.GiB
 	}
 
-	if !p.duration.IsSet {
+	if !p.duration.IsSet && p.scenarioPath == "" {
 		if p.putSizeUpperBound != 0 {
 			// user specified putSizeUpperBound, but not duration, override default 1 minute
 			// and run aisloader until putSizeUpperBound is reached
@@ -456,6 +472,20 @@ func parseCmdLine() (params, error) {
 		return params{}, fmt.Errorf("invalid option: PUT percent %d", p.putPct)
 	}
 
+	if p.scenarioPath != "" {
+		if p.getConfig || isDirectS3() {
+			return params{}, errors.New("option '-scenario' cannot be used with '-getconfig' or '-s3endpoint'")
+		}
+		if scen, err = loadScenario(p.scenarioPath, &p); err != nil {
+			return params{}, err
+		}
+		p.duration.Val = scen.duration()
+		p.numWorkers = scen.maxWorkers()
+		scen.Phases[0].apply(&p)
+	} else if p.scenarioReport != "" {
+		return params{}, errors.New("option '-scenario-report' requires '-scenario'")
+	}
+
 	// direct s3 access vs other command line
 	if isDirectS3() {
 		if p.randomProxy {
@@ -697,6 +727,7 @@ func newStats(t time.Time) sts {
 		put:       stats.NewHTTPReq(t),
 		get:       stats.NewHTTPReq(t),
 		getConfig: stats.NewHTTPReq(t),
+		list:      stats.NewHTTPReq(t),
 		statsd:    stats.NewStatsdMetrics(t),
 	}
 }
@@ -706,6 +737,7 @@ func (s *sts) aggregate(other sts) {
 	s.get.Aggregate(other.get)
 	s.put.Aggregate(other.put)
 	s.getConfig.Aggregate(other.getConfig)
+	s.list.Aggregate(other.list)
 }
 
 func setupBucket(runParams *params) error {
@@ -922,7 +954,11 @@ func Start(version, buildtime string) (err error) {
 
 	timer := time.NewTimer(runParams.duration.Val)
 
-	var statsTicker *time.Ticker
+	var (
+		statsTicker *time.Ticker
+		phaseTicker = &time.Ticker{} // nil channel unless running scenario
+		numWOs      int
+	)
 	if runParams.statsShowInterval == 0 {
 		statsTicker = time.NewTicker(math.MaxInt64)
 	} else {
@@ -954,7 +990,13 @@ func Start(version, buildtime string) (err error) {
 	preWriteStats(statsWriter, runParams.jsonFormat)
 
 	// Get the workers started
-	for i := 0; i < runParams.numWorkers; i++ {
+	numWOs = runParams.numWorkers
+	if scen != nil {
+		scen.start(tsStart)
+		numWOs = scen.toPost(tsStart)
+		phaseTicker = time.NewTicker(scenarioTick)
+	}
+	for i := 0; i < numWOs; i++ {
 		if err = postNewWorkOrder(); err != nil {
 			break
 		}
@@ -996,9 +1038,23 @@ MainLoop:
 				accumulatedStats.aggregate(intervalStats)
 				intervalStats = newStats(time.Now())
 			}
-			if err := postNewWorkOrder(); err != nil {
-				fmt.Fprintln(os.Stderr, err.Error())
-				break MainLoop
+			numWOs = 1
+			if scen != nil {
+				numWOs = scen.toPost(time.Now())
+			}
+			for i := 0; i < numWOs; i++ {
+				if err := postNewWorkOrder(); err != nil {
+					fmt.Fprintln(os.Stderr, err.Error())
+					break MainLoop
+				}
+			}
+		case <-phaseTicker.C: // ramp up (compare with the case above)
+			numWOs = scen.toPost(time.Now())
+			for i := 0; i < numWOs; i++ {
+				if err := postNewWorkOrder(); err != nil {
+					fmt.Fprintln(os.Stderr, err.Error())
+					break MainLoop
+				}
 			}
 		case <-statsTicker.C:
 			accumulatedStats.aggregate(intervalStats)
@@ -1026,6 +1082,9 @@ MainLoop:
 Done:
 	timer.Stop()
 	statsTicker.Stop()
+	if scen != nil {
+		phaseTicker.Stop()
+	}
 	close(workOrders)
 	wg.Wait() // wait until all workers complete their work
 
@@ -1037,6 +1096,11 @@ Done:
 
 	finalizeStats(statsWriter)
 	fmt.Printf("Stats written to %s\n", statsWriter.Name())
+	if scen != nil {
+		if errV := scen.finalize(runParams.scenarioReport); errV != nil && err == nil {
+			err = errV
+		}
+	}
 	if runParams.cleanUp.Val {
 		cleanup()
 	}
@@ -1118,6 +1182,14 @@ func newGetWorkOrder() (*workOrder, error) {
 	}, nil
 }
 
+func newListWorkOrder() *workOrder {
+	return &workOrder{
+		proxyURL: runParams.proxyURL,
+		bck:      runParams.bck,
+		op:       opList,
+	}
+}
+
 func newGetConfigWorkOrder() *workOrder {
 	return &workOrder{
 		proxyURL: runParams.proxyURL,
@@ -1130,6 +1202,8 @@ func postNewWorkOrder() (err error) {
 	switch {
 	case runParams.getConfig:
 		wo = newGetConfigWorkOrder()
+	case runParams.listPct > 0 && runParams.listPct > rnd.Intn(100):
+		wo = newListWorkOrder()
 	case runParams.putPct == 100:
 		wo, err = newPutWorkOrder()
 	case runParams.putPct == 0:
@@ -1148,6 +1222,9 @@ func postNewWorkOrder() (err error) {
 		}
 	}
 	if err == nil {
+		if scen != nil {
+			scen.numWOs++
+		}
 		workOrders <- wo
 	}
 	return
@@ -1188,10 +1265,16 @@ func completeWorkOrder(wo *workOrder, terminating bool) {
 		}
 	}
 
+	if scen != nil {
+		scen.numWOs--
+	}
 	if err := validateWorkOrder(wo, delta); err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "[ERROR] %s", err.Error())
 		return
 	}
+	if scen != nil {
+		scen.record(wo, delta)
+	}
 
 	switch wo.op {
 	case opGet:
@@ -1244,6 +1327,13 @@ func completeWorkOrder(wo *workOrder, terminating bool) {
 		}
 		// append to free later
 		wo2Free = append(wo2Free, wo)
+	case opList:
+		if wo.err == nil {
+			intervalStats.list.Add(wo.size, delta)
+		} else {
+			fmt.Println("LIST failed: ", wo.err)
+			intervalStats.list.AddErr()
+		}
 	case opConfig:
 		if wo.err == nil {
 			intervalStats.getConfig.Add(1, delta)
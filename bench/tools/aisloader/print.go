@@ -1,6 +1,6 @@
 // Package aisloader
 /*
- * Copyright (c) 2018-2021, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
  */
 
 package aisloader
@@ -57,7 +57,9 @@ var examples = `# 1. Cleanup (i.e., destroy) an existing bucket:
      $ aisloader -loaderid=loaderstring -loaderidhashlen=8 -getloaderid	# 0xdb
 # 12. Timed 100% GET _directly_ from S3 bucket (notice '-s3endpoint' command line):
      $ aisloader -bucket=s3://xyz -cleanup=false -numworkers=8 -pctput=0 -duration=10m -s3endpoint=https://s3.amazonaws.com
-# 13. PUT approx. 8000 files into s3 bucket directly, skip printing usage and defaults (NOTE: aistore is not being used):
+# 13. Run a declarative mixed-workload scenario (phases, ramps, latency SLOs) and write machine-readable report, e.g. for CI performance gates:
+     $ aisloader -bucket=ais://abc -cleanup=false -scenario=scenario.json -scenario-report=report.json -quiet
+# 14. PUT approx. 8000 files into s3 bucket directly, skip printing usage and defaults (NOTE: aistore is not being used):
      $ aisloader -bucket=s3://xyz -cleanup=false -minsize=16B -maxsize=16B -numworkers=8 -pctput=100 -totalputsize=128k -s3endpoint=https://s3.amazonaws.com -quiet
 `
 
@@ -197,11 +199,15 @@ func writeStatsJSON(to io.Writer, s sts, withcomma ...bool) {
 		Get *jsonStats `json:"get"`
 		Put *jsonStats `json:"put"`
 		Cfg *jsonStats `json:"cfg"`
+		Lst *jsonStats `json:"list,omitempty"`
 	}{
 		Get: jsonStatsFromReq(s.get),
 		Put: jsonStatsFromReq(s.put),
 		Cfg: jsonStatsFromReq(s.getConfig),
 	}
+	if s.list.Total() != 0 || s.list.TotalErrs() != 0 {
+		jStats.Lst = jsonStatsFromReq(s.list)
+	}
 
 	jsonOutput, err := json.MarshalIndent(jStats, "", "  ")
 	cos.AssertNoErr(err)
@@ -251,6 +257,14 @@ func writeHumanReadibleIntervalStats(to io.Writer, s, t sts) {
 			ps(s.get.Throughput(s.get.Start(), time.Now()))+" ("+ps(t.get.Throughput(t.get.Start(), time.Now()))+")",
 			errs)
 	}
+	if s.list.Total() != 0 {
+		p(to, statsPrintHeader, pt(), "LST",
+			pn(s.list.Total())+" ("+pn(t.list.Total())+")",
+			"-",
+			pl(s.list.MinLatency(), s.list.AvgLatency(), s.list.MaxLatency()),
+			"-",
+			pn(s.list.TotalErrs())+" ("+pn(t.list.TotalErrs())+")")
+	}
 	if s.getConfig.Total() != 0 {
 		p(to, statsPrintHeader, pt(), "CFG",
 			pn(s.getConfig.Total())+" ("+pn(t.getConfig.Total())+")",
@@ -288,6 +302,15 @@ func writeHumanReadibleFinalStats(to io.Writer, t sts) {
 			ps(sget.Throughput(sget.Start(), time.Now())),
 			pn(sget.TotalErrs()))
 	}
+	slist := &t.list
+	if slist.Total() > 0 {
+		p(to, statsPrintHeader, pt(), "LST",
+			pn(slist.Total()),
+			"-",
+			pl(slist.MinLatency(), slist.AvgLatency(), slist.MaxLatency()),
+			"-",
+			pn(slist.TotalErrs()))
+	}
 	sconfig := &t.getConfig
 	if sconfig.Total() > 0 {
 		p(to, statsPrintHeader, pt(), "CFG",
@@ -331,6 +354,7 @@ func printRunParams(p params) {
 		StatsInterval string `json:"stats interval"`
 		Backing       string `json:"backed by"`
 		Cleanup       bool   `json:"cleanup"`
+		Scenario      string `json:"scenario,omitempty"`
 	}{
 		Seed:          p.seed,
 		URL:           p.proxyURL,
@@ -346,6 +370,7 @@ func printRunParams(p params) {
 		StatsInterval: (time.Duration(runParams.statsShowInterval) * time.Second).String(),
 		Backing:       p.readerType,
 		Cleanup:       p.cleanUp.Val,
+		Scenario:      p.scenarioPath,
 	}, "", "   ")
 	cos.AssertNoErr(err)
 
@@ -0,0 +1,348 @@
+// Package aisloader
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+
+// declarative (mixed-workload) scenarios and latency SLOs
+
+package aisloader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/bench/tools/aisloader/stats"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Example (see `-scenario` command-line):
+// {
+//   "name": "train-and-ingest",
+//   "phases": [
+//     {"name": "warmup", "duration": "1m", "pctput": 100, "minsize": "1MB", "maxsize": "1MB", "numworkers": 16, "ramp_from": 1},
+//     {"name": "mixed",  "duration": "5m", "pctput": 20, "minsize": "4KB", "maxsize": "16MB", "numworkers": 64},
+//     {"name": "list",   "duration": "1m", "pctlist": 80, "numworkers": 8}
+//   ],
+//   "slo": [
+//     {"op": "GET", "percentile": 99, "max": "250ms"},
+//     {"op": "PUT", "percentile": 50, "max": "40ms", "phase": "mixed"}
+//   ]
+// }
+
+type (
+	scenario struct {
+		Name   string   `json:"name"`
+		Phases []*phase `json:"phases"`
+		SLO    []*slo   `json:"slo,omitempty"`
+
+		// runtime
+		started time.Time
+		cur     int // current phase
+		numWOs  int // work orders in flight
+	}
+	phase struct {
+		Name       string       `json:"name"`
+		Duration   cos.Duration `json:"duration"`
+		PutPct     int          `json:"pctput"`
+		ListPct    int          `json:"pctlist,omitempty"` // % of list-objects (single page) requests; the rest is split between PUT and GET as per `pctput`
+		MinSizeStr string       `json:"minsize,omitempty"` // defaults to the command-line `-minsize` (same for maxsize and numworkers)
+		MaxSizeStr string       `json:"maxsize,omitempty"`
+		NumWorkers int          `json:"numworkers,omitempty"`
+		RampFrom   int          `json:"ramp_from,omitempty"` // linearly ramp up (or down) from this number of workers to `numworkers`
+
+		minSize, maxSize int64
+		lat              [opCnt]stats.LatencyHist
+		errs             [opCnt]int64
+	}
+	// latency SLO: the given percentile of the named op's latency must not exceed `max`;
+	// applies to all phases unless `phase` is specified
+	slo struct {
+		Op         string       `json:"op"` // GET, PUT, or LIST
+		Phase      string       `json:"phase,omitempty"`
+		Percentile float64      `json:"percentile"`
+		Max        cos.Duration `json:"max"`
+	}
+
+	// machine-readable report (`-scenario-report`)
+	scenarioReport struct {
+		Name   string         `json:"name"`
+		Phases []*phaseReport `json:"phases"`
+		SLO    []*sloReport   `json:"slo"`
+		Pass   bool           `json:"pass"`
+	}
+	phaseReport struct {
+		Name string               `json:"name"`
+		Ops  map[string]*opReport `json:"ops"`
+	}
+	opReport struct {
+		Cnt  int64         `json:"count"`
+		Errs int64         `json:"errors"`
+		P50  time.Duration `json:"p50"`
+		P90  time.Duration `json:"p90"`
+		P99  time.Duration `json:"p99"`
+		P999 time.Duration `json:"p999"`
+		Max  time.Duration `json:"max"`
+	}
+	sloReport struct {
+		slo
+		Actual time.Duration `json:"actual"`
+		Pass   bool          `json:"pass"`
+	}
+)
+
+const scenarioTick = 100 * time.Millisecond // to ramp up
+
+var opNames = [opCnt]string{opPut: "PUT", opGet: "GET", opConfig: "CFG", opList: "LIST"}
+
+func loadScenario(fqn string, p *params) (*scenario, error) {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, err
+	}
+	scen := &scenario{}
+	if err := jsoniter.Unmarshal(b, scen); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario %q: %v", fqn, err)
+	}
+	if len(scen.Phases) == 0 {
+		return nil, fmt.Errorf("scenario %q: no phases", fqn)
+	}
+	for i, ph := range scen.Phases {
+		if ph.Name == "" {
+			ph.Name = fmt.Sprintf("phase-%d", i+1)
+		}
+		if ph.Duration <= 0 {
+			return nil, fmt.Errorf("scenario %q, phase %q: invalid duration %v", fqn, ph.Name, ph.Duration)
+		}
+		if ph.PutPct < 0 || ph.PutPct > 100 || ph.ListPct < 0 || ph.ListPct > 100 {
+			return nil, fmt.Errorf("scenario %q, phase %q: invalid percentage (pctput %d, pctlist %d)", fqn, ph.Name, ph.PutPct, ph.ListPct)
+		}
+		if ph.minSize, err = phaseSize(ph.MinSizeStr, p.minSize); err != nil {
+			return nil, fmt.Errorf("scenario %q, phase %q: %v", fqn, ph.Name, err)
+		}
+		if ph.maxSize, err = phaseSize(ph.MaxSizeStr, p.maxSize); err != nil {
+			return nil, fmt.Errorf("scenario %q, phase %q: %v", fqn, ph.Name, err)
+		}
+		if ph.maxSize < ph.minSize {
+			return nil, fmt.Errorf("scenario %q, phase %q: invalid min and max size (%d, %d)", fqn, ph.Name, ph.minSize, ph.maxSize)
+		}
+		if ph.NumWorkers <= 0 {
+			ph.NumWorkers = p.numWorkers
+		}
+		if ph.RampFrom < 0 {
+			return nil, fmt.Errorf("scenario %q, phase %q: invalid ramp_from %d", fqn, ph.Name, ph.RampFrom)
+		}
+	}
+	for _, s := range scen.SLO {
+		s.Op = strings.ToUpper(s.Op)
+		if s.opIdx() < 0 {
+			return nil, fmt.Errorf("scenario %q: invalid SLO op %q (expecting one of: GET, PUT, LIST)", fqn, s.Op)
+		}
+		if s.Percentile <= 0 || s.Percentile > 100 || s.Max <= 0 {
+			return nil, fmt.Errorf("scenario %q: invalid %s SLO (percentile %v, max %v)", fqn, s.Op, s.Percentile, s.Max)
+		}
+		if s.Phase != "" && scen.phase(s.Phase) == nil {
+			return nil, fmt.Errorf("scenario %q: %s SLO references unknown phase %q", fqn, s.Op, s.Phase)
+		}
+	}
+	return scen, nil
+}
+
+func phaseSize(s string, dflt int64) (int64, error) {
+	if s == "" {
+		return dflt, nil
+	}
+	return cos.ParseSize(s, cos.UnitsIEC)
+}
+
+func (scen *scenario) phase(name string) *phase {
+	for _, ph := range scen.Phases {
+		if ph.Name == name {
+			return ph
+		}
+	}
+	return nil
+}
+
+func (scen *scenario) duration() (d time.Duration) {
+	for _, ph := range scen.Phases {
+		d += ph.Duration.D()
+	}
+	return
+}
+
+func (scen *scenario) maxWorkers() (n int) {
+	for _, ph := range scen.Phases {
+		n = cos.Max(n, cos.Max(ph.NumWorkers, ph.RampFrom))
+	}
+	return
+}
+
+func (scen *scenario) start(now time.Time) {
+	scen.started = now
+	scen.cur = 0
+	scen.Phases[0].apply(&runParams)
+}
+
+// advance to the phase that corresponds to the elapsed time, and
+// return the number of workers (in-flight work orders) this phase calls for
+func (scen *scenario) update(now time.Time) int {
+	var (
+		elapsed = now.Sub(scen.started)
+		begin   time.Duration
+		idx     = len(scen.Phases) - 1
+	)
+	for i, ph := range scen.Phases {
+		if elapsed < begin+ph.Duration.D() {
+			idx = i
+			break
+		}
+		begin += ph.Duration.D()
+	}
+	ph := scen.Phases[idx]
+	if idx != scen.cur {
+		scen.cur = idx
+		ph.apply(&runParams)
+		fmt.Printf("%s Scenario %q: starting phase %q\n", prettyTimestamp(), scen.Name, ph.Name)
+	}
+	if ph.RampFrom == 0 {
+		return ph.NumWorkers
+	}
+	frac := float64(elapsed-begin) / float64(ph.Duration.D())
+	if frac > 1 {
+		frac = 1
+	}
+	return cos.Max(ph.RampFrom+int(frac*float64(ph.NumWorkers-ph.RampFrom)), 1)
+}
+
+// the number of new work orders to post
+func (scen *scenario) toPost(now time.Time) int {
+	return cos.Max(scen.update(now)-scen.numWOs, 0)
+}
+
+func (scen *scenario) record(wo *workOrder, delta time.Duration) {
+	ph := scen.Phases[scen.cur]
+	if wo.err != nil {
+		ph.errs[wo.op]++
+		return
+	}
+	ph.lat[wo.op].Add(delta)
+}
+
+func (ph *phase) apply(p *params) {
+	p.putPct = ph.PutPct
+	p.listPct = ph.ListPct
+	p.minSize, p.maxSize = ph.minSize, ph.maxSize
+}
+
+func (s *slo) opIdx() int {
+	for i, name := range opNames {
+		if name == s.Op && i != opConfig {
+			return i
+		}
+	}
+	return -1
+}
+
+//
+// report
+//
+
+func (scen *scenario) report() *scenarioReport {
+	rep := &scenarioReport{Name: scen.Name, Phases: make([]*phaseReport, 0, len(scen.Phases)), Pass: true}
+	for _, ph := range scen.Phases {
+		pr := &phaseReport{Name: ph.Name, Ops: make(map[string]*opReport, 2)}
+		for op := range ph.lat {
+			h := &ph.lat[op]
+			if h.Total() == 0 && ph.errs[op] == 0 {
+				continue
+			}
+			pr.Ops[opNames[op]] = &opReport{
+				Cnt:  h.Total(),
+				Errs: ph.errs[op],
+				P50:  h.Percentile(50),
+				P90:  h.Percentile(90),
+				P99:  h.Percentile(99),
+				P999: h.Percentile(99.9),
+				Max:  h.Max(),
+			}
+		}
+		rep.Phases = append(rep.Phases, pr)
+	}
+	for _, s := range scen.SLO {
+		var h stats.LatencyHist
+		for _, ph := range scen.Phases {
+			if s.Phase == "" || s.Phase == ph.Name {
+				h.Aggregate(&ph.lat[s.opIdx()])
+			}
+		}
+		sr := &sloReport{slo: *s, Actual: h.Percentile(s.Percentile)}
+		sr.Pass = sr.Actual <= s.Max.D()
+		rep.SLO = append(rep.SLO, sr)
+		rep.Pass = rep.Pass && sr.Pass
+	}
+	return rep
+}
+
+func (rep *scenarioReport) write(to io.Writer) error {
+	b, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(to, string(b))
+	return err
+}
+
+func (rep *scenarioReport) print() {
+	fmt.Printf("\nScenario %q:\n", rep.Name)
+	fmt.Printf("%-16s%-6s%-12s%-8s%-11s%-11s%-11s%-11s%-11s\n", "Phase", "OP", "Count", "Errors", "p50", "p90", "p99", "p99.9", "max")
+	for _, pr := range rep.Phases {
+		for _, op := range opNames {
+			or, ok := pr.Ops[op]
+			if !ok {
+				continue
+			}
+			fmt.Printf("%-16s%-6s%-12s%-8s%-11s%-11s%-11s%-11s%-11s\n", pr.Name, op, prettyNumber(or.Cnt), prettyNumber(or.Errs),
+				prettyDuration(int64(or.P50)), prettyDuration(int64(or.P90)), prettyDuration(int64(or.P99)),
+				prettyDuration(int64(or.P999)), prettyDuration(int64(or.Max)))
+		}
+	}
+	for _, sr := range rep.SLO {
+		status := "PASS"
+		if !sr.Pass {
+			status = "FAIL"
+		}
+		phase := "all phases"
+		if sr.Phase != "" {
+			phase = "phase " + sr.Phase
+		}
+		fmt.Printf("SLO %s p%v <= %v (%s): %v - %s\n", sr.Op, sr.Percentile, sr.Max, phase, time.Duration(sr.Actual), status)
+	}
+}
+
+// finalize scenario run: print and (optionally) write the report, and fail if any of the SLOs is violated
+func (scen *scenario) finalize(fqn string) error {
+	rep := scen.report()
+	rep.print()
+	if fqn != "" {
+		fh, err := cos.CreateFile(fqn)
+		if err != nil {
+			return err
+		}
+		err = rep.write(fh)
+		fh.Close()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Scenario report written to %s\n", fqn)
+	}
+	if !rep.Pass {
+		return errors.New("latency SLO violated")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Package stats provides various structs for collecting stats
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"math/bits"
+	"time"
+)
+
+// LatencyHist is a log-linear latency histogram: each power-of-two range of
+// microseconds is split into `histSubBuckets` equal sub-buckets, which bounds
+// the relative error of the reported percentiles by 1/histSubBuckets.
+// Same as HTTPReq, it assumes single-threaded access.
+type LatencyHist struct {
+	counts [histNumBuckets]int64
+	cnt    int64
+	max    time.Duration
+}
+
+const (
+	histSubBits    = 4
+	histSubBuckets = 1 << histSubBits
+	histNumBuckets = (64 - histSubBits + 1) * histSubBuckets
+)
+
+func histIndex(us uint64) int {
+	if us < histSubBuckets {
+		return int(us)
+	}
+	exp := bits.Len64(us) - histSubBits // >= 1
+	return exp*histSubBuckets + int(us>>(exp-1)) - histSubBuckets
+}
+
+// upper bound (microseconds) of the values that map to a given bucket
+func histUpper(idx int) uint64 {
+	if idx < histSubBuckets {
+		return uint64(idx)
+	}
+	exp := idx / histSubBuckets
+	sub := uint64(idx%histSubBuckets) + histSubBuckets
+	return (sub+1)<<(exp-1) - 1
+}
+
+func (h *LatencyHist) Add(d time.Duration) {
+	us := d.Microseconds()
+	if us < 0 {
+		us = 0
+	}
+	h.counts[histIndex(uint64(us))]++
+	h.cnt++
+	if d > h.max {
+		h.max = d
+	}
+}
+
+// Aggregate adds another histogram to self
+func (h *LatencyHist) Aggregate(other *LatencyHist) {
+	for i, c := range other.counts {
+		h.counts[i] += c
+	}
+	h.cnt += other.cnt
+	if other.max > h.max {
+		h.max = other.max
+	}
+}
+
+func (h *LatencyHist) Total() int64       { return h.cnt }
+func (h *LatencyHist) Max() time.Duration { return h.max }
+
+// Percentile returns (an upper-bound estimate of) the given percentile, e.g. 99.9
+func (h *LatencyHist) Percentile(pct float64) time.Duration {
+	if h.cnt == 0 {
+		return 0
+	}
+	rank := int64(pct / 100 * float64(h.cnt))
+	if rank >= h.cnt {
+		return h.max
+	}
+	var seen int64
+	for i, c := range h.counts {
+		seen += c
+		if seen > rank {
+			d := time.Duration(histUpper(i)) * time.Microsecond
+			if d > h.max {
+				d = h.max
+			}
+			return d
+		}
+	}
+	return h.max
+}
@@ -70,3 +70,26 @@ func TestStats(t *testing.T) {
 	verify(t, "Max latency", 100000000, total.MaxLatency())
 	verify(t, "Throughput", 5, total.Throughput(start, start.Add(70*time.Second)))
 }
+
+func TestLatencyHist(t *testing.T) {
+	var h, total stats.LatencyHist
+	for i := 1; i <= 1000; i++ {
+		h.Add(time.Duration(i) * time.Millisecond)
+	}
+	verify(t, "Total", 1000, h.Total())
+	verify(t, "Max", int64(time.Second), int64(h.Max()))
+	for _, pct := range []float64{50, 90, 99} {
+		exp := time.Duration(pct*10) * time.Millisecond
+		act := h.Percentile(pct)
+		// relative error is bounded by 1/16
+		if act < exp || act > exp+exp/16 {
+			t.Fatalf("Error: p%v, expected ~%v, actual = %v", pct, exp, act)
+		}
+	}
+	verify(t, "p100", int64(time.Second), int64(h.Percentile(100)))
+
+	total.Aggregate(&h)
+	total.Aggregate(&h)
+	verify(t, "Total", 2000, total.Total())
+	verify(t, "p50", int64(h.Percentile(50)), int64(total.Percentile(50)))
+}
@@ -1,6 +1,6 @@
 // Package aisloader
 /*
- * Copyright (c) 2018-2021, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
  */
 
 // worker routines
@@ -14,6 +14,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -94,6 +96,14 @@ func doGet(wo *workOrder) {
 	}
 }
 
+// a single page (compare with listObjectNames)
+func doList(wo *workOrder) {
+	bp := runParams.bp
+	bp.URL = wo.proxyURL
+	msg := &apc.LsoMsg{Prefix: runParams.subDir}
+	_, wo.err = api.ListObjectsPage(bp, wo.bck, msg)
+}
+
 func doGetConfig(wo *workOrder) {
 	wo.latencies, wo.err = getConfig(wo.proxyURL)
 }
@@ -115,6 +125,8 @@ func worker(wos <-chan *workOrder, results chan<- *workOrder, wg *sync.WaitGroup
 		case opGet:
 			doGet(wo)
 			numGets.Inc()
+		case opList:
+			doList(wo)
 		case opConfig:
 			doGetConfig(wo)
 		default:
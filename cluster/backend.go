@@ -22,10 +22,10 @@ type BackendProvider interface {
 	CreateBucket(bck *meta.Bck) (errCode int, err error)
 	ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (errCode int, err error)
 	ListBuckets(qbck cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err error)
-	PutObj(r io.ReadCloser, lom *LOM) (errCode int, err error)
-	DeleteObj(lom *LOM) (errCode int, err error)
 
-	// with context
+	// with context (e.g., request ID - see nlog.WithReqID)
+	PutObj(ctx context.Context, r io.ReadCloser, lom *LOM) (errCode int, err error)
+	DeleteObj(ctx context.Context, lom *LOM) (errCode int, err error)
 	HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs, errCode int, err error)
 	HeadObj(ctx context.Context, lom *LOM) (objAttrs *cmn.ObjAttrs, errCode int, err error)
 	GetObj(ctx context.Context, lom *LOM, owt cmn.OWT) (errCode int, err error)
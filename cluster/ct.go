@@ -5,13 +5,16 @@
 package cluster
 
 import (
+	"fmt"
 	"io"
 	"os"
 
 	"github.com/NVIDIA/aistore/cluster/meta"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
 )
 
 //////////////////////////////
@@ -111,7 +114,7 @@ func NewCTFromBO(bck *cmn.Bck, objName string, b meta.Bowner, ctType ...string)
 		}
 	}
 	var digest uint64
-	ct.mi, digest, err = HrwMpath(ct.bck.MakeUname(objName))
+	ct.mi, digest, err = HrwMpath(ct.bck.MakeUname(objName), MpathLabel(ct.bck.Bucket()))
 	if err != nil {
 		return
 	}
@@ -167,6 +170,9 @@ func (ct *CT) Write(t Target, reader io.Reader, size int64, workFQN ...string) (
 	if err := cos.Stat(bdir); err != nil {
 		return err
 	}
+	if size >= fs.URingMinSize && fs.URingAvail() {
+		return ct.writeURing(t, reader, size, workFQN...)
+	}
 	buf, slab := t.PageMM().Alloc()
 	if len(workFQN) == 0 {
 		_, err = cos.SaveReader(ct.fqn, reader, buf, cos.ChecksumNone, size)
@@ -176,3 +182,42 @@ func (ct *CT) Write(t Target, reader io.Reader, size int64, workFQN ...string) (
 	slab.Free(buf)
 	return err
 }
+
+// (compare with cos.SaveReader and cos.SaveReaderSafe)
+func (ct *CT) writeURing(t Target, reader io.Reader, size int64, workFQN ...string) (err error) {
+	fqn := ct.fqn
+	if len(workFQN) > 0 {
+		fqn = workFQN[0]
+	}
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return err
+	}
+	var (
+		buf, slab = t.PageMM().AllocSize(memsys.MaxPageSlabSize)
+		ubuf, usl = t.PageMM().AllocSize(memsys.MaxPageSlabSize)
+		w         = fs.NewURingWriter(fh, ubuf)
+		written   int64
+	)
+	written, err = io.CopyBuffer(w, io.LimitReader(reader, size), buf)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil && written != size {
+		err = fmt.Errorf("wrong size when saving to %q: expected %d, got %d", fqn, size, written)
+	}
+	slab.Free(buf)
+	usl.Free(ubuf)
+	if nerr := fh.Close(); err == nil {
+		err = nerr
+	}
+	if err == nil && fqn != ct.fqn {
+		err = cos.Rename(fqn, ct.fqn)
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(fqn); nerr != nil {
+			nlog.Errorf("nested error: %v: remove %q: %v", err, fqn, nerr)
+		}
+	}
+	return err
+}
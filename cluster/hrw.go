@@ -154,14 +154,42 @@ func HrwTargetTask(uuid string, smap *meta.Smap) (si *meta.Snode, err error) {
 	return
 }
 
-func HrwMpath(uname string) (mi *fs.Mountpath, digest uint64, err error) {
+// HrwMpath selects the mountpath for the given uname: from those labeled with the bucket's
+// placement label, if any - otherwise, from all available mountpaths (see cmn.PlacementConf).
+// Read-only mountpaths are skipped unless there are no others (see fs.SetReadOnly).
+// The scratch mountpath, if configured, is always skipped (see fs.Scratch)
+func HrwMpath(uname, label string) (mi *fs.Mountpath, digest uint64, err error) {
 	var (
-		max            uint64
 		availablePaths = fs.GetAvail()
+		scratch        = fs.ScratchLabel()
 	)
 	digest = xxhash.ChecksumString64S(uname, cos.MLCG32)
+	if label != "" && label != scratch && fs.AnyLabeled() {
+		if mi = hrwMpath(availablePaths, digest, label, scratch, fs.FlagWaitingDD|fs.FlagReadOnly); mi != nil {
+			return
+		}
+		// no such (writable) mountpaths on this target
+	}
+	if mi = hrwMpath(availablePaths, digest, "", scratch, fs.FlagWaitingDD|fs.FlagReadOnly); mi != nil {
+		return
+	}
+	// all read-only
+	if mi = hrwMpath(availablePaths, digest, "", scratch, fs.FlagWaitingDD); mi == nil {
+		err = cmn.ErrNoMountpaths
+	}
+	return
+}
+
+func hrwMpath(availablePaths fs.MPI, digest uint64, label, scratch string, skip uint64) (mi *fs.Mountpath) {
+	var max uint64
 	for _, mpathInfo := range availablePaths {
-		if mpathInfo.IsAnySet(fs.FlagWaitingDD) {
+		if mpathInfo.IsAnySet(skip) {
+			continue
+		}
+		if label != "" && mpathInfo.Label != label {
+			continue
+		}
+		if scratch != "" && mpathInfo.Label == scratch {
 			continue
 		}
 		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
@@ -170,8 +198,45 @@ func HrwMpath(uname string) (mi *fs.Mountpath, digest uint64, err error) {
 			mi = mpathInfo
 		}
 	}
-	if mi == nil {
-		err = cmn.ErrNoMountpaths
+	return
+}
+
+// MpathLabel returns the bucket's placement label (see HrwMpath above), looking up
+// the bucket in the BMD when not initialized - but only if there are labeled mountpaths
+func MpathLabel(bck *cmn.Bck) string {
+	if bck.Props != nil {
+		return bck.MpathLabel()
+	}
+	if !fs.AnyLabeled() || T == nil {
+		return ""
+	}
+	if props, present := T.Bowner().Get().Get((*meta.Bck)(bck)); present {
+		return props.Placement.MpathLabel()
+	}
+	return ""
+}
+
+// HrwCapacityMpath selects the capacity-tier mountpath for the given uname, that is,
+// from those _not_ labeled `fastTier` (see cmn.SpaceConf.FastTier); returns nil if none
+func HrwCapacityMpath(uname, fastTier string) (mi *fs.Mountpath) {
+	var (
+		max            uint64
+		availablePaths = fs.GetAvail()
+		scratch        = fs.ScratchLabel()
+		digest         = xxhash.ChecksumString64S(uname, cos.MLCG32)
+	)
+	for _, mpathInfo := range availablePaths {
+		if mpathInfo.Label == fastTier || mpathInfo.IsAnySet(fs.FlagWaitingDD|fs.FlagReadOnly) {
+			continue
+		}
+		if scratch != "" && mpathInfo.Label == scratch {
+			continue
+		}
+		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
+		if cs >= max {
+			max = cs
+			mi = mpathInfo
+		}
 	}
 	return
 }
@@ -0,0 +1,94 @@
+// Package cluster_test provides tests for cluster package
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HrwMpath", func() {
+	const tmpDir = "/tmp/hrw_test"
+
+	var (
+		mpaths = []string{tmpDir + "/mpath0", tmpDir + "/mpath1", tmpDir + "/mpath2"}
+		labels = []string{"ssd", "ssd", ""}
+	)
+
+	BeforeEach(func() {
+		fs.TestDisableValidation()
+		for i, mpath := range mpaths {
+			Expect(cos.CreateDir(mpath)).NotTo(HaveOccurred())
+			_, err := fs.AddMpath(mpath, labels[i], "" /*quota*/, "daeID", func() {}, true /*force*/)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		for _, mpath := range mpaths {
+			_, _ = fs.Remove(mpath)
+		}
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("should only select mountpaths labeled with the bucket's label", func() {
+		Expect(fs.AnyLabeled()).To(BeTrue())
+		used := make(map[string]bool, 2)
+		for i := 0; i < 100; i++ {
+			mi, _, err := cluster.HrwMpath(fmt.Sprintf("uname-%d", i), "ssd")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mi.Label).To(Equal("ssd"))
+			used[mi.Path] = true
+		}
+		Expect(used).To(HaveLen(2))
+	})
+
+	It("should select from all mountpaths when none is labeled accordingly", func() {
+		var unlabeled bool
+		for i := 0; i < 100; i++ {
+			mi, _, err := cluster.HrwMpath(fmt.Sprintf("uname-%d", i), "hdd")
+			Expect(err).NotTo(HaveOccurred())
+			unlabeled = unlabeled || mi.Label == ""
+		}
+		Expect(unlabeled).To(BeTrue())
+	})
+
+	It("should select capacity-tier mountpaths (not labeled as fast tier)", func() {
+		for i := 0; i < 100; i++ {
+			mi := cluster.HrwCapacityMpath(fmt.Sprintf("uname-%d", i), "ssd")
+			Expect(mi).NotTo(BeNil())
+			Expect(mi.Label).NotTo(Equal("ssd"))
+		}
+	})
+
+	It("should skip read-only mountpaths unless all are read-only", func() {
+		_, err := fs.SetReadOnly(mpaths[0], true, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.AnyReadOnly()).To(BeTrue())
+		for i := 0; i < 100; i++ {
+			mi, _, err := cluster.HrwMpath(fmt.Sprintf("uname-%d", i), "ssd")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mi.Path).To(Equal(mpaths[1]))
+		}
+		avail := fs.GetAvail()
+		for mpath := range avail {
+			_, err := fs.SetReadOnly(mpath, true, nil)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		mi, _, err := cluster.HrwMpath("uname", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mi.IsReadOnly()).To(BeTrue())
+		for mpath := range avail {
+			_, err := fs.SetReadOnly(mpath, false, nil)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+})
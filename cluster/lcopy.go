@@ -373,16 +373,28 @@ func (lom *LOM) leastUtilCopy() (fqn string) {
 	return
 }
 
-// returns the least utilized mountpath that does _not_ have a copy of this `lom` yet
-// (compare with leastUtilCopy())
+// returns the least utilized mountpath that does _not_ have a copy of this `lom` yet,
+// preferring those labeled with the bucket's placement label, if any (compare with leastUtilCopy())
 func (lom *LOM) LeastUtilNoCopy() (mi *fs.Mountpath) {
+	if label := lom.Bucket().MpathLabel(); label != "" && fs.AnyLabeled() {
+		if mi = lom.leastUtilNoCopy(label); mi != nil {
+			return
+		}
+	}
+	return lom.leastUtilNoCopy("")
+}
+
+func (lom *LOM) leastUtilNoCopy(label string) (mi *fs.Mountpath) {
 	var (
 		availablePaths = fs.GetAvail()
 		mpathUtils     = fs.GetAllMpathUtils()
 		minUtil        = int64(101) // to motivate the first assignment
 	)
 	for mpath, mpathInfo := range availablePaths {
-		if lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD) {
+		if lom.haveMpath(mpath) || mpathInfo.IsAnySet(fs.FlagWaitingDD|fs.FlagReadOnly) {
+			continue
+		}
+		if label != "" && mpathInfo.Label != label {
 			continue
 		}
 		if util := mpathUtils.Get(mpath); util < minUtil {
@@ -412,14 +424,14 @@ func (lom *LOM) haveMpath(mpath string) bool {
 func (lom *LOM) ToMpath() (mi *fs.Mountpath, isHrw bool) {
 	var (
 		availablePaths = fs.GetAvail()
-		hrwMi, _, err  = HrwMpath(lom.md.uname)
+		hrwMi, _, err  = HrwMpath(lom.md.uname, lom.Bucket().MpathLabel())
 	)
 	if err != nil {
 		nlog.Errorln(err)
 		return
 	}
 	debug.Assert(!hrwMi.IsAnySet(fs.FlagWaitingDD))
-	if lom.mi.Path != hrwMi.Path {
+	if lom.mi.Path != hrwMi.Path && !lom.isDemoted() {
 		return hrwMi, true
 	}
 	mirror := lom.MirrorConf()
@@ -443,3 +455,65 @@ func (lom *LOM) ToMpath() (mi *fs.Mountpath, isHrw bool) {
 	mi = lom.LeastUtilNoCopy() // NOTE: nil when not enough mountpaths
 	return
 }
+
+// MoveTo renames the object, along with all its copies (if any), to `dst` - in place,
+// within the respective mountpaths and without copying any data. Returns false
+// (and does nothing) if none of the existing copies resides on the destination's
+// HRW mountpath - in which case the caller must fall back to copy+delete.
+// NOTE: caller must write-lock both; `dst` (initialized) must not exist
+func (lom *LOM) MoveTo(dst *LOM) (bool, error) {
+	debug.AssertFunc(func() bool {
+		_, exclusive := lom.IsLocked()
+		_, excl2 := dst.IsLocked()
+		return exclusive && excl2
+	})
+	copies := lom.md.copies
+	if len(copies) == 0 {
+		copies = fs.MPI{lom.FQN: lom.mi}
+	}
+	if _, ok := copies[lom.FQN]; !ok {
+		return false, nil
+	}
+	var (
+		hrw    bool
+		moved  = make(map[string]string, len(copies)) // new FQN => old FQN
+		dstMPI = make(fs.MPI, len(copies))
+	)
+	for _, mi := range copies {
+		if mi.Path == dst.mi.Path {
+			hrw = true
+			break
+		}
+	}
+	if !hrw {
+		return false, nil
+	}
+	for oldFQN, mi := range copies {
+		newFQN := mi.MakePathFQN(dst.Bucket(), fs.ObjectType, dst.ObjName)
+		if err := cos.Rename(oldFQN, newFQN); err != nil {
+			for nfqn, ofqn := range moved { // roll back
+				if erb := os.Rename(nfqn, ofqn); erb != nil {
+					nlog.Errorf("%s: failed to roll back rename %s => %s: %v", lom, ofqn, nfqn, erb)
+				}
+			}
+			return false, err
+		}
+		moved[newFQN] = oldFQN
+		dstMPI[newFQN] = mi
+	}
+	lom.Uncache(true /*delDirty*/)
+
+	uname := dst.md.uname
+	dst.md = lom.md
+	dst.md.uname = uname
+	dst.md.copies = nil
+	if len(dstMPI) > 1 {
+		dst.md.copies = dstMPI
+	}
+	lom.md.copies = nil
+	lom.md.bckID = 0
+	if err := dst.Persist(); err != nil {
+		return true, err
+	}
+	return true, dst.syncMetaWithCopies()
+}
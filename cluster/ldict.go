@@ -0,0 +1,161 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/klauspost/compress/zstd"
+)
+
+// At-rest compression of small objects with per-bucket trained dictionaries (cmn.DictConf).
+// A compressed object is stored as a single zstd frame; its LOM carries the dictionary
+// version (cmn.DictVerObjMD) and the original size (cmn.DictSizeObjMD), while the LOM's
+// size and checksum are, respectively, those of the stored file and the original content.
+// Within the bucket (mirroring, EC, rebalance, etc.) compressed objects are handled
+// as is; reading them for users and other buckets goes through DictDecompress.
+
+// dictionaries are immutable - encoders and decoders are cached by bucket ID and version
+var dictCoders sync.Map // "bid/version" => *dictCoder
+
+type dictCoder struct {
+	enc *zstd.Encoder
+	dec *zstd.Decoder
+}
+
+func dictCoderFor(bck *meta.Bck, d *cmn.BckDict) (*dictCoder, error) {
+	key := strconv.FormatUint(bck.Props.BID, 16) + "/" + strconv.Itoa(d.Version)
+	if v, ok := dictCoders.Load(key); ok {
+		return v.(*dictCoder), nil
+	}
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderDict(d.Data), zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(d.Data), zstd.WithDecoderConcurrency(0))
+	if err != nil {
+		enc.Close()
+		return nil, err
+	}
+	v, loaded := dictCoders.LoadOrStore(key, &dictCoder{enc: enc, dec: dec})
+	if loaded {
+		enc.Close()
+		dec.Close()
+	}
+	return v.(*dictCoder), nil
+}
+
+// returns the dictionary version and the original size of a compressed object (zero version otherwise)
+func (lom *LOM) DictInfo() (version int, size int64) {
+	s, ok := lom.GetCustomKey(cmn.DictVerObjMD)
+	if !ok {
+		return 0, lom.SizeBytes()
+	}
+	version, _ = strconv.Atoi(s)
+	s, _ = lom.GetCustomKey(cmn.DictSizeObjMD)
+	size, _ = strconv.ParseInt(s, 10, 64)
+	return version, size
+}
+
+// DictCompress compresses the (not yet finalized) content at `workFQN` with the
+// bucket's current dictionary - iff enabled, applicable, and the result is smaller.
+// NOTE: expects lom's size and checksum to be those of the original content.
+func (lom *LOM) DictCompress(workFQN string) (bool, error) {
+	var (
+		bck  = lom.Bck()
+		conf = &lom.Bprops().Dict
+		size = lom.SizeBytes()
+	)
+	if !conf.Enabled || !bck.IsAIS() || size == 0 || size > conf.ObjSizeLimit() {
+		return false, nil
+	}
+	d := conf.Current()
+	if d == nil {
+		return false, nil
+	}
+	coder, err := dictCoderFor(bck, d)
+	if err != nil {
+		return false, err
+	}
+	data, err := os.ReadFile(workFQN)
+	if err != nil {
+		return false, err
+	}
+	out := coder.enc.EncodeAll(data, make([]byte, 0, len(data)))
+	if len(out) >= len(data) {
+		return false, nil
+	}
+	if err := os.WriteFile(workFQN, out, cos.PermRWR); err != nil {
+		return false, err
+	}
+	lom.SetCustomKey(cmn.DictVerObjMD, strconv.Itoa(d.Version))
+	lom.SetCustomKey(cmn.DictSizeObjMD, strconv.FormatInt(size, 10))
+	lom.SetSize(int64(len(out)))
+	return true, nil
+}
+
+// DictDecompress reads and decompresses a compressed object (see DictInfo).
+// NOTE: expects the object to be at least read-locked.
+func (lom *LOM) DictDecompress() ([]byte, error) {
+	version, size := lom.DictInfo()
+	d := lom.Bprops().Dict.Get(version)
+	if d == nil {
+		return nil, fmt.Errorf("%s: compression dictionary v%d not found", lom.Cname(), version)
+	}
+	coder, err := dictCoderFor(lom.Bck(), d)
+	if err != nil {
+		return nil, err
+	}
+	data, err := lom.readStored()
+	if err != nil {
+		return nil, err
+	}
+	out, err := coder.dec.DecodeAll(data, make([]byte, 0, size))
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to decompress (dictionary v%d): %w", lom.Cname(), version, err)
+	}
+	if int64(len(out)) != size {
+		return nil, fmt.Errorf("%s: decompressed size %d does not match the original %d", lom.Cname(), len(out), size)
+	}
+	return out, nil
+}
+
+// NewUserROC returns the content as the users (and other buckets) see it along with
+// the corresponding attributes: decompressed in memory, decrypted on the fly (see lenc.go),
+// or, for regular objects, opened as is. The returned reader is also an io.ReaderAt.
+// NOTE: expects the object to be at least read-locked.
+func (lom *LOM) NewUserROC() (cos.ReadOpenCloser, cos.OAH, error) {
+	var (
+		roc  cos.ReadOpenCloser
+		size int64
+	)
+	if version, _ := lom.DictInfo(); version != 0 {
+		data, err := lom.DictDecompress()
+		if err != nil {
+			return nil, nil, err
+		}
+		roc, size = cos.NewByteHandle(data), int64(len(data))
+	} else if lom.IsEncrypted() {
+		er, err := lom.newEncReader()
+		if err != nil {
+			return nil, nil, err
+		}
+		roc, size = er, er.size
+	} else {
+		fh, err := cos.NewFileHandle(lom.FQN)
+		return fh, lom, err
+	}
+	oa := &cmn.ObjAttrs{}
+	oa.CopyFrom(lom)
+	oa.Size = size
+	oa.DelCustomKeys(cmn.DictVerObjMD, cmn.DictSizeObjMD, cmn.EncKeyObjMD)
+	return roc, oa, nil
+}
@@ -0,0 +1,443 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// At-rest encryption of the objects stored on designated mountpaths (cmn.EncryptionConf).
+// An encrypted object is stored as a header followed by AES-256-GCM sealed segments:
+//
+//	"AISE" | version | (reserved) | salt (24 bytes) | segment 0 | ... | segment N-1
+//
+// where each segment (except, possibly, the last one) seals encSegSize bytes of the content,
+// the per-object key is HMAC-SHA256(cluster key, salt), the nonce is the segment's index,
+// and the additional data marks the last segment (to detect truncation).
+// Similar to at-rest compression (see ldict.go), the LOM carries the ID of the key
+// (cmn.EncKeyObjMD), while its size and checksum are, respectively, those of the stored
+// file and the original content. Within the cluster (mirroring, EC, rebalance, etc.)
+// encrypted objects are handled as is; reading them for users and other buckets
+// goes through NewUserROC.
+
+const (
+	encMagic    = "AISE"
+	encVersion  = 1
+	encHdrSize  = 32
+	encSaltOff  = 8
+	encSegSize  = 64 * cos.KiB
+	encTagSize  = 16
+	encNonceLen = 12
+
+	encKeyLen     = 32 // AES-256
+	encKMSTimeout = 30 * time.Second
+)
+
+type (
+	encKey struct {
+		raw []byte
+		id  string
+	}
+	// writes the header and seals the content segment by segment
+	encWriter struct {
+		w    io.Writer
+		aead cipher.AEAD
+		buf  []byte // (plaintext segment)
+		out  []byte // (sealed segment)
+		seg  uint64
+	}
+	// decrypts the stored object; the last decrypted segment is cached
+	// (not safe for concurrent use)
+	encReader struct {
+		fh    *os.File
+		fqn   string
+		key   *encKey
+		aead  cipher.AEAD
+		plain []byte
+		cbuf  []byte
+		size  int64 // original
+		nseg  int64
+		cur   int64 // cached segment
+		off   int64 // (Read)
+	}
+)
+
+// interface guard
+var (
+	_ cos.ReadOpenCloser = (*encReader)(nil)
+	_ io.ReaderAt        = (*encReader)(nil)
+)
+
+var encKeys struct {
+	key *encKey
+	src string
+	mu  sync.Mutex
+}
+
+// loads (once per configured source) the cluster-wide key
+func loadEncKey(conf *cmn.EncryptionConf) (*encKey, error) {
+	if conf.KeyFile == "" && conf.KMSURL == "" {
+		return nil, errors.New("encryption key is not configured")
+	}
+	src := conf.KeyFile + "|" + conf.KMSURL
+	encKeys.mu.Lock()
+	defer encKeys.mu.Unlock()
+	if encKeys.key != nil && encKeys.src == src {
+		return encKeys.key, nil
+	}
+	var (
+		b   []byte
+		err error
+	)
+	if conf.KeyFile != "" {
+		b, err = os.ReadFile(conf.KeyFile)
+	} else {
+		b, err = kmsGet(conf.KMSURL)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load encryption key: %w", err)
+	}
+	raw, err := hex.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil || len(raw) != encKeyLen {
+		return nil, fmt.Errorf("invalid encryption key: expecting %d bytes, hex-encoded", encKeyLen)
+	}
+	sum := sha256.Sum256(raw)
+	encKeys.key = &encKey{raw: raw, id: hex.EncodeToString(sum[:4])}
+	encKeys.src = src
+	return encKeys.key, nil
+}
+
+func kmsGet(u string) ([]byte, error) {
+	client := &http.Client{Timeout: encKMSTimeout}
+	resp, err := client.Get(u) //nolint:noctx // (one-time)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("KMS %q: %s", u, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, cos.KiB))
+}
+
+func (k *encKey) objAEAD(salt []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, k.raw)
+	mac.Write(salt)
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func encNonce(seg uint64) []byte {
+	var nonce [encNonceLen]byte
+	binary.BigEndian.PutUint64(nonce[encNonceLen-8:], seg)
+	return nonce[:]
+}
+
+func encAD(final bool) []byte {
+	if final {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// stored size given the original one
+func encStoredSize(size int64) int64 {
+	nseg := max((size+encSegSize-1)/encSegSize, 1)
+	return encHdrSize + size + nseg*encTagSize
+}
+
+// original size (negative if invalid) and the number of segments given the stored size
+func encSize(stored int64) (size, nseg int64) {
+	if stored < encHdrSize+encTagSize {
+		return -1, 0
+	}
+	nseg = (stored - encHdrSize + encSegSize + encTagSize - 1) / (encSegSize + encTagSize)
+	return stored - encHdrSize - nseg*encTagSize, nseg
+}
+
+///////////////
+// encWriter //
+///////////////
+
+func newEncWriter(w io.Writer, key *encKey) (*encWriter, error) {
+	var hdr [encHdrSize]byte
+	copy(hdr[:], encMagic)
+	hdr[len(encMagic)] = encVersion
+	if _, err := rand.Read(hdr[encSaltOff:]); err != nil {
+		return nil, err
+	}
+	aead, err := key.objAEAD(hdr[encSaltOff:])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(hdr[:]); err != nil {
+		return nil, err
+	}
+	ew := &encWriter{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, encSegSize),
+		out:  make([]byte, 0, encSegSize+encTagSize),
+	}
+	return ew, nil
+}
+
+func (ew *encWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		// a full segment gets sealed only when there's more to write (and so it is not the last one)
+		if len(ew.buf) == encSegSize {
+			if err = ew.seal(false); err != nil {
+				return n, err
+			}
+		}
+		k := copy(ew.buf[len(ew.buf):encSegSize], p)
+		ew.buf = ew.buf[:len(ew.buf)+k]
+		p = p[k:]
+		n += k
+	}
+	return n, nil
+}
+
+func (ew *encWriter) seal(final bool) error {
+	ew.out = ew.aead.Seal(ew.out[:0], encNonce(ew.seg), ew.buf, encAD(final))
+	ew.buf = ew.buf[:0]
+	ew.seg++
+	_, err := ew.w.Write(ew.out)
+	return err
+}
+
+// seals the last segment (does not close the underlying writer)
+func (ew *encWriter) Close() error { return ew.seal(true) }
+
+///////////////
+// encReader //
+///////////////
+
+func newEncReader(fqn string, key *encKey) (*encReader, error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
+	er := &encReader{fh: fh, fqn: fqn, key: key, cur: -1}
+	if err := er.init(); err != nil {
+		fh.Close()
+		return nil, err
+	}
+	return er, nil
+}
+
+func (er *encReader) init() error {
+	finfo, err := er.fh.Stat()
+	if err != nil {
+		return err
+	}
+	if er.size, er.nseg = encSize(finfo.Size()); er.size < 0 {
+		return fmt.Errorf("%s: invalid encrypted size %d", er.fqn, finfo.Size())
+	}
+	var hdr [encHdrSize]byte
+	if _, err := er.fh.ReadAt(hdr[:], 0); err != nil {
+		return err
+	}
+	if string(hdr[:len(encMagic)]) != encMagic || hdr[len(encMagic)] != encVersion {
+		return fmt.Errorf("%s: invalid encryption header", er.fqn)
+	}
+	if er.aead, err = er.key.objAEAD(hdr[encSaltOff:]); err != nil {
+		return err
+	}
+	er.plain = make([]byte, 0, encSegSize)
+	er.cbuf = make([]byte, encSegSize+encTagSize)
+	return nil
+}
+
+func (er *encReader) segment(idx int64) error {
+	if idx == er.cur {
+		return nil
+	}
+	var (
+		off   = encHdrSize + idx*(encSegSize+encTagSize)
+		n     = encSegSize + encTagSize
+		final = idx == er.nseg-1
+	)
+	if final {
+		n = int(er.size-idx*encSegSize) + encTagSize
+	}
+	cbuf := er.cbuf[:n]
+	if _, err := er.fh.ReadAt(cbuf, off); err != nil {
+		return err
+	}
+	plain, err := er.aead.Open(er.plain[:0], encNonce(uint64(idx)), cbuf, encAD(final))
+	if err != nil {
+		er.cur = -1
+		return fmt.Errorf("%s: failed to decrypt segment %d: %w", er.fqn, idx, err)
+	}
+	er.plain, er.cur = plain, idx
+	return nil
+}
+
+func (er *encReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, fmt.Errorf("%s: negative offset %d", er.fqn, off)
+	}
+	for len(p) > 0 {
+		if off >= er.size {
+			return n, io.EOF
+		}
+		idx := off / encSegSize
+		if err = er.segment(idx); err != nil {
+			return n, err
+		}
+		k := copy(p, er.plain[off-idx*encSegSize:])
+		p = p[k:]
+		n += k
+		off += int64(k)
+	}
+	return n, nil
+}
+
+func (er *encReader) Read(p []byte) (n int, err error) {
+	n, err = er.ReadAt(p, er.off)
+	er.off += int64(n)
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+func (er *encReader) Close() error { return er.fh.Close() }
+
+func (er *encReader) Open() (cos.ReadOpenCloser, error) { return newEncReader(er.fqn, er.key) }
+
+/////////
+// LOM //
+/////////
+
+// whether the object is stored encrypted
+func (lom *LOM) IsEncrypted() bool {
+	_, ok := lom.GetCustomKey(cmn.EncKeyObjMD)
+	return ok
+}
+
+// whether the object is stored compressed (see ldict.go) and/or encrypted
+func (lom *LOM) IsEncoded() bool {
+	version, _ := lom.DictInfo()
+	return version != 0 || lom.IsEncrypted()
+}
+
+// UserSize returns the size of the content as the users see it
+func (lom *LOM) UserSize() int64 {
+	if version, size := lom.DictInfo(); version != 0 {
+		return size
+	}
+	if lom.IsEncrypted() {
+		size, _ := encSize(lom.SizeBytes())
+		return size
+	}
+	return lom.SizeBytes()
+}
+
+// Encrypt encrypts the (not yet finalized) content at `workFQN` iff the object's
+// mountpath is designated (cmn.EncryptionConf.Label).
+// NOTE: expects lom's size and checksum to be those of the original content.
+func (lom *LOM) Encrypt(workFQN string) (bool, error) {
+	conf := &cmn.GCO.Get().Encryption
+	if conf.Label == "" || !fs.AnyLabeled() || lom.mi.Label != conf.Label {
+		return false, nil
+	}
+	key, err := loadEncKey(conf)
+	if err != nil {
+		return false, err
+	}
+	encFQN := workFQN + ".enc"
+	if err := lom.encryptTo(encFQN, workFQN, key); err != nil {
+		cos.RemoveFile(encFQN)
+		return false, err
+	}
+	if err := os.Rename(encFQN, workFQN); err != nil {
+		cos.RemoveFile(encFQN)
+		return false, err
+	}
+	lom.SetCustomKey(cmn.EncKeyObjMD, key.id)
+	lom.SetSize(encStoredSize(lom.SizeBytes()))
+	return true, nil
+}
+
+func (lom *LOM) encryptTo(dstFQN, srcFQN string, key *encKey) error {
+	src, err := os.Open(srcFQN)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(dstFQN, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	ew, err := newEncWriter(dst, key)
+	if err != nil {
+		dst.Close()
+		return err
+	}
+	buf, slab := T.PageMM().Alloc()
+	_, err = io.CopyBuffer(ew, src, buf)
+	slab.Free(buf)
+	if err == nil {
+		err = ew.Close()
+	}
+	if err == nil && lom.Bprops().Durability.Get().Fsync() {
+		err = dst.Sync()
+	}
+	if errC := dst.Close(); err == nil {
+		err = errC
+	}
+	return err
+}
+
+// NOTE: expects the object to be at least read-locked.
+func (lom *LOM) newEncReader() (*encReader, error) {
+	id, _ := lom.GetCustomKey(cmn.EncKeyObjMD)
+	key, err := loadEncKey(&cmn.GCO.Get().Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", lom.Cname(), err)
+	}
+	if key.id != id {
+		return nil, fmt.Errorf("%s: encrypted with a different key (%q vs configured %q)", lom.Cname(), id, key.id)
+	}
+	return newEncReader(lom.FQN, key)
+}
+
+// reads the entire stored content - decrypted, if need be
+func (lom *LOM) readStored() ([]byte, error) {
+	if !lom.IsEncrypted() {
+		return os.ReadFile(lom.FQN)
+	}
+	er, err := lom.newEncReader()
+	if err != nil {
+		return nil, err
+	}
+	defer er.Close()
+	data := make([]byte, er.size)
+	_, err = io.ReadFull(er, data)
+	return data, err
+}
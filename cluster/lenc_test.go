@@ -0,0 +1,97 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/tools/trand"
+)
+
+func encTestFile(t *testing.T, key *encKey, data []byte) string {
+	fqn := filepath.Join(t.TempDir(), trand.String(8))
+	fh, err := os.Create(fqn)
+	tassert.CheckFatal(t, err)
+	ew, err := newEncWriter(fh, key)
+	tassert.CheckFatal(t, err)
+	_, err = ew.Write(data)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, ew.Close())
+	tassert.CheckFatal(t, fh.Close())
+	return fqn
+}
+
+func TestEncRoundTrip(t *testing.T) {
+	key := &encKey{raw: bytes.Repeat([]byte{7}, encKeyLen), id: "test"}
+	for _, size := range []int{0, 1, encSegSize - 1, encSegSize, encSegSize + 1, 3*encSegSize + 5} {
+		data := []byte(trand.String(size))
+		fqn := encTestFile(t, key, data)
+
+		finfo, err := os.Stat(fqn)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, finfo.Size() == encStoredSize(int64(size)), "size %d: stored %d, expected %d",
+			size, finfo.Size(), encStoredSize(int64(size)))
+		osize, _ := encSize(finfo.Size())
+		tassert.Fatalf(t, osize == int64(size), "size %d: got %d", size, osize)
+
+		er, err := newEncReader(fqn, key)
+		tassert.CheckFatal(t, err)
+		out, err := io.ReadAll(er)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(out, data), "size %d: content mismatch", size)
+
+		if size > 2 {
+			off := int64(size / 2)
+			p := make([]byte, size-int(off))
+			n, err := er.ReadAt(p, off)
+			tassert.Fatalf(t, n == len(p) && (err == nil || err == io.EOF), "size %d: ReadAt(%d): %d, %v", size, off, n, err)
+			tassert.Fatalf(t, bytes.Equal(p, data[off:]), "size %d: ReadAt content mismatch", size)
+		}
+		er.Close()
+	}
+}
+
+func TestEncTamper(t *testing.T) {
+	var (
+		key  = &encKey{raw: bytes.Repeat([]byte{9}, encKeyLen), id: "test"}
+		data = []byte(trand.String(2*encSegSize + 100))
+	)
+	// flip a byte
+	fqn := encTestFile(t, key, data)
+	b, err := os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	b[encHdrSize+10] ^= 0xff
+	tassert.CheckFatal(t, os.WriteFile(fqn, b, 0o644))
+	er, err := newEncReader(fqn, key)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(er)
+	tassert.Errorf(t, err != nil, "expected authentication failure")
+	er.Close()
+
+	// drop the last segment
+	fqn = encTestFile(t, key, data)
+	b, err = os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, os.WriteFile(fqn, b[:encHdrSize+2*(encSegSize+encTagSize)], 0o644))
+	er, err = newEncReader(fqn, key)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(er)
+	tassert.Errorf(t, err != nil, "expected truncation to be detected")
+	er.Close()
+
+	// wrong key
+	fqn = encTestFile(t, key, data)
+	other := &encKey{raw: bytes.Repeat([]byte{1}, encKeyLen), id: "other"}
+	er, err = newEncReader(fqn, other)
+	tassert.CheckFatal(t, err)
+	_, err = io.ReadAll(er)
+	tassert.Errorf(t, err != nil, "expected decryption with a wrong key to fail")
+	er.Close()
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/transport"
@@ -124,6 +125,7 @@ func (lom *LOM) SetCustomMD(md cos.StrKVs) { lom.md.SetCustomMD(md) }
 
 func (lom *LOM) GetCustomKey(key string) (string, bool) { return lom.md.GetCustomKey(key) }
 func (lom *LOM) SetCustomKey(key, value string)         { lom.md.SetCustomKey(key, value) }
+func (lom *LOM) DelCustomKey(key string)                { lom.md.DelCustomKeys(key) }
 
 // lom <= transport.ObjHdr (NOTE: caller must call freeLOM)
 func AllocLomFromHdr(hdr *transport.ObjHdr) (lom *LOM, err error) {
@@ -136,7 +138,7 @@ func AllocLomFromHdr(hdr *transport.ObjHdr) (lom *LOM, err error) {
 }
 
 func (lom *LOM) ECEnabled() bool { return lom.Bprops().EC.Enabled }
-func (lom *LOM) IsHRW() bool     { return lom.HrwFQN == lom.FQN } // subj to resilvering
+func (lom *LOM) IsHRW() bool     { return lom.HrwFQN == lom.FQN || lom.isDemoted() } // subj to resilvering
 
 func (lom *LOM) Bprops() *cmn.BucketProps { return lom.bck.Props }
 
@@ -328,11 +330,20 @@ func (lom *LOM) ComputeCksum(cksumType string) (cksum *cos.CksumHash, err error)
 	if cksumType == cos.ChecksumNone {
 		return
 	}
+	if lom.IsEncoded() { // (the checksum of the original content)
+		var roc cos.ReadOpenCloser
+		if roc, _, err = lom.NewUserROC(); err != nil {
+			return
+		}
+		_, cksum, err = cos.CopyAndChecksum(io.Discard, roc, nil, cksumType)
+		cos.Close(roc)
+		return
+	}
 	if file, err = os.Open(lom.FQN); err != nil {
 		return
 	}
 	// No need to allocate `buf` as `io.Discard` has efficient `io.ReaderFrom` implementation.
-	_, cksum, err = cos.CopyAndChecksum(io.Discard, file, nil, cksumType)
+	cos.Offload(cos.PrioFg, func() { _, cksum, err = cos.CopyAndChecksum(io.Discard, file, nil, cksumType) })
 	cos.Close(file)
 	if err != nil {
 		return nil, err
@@ -491,15 +502,120 @@ func (lom *LOM) Remove(force ...bool) (err error) {
 	if os.IsNotExist(err) {
 		err = nil
 	}
+	fs.RemoveSidecar(lom.FQN, XattrLOM)
 	for copyFQN := range lom.md.copies {
 		if erc := cos.RemoveFile(copyFQN); erc != nil && !os.IsNotExist(erc) {
 			err = erc
 		}
+		fs.RemoveSidecar(copyFQN, XattrLOM)
+	}
+	if lom.FQN == lom.HrwFQN {
+		lom.rmDemoted() // (stale, if any)
 	}
 	lom.md.bckID = 0
 	return
 }
 
+// MoveToTrash is Remove that keeps the object's main replica - along with its (persisted)
+// metadata - in the mountpath's trash (fs.TrashType), to be undeleted or, upon expiration,
+// removed by the janitor; copies, if any, are removed
+func (lom *LOM) MoveToTrash() error {
+	debug.AssertFunc(func() bool {
+		_, exclusive := lom.IsLocked()
+		return exclusive
+	})
+	var (
+		copies   = lom.md.copies
+		trashFQN = lom.mi.MakePathFQN(lom.Bucket(), fs.TrashType, lom.ObjName)
+	)
+	lom.md.copies = nil
+	buf, mm := lom.marshal()
+	err := fs.SetXattr(lom.FQN, XattrLOM, buf)
+	mm.Free(buf)
+	lom.md.copies = copies
+	if err == nil {
+		err = cos.CreateDir(filepath.Dir(trashFQN))
+	}
+	if err == nil {
+		err = os.Rename(lom.FQN, trashFQN)
+	}
+	if err == nil {
+		fs.RenameSidecar(lom.FQN, trashFQN, XattrLOM)
+	} else if !os.IsNotExist(err) {
+		nlog.Warningf("failed to move %s to trash (%v) - removing", lom, err)
+	}
+	return lom.Remove()
+}
+
+// Undelete restores the object from the trash of any available mountpath
+// (the caller must make sure the object does not exist)
+func (lom *LOM) Undelete() error {
+	debug.AssertFunc(func() bool {
+		_, exclusive := lom.IsLocked()
+		return exclusive
+	})
+	var (
+		trashFQN string
+		trashMi  *fs.Mountpath
+		avail    = fs.GetAvail()
+	)
+	if mi := lom.mi; mi != nil && avail[mi.Path] != nil {
+		if fqn := mi.MakePathFQN(lom.Bucket(), fs.TrashType, lom.ObjName); cos.Stat(fqn) == nil {
+			trashFQN, trashMi = fqn, mi
+		}
+	}
+	if trashFQN == "" {
+		for _, mi := range avail {
+			fqn := mi.MakePathFQN(lom.Bucket(), fs.TrashType, lom.ObjName)
+			if cos.Stat(fqn) == nil {
+				trashFQN, trashMi = fqn, mi
+				break
+			}
+		}
+	}
+	if trashFQN == "" {
+		return cos.NewErrNotFound("%s in trash", lom.Cname())
+	}
+	// (metadata does not survive cross-mountpath copy)
+	md, err := fs.GetXattr(trashFQN, XattrLOM)
+	if err != nil {
+		return err
+	}
+	if err := cos.CreateDir(filepath.Dir(lom.FQN)); err != nil {
+		return err
+	}
+	if trashMi == lom.mi {
+		err = cos.Rename(trashFQN, lom.FQN)
+	} else {
+		err = lom.undeleteXmpath(trashFQN)
+	}
+	if err != nil {
+		return err
+	}
+	fs.RemoveSidecar(trashFQN, XattrLOM)
+	if err := fs.SetXattr(lom.FQN, XattrLOM, md); err != nil {
+		return err
+	}
+	lom.Uncache(true /*delDirty*/)
+	return lom.Load(false /*cache it*/, true /*locked*/)
+}
+
+// trashed on a different mountpath (e.g., prior to resilvering): mountpaths may reside
+// on different filesystems - copy to a workfile, rename into place, and remove the source
+func (lom *LOM) undeleteXmpath(trashFQN string) error {
+	workFQN := lom.mi.MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+	if _, _, err := cos.CopyFile(trashFQN, workFQN, nil, cos.ChecksumNone); err != nil {
+		return err
+	}
+	if err := cos.Rename(workFQN, lom.FQN); err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil {
+			nlog.Errorf(fmtNestedErr, errRemove)
+		}
+		return err
+	}
+	return cos.RemoveFile(trashFQN)
+}
+
 //
 // evict lom cache
 //
@@ -579,8 +695,15 @@ func (lom *LOM) Unlock(exclusive bool) {
 }
 
 // (compare with cos.CreateFile)
-func (lom *LOM) CreateFile(fqn string) (fh *os.File, err error) {
-	fh, err = os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+func (lom *LOM) CreateFile(fqn string) (*os.File, error) { return lom.createFile(fqn, os.OpenFile) }
+
+// same as above with the OS page cache bypassed (see cmn.DirectIOConf and fs.DirectWriter)
+func (lom *LOM) CreateFileDirect(fqn string) (*os.File, error) {
+	return lom.createFile(fqn, fs.TryDirectOpen)
+}
+
+func (lom *LOM) createFile(fqn string, open func(string, int, os.FileMode) (*os.File, error)) (fh *os.File, err error) {
+	fh, err = open(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
 	if err == nil || !os.IsNotExist(err) {
 		return
 	}
@@ -593,7 +716,7 @@ func (lom *LOM) CreateFile(fqn string) (fh *os.File, err error) {
 	if err = cos.CreateDir(fdir); err != nil {
 		return
 	}
-	fh, err = os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+	fh, err = open(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
 	return
 }
 
@@ -6,6 +6,7 @@ package cluster
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -21,6 +22,13 @@ type (
 	DP interface {
 		Reader(lom *LOM) (reader cos.ReadOpenCloser, oah cos.OAH, err error)
 	}
+	// (optional) data provider that can also transform an arbitrary payload
+	// that is not stored as an object - e.g., a TAR-formatted group of objects
+	// (see many-to-one transforms in ext/etl)
+	PayloadDP interface {
+		DP
+		PayloadReader(name string, payload io.Reader, size int64) (reader cos.ReadOpenCloser, oah cos.OAH, err error)
+	}
 
 	LDP struct{}
 
@@ -55,6 +63,11 @@ func (*LDP) Reader(lom *LOM) (cos.ReadOpenCloser, cos.OAH, error) {
 	lom.Lock(false)
 	loadErr := lom.Load(false /*cache it*/, true /*locked*/)
 	if loadErr == nil {
+		if lom.IsEncoded() { // at-rest compressed and/or encrypted
+			roc, oah, err := lom.NewUserROC()
+			lom.Unlock(false)
+			return roc, oah, err
+		}
 		roc, err := lom.NewDeferROC()
 		return roc, lom, err
 	}
@@ -994,6 +994,54 @@ var _ = Describe("LOM", func() {
 		})
 	})
 
+	Describe("Trash", func() {
+		It("should move object to trash and undelete it", func() {
+			const size = 456
+			testObjectName := "trash-foldr/test-obj.ext"
+			localFQN := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObjectName)
+			trashFQN := mis[0].MakePathFQN(&localBckA, fs.TrashType, testObjectName)
+
+			lom := filePut(localFQN, size)
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+			Expect(lom.MoveToTrash()).NotTo(HaveOccurred())
+			Expect(localFQN).NotTo(BeAnExistingFile())
+			Expect(trashFQN).To(BeAnExistingFile())
+
+			lom = NewBasicLom(localFQN)
+			Expect(lom.Undelete()).NotTo(HaveOccurred())
+			Expect(localFQN).To(BeAnExistingFile())
+			Expect(trashFQN).NotTo(BeAnExistingFile())
+			Expect(lom.SizeBytes()).To(BeEquivalentTo(size))
+
+			// nothing left in trash
+			Expect(lom.Undelete()).To(HaveOccurred())
+		})
+
+		It("should undelete object trashed on another mountpath", func() {
+			const size = 789
+			testObjectName := "trash-foldr/test-obj-xmpath.ext"
+			localFQN := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObjectName)
+			trashFQN := mis[0].MakePathFQN(&localBckA, fs.TrashType, testObjectName)
+			otherFQN := mis[1].MakePathFQN(&localBckA, fs.TrashType, testObjectName)
+
+			lom := filePut(localFQN, size)
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+			Expect(lom.MoveToTrash()).NotTo(HaveOccurred())
+			Expect(cos.Rename(trashFQN, otherFQN)).NotTo(HaveOccurred())
+			fs.RenameSidecar(trashFQN, otherFQN, cluster.XattrLOM)
+
+			lom = NewBasicLom(localFQN)
+			Expect(lom.Undelete()).NotTo(HaveOccurred())
+			Expect(localFQN).To(BeAnExistingFile())
+			Expect(otherFQN).NotTo(BeAnExistingFile())
+			Expect(lom.SizeBytes()).To(BeEquivalentTo(size))
+		})
+	})
+
 	Describe("local and cloud bucket with the same name", func() {
 		It("should have different fqn", func() {
 			testObject := "foldr/test-obj.ext"
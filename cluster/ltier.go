@@ -0,0 +1,142 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// LOM tiering (see cmn.SpaceConf.FastTier):
+// - new objects are written to their HRW mountpaths on the fast tier (see MpathLabel);
+// - cold objects get demoted to their HRW mountpaths on the capacity tier (TierDown), and
+// - promoted back upon access (TierUp)
+//
+
+func fastTier() (label string) {
+	if fs.AnyLabeled() {
+		label = cmn.GCO.Get().Space.FastTier
+	}
+	return
+}
+
+// whether the object resides on its capacity-tier HRW mountpath
+func (lom *LOM) isDemoted() bool {
+	label := fastTier()
+	if label == "" || lom.mi.Label == label || lom.Bucket().MpathLabel() != label {
+		return false
+	}
+	mi := HrwCapacityMpath(lom.Uname(), label)
+	return mi != nil && mi.Path == lom.mi.Path
+}
+
+// returns the object's (existing) capacity-tier FQN, if any
+func (lom *LOM) demotedFQN(label string) string {
+	if lom.mi.Label != label {
+		return "" // not placed on the fast tier
+	}
+	mi := HrwCapacityMpath(lom.Uname(), label)
+	if mi == nil || mi.Path == lom.mi.Path {
+		return ""
+	}
+	fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+	if cos.Stat(fqn) != nil {
+		return ""
+	}
+	return fqn
+}
+
+// whether the object has been demoted (and not promoted since)
+func (lom *LOM) HasDemoted() bool {
+	label := fastTier()
+	return label != "" && lom.demotedFQN(label) != ""
+}
+
+// NOTE: under w-lock
+func (lom *LOM) rmDemoted() {
+	label := fastTier()
+	if label == "" {
+		return
+	}
+	if fqn := lom.demotedFQN(label); fqn != "" {
+		if err := cos.RemoveFile(fqn); err != nil {
+			nlog.Errorf("%s: failed to remove demoted %q: %v", lom, fqn, err)
+		}
+	}
+}
+
+// TierUp promotes the object (that's not found at its fast-tier HRW location)
+// from the capacity tier - and returns false if there's nothing to promote
+// (compare with RestoreToLocation)
+func (lom *LOM) TierUp() (exists bool) {
+	label := fastTier()
+	if label == "" {
+		return
+	}
+	fqn := lom.demotedFQN(label)
+	if fqn == "" {
+		return
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err == nil {
+		return true // promoted (or written) in the meantime
+	}
+	var (
+		saved     = lom.md.pushrt()
+		buf, slab = T.PageMM().Alloc()
+	)
+	dst, err := lom._restore(fqn, buf)
+	slab.Free(buf)
+	if err != nil {
+		if !cmn.IsObjNotExist(err) {
+			nlog.Errorf("%s: failed to promote %q: %v", lom, fqn, err)
+		}
+		return
+	}
+	lom.md = dst.md
+	lom.md.poprt(saved)
+	FreeLOM(dst)
+
+	// remove the capacity-tier replica (in a mirrored bucket, a copy - see copy2fqn)
+	if _, ok := lom.md.copies[fqn]; ok {
+		if err = lom.DelCopies(fqn); err == nil {
+			err = lom.Persist()
+		}
+	} else {
+		err = cos.RemoveFile(fqn)
+	}
+	if err != nil {
+		nlog.Errorf("%s: failed to remove demoted %q: %v", lom, fqn, err)
+	}
+	return true
+}
+
+// TierDown moves the object from the fast tier to its capacity-tier HRW mountpath;
+// objects with copies, objects that are already on the capacity tier, and those with
+// no capacity tier to go to are skipped (returning false)
+// NOTE: caller must w-lock and load
+func (lom *LOM) TierDown(label string, buf []byte) (demoted bool, err error) {
+	if lom.mi.Label != label || lom.HasCopies() || lom.MirrorConf().Enabled {
+		return
+	}
+	mi := HrwCapacityMpath(lom.Uname(), label)
+	if mi == nil {
+		return
+	}
+	dst, err := lom.Copy2FQN(mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName), buf)
+	if err != nil {
+		return
+	}
+	FreeLOM(dst)
+	lom.Uncache(true /*delDirty*/)
+	if err = cos.RemoveFile(lom.FQN); err != nil {
+		return
+	}
+	return true, nil
+}
@@ -29,6 +29,7 @@ const (
 	SnodeMaint
 	SnodeDecomm
 	SnodeMaintPostReb
+	SnodeSpare // standby target: joined in maintenance mode, holds no data (see HrwID)
 )
 
 const SnodeMaintDecomm = SnodeMaint | SnodeDecomm
@@ -72,7 +73,8 @@ type (
 		DaeType    string     `json:"daemon_type"`       // "target" or "proxy"
 		DaeID      string     `json:"daemon_id"`
 		name       string
-		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
+		Flags      cos.BitFlags `json:"flags"`            // enum { SnodeNonElectable, SnodeIC, ... }
+		HrwID      string       `json:"hrw_id,omitempty"` // when activated in place of a failed target: its HRW position
 		idDigest   uint64
 	}
 
@@ -118,10 +120,28 @@ func (d *Snode) Digest() uint64 { return d.idDigest }
 
 func (d *Snode) setDigest() {
 	if d.idDigest == 0 {
-		d.idDigest = xxhash.ChecksumString64S(d.ID(), cos.MLCG32)
+		d.idDigest = xxhash.ChecksumString64S(d.HrwOwner(), cos.MLCG32)
 	}
 }
 
+// the ID that determines the node's HRW position - its own unless it has taken over
+// the position of a (failed) target
+func (d *Snode) HrwOwner() string {
+	if d.HrwID != "" {
+		return d.HrwID
+	}
+	return d.ID()
+}
+
+func (d *Snode) SetHrwID(id string) {
+	if id == d.ID() {
+		id = ""
+	}
+	d.HrwID = id
+	d.idDigest = 0
+	d.setDigest()
+}
+
 func (d *Snode) ID() string   { return d.DaeID }
 func (d *Snode) Type() string { return d.DaeType } // enum { apc.Proxy, apc.Target }
 
@@ -268,6 +288,7 @@ func (d *Snode) InMaintPostReb() bool {
 }
 func (d *Snode) nonElectable() bool { return d.Flags.IsSet(SnodeNonElectable) }
 func (d *Snode) IsIC() bool         { return d.Flags.IsSet(SnodeIC) }
+func (d *Snode) IsSpare() bool      { return d.Flags.IsSet(SnodeSpare) }
 
 func (d *Snode) Fl2S() string {
 	if d.Flags == 0 {
@@ -279,6 +300,8 @@ func (d *Snode) Fl2S() string {
 		a = append(a, "non-elect")
 	case d.Flags&SnodeIC != 0:
 		a = append(a, "ic")
+	case d.Flags&SnodeSpare != 0:
+		a = append(a, "spare")
 	case d.Flags&SnodeMaint != 0:
 		a = append(a, "maintenance-mode")
 	case d.Flags&SnodeDecomm != 0:
@@ -0,0 +1,533 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// LDAP (Active Directory) login: users that are not registered with AuthN
+// authenticate with their directory credentials, and the directory groups they
+// belong to get mapped to AuthN roles (see authn.LDAPConf.GroupRoles).
+//
+// The sequence:
+//  1. bind as the service account (or anonymously) and look up the user's DN and
+//     groups; resolve nested groups (groups' own group membership) up to the
+//     configured depth;
+//  2. bind as the user to verify the password.
+// The result of step 1 (DN and roles) is cached for the configured TTL - the
+// password, on the other hand, is verified with every login.
+//
+// Implements the minimal subset of LDAPv3 (RFC 4511): simple bind, search with
+// equality and presence filters, and unbind.
+
+const (
+	ldapDfltUserAttr  = "uid"
+	ldapDfltGroupAttr = "memberOf"
+	ldapDfltCacheTTL  = 5 * time.Minute
+	ldapMaxMsgSize    = 16 * 1024 * 1024
+)
+
+// BER tags
+const (
+	berBool    = 0x01
+	berInt     = 0x02
+	berOctets  = 0x04
+	berEnum    = 0x0a
+	berSeq     = 0x30
+	berCtxPrim = 0x80 // context-specific, primitive
+	berCtxCons = 0xa0 // context-specific, constructed
+	berAppPrim = 0x40
+	berAppCons = 0x60
+)
+
+// LDAP protocol operations (application tags)
+const (
+	ldapBindRequest   = 0
+	ldapBindResponse  = 1
+	ldapUnbindRequest = 2
+	ldapSearchRequest = 3
+	ldapSearchEntry   = 4
+	ldapSearchDone    = 5
+	ldapSearchRef     = 19
+)
+
+const (
+	ldapScopeBase = 0
+	ldapScopeSub  = 2
+
+	ldapResultSuccess   = 0
+	ldapResultNoSuchObj = 32
+)
+
+type (
+	ldapAuth struct {
+		cache map[string]*ldapCached // login name => cached DN and roles
+		mu    sync.Mutex
+	}
+	ldapCached struct {
+		dn      string
+		roles   []string
+		expires time.Time
+	}
+	ldapConn struct {
+		conn  net.Conn
+		r     *bufio.Reader
+		msgID int
+	}
+	ldapEntry struct {
+		dn    string
+		attrs map[string][]string // lowercased attribute name => values
+	}
+	// BER element
+	ber struct {
+		tag      byte
+		value    []byte
+		children []*ber
+	}
+	errLDAPResult struct {
+		op   string
+		code int
+		msg  string
+	}
+)
+
+var errLDAPNoUser = errors.New("ldap: user not found")
+
+func newLDAPAuth() *ldapAuth {
+	return &ldapAuth{cache: make(map[string]*ldapCached, 16)}
+}
+
+func (e *errLDAPResult) Error() string {
+	return fmt.Sprintf("ldap %s: result code %d (%s)", e.op, e.code, e.msg)
+}
+
+// login authenticates the user with the directory and returns an AuthN user
+// with roles derived from the user's groups
+func (la *ldapAuth) login(conf *authn.LDAPConf, userID, pwd string) (*authn.User, error) {
+	if pwd == "" {
+		return nil, errInvalidCredentials // (an empty password would be an unauthenticated bind)
+	}
+	c, err := ldapDial(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer c.close()
+
+	cached := la.get(userID)
+	if cached == nil {
+		if cached, err = la.lookup(c, conf, userID); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.bind(cached.dn, pwd); err != nil {
+		nlog.Warningf("ldap: failed to bind as %q: %v", cached.dn, err)
+		la.del(userID)
+		return nil, errInvalidCredentials
+	}
+	if len(cached.roles) == 0 {
+		return nil, fmt.Errorf("ldap: user %q does not belong to any group that maps to %s role", userID, svcName)
+	}
+	return &authn.User{ID: userID, Roles: cached.roles}, nil
+}
+
+func (la *ldapAuth) lookup(c *ldapConn, conf *authn.LDAPConf, userID string) (*ldapCached, error) {
+	if err := c.bind(conf.BindDN, conf.BindPassword); err != nil {
+		return nil, fmt.Errorf("ldap: failed to bind as service account %q: %w", conf.BindDN, err)
+	}
+	var (
+		userAttr  = ldapDflt(conf.UserAttr, ldapDfltUserAttr)
+		groupAttr = ldapDflt(conf.GroupAttr, ldapDfltGroupAttr)
+	)
+	entries, err := c.search(conf.UserBaseDN, ldapScopeSub, berEq(userAttr, userID), groupAttr)
+	if err != nil {
+		return nil, err
+	}
+	switch len(entries) {
+	case 0:
+		return nil, errLDAPNoUser
+	case 1:
+	default:
+		return nil, fmt.Errorf("ldap: %s=%s is ambiguous (found %d entries)", userAttr, userID, len(entries))
+	}
+	groups, err := c.resolveGroups(entries[0].attrs[strings.ToLower(groupAttr)], groupAttr, conf.NestedDepth)
+	if err != nil {
+		return nil, err
+	}
+	cached := &ldapCached{dn: entries[0].dn, roles: ldapRoles(conf.GroupRoles, groups)}
+	ttl := time.Duration(conf.CacheTTL)
+	if ttl == 0 {
+		ttl = ldapDfltCacheTTL
+	}
+	if ttl > 0 {
+		cached.expires = time.Now().Add(ttl)
+		la.mu.Lock()
+		la.cache[userID] = cached
+		la.mu.Unlock()
+	}
+	return cached, nil
+}
+
+func (la *ldapAuth) get(userID string) *ldapCached {
+	la.mu.Lock()
+	defer la.mu.Unlock()
+	cached, ok := la.cache[userID]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(cached.expires) {
+		delete(la.cache, userID)
+		return nil
+	}
+	return cached
+}
+
+func (la *ldapAuth) del(userID string) {
+	la.mu.Lock()
+	delete(la.cache, userID)
+	la.mu.Unlock()
+}
+
+// ldapRoles maps group DNs to AuthN roles; a mapping can be keyed by the group's
+// DN or its CN (both case-insensitive)
+func ldapRoles(mapping map[string][]string, groups []string) (roles []string) {
+	if len(mapping) == 0 {
+		return
+	}
+	lower := make(map[string][]string, len(mapping))
+	for k, v := range mapping {
+		lower[strings.ToLower(k)] = v
+	}
+	seen := make(map[string]struct{}, 4)
+	for _, dn := range groups {
+		mapped, ok := lower[strings.ToLower(dn)]
+		if !ok {
+			mapped = lower[strings.ToLower(ldapCN(dn))]
+		}
+		for _, role := range mapped {
+			if _, ok := seen[role]; !ok {
+				seen[role] = struct{}{}
+				roles = append(roles, role)
+			}
+		}
+	}
+	return
+}
+
+// "CN=ml-team,OU=Groups,DC=corp,DC=com" => "ml-team"
+func ldapCN(dn string) string {
+	rdn, _, _ := strings.Cut(dn, ",")
+	if name, value, ok := strings.Cut(rdn, "="); ok && strings.EqualFold(strings.TrimSpace(name), "cn") {
+		return strings.TrimSpace(value)
+	}
+	return ""
+}
+
+func ldapDflt(val, dflt string) string {
+	if val == "" {
+		return dflt
+	}
+	return val
+}
+
+//////////////
+// ldapConn //
+//////////////
+
+func ldapDial(conf *authn.LDAPConf) (*ldapConn, error) {
+	u, err := url.Parse(conf.URL)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: invalid URL %q: %v", conf.URL, err)
+	}
+	var (
+		conn    net.Conn
+		host    = u.Host
+		timeout = time.Duration(Conf.Timeout.Default)
+		dialer  = &net.Dialer{Timeout: timeout}
+	)
+	switch u.Scheme {
+	case "ldap":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "389")
+		}
+		conn, err = dialer.Dial("tcp", host)
+	case "ldaps":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "636")
+		}
+		tlsConf := &tls.Config{ServerName: u.Hostname(), InsecureSkipVerify: conf.SkipVerify} //nolint:gosec // configurable
+		conn, err = tls.DialWithDialer(dialer, "tcp", host, tlsConf)
+	default:
+		return nil, fmt.Errorf("ldap: invalid URL scheme %q (expecting ldap or ldaps)", u.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if timeout > 0 {
+		conn.SetDeadline(time.Now().Add(timeout))
+	}
+	return &ldapConn{conn: conn, r: bufio.NewReader(conn)}, nil
+}
+
+func (c *ldapConn) close() {
+	c.send(berApp(ldapUnbindRequest, false, nil))
+	c.conn.Close()
+}
+
+func (c *ldapConn) send(op []byte) error {
+	c.msgID++
+	msg := berTLV(berSeq, append(berInteger(berInt, int64(c.msgID)), op...))
+	_, err := c.conn.Write(msg)
+	return err
+}
+
+func (c *ldapConn) recv() (*ber, error) {
+	msg, err := berRead(c.r)
+	if err != nil {
+		return nil, err
+	}
+	if msg.tag != berSeq || len(msg.children) < 2 {
+		return nil, errors.New("ldap: malformed message")
+	}
+	return msg.children[1], nil
+}
+
+func (c *ldapConn) bind(dn, pwd string) error {
+	req := berInteger(berInt, 3) // LDAPv3
+	req = append(req, berTLV(berOctets, []byte(dn))...)
+	req = append(req, berTLV(berCtxPrim, []byte(pwd))...) // simple
+	if err := c.send(berApp(ldapBindRequest, true, req)); err != nil {
+		return err
+	}
+	op, err := c.recv()
+	if err != nil {
+		return err
+	}
+	if op.tag != berAppCons|ldapBindResponse {
+		return fmt.Errorf("ldap: unexpected bind response (tag %#x)", op.tag)
+	}
+	return ldapResult("bind", op)
+}
+
+func (c *ldapConn) search(base string, scope int64, filter []byte, attrs ...string) ([]*ldapEntry, error) {
+	req := berTLV(berOctets, []byte(base))
+	req = append(req, berInteger(berEnum, scope)...)
+	req = append(req, berInteger(berEnum, 0)...) // never deref aliases
+	req = append(req, berInteger(berInt, 0)...)  // size limit
+	req = append(req, berInteger(berInt, 0)...)  // time limit
+	req = append(req, berTLV(berBool, []byte{0})...)
+	req = append(req, filter...)
+	var attrList []byte
+	for _, a := range attrs {
+		attrList = append(attrList, berTLV(berOctets, []byte(a))...)
+	}
+	req = append(req, berTLV(berSeq, attrList)...)
+	if err := c.send(berApp(ldapSearchRequest, true, req)); err != nil {
+		return nil, err
+	}
+	var entries []*ldapEntry
+	for {
+		op, err := c.recv()
+		if err != nil {
+			return nil, err
+		}
+		switch op.tag {
+		case berAppCons | ldapSearchEntry:
+			entry, err := ldapParseEntry(op)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		case berAppCons | ldapSearchRef:
+			// referrals are not followed
+		case berAppCons | ldapSearchDone:
+			return entries, ldapResult("search", op)
+		default:
+			return nil, fmt.Errorf("ldap: unexpected search response (tag %#x)", op.tag)
+		}
+	}
+}
+
+// breadth-first, up to `depth` levels of nesting; groups that cannot be read are skipped
+func (c *ldapConn) resolveGroups(direct []string, groupAttr string, depth int) ([]string, error) {
+	var (
+		all   = make([]string, 0, len(direct))
+		seen  = make(map[string]struct{}, len(direct))
+		level = direct
+	)
+	for _, dn := range direct {
+		seen[strings.ToLower(dn)] = struct{}{}
+		all = append(all, dn)
+	}
+	for ; depth > 0 && len(level) > 0; depth-- {
+		var next []string
+		for _, dn := range level {
+			entries, err := c.search(dn, ldapScopeBase, berPresent("objectClass"), groupAttr)
+			if err != nil {
+				var errRes *errLDAPResult
+				if errors.As(err, &errRes) && errRes.code == ldapResultNoSuchObj {
+					continue
+				}
+				return nil, err
+			}
+			for _, e := range entries {
+				for _, parent := range e.attrs[strings.ToLower(groupAttr)] {
+					if _, ok := seen[strings.ToLower(parent)]; ok {
+						continue
+					}
+					seen[strings.ToLower(parent)] = struct{}{}
+					all = append(all, parent)
+					next = append(next, parent)
+				}
+			}
+		}
+		level = next
+	}
+	return all, nil
+}
+
+func ldapResult(op string, res *ber) error {
+	if len(res.children) < 3 {
+		return fmt.Errorf("ldap %s: malformed result", op)
+	}
+	code := int(berToInt(res.children[0].value))
+	if code == ldapResultSuccess {
+		return nil
+	}
+	return &errLDAPResult{op: op, code: code, msg: string(res.children[2].value)}
+}
+
+func ldapParseEntry(op *ber) (*ldapEntry, error) {
+	if len(op.children) < 2 {
+		return nil, errors.New("ldap: malformed search entry")
+	}
+	entry := &ldapEntry{dn: string(op.children[0].value), attrs: make(map[string][]string, 2)}
+	for _, attr := range op.children[1].children {
+		if len(attr.children) < 2 {
+			continue
+		}
+		name := strings.ToLower(string(attr.children[0].value))
+		for _, v := range attr.children[1].children {
+			entry.attrs[name] = append(entry.attrs[name], string(v.value))
+		}
+	}
+	return entry, nil
+}
+
+//
+// BER (basic encoding rules) - encoding and decoding
+//
+
+func berTLV(tag byte, value []byte) []byte {
+	out := make([]byte, 0, len(value)+6)
+	out = append(out, tag)
+	switch l := len(value); {
+	case l < 0x80:
+		out = append(out, byte(l))
+	case l < 0x100:
+		out = append(out, 0x81, byte(l))
+	case l < 0x10000:
+		out = append(out, 0x82, byte(l>>8), byte(l))
+	default:
+		out = append(out, 0x84, byte(l>>24), byte(l>>16), byte(l>>8), byte(l))
+	}
+	return append(out, value...)
+}
+
+func berInteger(tag byte, v int64) []byte {
+	var b []byte
+	for {
+		b = append([]byte{byte(v)}, b...)
+		if (v < 0x80 && v >= -0x80) || len(b) == 8 {
+			break
+		}
+		v >>= 8
+	}
+	return berTLV(tag, b)
+}
+
+func berApp(op byte, constructed bool, value []byte) []byte {
+	if constructed {
+		return berTLV(berAppCons|op, value)
+	}
+	return berTLV(berAppPrim|op, value)
+}
+
+// equalityMatch filter: (attr=value)
+func berEq(attr, value string) []byte {
+	return berTLV(berCtxCons|3, append(berTLV(berOctets, []byte(attr)), berTLV(berOctets, []byte(value))...))
+}
+
+// present filter: (attr=*)
+func berPresent(attr string) []byte { return berTLV(berCtxPrim|7, []byte(attr)) }
+
+func berToInt(b []byte) (v int64) {
+	for i, c := range b {
+		if i == 0 && c&0x80 != 0 {
+			v = -1
+		}
+		v = v<<8 | int64(c)
+	}
+	return
+}
+
+func berRead(r io.Reader) (*ber, error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, err
+	}
+	l := int(hdr[1])
+	if l&0x80 != 0 {
+		n := l & 0x7f
+		if n == 0 || n > 4 {
+			return nil, fmt.Errorf("ldap: unsupported BER length encoding (%#x)", hdr[1])
+		}
+		var lb [4]byte
+		if _, err := io.ReadFull(r, lb[:n]); err != nil {
+			return nil, err
+		}
+		l = 0
+		for _, c := range lb[:n] {
+			l = l<<8 | int(c)
+		}
+	}
+	if l > ldapMaxMsgSize {
+		return nil, fmt.Errorf("ldap: message too large (%d)", l)
+	}
+	value := make([]byte, l)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return nil, err
+	}
+	return berParse(hdr[0], value)
+}
+
+func berParse(tag byte, value []byte) (*ber, error) {
+	el := &ber{tag: tag, value: value}
+	if tag&0x20 == 0 { // primitive
+		return el, nil
+	}
+	r := bytes.NewReader(value)
+	for r.Len() > 0 {
+		child, err := berRead(r)
+		if err != nil {
+			return nil, fmt.Errorf("ldap: malformed BER: %v", err)
+		}
+		el.children = append(el.children, child)
+	}
+	return el, nil
+}
@@ -27,6 +27,7 @@ type mgr struct {
 	clientHTTP  *http.Client
 	clientHTTPS *http.Client
 	db          kvdb.Driver
+	ldap        *ldapAuth
 }
 
 var (
@@ -56,6 +57,7 @@ func newMgr(driver kvdb.Driver) (*mgr, error) {
 		clientHTTP:  clientHTTP,
 		clientHTTPS: clientHTTPS,
 		db:          driver,
+		ldap:        newLDAPAuth(),
 	}
 	err := initializeDB(driver)
 	return mgr, err
@@ -380,14 +382,29 @@ func (m *mgr) issueToken(userID, pwd string, msg *authn.LoginMsg) (string, error
 		cid     string
 	)
 
+	Conf.RLock()
+	ldapConf := Conf.LDAP
+	Conf.RUnlock()
+
 	err = m.db.Get(usersCollection, userID, uInfo)
-	if err != nil {
+	switch {
+	case err == nil:
+		if !isSamePassword(pwd, uInfo.Password) {
+			return "", errInvalidCredentials
+		}
+	case ldapConf.Enabled:
+		// not a registered user - try the directory
+		if uInfo, err = m.ldap.login(&ldapConf, userID, pwd); err != nil {
+			nlog.Errorln(err)
+			if err == errLDAPNoUser {
+				return "", errInvalidCredentials
+			}
+			return "", err
+		}
+	default:
 		nlog.Errorln(err)
 		return "", errInvalidCredentials
 	}
-	if !isSamePassword(pwd, uInfo.Password) {
-		return "", errInvalidCredentials
-	}
 	if !uInfo.IsAdmin() {
 		if msg.ClusterID == "" {
 			return "", fmt.Errorf("Couldn't issue token for %q: cluster ID not set", userID)
@@ -5,6 +5,8 @@
 package main
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 
@@ -626,3 +628,52 @@ func TestMergeBckACLS(t *testing.T) {
 		}
 	}
 }
+
+func TestLDAPGroupRoles(t *testing.T) {
+	mapping := map[string][]string{
+		"CN=ml-team,OU=Groups,DC=corp,DC=com": {BucketOwnerRole},
+		"Admins":                              {authn.AdminRole},
+		"readers":                             {GuestRole, BucketOwnerRole},
+	}
+	tests := []struct {
+		groups []string
+		roles  []string
+	}{
+		{[]string{"cn=ML-Team,ou=groups,dc=corp,dc=com"}, []string{BucketOwnerRole}},
+		{[]string{"CN=admins,OU=IT,DC=corp,DC=com"}, []string{authn.AdminRole}},
+		{[]string{"cn=readers,dc=corp", "CN=ml-team,OU=Groups,DC=corp,DC=com"}, []string{GuestRole, BucketOwnerRole}},
+		{[]string{"cn=other,dc=corp", "ou=admins,dc=corp"}, nil},
+	}
+	for _, test := range tests {
+		roles := ldapRoles(mapping, test.groups)
+		if len(roles) != len(test.roles) {
+			t.Fatalf("%v: expected roles %v, got %v", test.groups, test.roles, roles)
+		}
+		for i := range roles {
+			if roles[i] != test.roles[i] {
+				t.Errorf("%v: expected roles %v, got %v", test.groups, test.roles, roles)
+			}
+		}
+	}
+}
+
+func TestLDAPBER(t *testing.T) {
+	for _, v := range []int64{0, 1, 127, 128, 255, 256, 65535, 1 << 30, -1, -129} {
+		b := berInteger(berInt, v)
+		el, err := berParse(b[0], b[2:])
+		tassert.CheckFatal(t, err)
+		if got := berToInt(el.value); got != v {
+			t.Errorf("expected %d, got %d", v, got)
+		}
+	}
+	long := strings.Repeat("x", 70000)
+	msg := berTLV(berSeq, append(berEq("uid", "user1"), berTLV(berOctets, []byte(long))...))
+	el, err := berRead(bytes.NewReader(msg))
+	tassert.CheckFatal(t, err)
+	if len(el.children) != 2 || len(el.children[0].children) != 2 || string(el.children[1].value) != long {
+		t.Fatalf("failed to decode BER message")
+	}
+	if string(el.children[0].children[1].value) != "user1" {
+		t.Errorf("expected %q, got %q", "user1", el.children[0].children[1].value)
+	}
+}
@@ -9,21 +9,26 @@ AUTOCOMPLETE_DIR_OH_MY_ZSH="$HOME/.oh-my-zsh/completions"
 AUTOCOMPLETE_FILE_OH_MY_ZSH="${AUTOCOMPLETE_DIR_OH_MY_ZSH}/_ais"
 AUTOCOMPLETE_DIR_ZSH="$HOME/.zsh/completion"
 AUTOCOMPLETE_FILE_ZSH="${AUTOCOMPLETE_DIR_ZSH}/_ais"
+AUTOCOMPLETE_DIR_FISH="$HOME/.config/fish/completions"
+AUTOCOMPLETE_FILE_FISH="${AUTOCOMPLETE_DIR_FISH}/ais.fish"
 
 BASH_AUTOCOMPLETE_SOURCE_FILE="${DIR}/bash"
 ZSH_AUTOCOMPLETE_SOURCE_FILE="${DIR}/zsh"
+FISH_AUTOCOMPLETE_SOURCE_FILE="${DIR}/fish"
 
 SUDO=sudo
 [[ $(id -u) == 0 ]] && SUDO=""
 
 echo "*** Installing AIS CLI autocompletions into:"
-echo "***     ${AUTOCOMPLETE_DIR_BASH} and"
-echo "***     ${AUTOCOMPLETE_DIR_ZSH} (or ${AUTOCOMPLETE_DIR_OH_MY_ZSH})"
+echo "***     ${AUTOCOMPLETE_DIR_BASH},"
+echo "***     ${AUTOCOMPLETE_DIR_ZSH} (or ${AUTOCOMPLETE_DIR_OH_MY_ZSH}), and"
+echo "***     ${AUTOCOMPLETE_DIR_FISH}"
 echo "*** You can always uninstall autocompletions by running:"
 echo "***     ${DIR}/uninstall.sh"
 echo "*** To enable autocompletions in your current shell, run:"
 echo "***     source ${BASH_AUTOCOMPLETE_SOURCE_FILE} or"
-echo "***     source ${ZSH_AUTOCOMPLETE_SOURCE_FILE}"
+echo "***     source ${ZSH_AUTOCOMPLETE_SOURCE_FILE} or"
+echo "***     source ${FISH_AUTOCOMPLETE_SOURCE_FILE}"
 echo "***"
 read -r -p "Proceed? [Y/n] " response
 case "$response" in
@@ -54,6 +59,17 @@ case "$response" in
     else
       echo "Skipping zsh completions - target directory absent."
     fi
+
+    if [[ -d ${AUTOCOMPLETE_DIR_FISH} ]]; then
+      cp ${FISH_AUTOCOMPLETE_SOURCE_FILE} ${AUTOCOMPLETE_FILE_FISH}
+      if [[ $? -eq 0 ]]; then
+        echo "Fish completions successfully installed."
+      else
+        echo "Fish completions not installed (some error occurred)."
+      fi
+    else
+      echo "Skipping fish completions - target directory absent."
+    fi
     echo "Done."
     ;;
 esac
@@ -7,6 +7,8 @@ AUTOCOMPLETE_DIR_OH_MY_ZSH="$HOME/.oh-my-zsh/completions"
 AUTOCOMPLETE_FILE_OH_MY_ZSH="${AUTOCOMPLETE_DIR_OH_MY_ZSH}/_ais"
 AUTOCOMPLETE_DIR_ZSH="$HOME/.zsh/completion"
 AUTOCOMPLETE_FILE_ZSH="${AUTOCOMPLETE_DIR_ZSH}/_ais"
+AUTOCOMPLETE_DIR_FISH="$HOME/.config/fish/completions"
+AUTOCOMPLETE_FILE_FISH="${AUTOCOMPLETE_DIR_FISH}/ais.fish"
 
 SUDO=sudo
 [[ $(id -u) == 0 ]] && SUDO=""
@@ -15,6 +17,7 @@ echo -n "Uninstalling AIS CLI autocompletions..."
 [[ -f ${AUTOCOMPLETE_FILE_BASH} ]] && $SUDO rm ${AUTOCOMPLETE_FILE_BASH}
 [[ -f ${AUTOCOMPLETE_FILE_ZSH} ]] && rm ${AUTOCOMPLETE_FILE_ZSH}
 [[ -f ${AUTOCOMPLETE_FILE_OH_MY_ZSH} ]] && rm ${AUTOCOMPLETE_FILE_OH_MY_ZSH}
+[[ -f ${AUTOCOMPLETE_FILE_FISH} ]] && rm ${AUTOCOMPLETE_FILE_FISH}
 rm ~/.zcompdump* &> /dev/null # Sometimes needed for zsh users (see: https://github.com/robbyrussell/oh-my-zsh/issues/3356)
 sleep 0.5
 echo " Done"
@@ -124,7 +124,7 @@ var (
 	// archive ls
 	archLsCmd = cli.Command{
 		Name:         cmdList,
-		Usage:        "list archived content (supported formats: " + archFormats + ")",
+		Usage:        "list archived content (supported formats: " + archFormatsRO + ")",
 		ArgsUsage:    optionalShardArgument,
 		Flags:        rmFlags(bucketCmdsFlags[commandList], listArchFlag), // is implied
 		Action:       listArchHandler,
@@ -41,6 +41,7 @@ func createBucket(c *cli.Context, bck cmn.Bck, props *cmn.BucketPropsToUpdate, d
 		}
 		return fmt.Errorf("failed to create %q: %v", bck, err)
 	}
+	cmplCacheInvalidate(cmplKeyBuckets)
 	// NOTE: see docs/bucket.md#default-bucket-properties
 	fmt.Fprintf(c.App.Writer, "%q created\n", bck.Cname(""))
 	return
@@ -59,6 +60,7 @@ func destroyBuckets(c *cli.Context, buckets []cmn.Bck) (err error) {
 			}
 		}
 		if err = api.DestroyBucket(apiBP, bck); err == nil {
+			cmplCacheInvalidate(cmplKeyBuckets)
 			fmt.Fprintf(c.App.Writer, "%q destroyed\n", bck.Cname(""))
 			continue
 		}
@@ -84,6 +86,7 @@ func mvBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
 	if err != nil {
 		return V(err)
 	}
+	cmplCacheInvalidate(cmplKeyBuckets)
 	_, xname := xact.GetKindName(apc.ActMoveBck)
 	text := fmt.Sprintf("%s[%s] %s => %s", xname, xid, bckFrom, bckTo)
 	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
@@ -74,6 +74,7 @@ var (
 			allObjsOrBcksFlag,
 			listObjCachedFlag,
 			nameOnlyFlag,
+			lsPrefetchMDFlag,
 			objPropsFlag,
 			regexLsAnyFlag,
 			templateFlag,
@@ -482,15 +483,20 @@ func updateBckProps(c *cli.Context, bck cmn.Bck, currProps *cmn.BucketProps, upd
 		displayPropsEqMsg(c, bck)
 		return nil
 	}
-	if _, err = api.SetBucketProps(apiBP, bck, updateProps); err != nil {
-		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
+	res, err := api.SetBucketPropsDiff(apiBP, bck, updateProps)
+	if err != nil {
+		if herr, ok := err.(*cmn.ErrHTTP); ok && (herr.Status == http.StatusNotFound || herr.Status == http.StatusConflict) {
 			return herr
 		}
 		helpMsg := fmt.Sprintf("To show bucket properties, run '%s %s %s %s'",
 			cliName, commandShow, cmdBucket, bck.Cname(""))
 		return newAdditionalInfoError(err, helpMsg)
 	}
-	showDiff(c, currProps, allNewProps)
+	if len(res.Diff) == 0 {
+		displayPropsEqMsg(c, bck)
+		return nil
+	}
+	showDiff(c, res.Diff)
 	actionDone(c, "\nBucket props successfully updated.")
 	return nil
 }
@@ -504,25 +510,14 @@ func displayPropsEqMsg(c *cli.Context, bck cmn.Bck) {
 	fmt.Fprintf(c.App.Writer, "Bucket %q already has the same values of props, nothing to do\n", bck.Cname(""))
 }
 
-func showDiff(c *cli.Context, currProps, newProps *cmn.BucketProps) {
-	var (
-		origKV = bckPropList(currProps, true)
-		newKV  = bckPropList(newProps, true)
-	)
-	for _, np := range newKV {
-		var found bool
-		for _, op := range origKV {
-			if np.Name != op.Name {
-				continue
-			}
-			found = true
-			if np.Value != op.Value {
-				fmt.Fprintf(c.App.Writer, "%q set to: %q (was: %q)\n", np.Name, np.Value, op.Value)
-			}
-		}
-		if !found && np.Value != "" {
-			fmt.Fprintf(c.App.Writer, "%q set to: %q (was: n/a)\n", np.Name, np.Value)
+// (as reported by the cluster)
+func showDiff(c *cli.Context, diff []cmn.PropDiff) {
+	for _, d := range diff {
+		before := d.Before
+		if before == "" {
+			before = "n/a"
 		}
+		fmt.Fprintf(c.App.Writer, "%q set to: %q (was: %q)\n", d.Name, d.After, before)
 	}
 }
 
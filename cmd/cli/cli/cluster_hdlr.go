@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
@@ -37,6 +38,7 @@ var (
 		cmdPrimary: {},
 		cmdJoin: {
 			roleFlag,
+			dryRunFlag,
 		},
 		cmdStartMaint: {
 			noRebalanceFlag,
@@ -46,6 +48,11 @@ var (
 			noRebalanceFlag,
 			yesFlag,
 		},
+		cmdActivateSpare: {
+			replaceNodeFlag,
+			noRebalanceFlag,
+			yesFlag,
+		},
 		cmdShutdown + ".node": {
 			noRebalanceFlag,
 			rmUserDataFlag,
@@ -181,6 +188,16 @@ var (
 						Action:       nodeMaintShutDecommHandler,
 						BashComplete: suggestNodesInMaint,
 					},
+					{
+						Name: cmdActivateSpare,
+						Usage: "activate spare target (see 'aisnode -spare'), optionally in place of a failed one, e.g.:\n" +
+							indent4 + "\t- 'activate-spare t[abc]' - same as '" + cmdStopMaint + "';\n" +
+							indent4 + "\t- 'activate-spare t[abc] --replace t[xyz]' - replace failed t[xyz] with t[abc]",
+						ArgsUsage:    nodeIDArgument,
+						Flags:        clusterCmdsFlags[cmdActivateSpare],
+						Action:       nodeMaintShutDecommHandler,
+						BashComplete: suggestNodesInMaint,
+					},
 					{
 						Name:         cmdNodeDecommission,
 						Usage:        "safely and permanently remove node from the cluster",
@@ -327,6 +344,9 @@ func joinNodeHandler(c *cli.Context) (err error) {
 		// for the primary to perform initial handshake, validation, and the rest of it (NOTE: control-net)
 		ControlNet: netInfo,
 	}
+	if flagIsSet(c, dryRunFlag) {
+		return joinDryRun(c, nodeInfo)
+	}
 	if rebID, nodeInfo.DaeID, err = api.JoinCluster(apiBP, nodeInfo); err != nil {
 		return
 	}
@@ -345,6 +365,23 @@ func joinNodeHandler(c *cli.Context) (err error) {
 	return
 }
 
+// show what'd happen if the node joined; the node itself remains outside the cluster (standby)
+func joinDryRun(c *cli.Context, nodeInfo *meta.Snode) error {
+	ji, err := api.JoinClusterDryRun(apiBP, nodeInfo)
+	if err != nil {
+		return V(err)
+	}
+	fmt.Fprintf(c.App.Writer, "Joining %s would migrate %d objects (%s), estimated duration %v\n",
+		ji.DaemonID, ji.Total.Objs, cos.ToSizeIEC(ji.Total.Size, 2), ji.EstDuration)
+	for cname, cnt := range ji.Buckets {
+		fmt.Fprintf(c.App.Writer, "  %s:\t%d objects (%s)\n", cname, cnt.Objs, cos.ToSizeIEC(cnt.Size, 2))
+	}
+	for tid, cnt := range ji.Targets {
+		fmt.Fprintf(c.App.Writer, "  from %s:\t%d objects (%s)\n", tid, cnt.Objs, cos.ToSizeIEC(cnt.Size, 2))
+	}
+	return nil
+}
+
 // (compare w/ cluster-level clusterDecommissionHandler & clusterShutdownHandler)
 func nodeMaintShutDecommHandler(c *cli.Context) error {
 	if c.NArg() < 1 {
@@ -410,6 +447,25 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 			}
 		}
 		xid, err = api.StopMaintenance(apiBP, actValue)
+	case cmdActivateSpare:
+		var rsname string
+		if flagIsSet(c, replaceNodeFlag) {
+			var rnode *meta.Snode
+			if rnode, rsname, err = getNode(c, parseStrFlag(c, replaceNodeFlag)); err != nil {
+				return err
+			}
+			actValue.Replace = rnode.ID()
+		}
+		if !flagIsSet(c, yesFlag) {
+			prompt := fmt.Sprintf("Activate spare %s", sname)
+			if rsname != "" {
+				prompt += " in place of " + rsname
+			}
+			if ok := confirm(c, prompt); !ok {
+				return nil
+			}
+		}
+		xid, err = api.ActivateSpare(apiBP, actValue)
 	case cmdNodeDecommission:
 		if !flagIsSet(c, yesFlag) {
 			warn := fmt.Sprintf("about to permanently decommission %s. The operation cannot be undone!", sname)
@@ -434,7 +490,7 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 		fmt.Fprintf(c.App.Writer, fmtRebalanceStarted, xid)
 	}
 	switch action {
-	case cmdStopMaint:
+	case cmdStopMaint, cmdActivateSpare:
 		fmt.Fprintf(c.App.Writer, "%s is now active\n", sname)
 	case cmdNodeDecommission:
 		if skipRebalance || node.IsProxy() {
@@ -0,0 +1,111 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file caches live (server-side) shell completion suggestions.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+)
+
+// Completion happens in a separate (short-lived) process for every <TAB>,
+// which is why suggestions that require a roundtrip to the cluster
+// (buckets, objects, nodes, running jobs) are persisted between invocations
+// in the CLI config directory, and reused for as long as `completion.cache_ttl`.
+
+const (
+	cmplCacheFname = "cmpl.cache.json"
+
+	// cache keys (prefixes)
+	cmplKeyBuckets  = "bck:"
+	cmplKeyBackends = "backend:"
+	cmplKeyObjects  = "obj:"
+	cmplKeyNodes    = "node:"
+	cmplKeyXactions = "xact:"
+
+	// the word that's being completed (the shell does not pass it as an argument - see autocomplete/*)
+	envCmplCur = "AIS_CLI_CMPL_CUR"
+)
+
+type (
+	cmplEntry struct {
+		Values []string `json:"v"`
+		Ts     int64    `json:"ts"` // unix nano
+	}
+	cmplCache struct {
+		URL     string                `json:"url"` // cluster endpoint the entries belong to
+		Entries map[string]*cmplEntry `json:"entries"`
+	}
+)
+
+// current (partially typed) word, if provided by the completion script
+func cmplCur() string { return os.Getenv(envCmplCur) }
+
+func cmplCachePath() string { return filepath.Join(config.ConfigDir, cmplCacheFname) }
+
+func cmplTTL() time.Duration {
+	if cfg == nil {
+		return 0
+	}
+	return cfg.Completion.CacheTTL
+}
+
+// return cached values if fresh; otherwise, call `fetch` and cache the result
+func cmplCached(key string, fetch func() ([]string, error)) ([]string, error) {
+	ttl := cmplTTL()
+	if ttl <= 0 {
+		return fetch()
+	}
+	var (
+		cache = &cmplCache{}
+		now   = time.Now()
+		path  = cmplCachePath()
+	)
+	if _, err := jsp.Load(path, cache, jsp.Plain()); err != nil || cache.URL != apiBP.URL || cache.Entries == nil {
+		cache = &cmplCache{URL: apiBP.URL, Entries: make(map[string]*cmplEntry, 4)}
+	}
+	if e, ok := cache.Entries[key]; ok && now.Sub(time.Unix(0, e.Ts)) < ttl {
+		return e.Values, nil
+	}
+	values, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	// evict stale
+	for k, e := range cache.Entries {
+		if now.Sub(time.Unix(0, e.Ts)) >= ttl {
+			delete(cache.Entries, k)
+		}
+	}
+	cache.Entries[key] = &cmplEntry{Values: values, Ts: now.UnixNano()}
+	_ = jsp.Save(path, cache, jsp.Plain(), nil /*sgl*/) // best effort
+	return values, nil
+}
+
+// invalidate upon create/destroy and similar (mutating) commands
+func cmplCacheInvalidate(keyPrefix string) {
+	var (
+		cache = &cmplCache{}
+		path  = cmplCachePath()
+	)
+	if _, err := jsp.Load(path, cache, jsp.Plain()); err != nil || len(cache.Entries) == 0 {
+		return
+	}
+	var n int
+	for k := range cache.Entries {
+		if strings.HasPrefix(k, keyPrefix) {
+			delete(cache.Entries, k)
+			n++
+		}
+	}
+	if n > 0 {
+		_ = jsp.Save(path, cache, jsp.Plain(), nil)
+	}
+}
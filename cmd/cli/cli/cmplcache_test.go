@@ -0,0 +1,72 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCmplCache(t *testing.T) {
+	savedDir, savedCfg, savedURL := config.ConfigDir, cfg, apiBP.URL
+	t.Cleanup(func() { config.ConfigDir, cfg, apiBP.URL = savedDir, savedCfg, savedURL })
+
+	config.ConfigDir = t.TempDir()
+	cfg = &config.Config{Completion: config.CompletionConfig{CacheTTL: time.Minute}}
+	apiBP.URL = "http://localhost:8080"
+
+	var n int
+	fetch := func() ([]string, error) {
+		n++
+		return []string{"ais://abc", "ais://xyz"}, nil
+	}
+	get := func(key string) []string {
+		values, err := cmplCached(key, fetch)
+		tassert.CheckFatal(t, err)
+		return values
+	}
+
+	// cached
+	values := get(cmplKeyBuckets)
+	tassert.Fatalf(t, len(values) == 2 && n == 1, "expected 2 values and 1 fetch, got %v and %d", values, n)
+	values = get(cmplKeyBuckets)
+	tassert.Errorf(t, len(values) == 2 && n == 1, "expected cached values, got %v and %d fetches", values, n)
+
+	// separate key
+	get(cmplKeyObjects + "ais://abc")
+	tassert.Errorf(t, n == 2, "expected 2 fetches, got %d", n)
+
+	// invalidate by prefix: objects remain cached
+	cmplCacheInvalidate(cmplKeyBuckets)
+	get(cmplKeyBuckets)
+	get(cmplKeyObjects + "ais://abc")
+	tassert.Errorf(t, n == 3, "expected 3 fetches, got %d", n)
+
+	// different cluster
+	apiBP.URL = "http://localhost:9090"
+	get(cmplKeyBuckets)
+	tassert.Errorf(t, n == 4, "expected 4 fetches, got %d", n)
+
+	// fetch errors are not cached
+	_, err := cmplCached(cmplKeyNodes, func() ([]string, error) { return nil, errors.New("fail") })
+	tassert.Errorf(t, err != nil, "expected error")
+	get(cmplKeyNodes)
+	tassert.Errorf(t, n == 5, "expected 5 fetches, got %d", n)
+
+	// caching disabled
+	cfg.Completion.CacheTTL = 0
+	get(cmplKeyBuckets)
+	get(cmplKeyBuckets)
+	tassert.Errorf(t, n == 7, "expected 7 fetches, got %d", n)
+
+	// no config
+	cfg = nil
+	get(cmplKeyBuckets)
+	tassert.Errorf(t, n == 8, "expected 8 fetches, got %d", n)
+}
@@ -43,6 +43,7 @@ var (
 		feat.FeaturesPropName:                 append(feat.All, NilValue),
 		"write_policy.data":                   apc.SupportedWritePolicy,
 		"write_policy.md":                     apc.SupportedWritePolicy,
+		"durability.policy":                   apc.SupportedDurability,
 		"ec.compression":                      apc.SupportedCompression,
 		"compression.checksum":                apc.SupportedCompression,
 		"rebalance.compression":               apc.SupportedCompression,
@@ -228,22 +229,36 @@ func suggestProxies(c *cli.Context)  { suggestNode(c, allProxies) }
 func suggestAllNodes(c *cli.Context) { suggestNode(c, allNodes) }
 
 func suggestNode(c *cli.Context, ty int) {
-	smap, err := getClusterMap(c)
+	names, err := cmplCached(cmplKeyNodes, func() ([]string, error) {
+		smap, err := getClusterMap(c)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, smap.CountProxies()+smap.CountTargets())
+		for sid := range smap.Pmap {
+			names = append(names, meta.Pname(sid))
+		}
+		for sid := range smap.Tmap {
+			names = append(names, meta.Tname(sid))
+		}
+		return names, nil
+	})
 	if err != nil {
 		completionErr(c, err)
 		return
 	}
-	if _, _, err = getNode(c, argLast(c)); err == nil {
-		return // node already selected
-	}
-	if ty != allTargets {
-		for sid := range smap.Pmap {
-			fmt.Println(meta.Pname(sid))
+	if c.NArg() > 0 {
+		last := argLast(c)
+		for _, name := range names {
+			if last == name || last == meta.N2ID(name) {
+				return // node already selected
+			}
 		}
 	}
-	if ty != allProxies {
-		for sid := range smap.Tmap {
-			fmt.Println(meta.Tname(sid))
+	for _, name := range names {
+		isProxy := strings.HasPrefix(name, meta.PnamePrefix)
+		if (isProxy && ty != allTargets) || (!isProxy && ty != allProxies) {
+			fmt.Println(name)
 		}
 	}
 }
@@ -358,30 +373,109 @@ func (opts *bcmplop) buckets(c *cli.Context) {
 		return
 	}
 
+	// "ais://bucket/prefix" - suggest objects
+	if opts.separator && suggestObjects(c) {
+		return
+	}
+
 	query := cmn.QueryBcks{Provider: opts.provider}
-	buckets, err := api.ListBuckets(apiBP, query, apc.FltPresent) // NOTE: `present` only
+	names, err := cmplCached(cmplKeyBuckets+query.Provider, func() ([]string, error) {
+		buckets, err := api.ListBuckets(apiBP, query, apc.FltPresent) // NOTE: `present` only
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(buckets))
+		for i := range buckets {
+			names = append(names, buckets[i].Cname(""))
+		}
+		return names, nil
+	})
 	if err != nil {
 		completionErr(c, err)
 		return
 	}
 	if query.Provider == "" {
-		config, err := api.GetClusterConfig(apiBP)
+		schemes, err := cmplCached(cmplKeyBackends, func() ([]string, error) {
+			config, err := api.GetClusterConfig(apiBP)
+			if err != nil {
+				return nil, err
+			}
+			schemes := make([]string, 0, len(config.Backend.Conf))
+			for provider := range config.Backend.Conf {
+				if provider == apc.AIS {
+					qbck := cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.NsAnyRemote}
+					schemes = append(schemes, qbck.String())
+				} else {
+					schemes = append(schemes, apc.ToScheme(provider)+apc.BckProviderSeparator)
+				}
+			}
+			return schemes, nil
+		})
 		if err != nil {
 			completionErr(c, err)
 			return
 		}
-		for provider := range config.Backend.Conf {
-			if provider == apc.AIS {
-				qbck := cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.NsAnyRemote}
-				fmt.Println(qbck)
-			} else {
-				fmt.Printf("%s://\n", apc.ToScheme(provider))
-			}
+		for _, scheme := range schemes {
+			fmt.Println(scheme)
 		}
 	}
+	buckets = make([]cmn.Bck, 0, len(names))
+	for _, name := range names {
+		bck, err := parseBckURI(c, name, true /*errorOnly*/)
+		if err != nil {
+			continue
+		}
+		buckets = append(buckets, bck)
+	}
 	printNotUsedBuckets(c, buckets, opts.separator, opts.multiple)
 }
 
+// given partially typed "ais://bucket/prefix" (see `envCmplCur`), suggest up to
+// `completion.max_objects` names that start with the prefix;
+// return false if the current word is not a bucket-qualified object name
+func suggestObjects(c *cli.Context) bool {
+	cur := cmplCur()
+	if !strings.Contains(cur, apc.BckProviderSeparator) {
+		return false
+	}
+	uri := strings.SplitN(cur, apc.BckProviderSeparator, 2)
+	if !strings.Contains(uri[1], "/") {
+		return false // still typing bucket name
+	}
+	bck, prefix, err := parseBckObjURI(c, cur, true /*emptyObjnameOK*/)
+	if err != nil {
+		return false
+	}
+	if cfg == nil {
+		return false
+	}
+	limit := cfg.Completion.MaxObjects
+	names, err := cmplCached(cmplKeyObjects+bck.Cname(prefix), func() ([]string, error) {
+		lsmsg := &apc.LsoMsg{Prefix: prefix, PageSize: uint(limit)}
+		lsmsg.SetFlag(apc.LsNameOnly)
+		lst, err := api.ListObjectsPage(apiBP, bck, lsmsg)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(lst.Entries))
+		for _, en := range lst.Entries {
+			names = append(names, en.Name)
+			if len(names) >= limit {
+				break
+			}
+		}
+		return names, nil
+	})
+	if err != nil {
+		completionErr(c, err)
+		return true
+	}
+	for _, name := range names {
+		fmt.Println(bck.Cname(name))
+	}
+	return true
+}
+
 // The function lists buckets names if the first argument was not yet given, otherwise it lists flags and additional completions
 // Multiple buckets will also be listed if 'multiple'
 // Printed names will end with '/' if 'separator'
@@ -512,7 +606,9 @@ func runningJobCompletions(c *cli.Context) {
 			fmt.Println(strings.Join(names, " "))
 			return
 		}
-		kindIDs, err := api.GetAllRunningXactions(apiBP, "")
+		kindIDs, err := cmplCached(cmplKeyXactions, func() ([]string, error) {
+			return api.GetAllRunningXactions(apiBP, "")
+		})
 		if err != nil {
 			completionErr(c, err)
 			return
@@ -551,7 +647,9 @@ func runningJobCompletions(c *cli.Context) {
 			return
 		}
 		// complete xid
-		xactIDs, err := api.GetAllRunningXactions(apiBP, name)
+		xactIDs, err := cmplCached(cmplKeyXactions+name, func() ([]string, error) {
+			return api.GetAllRunningXactions(apiBP, name)
+		})
 		if err != nil {
 			completionErr(c, err)
 			return
@@ -54,20 +54,22 @@ const (
 // - show subcommands (`show <what>`)
 // - 3rd level subcommands
 const (
-	commandCat       = "cat"
-	commandConcat    = "concat"
-	commandCopy      = "cp"
-	commandCreate    = "create"
-	commandGet       = "get"
-	commandList      = "ls"
-	commandSetCustom = "set-custom"
-	commandPut       = "put"
-	commandRemove    = "rm"
-	commandRename    = "mv"
-	commandSet       = "set"
-	commandStart     = apc.ActXactStart
-	commandStop      = apc.ActXactStop
-	commandWait      = "wait"
+	commandCat        = "cat"
+	commandConcat     = "concat"
+	commandCopy       = "cp"
+	commandCreate     = "create"
+	commandGet        = "get"
+	commandList       = "ls"
+	commandSetCustom  = "set-custom"
+	commandProvenance = "provenance"
+	commandPut        = "put"
+	commandRemove     = "rm"
+	commandRename     = "mv"
+	commandUndelete   = "undelete"
+	commandSet        = "set"
+	commandStart      = apc.ActXactStart
+	commandStop       = apc.ActXactStop
+	commandWait       = "wait"
 
 	cmdSmap   = apc.WhatSmap
 	cmdBMD    = apc.WhatBMD
@@ -127,6 +129,7 @@ const (
 	cmdJoin                = "join"
 	cmdStartMaint          = "start-maintenance"
 	cmdStopMaint           = "stop-maintenance"
+	cmdActivateSpare       = "activate-spare"
 	cmdNodeDecommission    = "decommission"
 	cmdClusterDecommission = "decommission"
 
@@ -139,6 +142,8 @@ const (
 	cmdShowCounters   = "counters"
 	cmdShowThroughput = "throughput"
 	cmdShowLatency    = "latency"
+	cmdPerfCompare    = "compare"
+	cmdPerfSnapshot   = "snapshot"
 
 	// Bucket properties subcommands
 	cmdSetBprops   = "set"
@@ -227,7 +232,9 @@ const (
 	jobShowRebalanceArgument = "[REB_ID] [NODE_ID]"
 
 	// Perf
-	showPerfArgument = "show performance counters, throughput, latency, and more (" + tabtab + " specific view)"
+	showPerfArgument     = "show performance counters, throughput, latency, and more (" + tabtab + " specific view)"
+	perfCompareArgument  = "[BEFORE_FILE [AFTER_FILE]]"
+	perfSnapshotArgument = "FILE"
 
 	// ETL
 	etlNameArgument     = "ETL_NAME"
@@ -343,8 +350,9 @@ var (
 	indent2 = strings.Repeat(indent1, 2)
 	indent4 = strings.Repeat(indent1, 4)
 
-	archFormats = ".tar, .tgz or .tar.gz, .zip, .tar.lz4" // namely, archive.FileExtensions
-	archExts    = "(" + archFormats + ")"
+	archFormats   = ".tar, .tgz or .tar.gz, .zip, .tar.lz4" // namely, archive.FileExtensions
+	archExts      = "(" + archFormats + ")"
+	archFormatsRO = archFormats + ", and .7z (read-only)" // plus archive.ReadOnlyExtensions
 
 	//
 	// scope 'all'
@@ -530,6 +538,11 @@ var (
 		Name:  "name-only",
 		Usage: "faster request to retrieve only the names of objects (if defined, '--props' flag will be ignored)",
 	}
+	lsPrefetchMDFlag = cli.BoolFlag{
+		Name: "prefetch-md",
+		Usage: "load metadata of the listed objects into (target) memory in the background,\n" +
+			indent4 + "\tto speed-up subsequent GET requests (e.g., when listing is followed by reading most of the listed objects)",
+	}
 
 	// Log severity (cmn.LogInfo, ....) enum
 	logSevFlag = cli.StringFlag{
@@ -575,6 +588,29 @@ var (
 	}
 	syncFlag = cli.BoolFlag{Name: "sync", Usage: "sync bucket with Cloud"}
 
+	dloadExtractFlag = cli.BoolFlag{
+		Name: "extract",
+		Usage: "extract downloaded archives " + archExts + " upon arrival,\n" +
+			indent4 + "\tone object per archived file under a prefix (see '--extract-prefix')",
+	}
+	dloadExtractPrefixFlag = cli.StringFlag{
+		Name:  "extract-prefix",
+		Usage: "destination virtual directory for extracted files (default: archive name without extension)",
+	}
+	dloadKeepArchiveFlag = cli.BoolFlag{
+		Name:  "keep-archive",
+		Usage: "when extracting, store the downloaded archive as well",
+	}
+	dloadMirrorsFlag = cli.StringFlag{
+		Name: "mirrors",
+		Usage: "comma-separated list of alternative links (mirrors) to fall back on, in order of preference\n" +
+			indent4 + "\t(single-object download only)",
+	}
+	dloadRaceMirrorsFlag = cli.BoolFlag{
+		Name:  "race-mirrors",
+		Usage: "request the source and all its mirrors in parallel and keep the first successful response",
+	}
+
 	// dsort
 	dsortFsizeFlag  = cli.StringFlag{Name: "fsize", Value: "1024", Usage: "size of the files in a shard"}
 	dsortLogFlag    = cli.StringFlag{Name: "log", Usage: "filename to log metrics (statistics)"}
@@ -850,6 +886,10 @@ var (
 		Name:  "no-resilver",
 		Usage: "do _not_ resilver data off of the mountpaths that are being disabled or detached",
 	}
+	replaceNodeFlag = cli.StringFlag{
+		Name:  "replace",
+		Usage: "ID of the failed target to replace: the spare takes over its position in the cluster (no rebalance)",
+	}
 	noShutdownFlag = cli.BoolFlag{
 		Name:  "no-shutdown",
 		Usage: "do not shutdown node upon decommissioning it from the cluster",
@@ -892,6 +932,17 @@ var (
 		Name:  "mountpath",
 		Usage: "show target mountpaths with underlying disks and used/available capacities",
 	}
+	perfWindowFlag = DurationFlag{
+		Name: "window",
+		Usage: "time interval to measure performance (counters, throughput, and latencies) over;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+		Value: 10 * time.Second,
+	}
+	perfThresholdFlag = cli.IntFlag{
+		Name:  "threshold",
+		Usage: "highlight regressions (higher latency, lower throughput, more errors) beyond this percentage",
+		Value: 10,
+	}
 
 	// LRU
 	lruBucketsFlag = cli.StringFlag{
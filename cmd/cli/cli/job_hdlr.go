@@ -61,6 +61,11 @@ var (
 			waitJobXactFinishedFlag,
 			limitBytesPerHourFlag,
 			syncFlag,
+			dloadExtractFlag,
+			dloadExtractPrefixFlag,
+			dloadKeepArchiveFlag,
+			dloadMirrorsFlag,
+			dloadRaceMirrorsFlag,
 			unitsFlag,
 		},
 		cmdDsort: {
@@ -376,6 +381,14 @@ func startDownloadHandler(c *cli.Context) error {
 			Connections:  parseIntFlag(c, limitConnectionsFlag),
 			BytesPerHour: int(limitBPH),
 		},
+		Extract: dload.ExtractOpts{
+			Enabled:     flagIsSet(c, dloadExtractFlag),
+			Prefix:      parseStrFlag(c, dloadExtractPrefixFlag),
+			KeepArchive: flagIsSet(c, dloadKeepArchiveFlag),
+		},
+	}
+	if flagIsSet(c, dloadRaceMirrorsFlag) {
+		basePayload.MirrorMode = dload.MirrorRace
 	}
 
 	if basePayload.Bck.Props, err = api.HeadBucket(apiBP, basePayload.Bck, true /* don't add */); err != nil {
@@ -431,6 +444,10 @@ func startDownloadHandler(c *cli.Context) error {
 		}
 	}
 
+	if flagIsSet(c, dloadMirrorsFlag) && dlType != dload.TypeSingle {
+		return fmt.Errorf("flag %s is only supported for single-object downloads", qflprn(dloadMirrorsFlag))
+	}
+
 	switch dlType {
 	case dload.TypeSingle:
 		payload := dload.SingleBody{
@@ -440,6 +457,9 @@ func startDownloadHandler(c *cli.Context) error {
 				ObjName: pathSuffix, // in this case pathSuffix is a full name of the object
 			},
 		}
+		if flagIsSet(c, dloadMirrorsFlag) {
+			payload.Mirrors = splitCsv(parseStrFlag(c, dloadMirrorsFlag))
+		}
 		id, err = api.DownloadWithParam(apiBP, dlType, payload)
 	case dload.TypeMulti:
 		var objects []string
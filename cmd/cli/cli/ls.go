@@ -251,6 +251,9 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 	if flagIsSet(c, allObjsOrBcksFlag) {
 		msg.SetFlag(apc.LsAll)
 	}
+	if flagIsSet(c, lsPrefetchMDFlag) {
+		msg.SetFlag(apc.LsPrefetchMD)
+	}
 
 	var (
 		props    []string
@@ -439,6 +442,9 @@ func (o *lstFilter) apply(entries cmn.LsoEntries) (matching, rest cmn.LsoEntries
 	return
 }
 
+// all listable archive formats, including read-only
+var lsArchExts = append(append([]string{}, archive.FileExtensions...), archive.ReadOnlyExtensions...)
+
 // prefix that crosses shard boundary, e.g.:
 // `ais ls bucket --prefix virt-subdir/A.tar.gz/dir-or-prefix-inside`
 func splitPrefixShardBoundary(prefix string) (external, internal string) {
@@ -446,7 +452,7 @@ func splitPrefixShardBoundary(prefix string) (external, internal string) {
 		return
 	}
 	external = prefix
-	for _, ext := range archive.FileExtensions {
+	for _, ext := range lsArchExts {
 		i := strings.Index(prefix, ext+"/")
 		if i <= 0 {
 			continue
@@ -459,7 +465,7 @@ func splitPrefixShardBoundary(prefix string) (external, internal string) {
 }
 
 func splitObjnameShardBoundary(fullName string) (objName, fileName string) {
-	for _, ext := range archive.FileExtensions {
+	for _, ext := range lsArchExts {
 		i := strings.Index(fullName, ext+"/")
 		if i <= 0 {
 			continue
@@ -26,6 +26,58 @@ import (
 	"github.com/vbauerster/mpb/v4"
 )
 
+func provenanceHandler(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return missingArgumentsError(c, "object name in the form "+objectArgument)
+	}
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	prov, err := api.GetObjectProvenance(apiBP, bck, objName)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			return fmt.Errorf("%q not found in %s", objName, bck.Cname(""))
+		}
+		return err
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(prov, teb.PropsSimpleTmpl, teb.Jopts(true))
+	}
+	propNVs := nvpairList{
+		{"target", prov.Target},
+		{"mountpath", prov.Mountpath},
+		{"size", cos.ToSizeIEC(prov.Size, 2)},
+		{"last-written", cos.FormatNanoTime(prov.Mtime, "")},
+	}
+	if prov.Ver != "" {
+		propNVs = append(propNVs, nvpair{"version", prov.Ver})
+	}
+	if prov.Source != "" {
+		propNVs = append(propNVs, nvpair{"source", prov.Source})
+	}
+	if prov.Mirror.Copies > 1 {
+		propNVs = append(propNVs, nvpair{"copies", strings.Join(prov.Mirror.Paths, ", ")})
+	}
+	if len(prov.ECSlices) > 0 {
+		slices := make([]string, 0, len(prov.ECSlices))
+		for tid, id := range prov.ECSlices {
+			slices = append(slices, fmt.Sprintf("%s:%d", tid, id))
+		}
+		sort.Strings(slices)
+		propNVs = append(propNVs, nvpair{"ec", fmt.Sprintf("%d:%d (%s)", prov.EC.DataSlices, prov.EC.ParitySlices,
+			strings.Join(slices, ", "))})
+	}
+	for _, ck := range prov.Cksums {
+		propNVs = append(propNVs, nvpair{"checksum." + ck.Origin, ck.Type + "[" + ck.Value + "]"})
+	}
+	for _, job := range prov.Jobs {
+		propNVs = append(propNVs, nvpair{"job", fmt.Sprintf("%s[%s] (%s, started %s)", job.Kind, job.ID,
+			job.Status, cos.FormatTime(job.StartTime, ""))})
+	}
+	return teb.Print(propNVs, teb.PropsSimpleTmpl)
+}
+
 // Promote AIS-colocated files and directories to objects.
 func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 	var (
@@ -26,7 +26,8 @@ var (
 			verboseFlag,
 			yesFlag,
 		),
-		commandRename: {},
+		commandRename:   {},
+		commandUndelete: {},
 		commandGet: {
 			offsetFlag,
 			lengthFlag,
@@ -77,6 +78,9 @@ var (
 			unitsFlag,
 			progressFlag,
 		},
+		commandProvenance: {
+			jsonFlag,
+		},
 		commandCat: {
 			offsetFlag,
 			lengthFlag,
@@ -136,6 +140,16 @@ var (
 			objectCmdSetCustom,
 			bucketObjCmdEvict,
 			makeAlias(showCmdObject, "", true, commandShow), // alias for `ais show`
+			{
+				Name: commandProvenance,
+				Usage: "show object's provenance: target, mountpath, and copies (or EC slices) that store the object,\n" +
+					indent4 + "\tthe time of the last write and the job(s) that were running at that time,\n" +
+					indent4 + "\tchecksum lineage, and the object's backend source",
+				ArgsUsage:    objectArgument,
+				Flags:        objectCmdsFlags[commandProvenance],
+				Action:       provenanceHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
 			{
 				Name:         commandRename,
 				Usage:        "move/rename object",
@@ -152,6 +166,15 @@ var (
 				Action:       removeObjectHandler,
 				BashComplete: bucketCompletions(bcmplop{multiple: true, separator: true}),
 			},
+			{
+				Name: commandUndelete,
+				Usage: "restore deleted object from trash (ais:// buckets only);\n" +
+					indent4 + "\tnote: requires cluster config 'space.trash_retention' > 0 at the time of deletion",
+				ArgsUsage:    objectArgument,
+				Flags:        objectCmdsFlags[commandUndelete],
+				Action:       undeleteObjectHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
 			{
 				Name:         commandPromote,
 				Usage:        "promote files and directories (i.e., replicate files and convert them to objects)",
@@ -226,6 +249,25 @@ func mvObjectHandler(c *cli.Context) (err error) {
 	return
 }
 
+func undeleteObjectHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	uri := c.Args().Get(0)
+	bck, objName, err := parseBckObjURI(c, uri, false)
+	if err != nil {
+		return err
+	}
+	if !bck.IsAIS() {
+		return incorrectUsageMsg(c, "provider %q not supported", bck.Provider)
+	}
+	if err := api.UndeleteObject(apiBP, bck, objName); err != nil {
+		return V(err)
+	}
+	fmt.Fprintf(c.App.Writer, "%s restored\n", bck.Cname(objName))
+	return nil
+}
+
 func removeObjectHandler(c *cli.Context) (err error) {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/urfave/cli"
 )
@@ -66,6 +67,8 @@ var (
 			showLatency,
 			showCmdMpathCapacity,
 			makeAlias(showCmdDisk, "", true /*silent*/, cmdShowDisk),
+			showCmdPerfCompare,
+			showCmdPerfSnapshot,
 		},
 	}
 	showCounters = cli.Command{
@@ -103,6 +106,30 @@ var (
 		Action:       showMpathCapHandler,
 		BashComplete: suggestTargets,
 	}
+	showCmdPerfCompare = cli.Command{
+		Name: cmdPerfCompare,
+		Usage: "compare performance before and after (e.g., a configuration change or an upgrade), e.g.:\n" +
+			indent2 + "\t- 'compare' - measure two consecutive time windows (see " + qflprn(perfWindowFlag) + ") and compare;\n" +
+			indent2 + "\t- 'compare before.json' - compare saved snapshot (see '" + cmdPerfSnapshot + "') with the current performance;\n" +
+			indent2 + "\t- 'compare before.json after.json' - compare two saved snapshots",
+		ArgsUsage: perfCompareArgument,
+		Flags: []cli.Flag{
+			perfWindowFlag,
+			perfThresholdFlag,
+			allColumnsFlag,
+			noHeaderFlag,
+			regexColsFlag,
+			unitsFlag,
+		},
+		Action: perfCompareHandler,
+	}
+	showCmdPerfSnapshot = cli.Command{
+		Name:      cmdPerfSnapshot,
+		Usage:     "measure performance over a time window and save the result to be compared later (see '" + cmdPerfCompare + "')",
+		ArgsUsage: perfSnapshotArgument,
+		Flags:     []cli.Flag{perfWindowFlag},
+		Action:    perfSnapshotHandler,
+	}
 )
 
 func showPerfHandler(c *cli.Context) error {
@@ -252,15 +279,7 @@ func _latency(c *cli.Context, metrics cos.StrKVs, mapBegin, mapEnd teb.StstMap,
 				continue
 			}
 			vend := end.Tracker[name]
-			ncounter := name[:len(name)-1] // ".ns" => ".n"
-			switch name {
-			case stats.GetLatency:
-				ncounter = stats.GetCount
-			case stats.PutLatency:
-				ncounter = stats.PutCount
-			case stats.AppendLatency:
-				ncounter = stats.AppendCount
-			}
+			ncounter := _latencyCounter(name)
 			if cntBegin, ok1 := begin.Tracker[ncounter]; ok1 {
 				if cntEnd, ok2 := end.Tracker[ncounter]; ok2 && cntEnd.Value > cntBegin.Value {
 					// (cumulative-end-time - cumulative-begin-time) / num-requests
@@ -278,6 +297,19 @@ func _latency(c *cli.Context, metrics cos.StrKVs, mapBegin, mapEnd teb.StstMap,
 	return
 }
 
+// the counter to average a given latency
+func _latencyCounter(name string) string {
+	switch name {
+	case stats.GetLatency:
+		return stats.GetCount
+	case stats.PutLatency:
+		return stats.PutCount
+	case stats.AppendLatency:
+		return stats.AppendCount
+	}
+	return name[:len(name)-1] // ".ns" => ".n"
+}
+
 // (main method)
 func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, totals map[string]int64, inclAvgSize bool) error {
 	var (
@@ -466,3 +498,158 @@ func showMpathCapHandler(c *cli.Context) error {
 	out := table.Template(hideHeader)
 	return teb.Print(tstatusMap, out)
 }
+
+//
+// compare performance: before vs after
+//
+
+func perfSnapshotHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	fname := c.Args().Get(0)
+	snap, err := perfSnap(c)
+	if err != nil {
+		return err
+	}
+	if err := jsp.Save(fname, snap, jsp.Plain(), nil); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Saved performance snapshot (%d targets, %v window) to %q", len(snap.Values), snap.Window, fname))
+	return nil
+}
+
+func perfCompareHandler(c *cli.Context) (err error) {
+	var (
+		before, after *teb.PerfSnap
+		regex         *regexp.Regexp
+		regexStr      = parseStrFlag(c, regexColsFlag)
+		hideHeader    = flagIsSet(c, noHeaderFlag)
+		threshold     = parseIntFlag(c, perfThresholdFlag)
+		units, errU   = parseUnitsFlag(c, unitsFlag)
+	)
+	if errU != nil {
+		return errU
+	}
+	if threshold < 0 {
+		return fmt.Errorf("invalid %s value %d (expecting non-negative percentage)", qflprn(perfThresholdFlag), threshold)
+	}
+	if regexStr != "" {
+		if regex, err = regexp.Compile(regexStr); err != nil {
+			return err
+		}
+	}
+	switch c.NArg() {
+	case 0:
+		if before, err = perfSnap(c); err != nil {
+			return err
+		}
+		after, err = perfSnap(c)
+	case 1:
+		if before, err = loadPerfSnap(c.Args().Get(0)); err != nil {
+			return err
+		}
+		after, err = perfSnap(c)
+	case 2:
+		if before, err = loadPerfSnap(c.Args().Get(0)); err != nil {
+			return err
+		}
+		after, err = loadPerfSnap(c.Args().Get(1))
+	default:
+		return incorrectUsageMsg(c, "", c.Args()[2:])
+	}
+	if err != nil {
+		return err
+	}
+
+	smap, err := getClusterMap(c)
+	if err != nil {
+		smap = &meta.Smap{} // comparing saved snapshots doesn't require the cluster
+	}
+	ctx := teb.PerfDiffCtx{Smap: smap, Regex: regex, Units: units, Threshold: threshold, AllRows: flagIsSet(c, allColumnsFlag)}
+	table, numRegressed := teb.NewPerfDiffTab(before, after, &ctx)
+
+	actionCptn(c, "before: ", fmt.Sprintf("%s (%v window)", cos.FormatTime(before.Time, ""), before.Window))
+	actionCptn(c, "after:  ", fmt.Sprintf("%s (%v window)", cos.FormatTime(after.Time, ""), after.Window))
+	if err := teb.Print(nil, table.Template(hideHeader)); err != nil {
+		return err
+	}
+	if numRegressed > 0 {
+		actionWarn(c, fmt.Sprintf("%d metric%s regressed by more than %d%%", numRegressed, cos.Plural(numRegressed), threshold))
+	}
+	return nil
+}
+
+// measure target performance over the time window: counters and sizes are the
+// increments, throughputs are per second, and latencies - per request
+func perfSnap(c *cli.Context) (*teb.PerfSnap, error) {
+	window := parseDurationFlag(c, perfWindowFlag)
+	if window < time.Second {
+		return nil, fmt.Errorf("invalid %s value %v (expecting 1s or greater)", qflprn(perfWindowFlag), window)
+	}
+	metrics, err := getMetricNames(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(metrics) == 0 {
+		return nil, cmn.NewErrNoNodes(apc.Target, 0)
+	}
+	mapBegin, mapEnd, err := _cluStatusBeginEnd(c, nil, window)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		seconds = cos.MaxI64(int64(window.Seconds()), 1)
+		snap    = &teb.PerfSnap{
+			Time:    time.Now(),
+			Window:  cos.Duration(window),
+			Metrics: metrics,
+			Values:  make(map[string]map[string]int64, len(mapEnd)),
+		}
+	)
+	for tid, end := range mapEnd {
+		begin := mapBegin[tid]
+		if begin == nil || begin.Status != teb.NodeOnline || end.Status != teb.NodeOnline {
+			continue
+		}
+		values := make(map[string]int64, len(end.Tracker))
+		for name, v := range end.Tracker {
+			kind, ok := metrics[name]
+			if !ok {
+				continue
+			}
+			delta := v.Value - begin.Tracker[name].Value
+			switch kind {
+			case stats.KindCounter, stats.KindSize:
+				values[name] = delta
+			case stats.KindThroughput:
+				values[name] = delta / seconds
+			case stats.KindLatency:
+				ncounter := _latencyCounter(name)
+				if cnt := end.Tracker[ncounter].Value - begin.Tracker[ncounter].Value; cnt > 0 {
+					values[name] = delta / cnt
+				} else {
+					values[name] = 0
+				}
+			default:
+				values[name] = v.Value
+			}
+		}
+		snap.Values[tid] = values
+	}
+	return snap, nil
+}
+
+func loadPerfSnap(fname string) (*teb.PerfSnap, error) {
+	snap := &teb.PerfSnap{}
+	if _, err := jsp.Load(fname, snap, jsp.Plain()); err != nil {
+		return nil, fmt.Errorf("failed to load performance snapshot %q: %v", fname, err)
+	}
+	if len(snap.Values) == 0 {
+		return nil, fmt.Errorf("performance snapshot %q is empty", fname)
+	}
+	return snap, nil
+}
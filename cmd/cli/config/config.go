@@ -26,6 +26,9 @@ const (
 	defaultAISPort   = 8080
 	defaultAuthNPort = 52001
 	defaultDockerIP  = "172.50.0.2"
+
+	defaultCmplCacheTTL   = 30 * time.Second
+	defaultCmplMaxObjects = 64
 )
 
 type (
@@ -44,17 +47,24 @@ type (
 	AuthConfig struct {
 		URL string `json:"url"`
 	}
+	// shell completion: live (server-side) suggestions
+	CompletionConfig struct {
+		CacheTTLStr string        `json:"cache_ttl"`   // cache suggestions for so long ("0s" to disable caching)
+		CacheTTL    time.Duration `json:"-"`           //
+		MaxObjects  int           `json:"max_objects"` // max object names to suggest (upper bound on listing)
+	}
 	AliasConfig cos.StrKVs // (see DefaultAliasConfig below)
 
 	// all of the above
 	Config struct {
-		Cluster         ClusterConfig `json:"cluster"`
-		Timeout         TimeoutConfig `json:"timeout"`
-		Auth            AuthConfig    `json:"auth"`
-		Aliases         AliasConfig   `json:"aliases"`
-		DefaultProvider string        `json:"default_provider,omitempty"` // NOTE: not supported yet (see app.go)
-		NoColor         bool          `json:"no_color"`
-		Verbose         bool          `json:"verbose"` // more warnings, errors with backtraces and details
+		Cluster         ClusterConfig    `json:"cluster"`
+		Timeout         TimeoutConfig    `json:"timeout"`
+		Auth            AuthConfig       `json:"auth"`
+		Aliases         AliasConfig      `json:"aliases"`
+		Completion      CompletionConfig `json:"completion"`
+		DefaultProvider string           `json:"default_provider,omitempty"` // NOTE: not supported yet (see app.go)
+		NoColor         bool             `json:"no_color"`
+		Verbose         bool             `json:"verbose"` // more warnings, errors with backtraces and details
 	}
 )
 
@@ -104,7 +114,12 @@ func init() {
 		Auth: AuthConfig{
 			URL: fmt.Sprintf(urlFmt, proto, defaultAISIP, defaultAuthNPort),
 		},
-		Aliases:         DefaultAliasConfig,
+		Aliases: DefaultAliasConfig,
+		Completion: CompletionConfig{
+			CacheTTLStr: defaultCmplCacheTTL.String(),
+			CacheTTL:    defaultCmplCacheTTL,
+			MaxObjects:  defaultCmplMaxObjects,
+		},
 		DefaultProvider: apc.AIS,
 		NoColor:         false,
 	}
@@ -155,6 +170,16 @@ func (c *Config) validate() (err error) {
 	if c.Aliases == nil {
 		c.Aliases = DefaultAliasConfig
 	}
+	// (older configs may not have the completion section)
+	if c.Completion.CacheTTLStr == "" {
+		c.Completion.CacheTTLStr = defaultCmplCacheTTL.String()
+	}
+	if c.Completion.CacheTTL, err = time.ParseDuration(c.Completion.CacheTTLStr); err != nil {
+		return fmt.Errorf("invalid completion.cache_ttl format %q: %v", c.Completion.CacheTTLStr, err)
+	}
+	if c.Completion.MaxObjects <= 0 {
+		c.Completion.MaxObjects = defaultCmplMaxObjects
+	}
 	return nil
 }
 
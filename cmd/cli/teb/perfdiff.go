@@ -0,0 +1,116 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// `ais performance compare`
+
+const (
+	colMetric = "METRIC"
+	colBefore = "BEFORE"
+	colAfter  = "AFTER"
+	colDelta  = "DELTA"
+	colChange = "CHANGE"
+)
+
+type (
+	// per-target metrics measured over a time window: counters and sizes are
+	// the window's increments, throughputs - per second, latencies - per request
+	PerfSnap struct {
+		Time    time.Time                   `json:"time"`
+		Window  cos.Duration                `json:"window"`
+		Metrics cos.StrKVs                  `json:"metrics"` // name => kind
+		Values  map[string]map[string]int64 `json:"values"`  // target ID => (name => value)
+	}
+	PerfDiffCtx struct {
+		Smap      *meta.Smap
+		Sid       string         // single target, unless ""
+		Regex     *regexp.Regexp // filter metric names
+		Units     string
+		Threshold int  // regression threshold, in percent
+		AllRows   bool // include unchanged and all-zero metrics
+	}
+)
+
+func NewPerfDiffTab(before, after *PerfSnap, c *PerfDiffCtx) (table *Table, numRegressed int) {
+	table = newTable(&header{name: colTarget}, &header{name: colMetric}, &header{name: colBefore},
+		&header{name: colAfter}, &header{name: colDelta}, &header{name: colChange})
+
+	tids := make([]string, 0, len(after.Values))
+	for tid := range after.Values {
+		if _, ok := before.Values[tid]; ok {
+			tids = append(tids, tid)
+		}
+	}
+	sort.Strings(tids)
+	for _, tid := range tids {
+		if c.Sid != "" && c.Sid != tid {
+			continue
+		}
+		var (
+			vb, va = before.Values[tid], after.Values[tid]
+			names  = make([]string, 0, len(va))
+		)
+		for name := range va {
+			if _, ok := vb[name]; ok && (c.Regex == nil || c.Regex.MatchString(name)) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			b, a := vb[name], va[name]
+			if !c.AllRows && a == b {
+				continue
+			}
+			kind := after.Metrics[name]
+			change, regressed := _change(name, kind, b, a, c.Threshold)
+			if regressed {
+				change = fred("%s", change)
+				numRegressed++
+			}
+			var delta string
+			switch {
+			case a > b:
+				delta = "+" + FmtStatValue(name, kind, a-b, c.Units)
+			case a < b:
+				delta = "-" + FmtStatValue(name, kind, b-a, c.Units)
+			default:
+				delta = "0"
+			}
+			table.addRow(row{fmtDaemonID(tid, c.Smap, ""), name,
+				FmtStatValue(name, kind, b, c.Units), FmtStatValue(name, kind, a, c.Units), delta, change})
+		}
+	}
+	return
+}
+
+// regression: latency or errors up, throughput down - by more than the threshold
+func _change(name, kind string, b, a int64, threshold int) (change string, regressed bool) {
+	if b == 0 {
+		if a == 0 {
+			return "0%", false
+		}
+		return "new", stats.IsErrMetric(name)
+	}
+	pct := float64(a-b) * 100 / float64(b)
+	change = fmt.Sprintf("%+.1f%%", pct)
+	switch {
+	case stats.IsErrMetric(name), kind == stats.KindLatency:
+		regressed = pct > float64(threshold)
+	case kind == stats.KindThroughput || kind == stats.KindComputedThroughput:
+		regressed = -pct > float64(threshold)
+	}
+	return
+}
@@ -120,6 +120,8 @@ offline:
 func FmtNodeStatus(node *meta.Snode) (status string) {
 	status = NodeOnline
 	switch {
+	case node.Flags.IsSet(meta.SnodeSpare):
+		status = apc.NodeSpare
 	case node.Flags.IsSet(meta.SnodeMaint):
 		status = apc.NodeMaintenance
 	case node.Flags.IsSet(meta.SnodeDecomm):
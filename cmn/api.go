@@ -43,6 +43,13 @@ type (
 		BackendBck  Bck             `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
 		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"`
 		WritePolicy WritePolicyConf `json:"write_policy"`
+		Schema      SchemaConf      `json:"schema"`                         // PUT validation rules
+		Dict        DictConf        `json:"dict"`                           // at-rest compression with trained dictionary
+		Union       UnionConf       `json:"union"`                          // read-only union (merge) of other buckets
+		Scratch     ScratchConf     `json:"scratch"`                        // node-local ephemeral content
+		Placement   PlacementConf   `json:"placement"`                      // label-based mountpath placement
+		Durability  DurabilityConf  `json:"durability"`                     // fsync policy upon PUT
+		DirectIO    DirectIOConf    `json:"direct_io"`                      // bypass OS page cache
 		Provider    string          `json:"provider" list:"readonly"`       // backend provider
 		Renamed     string          `list:"omit"`                           // non-empty if the bucket has been renamed
 		Cksum       CksumConf       `json:"checksum"`                       // the bucket's checksum
@@ -79,11 +86,16 @@ type (
 		// set the value of the environment variable will be loaded (AWS_PROFILE,
 		// or AWS_DEFAULT_PROFILE if the Shared Config is enabled)."
 		Profile string `json:"profile,omitempty"`
+
+		// S3-compatible backend (one of the apc.S3Preset* enum) - empty for Amazon S3;
+		// when the endpoint is not set, it is derived from the preset and the region
+		Preset string `json:"preset,omitempty"`
 	}
 	ExtraPropsAWSToUpdate struct {
 		CloudRegion *string `json:"cloud_region"`
 		Endpoint    *string `json:"endpoint"`
 		Profile     *string `json:"profile"`
+		Preset      *string `json:"preset"`
 	}
 
 	ExtraPropsHTTP struct {
@@ -114,14 +126,116 @@ type (
 		EC          *ECConfToUpdate          `json:"ec,omitempty"`
 		Access      *apc.AccessAttrs         `json:"access,string,omitempty"`
 		WritePolicy *WritePolicyConfToUpdate `json:"write_policy,omitempty"`
+		Schema      *SchemaConfToUpdate      `json:"schema,omitempty"`
+		Dict        *DictConfToUpdate        `json:"dict,omitempty"`
+		Union       *UnionConfToUpdate       `json:"union,omitempty"`
+		Scratch     *ScratchConfToUpdate     `json:"scratch,omitempty"`
+		Placement   *PlacementConfToUpdate   `json:"placement,omitempty"`
+		Durability  *DurabilityConfToUpdate  `json:"durability,omitempty"`
+		DirectIO    *DirectIOConfToUpdate    `json:"direct_io,omitempty"`
 		Extra       *ExtraToUpdate           `json:"extra,omitempty"`
 		Force       bool                     `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
 
+	// Bucket schema: validation rules enforced upon (user) PUT - empty means no validation.
+	// All lists are comma-separated.
+	SchemaConf struct {
+		// allowed object name extensions, e.g. ".jpg,.png,.tar.gz"
+		Extensions string `json:"extensions"`
+		// allowed content types as detected by the content's leading (magic) bytes,
+		// e.g. "image/jpeg,image/png" or "image/*" (see net/http.DetectContentType)
+		ContentTypes string `json:"content_types"`
+		// custom metadata keys that each object must have (see apc.HdrObjCustomMD)
+		RequiredMD string `json:"required_md"`
+		// max object size (0 - unlimited)
+		MaxSize cos.SizeIEC `json:"max_size"`
+	}
+	SchemaConfToUpdate struct {
+		Extensions   *string      `json:"extensions,omitempty"`
+		ContentTypes *string      `json:"content_types,omitempty"`
+		RequiredMD   *string      `json:"required_md,omitempty"`
+		MaxSize      *cos.SizeIEC `json:"max_size,omitempty"`
+	}
+
+	// At-rest compression of small objects with a per-bucket zstd dictionary that is trained
+	// on demand (apc.ActTrainDict) from the bucket's own objects. Dictionaries are versioned
+	// and never removed: each object remains compressed with the version it was written with.
+	DictConf struct {
+		Dicts   []BckDict   `json:"dicts,omitempty" list:"omit"` // oldest first (the last one is current)
+		MaxSize cos.SizeIEC `json:"max_size"`                    // larger objects are stored as is (0: DfltDictObjSize)
+		Enabled bool        `json:"enabled"`
+	}
+	DictConfToUpdate struct {
+		MaxSize *cos.SizeIEC `json:"max_size,omitempty"`
+		Enabled *bool        `json:"enabled,omitempty"`
+	}
+	// Union (merge) bucket: read-only virtual view over the comma-separated list of
+	// member buckets, e.g. "ais://train-2022,s3://train-2023". When the same object name
+	// exists in more than one member, the first (leftmost) member takes precedence.
+	UnionConf struct {
+		Members string `json:"members"`
+	}
+	UnionConfToUpdate struct {
+		Members *string `json:"members,omitempty"`
+	}
+	// Scratch bucket: node-local, non-replicated, and non-rebalanced storage for intermediate
+	// content (ETL temporaries, dsort spills, etc.) that gets removed once older than TTL.
+	// Can only be enabled at bucket creation time.
+	ScratchConf struct {
+		TTL     cos.Duration `json:"ttl"` // since last modification (0: DfltScratchTTL)
+		Enabled bool         `json:"enabled"`
+	}
+	ScratchConfToUpdate struct {
+		TTL     *cos.Duration `json:"ttl,omitempty"`
+		Enabled *bool         `json:"enabled,omitempty"`
+	}
+	// Placement: store the bucket's objects only on mountpaths labeled with Label (e.g., "ssd")
+	// - on those targets that have such mountpaths; all others use all their mountpaths.
+	// Changing the label of an existing bucket triggers resilvering (see also FSPConf).
+	PlacementConf struct {
+		Label string `json:"label"` // empty: all mountpaths
+	}
+	PlacementConfToUpdate struct {
+		Label *string `json:"label,omitempty"`
+	}
+	// Durability: crash-consistency vs. throughput tradeoff when finalizing PUT(object) -
+	// one of the apc.Durability* enum (default: none, unless feat.FsyncPUT is set).
+	DurabilityConf struct {
+		Policy apc.Durability `json:"policy"`
+	}
+	DurabilityConfToUpdate struct {
+		Policy *apc.Durability `json:"policy,omitempty"`
+	}
+	// Direct I/O: read and write the bucket's objects with O_DIRECT, bypassing the OS page cache
+	// (see fs.DirectWriter and fs.DirectReader); cluster-wide, the same is enabled by feat.DirectIO.
+	DirectIOConf struct {
+		Enabled bool `json:"enabled"`
+	}
+	DirectIOConfToUpdate struct {
+		Enabled *bool `json:"enabled,omitempty"`
+	}
+	BckDict struct {
+		Data    []byte `json:"data"`
+		Created int64  `json:"created,string"`
+		Version int    `json:"version"`
+	}
+
 	BackendBckToUpdate struct {
 		Name     *string `json:"name"`
 		Provider *string `json:"provider"`
 	}
+
+	// property (IterFields tag) that has changed - see BucketProps.Diff
+	PropDiff struct {
+		Name   string `json:"name"`
+		Before string `json:"before"`
+		After  string `json:"after"`
+	}
+	// api.SetBucketPropsDiff response
+	BpropsUpdateResult struct {
+		Xid  string     `json:"xid,omitempty"` // xaction that was started (e.g., re-mirroring), if any
+		Diff []PropDiff `json:"diff"`
+	}
 )
 
 /////////////////
@@ -186,6 +300,36 @@ func (bp *BucketProps) Equal(other *BucketProps) (eq bool) {
 	return
 }
 
+// returns the properties that differ (sorted by name)
+func (bp *BucketProps) Diff(other *BucketProps) (diff []PropDiff) {
+	var (
+		before = bp.nvs()
+		after  = other.nvs()
+	)
+	for name, a := range after {
+		if b, ok := before[name]; !ok || a != b {
+			diff = append(diff, PropDiff{Name: name, Before: b, After: a})
+		}
+	}
+	for name, b := range before {
+		if _, ok := after[name]; !ok {
+			diff = append(diff, PropDiff{Name: name, Before: b})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Name < diff[j].Name })
+	return
+}
+
+func (bp *BucketProps) nvs() cos.StrKVs {
+	nvs := make(cos.StrKVs, 64)
+	err := IterFields(bp, func(tag string, field IterField) (error, bool) {
+		nvs[tag] = fmt.Sprintf("%v", field.Value())
+		return nil, false
+	})
+	debug.AssertNoErr(err)
+	return nvs
+}
+
 func (bp *BucketProps) Validate(targetCnt int) error {
 	debug.Assert(apc.IsProvider(bp.Provider))
 	if !bp.BackendBck.IsEmpty() {
@@ -203,8 +347,26 @@ func (bp *BucketProps) Validate(targetCnt int) error {
 			return fmt.Errorf("backend bucket %q must be remote", bp.BackendBck)
 		}
 	}
+	if bp.Union.IsSet() {
+		if bp.Provider != apc.AIS {
+			return fmt.Errorf("wrong bucket provider %q: only AIS buckets can be a union of other buckets", bp.Provider)
+		}
+		if !bp.BackendBck.IsEmpty() {
+			return fmt.Errorf("union bucket cannot have a backend (%q)", bp.BackendBck)
+		}
+	}
+	if bp.Scratch.Enabled {
+		switch {
+		case bp.Provider != apc.AIS:
+			return fmt.Errorf("wrong bucket provider %q: only AIS buckets can be scratch", bp.Provider)
+		case !bp.BackendBck.IsEmpty() || bp.Union.IsSet():
+			return fmt.Errorf("scratch bucket cannot have a backend or be a union of other buckets")
+		case bp.Mirror.Enabled || bp.EC.Enabled:
+			return fmt.Errorf("scratch bucket cannot be mirrored or erasure coded")
+		}
+	}
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.LRU, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.Schema, &bp.Dict, &bp.Union, &bp.Scratch, &bp.Placement, &bp.Durability} {
 		var err error
 		if pv == &bp.EC {
 			err = bp.EC.ValidateAsProps(targetCnt)
@@ -266,6 +428,17 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 		if c.HTTP.OrigURLBck == "" {
 			return fmt.Errorf("original bucket URL must be set for a bucket with HTTP provider")
 		}
+	case apc.AWS:
+		if c.AWS.Preset == "" {
+			break
+		}
+		if !apc.IsS3Preset(c.AWS.Preset) {
+			return fmt.Errorf("invalid S3 preset %q (expecting one of: %s)", c.AWS.Preset, apc.AllS3Presets)
+		}
+		// Spaces: region is encoded in the endpoint; B2: region is also required to sign requests
+		if c.AWS.CloudRegion == "" && (c.AWS.Endpoint == "" || c.AWS.Preset == apc.S3PresetB2) {
+			return fmt.Errorf("S3 preset %q requires region (extra.aws.cloud_region)", c.AWS.Preset)
+		}
 	}
 	return nil
 }
@@ -57,3 +57,8 @@ func IsErrUnknownFileExt(err error) bool {
 	_, ok := err.(*ErrUnknownFileExt)
 	return ok
 }
+
+// zip and 7z require random access
+func errNotReaderAt(mime string, r any) error {
+	return fmt.Errorf("%s archive requires random access (io.ReaderAt), have %T", mime, r)
+}
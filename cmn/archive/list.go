@@ -15,6 +15,7 @@ import (
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/bodgit/sevenzip"
 	"github.com/pierrec/lz4/v3"
 )
 
@@ -51,6 +52,11 @@ func List(fqn string) ([]*Entry, error) {
 		}
 	case ExtTarLz4:
 		lst, err = lsLz4(fh)
+	case Ext7z:
+		finfo, err = os.Stat(fqn)
+		if err == nil {
+			lst, err = ls7z(fh, finfo.Size())
+		}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -63,7 +69,7 @@ func List(fqn string) ([]*Entry, error) {
 	return lst, nil
 }
 
-// list: tar, tgz, zip, msgpack
+// list: tar, tgz, zip, tar.lz4, 7z
 func lsTar(reader io.Reader) (lst []*Entry, _ error) {
 	tr := tar.NewReader(reader)
 	for {
@@ -113,3 +119,17 @@ func lsLz4(reader io.Reader) ([]*Entry, error) {
 	lzr := lz4.NewReader(reader)
 	return lsTar(lzr)
 }
+
+func ls7z(readerAt cos.ReadReaderAt, size int64) (lst []*Entry, err error) {
+	var sr *sevenzip.Reader
+	if sr, err = sevenzip.NewReader(readerAt, size); err != nil {
+		return
+	}
+	for _, f := range sr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		lst = append(lst, &Entry{Name: f.Name, Size: int64(f.UncompressedSize)})
+	}
+	return
+}
@@ -29,6 +29,9 @@ const (
 	ExtTarGz  = ".tar.gz"
 	ExtZip    = ".zip"
 	ExtTarLz4 = ".tar.lz4"
+
+	// read-only (can be listed and read from via `archpath` but not created or appended)
+	Ext7z = ".7z"
 )
 
 const (
@@ -49,14 +52,19 @@ type detect struct {
 
 var FileExtensions = []string{ExtTar, ExtTgz, ExtTarGz, ExtZip, ExtTarLz4}
 
+// supported for reading only
+var ReadOnlyExtensions = []string{Ext7z}
+
 // standard file signatures
 var (
 	magicTar  = detect{offset: 257, sig: []byte("ustar"), mime: ExtTar}
 	magicGzip = detect{sig: []byte{0x1f, 0x8b}, mime: ExtTarGz}
 	magicZip  = detect{sig: []byte{0x50, 0x4b}, mime: ExtZip}
 	magicLz4  = detect{sig: []byte{0x04, 0x22, 0x4d, 0x18}, mime: ExtTarLz4}
+	magic7z   = detect{sig: []byte{'7', 'z', 0xbc, 0xaf, 0x27, 0x1c}, mime: Ext7z}
 
 	allMagics = []detect{magicTar, magicGzip, magicZip, magicLz4} // NOTE: must contain all
+	roMagics  = []detect{magic7z}                                 // read-only
 )
 
 // motivation: prevent from creating archives with non-standard extensions
@@ -84,6 +92,24 @@ func Mime(mime, filename string) (string, error) {
 	return byExt(filename)
 }
 
+// same as above, plus read-only formats (usage: listing and reading)
+func MimeRead(mime, filename string) (string, error) {
+	m, err := Mime(mime, filename)
+	if err == nil {
+		return m, nil
+	}
+	for _, ext := range ReadOnlyExtensions {
+		if mime != "" {
+			if strings.Contains(mime, ext[1:]) { // e.g. "application/x-7z-compressed"
+				return ext, nil
+			}
+		} else if strings.HasSuffix(filename, ext) {
+			return ext, nil
+		}
+	}
+	return "", err
+}
+
 // e.g. MIME: "application/zip"
 func normalize(mime string) (string, error) {
 	switch {
@@ -113,7 +139,7 @@ func byExt(filename string) (string, error) {
 
 // NOTE convention: caller may pass nil `smm` _not_ to spend time (usage: listing and reading)
 func MimeFile(file *os.File, smm *memsys.MMSA, mime, archname string) (m string, err error) {
-	m, err = Mime(mime, archname)
+	m, err = MimeRead(mime, archname)
 	if err == nil || IsErrUnknownMime(err) {
 		return
 	}
@@ -126,7 +152,7 @@ func MimeFile(file *os.File, smm *memsys.MMSA, mime, archname string) (m string,
 		n         int
 		buf, slab = smm.AllocSize(sizeDetectMime)
 	)
-	m, n, err = _detect(file, archname, buf)
+	m, n, err = _detect(file, archname, buf, true /*read-only formats*/)
 	if n > 0 {
 		_, errV := file.Seek(0, io.SeekStart)
 		debug.AssertNoErr(errV)
@@ -158,13 +184,13 @@ func MimeFQN(smm *memsys.MMSA, mime, archname string) (m string, err error) {
 		return "", err
 	}
 	buf, slab := smm.AllocSize(sizeDetectMime)
-	m, _, err = _detect(fh, archname, buf)
+	m, _, err = _detect(fh, archname, buf, false)
 	slab.Free(buf)
 	cos.Close(fh)
 	return
 }
 
-func _detect(file *os.File, archname string, buf []byte) (m string, n int, err error) {
+func _detect(file *os.File, archname string, buf []byte, ro bool) (m string, n int, err error) {
 	n, err = file.Read(buf)
 	if err != nil {
 		return
@@ -178,6 +204,13 @@ func _detect(file *os.File, archname string, buf []byte) (m string, n int, err e
 			return magic.mime, n, nil
 		}
 	}
+	if ro {
+		for _, magic := range roMagics {
+			if bytes.HasPrefix(buf, magic.sig) {
+				return magic.mime, n, nil
+			}
+		}
+	}
 	err = fmt.Errorf("failed to detect supported file signatures in %q", archname)
 	return
 }
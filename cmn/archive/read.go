@@ -14,6 +14,7 @@ import (
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/bodgit/sevenzip"
 	"github.com/pierrec/lz4/v3"
 )
 
@@ -54,6 +55,11 @@ type (
 		tr  tarReader
 		lzr *lz4.Reader
 	}
+	sevenReader struct {
+		baseR
+		size int64
+		sr   *sevenzip.Reader
+	}
 )
 
 // interface guard
@@ -62,6 +68,7 @@ var (
 	_ Reader = (*tgzReader)(nil)
 	_ Reader = (*zipReader)(nil)
 	_ Reader = (*lz4Reader)(nil)
+	_ Reader = (*sevenReader)(nil)
 )
 
 func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error) {
@@ -75,6 +82,9 @@ func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error)
 		ar = &zipReader{size: size[0]}
 	case ExtTarLz4:
 		ar = &lz4Reader{}
+	case Ext7z:
+		debug.Assert(len(size) > 0 && size[0] > 0, "size required")
+		ar = &sevenReader{size: size[0]}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -152,7 +162,9 @@ func (tgr *tgzReader) Range(filename string, rcb ReadCB) (cos.ReadCloseSizer, er
 
 func (zr *zipReader) init(fh io.Reader) (err error) {
 	readerAt, ok := fh.(io.ReaderAt)
-	debug.Assert(ok, "expecting io.ReaderAt")
+	if !ok {
+		return errNotReaderAt(ExtZip, fh)
+	}
 	zr.baseR.init(fh)
 	zr.zr, err = zip.NewReader(readerAt, zr.size)
 	return
@@ -203,6 +215,46 @@ func (lzr *lz4Reader) Range(filename string, rcb ReadCB) (cos.ReadCloseSizer, er
 	return lzr.tr.Range(filename, rcb)
 }
 
+// sevenReader (read-only format)
+
+func (sr *sevenReader) init(fh io.Reader) (err error) {
+	readerAt, ok := fh.(io.ReaderAt)
+	if !ok {
+		return errNotReaderAt(Ext7z, fh)
+	}
+	sr.baseR.init(fh)
+	sr.sr, err = sevenzip.NewReader(readerAt, sr.size)
+	return
+}
+
+func (sr *sevenReader) Range(filename string, rcb ReadCB) (reader cos.ReadCloseSizer, err error) {
+	for _, f := range sr.sr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		size := int64(f.UncompressedSize)
+		// select one
+		if filename != "" {
+			if f.Name == filename || namesEq(f.Name, filename) {
+				csf := &cslFile{size: size}
+				csf.file, err = f.Open()
+				reader = csf
+				return
+			}
+			continue
+		}
+		csf := &cslFile{size: size}
+		if csf.file, err = f.Open(); err != nil {
+			return
+		}
+		stop, err := rcb(f.Name, csf, &f.FileHeader)
+		if stop || err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
 //
 // more limited readers
 //
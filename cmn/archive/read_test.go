@@ -0,0 +1,212 @@
+// Package archive: write, read, copy, append, list primitives
+// across all supported formats
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+var testFiles = map[string]string{
+	"a.txt":         "aaa",
+	"dir/b.txt":     "bbbbbb",
+	"dir/sub/c.bin": strings.Repeat("c", 300),
+}
+
+func TestListAndRead(t *testing.T) {
+	for _, mime := range []string{archive.ExtTar, archive.ExtTgz, archive.ExtZip, archive.ExtTarLz4, archive.Ext7z} {
+		t.Run(mime, func(t *testing.T) {
+			var (
+				b   = makeArchive(t, mime)
+				fqn = filepath.Join(t.TempDir(), "test"+mime)
+			)
+			tassert.CheckFatal(t, os.WriteFile(fqn, b, cos.PermRWR))
+
+			// list
+			lst, err := archive.List(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, len(lst) == len(testFiles), "expected %d entries, got %d", len(testFiles), len(lst))
+			for _, e := range lst {
+				body, ok := testFiles[e.Name]
+				tassert.Fatalf(t, ok, "unexpected entry %q", e.Name)
+				tassert.Errorf(t, e.Size == int64(len(body)), "%s: expected size %d, got %d", e.Name, len(body), e.Size)
+			}
+
+			// read all
+			ar, err := archive.NewReader(mime, bytes.NewReader(b), int64(len(b)))
+			tassert.CheckFatal(t, err)
+			got := make(map[string]string, len(testFiles))
+			_, err = ar.Range("", func(filename string, reader cos.ReadCloseSizer, _ any) (bool, error) {
+				data, err := io.ReadAll(reader)
+				reader.Close()
+				got[filename] = string(data)
+				return false, err
+			})
+			tassert.CheckFatal(t, err)
+			for name, body := range testFiles {
+				tassert.Errorf(t, got[name] == body, "%s: content mismatch (%d vs %d bytes)", name, len(got[name]), len(body))
+			}
+
+			// read one
+			ar, err = archive.NewReader(mime, bytes.NewReader(b), int64(len(b)))
+			tassert.CheckFatal(t, err)
+			reader, err := ar.Range("dir/b.txt", nil)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, reader != nil, "dir/b.txt not found")
+			data, err := io.ReadAll(reader)
+			reader.Close()
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, string(data) == testFiles["dir/b.txt"], "unexpected content %q", data)
+		})
+	}
+}
+
+// zip and 7z require random access
+func TestReaderAtRequired(t *testing.T) {
+	for _, mime := range []string{archive.ExtZip, archive.Ext7z} {
+		b := makeArchive(t, mime)
+		_, err := archive.NewReader(mime, io.MultiReader(bytes.NewReader(b)), int64(len(b)))
+		tassert.Errorf(t, err != nil, "%s: expected error", mime)
+	}
+}
+
+func makeArchive(t *testing.T, mime string) []byte {
+	names := make([]string, 0, len(testFiles))
+	for name := range testFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if mime == archive.Ext7z {
+		return make7z(names)
+	}
+	var (
+		buf bytes.Buffer
+		aw  = archive.NewWriter(mime, &buf, nil, nil)
+	)
+	for _, name := range names {
+		body := testFiles[name]
+		err := aw.Write(name, cos.SimpleOAH{Size: int64(len(body))}, strings.NewReader(body))
+		tassert.CheckFatal(t, err)
+	}
+	aw.Fini()
+	return buf.Bytes()
+}
+
+//
+// minimal 7z writer: one uncompressed ("copy" coder) folder per file, plain header
+//
+
+const (
+	k7zEnd             = 0x00
+	k7zHeader          = 0x01
+	k7zMainStreamsInfo = 0x04
+	k7zFilesInfo       = 0x05
+	k7zPackInfo        = 0x06
+	k7zUnpackInfo      = 0x07
+	k7zSubStreamsInfo  = 0x08
+	k7zSize            = 0x09
+	k7zCRC             = 0x0a
+	k7zFolder          = 0x0b
+	k7zCodersUnpackSz  = 0x0c
+	k7zName            = 0x11
+)
+
+func make7z(names []string) []byte {
+	var data, hdr bytes.Buffer
+	for _, name := range names {
+		data.WriteString(testFiles[name])
+	}
+	hdr.WriteByte(k7zHeader)
+	hdr.WriteByte(k7zMainStreamsInfo)
+	{
+		hdr.WriteByte(k7zPackInfo)
+		write7zNum(&hdr, 0) // pack position
+		write7zNum(&hdr, len(names))
+		hdr.WriteByte(k7zSize)
+		for _, name := range names {
+			write7zNum(&hdr, len(testFiles[name]))
+		}
+		hdr.WriteByte(k7zEnd)
+
+		hdr.WriteByte(k7zUnpackInfo)
+		hdr.WriteByte(k7zFolder)
+		write7zNum(&hdr, len(names))
+		hdr.WriteByte(0) // not external
+		for range names {
+			write7zNum(&hdr, 1) // number of coders
+			hdr.WriteByte(0x01) // simple coder with 1-byte ID
+			hdr.WriteByte(0x00) // copy
+		}
+		hdr.WriteByte(k7zCodersUnpackSz)
+		for _, name := range names {
+			write7zNum(&hdr, len(testFiles[name]))
+		}
+		hdr.WriteByte(k7zEnd)
+
+		// (one stream per folder)
+		hdr.WriteByte(k7zSubStreamsInfo)
+		hdr.WriteByte(k7zCRC)
+		hdr.WriteByte(1) // all defined
+		for _, name := range names {
+			binary.Write(&hdr, binary.LittleEndian, crc32.ChecksumIEEE([]byte(testFiles[name]))) //nolint:errcheck // bytes.Buffer
+		}
+		hdr.WriteByte(k7zEnd)
+	}
+	hdr.WriteByte(k7zEnd)
+
+	hdr.WriteByte(k7zFilesInfo)
+	write7zNum(&hdr, len(names))
+	{
+		var nb bytes.Buffer
+		nb.WriteByte(0) // not external
+		for _, name := range names {
+			for _, u := range utf16.Encode([]rune(name + "\x00")) {
+				binary.Write(&nb, binary.LittleEndian, u) //nolint:errcheck // bytes.Buffer
+			}
+		}
+		hdr.WriteByte(k7zName)
+		write7zNum(&hdr, nb.Len())
+		hdr.Write(nb.Bytes())
+	}
+	hdr.WriteByte(k7zEnd)
+	hdr.WriteByte(k7zEnd)
+
+	// signature header
+	start := make([]byte, 20)
+	binary.LittleEndian.PutUint64(start[0:], uint64(data.Len()))
+	binary.LittleEndian.PutUint64(start[8:], uint64(hdr.Len()))
+	binary.LittleEndian.PutUint32(start[16:], crc32.ChecksumIEEE(hdr.Bytes()))
+
+	var out bytes.Buffer
+	out.Write([]byte{'7', 'z', 0xbc, 0xaf, 0x27, 0x1c, 0, 4})
+	binary.Write(&out, binary.LittleEndian, crc32.ChecksumIEEE(start)) //nolint:errcheck // bytes.Buffer
+	out.Write(start)
+	out.Write(data.Bytes())
+	out.Write(hdr.Bytes())
+	return out.Bytes()
+}
+
+// 7z variable-length number (values below 16K)
+func write7zNum(w *bytes.Buffer, n int) {
+	if n < 0x80 {
+		w.WriteByte(byte(n))
+		return
+	}
+	w.WriteByte(0x80 | byte(n>>8))
+	w.WriteByte(byte(n))
+}
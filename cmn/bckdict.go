@@ -0,0 +1,58 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+const (
+	DfltDictObjSize = 64 * cos.KiB // default DictConf.MaxSize
+	MaxDictObjSize  = cos.MiB
+
+	DfltBckDictSize = 64 * cos.KiB
+	MaxBckDictSize  = 128 * cos.KiB // (dictionaries are stored in BMD)
+	MaxBckDicts     = 16
+)
+
+// interface guard
+var _ PropsValidator = (*DictConf)(nil)
+
+func (c *DictConf) ValidateAsProps(...any) error {
+	if c.MaxSize < 0 || c.MaxSize > MaxDictObjSize {
+		return fmt.Errorf("invalid dict.max_size %d (expecting [0, %s])", c.MaxSize, cos.ToSizeIEC(MaxDictObjSize, 0))
+	}
+	if len(c.Dicts) > MaxBckDicts {
+		return fmt.Errorf("number of trained dictionaries %d exceeds the maximum %d", len(c.Dicts), MaxBckDicts)
+	}
+	return nil
+}
+
+// current (most recently trained) dictionary or nil
+func (c *DictConf) Current() *BckDict {
+	if l := len(c.Dicts); l > 0 {
+		return &c.Dicts[l-1]
+	}
+	return nil
+}
+
+func (c *DictConf) Get(version int) *BckDict {
+	for i := range c.Dicts {
+		if c.Dicts[i].Version == version {
+			return &c.Dicts[i]
+		}
+	}
+	return nil
+}
+
+func (c *DictConf) ObjSizeLimit() int64 {
+	if c.MaxSize == 0 {
+		return DfltDictObjSize
+	}
+	return int64(c.MaxSize)
+}
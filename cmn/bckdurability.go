@@ -0,0 +1,36 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/feat"
+)
+
+// interface guard
+var _ PropsValidator = (*DurabilityConf)(nil)
+
+func (c *DurabilityConf) ValidateAsProps(...any) error {
+	if err := c.Policy.Validate(); err != nil {
+		return fmt.Errorf("durability.policy: %v", err)
+	}
+	return nil
+}
+
+// the bucket's policy or, if not set, the one implied by the (legacy) feat.FsyncPUT
+func (c *DurabilityConf) Get() apc.Durability {
+	if c.Policy == apc.DurabilityDefault && Features.IsSet(feat.FsyncPUT) {
+		return apc.DurabilityFsync
+	}
+	return c.Policy
+}
+
+// enabled for the bucket or, cluster-wide, via feat.DirectIO
+func (c *DirectIOConf) Get() bool {
+	return c.Enabled || Features.IsSet(feat.DirectIO)
+}
@@ -0,0 +1,86 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// list-buckets with filters and (optional) usage - see apc.LsbMsg
+
+type (
+	// quick (and approximate) per-bucket stats
+	BckUsage struct {
+		ObjCount     uint64 `json:"obj_count,string"`     // number of objects on disk, including local (mirrored) copies
+		Size         uint64 `json:"size,string"`          // used bytes (on disk)
+		LastModified int64  `json:"last_modified,string"` // (unix nano) the most recent put or delete, as per directory mtimes
+	}
+	BckInfo struct {
+		Usage *BckUsage `json:"usage,omitempty"`
+		Bck   Bck       `json:"bck"`
+	}
+	BckInfos []*BckInfo
+
+	LsbFilter struct {
+		re    *regexp.Regexp
+		props map[string]string
+	}
+)
+
+// interface guard
+var _ sort.Interface = (*BckInfos)(nil)
+
+func (infos BckInfos) Len() int           { return len(infos) }
+func (infos BckInfos) Less(i, j int) bool { return infos[i].Bck.Less(&infos[j].Bck) }
+func (infos BckInfos) Swap(i, j int)      { infos[i], infos[j] = infos[j], infos[i] }
+
+func (u *BckUsage) Aggregate(from *BckUsage) {
+	u.ObjCount += from.ObjCount
+	u.Size += from.Size
+	if from.LastModified > u.LastModified {
+		u.LastModified = from.LastModified
+	}
+}
+
+///////////////
+// LsbFilter //
+///////////////
+
+func NewLsbFilter(msg *apc.LsbMsg) (*LsbFilter, error) {
+	flt := &LsbFilter{props: msg.Props}
+	if msg.NameRegex != "" {
+		re, err := regexp.Compile(msg.NameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bucket name regex %q: %v", msg.NameRegex, err)
+		}
+		flt.re = re
+	}
+	return flt, nil
+}
+
+// `props` is nil when the bucket is not present in the cluster
+func (flt *LsbFilter) Match(bck *Bck, props *BucketProps) bool {
+	if flt.re != nil && !flt.re.MatchString(bck.Name) {
+		return false
+	}
+	if len(flt.props) == 0 {
+		return true
+	}
+	if props == nil {
+		return false
+	}
+	nvs := props.nvs()
+	for name, v := range flt.props {
+		if pv, ok := nvs[name]; !ok || pv != v {
+			return false
+		}
+	}
+	return true
+}
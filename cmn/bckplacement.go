@@ -0,0 +1,34 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import "fmt"
+
+// interface guard
+var _ PropsValidator = (*PlacementConf)(nil)
+
+func (c *PlacementConf) ValidateAsProps(...any) error {
+	if err := ValidateMpathLabel(c.Label); err != nil {
+		return fmt.Errorf("placement.label: %v", err)
+	}
+	return nil
+}
+
+// (with no props - no label)
+func (b *Bck) MpathLabel() string {
+	if b.Props == nil {
+		return ""
+	}
+	return b.Props.Placement.MpathLabel()
+}
+
+// the bucket's own placement label, if any; otherwise, the fast tier (see SpaceConf.FastTier)
+func (c *PlacementConf) MpathLabel() string {
+	if c.Label != "" {
+		return c.Label
+	}
+	return GCO.Get().Space.FastTier
+}
@@ -0,0 +1,30 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"time"
+)
+
+const DfltScratchTTL = 24 * time.Hour // default ScratchConf.TTL
+
+// interface guard
+var _ PropsValidator = (*ScratchConf)(nil)
+
+func (c *ScratchConf) ValidateAsProps(...any) error {
+	if c.TTL < 0 {
+		return fmt.Errorf("invalid scratch.ttl %v (expecting non-negative duration)", c.TTL)
+	}
+	return nil
+}
+
+func (c *ScratchConf) TTLOrDflt() time.Duration {
+	if c.TTL == 0 {
+		return DfltScratchTTL
+	}
+	return c.TTL.D()
+}
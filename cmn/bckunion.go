@@ -0,0 +1,45 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// interface guard
+var _ PropsValidator = (*UnionConf)(nil)
+
+func (c *UnionConf) IsSet() bool { return c.Members != "" }
+
+// Bcks returns union members in the order of precedence (the first one wins).
+func (c *UnionConf) Bcks() (bcks Bcks, err error) {
+	for _, uri := range splitSchemaList(c.Members) {
+		bck, objName, err := ParseBckObjectURI(uri, ParseURIOpts{DefaultProvider: apc.AIS})
+		if err != nil {
+			return nil, fmt.Errorf("invalid union member %q: %v", uri, err)
+		}
+		if objName != "" || bck.Name == "" {
+			return nil, fmt.Errorf("invalid union member %q: expecting bucket, e.g. \"ais://abc\"", uri)
+		}
+		if err := bck.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid union member %q: %v", uri, err)
+		}
+		for i := range bcks {
+			if bcks[i].Equal(&bck) {
+				return nil, fmt.Errorf("duplicate union member %q", uri)
+			}
+		}
+		bcks = append(bcks, bck)
+	}
+	return bcks, nil
+}
+
+func (c *UnionConf) ValidateAsProps(...any) error {
+	_, err := c.Bcks()
+	return err
+}
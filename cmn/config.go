@@ -55,6 +55,7 @@ type (
 		Backend    BackendConf    `json:"backend" allow:"cluster"`
 		Mirror     MirrorConf     `json:"mirror" allow:"cluster"`
 		EC         ECConf         `json:"ec" allow:"cluster"`
+		ECRepair   ECRepairConf   `json:"ec_repair" allow:"cluster"`
 		Log        LogConf        `json:"log"`
 		Periodic   PeriodConf     `json:"periodic"`
 		Timeout    TimeoutConf    `json:"timeout"`
@@ -75,13 +76,20 @@ type (
 		DSort      DSortConf      `json:"distributed_sort"`
 		Transport  TransportConf  `json:"transport"`
 		Memsys     MemsysConf     `json:"memsys"`
+		CPU        CPUConf        `json:"cpu"`
 
 		// Transform (offline) or Copy src Bucket => dst bucket
 		TCB TCBConf `json:"tcb"`
 
+		// persistent history of finished xactions
+		XactHistory XactHistoryConf `json:"xact_history"`
+
 		// metadata write policy: (immediate | delayed | never)
 		WritePolicy WritePolicyConf `json:"write_policy"`
 
+		// at-rest encryption of the objects stored on designated mountpaths
+		Encryption EncryptionConf `json:"encryption"`
+
 		// standalone enumerated features that can be configured
 		// to flip assorted global defaults (see cmn/feat/feat.go)
 		Features feat.Flags `json:"features,string" allow:"cluster"`
@@ -96,6 +104,7 @@ type (
 		Backend     *BackendConf             `json:"backend,omitempty"`
 		Mirror      *MirrorConfToUpdate      `json:"mirror,omitempty"`
 		EC          *ECConfToUpdate          `json:"ec,omitempty"`
+		ECRepair    *ECRepairConfToUpdate    `json:"ec_repair,omitempty"`
 		Log         *LogConfToUpdate         `json:"log,omitempty"`
 		Periodic    *PeriodConfToUpdate      `json:"periodic,omitempty"`
 		Timeout     *TimeoutConfToUpdate     `json:"timeout,omitempty"`
@@ -115,8 +124,11 @@ type (
 		DSort       *DSortConfToUpdate       `json:"distributed_sort,omitempty"`
 		Transport   *TransportConfToUpdate   `json:"transport,omitempty"`
 		Memsys      *MemsysConfToUpdate      `json:"memsys,omitempty"`
+		CPU         *CPUConfToUpdate         `json:"cpu,omitempty"`
 		TCB         *TCBConfToUpdate         `json:"tcb,omitempty"`
+		XactHistory *XactHistoryConfToUpdate `json:"xact_history,omitempty"`
 		WritePolicy *WritePolicyConfToUpdate `json:"write_policy,omitempty"`
+		Encryption  *EncryptionConfToUpdate  `json:"encryption,omitempty"`
 		Proxy       *ProxyConfToUpdate       `json:"proxy,omitempty"`
 		Features    *feat.Flags              `json:"features,string,omitempty"`
 
@@ -188,19 +200,39 @@ type (
 		DiskOnly     *bool   `json:"disk_only,omitempty"`
 	}
 
+	// restoration (repair) of damaged or missing erasure-coded objects - cluster-wide
+	// (unlike ECConf, not a bucket property)
+	ECRepairConf struct {
+		Rate    cos.SizeIEC `json:"rate"`    // max restored bytes per second per target (0 - unlimited)
+		Workers int         `json:"workers"` // concurrent restorations per mountpath (0 - default)
+	}
+	ECRepairConfToUpdate struct {
+		Rate    *cos.SizeIEC `json:"rate,omitempty"`
+		Workers *int         `json:"workers,omitempty"`
+	}
+
 	LogConf struct {
 		Level     cos.LogLevel `json:"level"`      // log level (aka verbosity)
 		MaxSize   cos.SizeIEC  `json:"max_size"`   // exceeding this size triggers log rotation
 		MaxTotal  cos.SizeIEC  `json:"max_total"`  // (sum individual log sizes); exceeding this number triggers cleanup
 		FlushTime cos.Duration `json:"flush_time"` // log flush interval
 		StatsTime cos.Duration `json:"stats_time"` // log stats interval (must be a multiple of `PeriodConf.StatsTime`)
+		// per-module levels (e.g. "ec:4,reb:5") - to raise verbosity of the named modules above `Level`
+		ModuleLevels cos.LogModuleLevels `json:"module_levels,omitempty"`
+		// output format: "text" (default) or "json" (one object per line)
+		Format string `json:"format,omitempty"`
+		// max number of info messages per second from any given line of code; zero means no sampling
+		Sampling int `json:"sampling,omitempty"`
 	}
 	LogConfToUpdate struct {
-		Level     *cos.LogLevel `json:"level,omitempty"`
-		MaxSize   *cos.SizeIEC  `json:"max_size,omitempty"`
-		MaxTotal  *cos.SizeIEC  `json:"max_total,omitempty"`
-		FlushTime *cos.Duration `json:"flush_time,omitempty"`
-		StatsTime *cos.Duration `json:"stats_time,omitempty"`
+		Level        *cos.LogLevel        `json:"level,omitempty"`
+		MaxSize      *cos.SizeIEC         `json:"max_size,omitempty"`
+		MaxTotal     *cos.SizeIEC         `json:"max_total,omitempty"`
+		FlushTime    *cos.Duration        `json:"flush_time,omitempty"`
+		StatsTime    *cos.Duration        `json:"stats_time,omitempty"`
+		ModuleLevels *cos.LogModuleLevels `json:"module_levels,omitempty"`
+		Format       *string              `json:"format,omitempty"`
+		Sampling     *int                 `json:"sampling,omitempty"`
 	}
 
 	// NOTE: StatsTime is a one important timer
@@ -248,12 +280,16 @@ type (
 		PrimaryURL   string `json:"primary_url"`
 		OriginalURL  string `json:"original_url"`
 		DiscoveryURL string `json:"discovery_url"`
+		// (optional) resolves to additional proxy URLs to join the cluster,
+		// e.g. "dns-srv://_ais._tcp.example.com" (see cmn.Discover)
+		Discovery    string `json:"discovery,omitempty"`
 		NonElectable bool   `json:"non_electable"`
 	}
 	ProxyConfToUpdate struct {
 		PrimaryURL   *string `json:"primary_url,omitempty"`
 		OriginalURL  *string `json:"original_url,omitempty"`
 		DiscoveryURL *string `json:"discovery_url,omitempty"`
+		Discovery    *string `json:"discovery,omitempty"`
 		NonElectable *bool   `json:"non_electable,omitempty"`
 	}
 
@@ -274,12 +310,46 @@ type (
 		// Out-of-Space: if exceeded, the target starts failing new PUTs and keeps
 		// failing them until its local used-cap gets back below HighWM (see above)
 		OOS int64 `json:"out_of_space"`
+
+		// janitor (apc.ActJanitor): minimum age of a leftover workfile, an idle
+		// multipart upload, and an orphaned ETL output, respectively, to be removed
+		WorkfileAge cos.Duration `json:"workfile_age"`
+		MptAge      cos.Duration `json:"mpt_age"`
+		ETLAge      cos.Duration `json:"etl_age"`
+
+		// tiered mountpaths (optional): new and recently accessed objects are stored on the
+		// mountpaths labeled `fast_tier` (see FSPConf), while objects not accessed during
+		// `demote_age` get demoted to the remaining (capacity) mountpaths (apc.ActTierDemote)
+		FastTier  string       `json:"fast_tier,omitempty"`
+		DemoteAge cos.Duration `json:"demote_age,omitempty"`
+
+		// scratch mountpath (optional): the mountpath labeled `scratch` (see FSPConf) takes
+		// temporary content - workfiles of PUTs and EC, and dsort spills - but no objects
+		Scratch string `json:"scratch,omitempty"`
+
+		// trash bin (optional): deleted objects (ais:// buckets only) are kept in the
+		// per-mountpath trash for `trash_retention` - to be undeleted (apc.ActUndeleteObject)
+		// or, upon expiration, removed by the janitor; zero means immediate removal
+		TrashRetention cos.Duration `json:"trash_retention,omitempty"`
+
+		// capacity trend: alert when the used capacity is projected to reach HighWM
+		// within so many days (at the current growth rate); zero - default, negative - no alerts
+		AlertDays int64 `json:"alert_days,omitempty"`
 	}
 	SpaceConfToUpdate struct {
-		CleanupWM *int64 `json:"cleanupwm,omitempty"`
-		LowWM     *int64 `json:"lowwm,omitempty"`
-		HighWM    *int64 `json:"highwm,omitempty"`
-		OOS       *int64 `json:"out_of_space,omitempty"`
+		CleanupWM   *int64        `json:"cleanupwm,omitempty"`
+		LowWM       *int64        `json:"lowwm,omitempty"`
+		HighWM      *int64        `json:"highwm,omitempty"`
+		OOS         *int64        `json:"out_of_space,omitempty"`
+		WorkfileAge *cos.Duration `json:"workfile_age,omitempty"`
+		MptAge      *cos.Duration `json:"mpt_age,omitempty"`
+		ETLAge      *cos.Duration `json:"etl_age,omitempty"`
+		FastTier    *string       `json:"fast_tier,omitempty"`
+		DemoteAge   *cos.Duration `json:"demote_age,omitempty"`
+		Scratch     *string       `json:"scratch,omitempty"`
+
+		TrashRetention *cos.Duration `json:"trash_retention,omitempty"`
+		AlertDays      *int64        `json:"alert_days,omitempty"`
 	}
 
 	LRUConf struct {
@@ -290,12 +360,27 @@ type (
 		// CapacityUpdTimeStr denotes the frequency at which AIStore updates local capacity utilization
 		CapacityUpdTime cos.Duration `json:"capacity_upd_time"`
 
+		// LowWM and HighWM: bucket-level overrides of the cluster-wide space.lowwm and space.highwm
+		// (zero: not set, use cluster-wide)
+		// - e.g., scratch bucket: lower watermarks (evicted sooner and further down);
+		// - curated cached dataset: higher watermarks (held longer)
+		LowWM  int64 `json:"lowwm"`
+		HighWM int64 `json:"highwm"`
+
+		// EvictWeight: relative eviction priority (zero: default, same as 100)
+		// - under pressure, buckets with greater weights get evicted first;
+		// - the dont-evict time (above) gets scaled by 100/EvictWeight
+		EvictWeight int64 `json:"evict_weight"`
+
 		// Enabled: LRU will only run when set to true
 		Enabled bool `json:"enabled"`
 	}
 	LRUConfToUpdate struct {
 		DontEvictTime   *cos.Duration `json:"dont_evict_time,omitempty"`
 		CapacityUpdTime *cos.Duration `json:"capacity_upd_time,omitempty"`
+		LowWM           *int64        `json:"lowwm,omitempty"`
+		HighWM          *int64        `json:"highwm,omitempty"`
+		EvictWeight     *int64        `json:"evict_weight,omitempty"`
 		Enabled         *bool         `json:"enabled,omitempty"`
 	}
 
@@ -305,6 +390,13 @@ type (
 		DiskUtilMaxWM   int64        `json:"disk_util_max_wm"`
 		IostatTimeLong  cos.Duration `json:"iostat_time_long"`
 		IostatTimeShort cos.Duration `json:"iostat_time_short"`
+		// number of goroutines to traverse a given mountpath (LRU, rebalance, and similar);
+		// zero or one - single-threaded traversal
+		WalkWorkers int `json:"walk_workers,omitempty"`
+		// hot-plug: periodically look for newly mounted filesystems that match the (glob) pattern,
+		// e.g. "/mnt/disk*", and attach them as mountpaths; empty - disabled
+		HotplugPattern  string       `json:"hotplug_pattern,omitempty"`
+		HotplugInterval cos.Duration `json:"hotplug_interval,omitempty"`
 	}
 	DiskConfToUpdate struct {
 		DiskUtilLowWM   *int64        `json:"disk_util_low_wm,omitempty"`
@@ -312,6 +404,9 @@ type (
 		DiskUtilMaxWM   *int64        `json:"disk_util_max_wm,omitempty"`
 		IostatTimeLong  *cos.Duration `json:"iostat_time_long,omitempty"`
 		IostatTimeShort *cos.Duration `json:"iostat_time_short,omitempty"`
+		WalkWorkers     *int          `json:"walk_workers,omitempty"`
+		HotplugPattern  *string       `json:"hotplug_pattern,omitempty"`
+		HotplugInterval *cos.Duration `json:"hotplug_interval,omitempty"`
 	}
 
 	RebalanceConf struct {
@@ -319,12 +414,30 @@ type (
 		DestRetryTime cos.Duration `json:"dest_retry_time"`   // max wait for ACKs & neighbors to complete
 		SbundleMult   int          `json:"bundle_multiplier"` // stream-bundle multiplier: num streams to destination
 		Enabled       bool         `json:"enabled"`           // true=auto-rebalance | manual rebalancing
+
+		// (optional) capacity-driven rebalance: primary triggers cluster-wide rebalance when
+		// used capacity (%) of any two targets differs by more than `CapDiffPct` and stays that way
+		// for at least `CapDiffTime` (hysteresis); zero `CapDiffPct` (default) disables
+		CapDiffPct  int          `json:"cap_diff_pct,omitempty"`
+		CapDiffTime cos.Duration `json:"cap_diff_time,omitempty"`
+
+		// (optional) max aggregate bandwidth (bytes/sec) of the rebalance data streams
+		// from a given target; zero (default) - no limit
+		Bandwidth cos.SizeIEC `json:"bandwidth,omitempty"`
+
+		// (optional) upon successful rebalance, run post-rebalance verification
+		// (see apc.ActRebVerify)
+		Verify bool `json:"verify,omitempty"`
 	}
 	RebalanceConfToUpdate struct {
 		DestRetryTime *cos.Duration `json:"dest_retry_time,omitempty"`
 		Compression   *string       `json:"compression,omitempty"`
 		SbundleMult   *int          `json:"bundle_multiplier"`
 		Enabled       *bool         `json:"enabled,omitempty"`
+		CapDiffPct    *int          `json:"cap_diff_pct,omitempty"`
+		CapDiffTime   *cos.Duration `json:"cap_diff_time,omitempty"`
+		Bandwidth     *cos.SizeIEC  `json:"bandwidth,omitempty"`
+		Verify        *bool         `json:"verify,omitempty"`
 	}
 
 	ResilverConf struct {
@@ -399,40 +512,71 @@ type (
 		Proto           string `json:"-"`                 // http or https (set depending on `UseHTTPS`)
 		Certificate     string `json:"server_crt"`        // HTTPS: openssl certificate
 		Key             string `json:"server_key"`        // HTTPS: openssl key
+		ClientCA        string `json:"client_ca_tls"`     // HTTPS: CA bundle to authenticate intra-cluster peers (mutual TLS)
 		WriteBufferSize int    `json:"write_buffer_size"` // http.Transport.WriteBufferSize; zero defaults to 4KB
 		ReadBufferSize  int    `json:"read_buffer_size"`  // http.Transport.ReadBufferSize; ditto
 		UseHTTPS        bool   `json:"use_https"`         // use HTTPS instead of HTTP
 		SkipVerify      bool   `json:"skip_verify"`       // skip HTTPS cert verification (used with self-signed certs)
 		Chunked         bool   `json:"chunked_transfer"`  // NOTE: not used Feb 2023
+		UseQUIC         bool   `json:"use_quic"`          // intra-cluster streams over HTTP/3 (QUIC); requires HTTPS and build tag 'quic'
 	}
 	HTTPConfToUpdate struct {
 		Certificate     *string `json:"server_crt,omitempty"`
 		Key             *string `json:"server_key,omitempty"`
+		ClientCA        *string `json:"client_ca_tls,omitempty"`
 		WriteBufferSize *int    `json:"write_buffer_size,omitempty" list:"readonly"`
 		ReadBufferSize  *int    `json:"read_buffer_size,omitempty" list:"readonly"`
 		UseHTTPS        *bool   `json:"use_https,omitempty"`
 		SkipVerify      *bool   `json:"skip_verify,omitempty"`
 		Chunked         *bool   `json:"chunked_transfer,omitempty"` // https://tools.ietf.org/html/rfc7230#page-36
+		UseQUIC         *bool   `json:"use_quic,omitempty" list:"readonly"`
 	}
 
 	FSHCConf struct {
-		TestFileCount int  `json:"test_files"`  // number of files to read/write
-		ErrorLimit    int  `json:"error_limit"` // exceeding err limit causes disabling mountpath
-		Enabled       bool `json:"enabled"`
+		TestFileCount int `json:"test_files"`  // number of files to read/write
+		ErrorLimit    int `json:"error_limit"` // exceeding err limit causes disabling mountpath
+		// (optional) poll disks' SMART attributes (via smartctl) at this interval; zero disables
+		SmartInterval cos.Duration `json:"smart_interval,omitempty"`
+		// tolerated number of reallocated and pending sectors (or NVMe media errors) per disk
+		SmartErrorLimit int64 `json:"smart_error_limit,omitempty"`
+		// upon SMART failure, disable the mountpath (default: make it read-only - see fs.SetReadOnly)
+		SmartDisable bool `json:"smart_disable,omitempty"`
+		// per-mountpath I/O error budget: disable the mountpath when the number of I/O errors
+		// within the sliding window exceeds the limit (zero values: defaults - see below)
+		IOErrLimit  int          `json:"io_err_limit,omitempty"`
+		IOErrWindow cos.Duration `json:"io_err_window,omitempty"`
+		// (optional) periodically probe the mountpaths disabled due to exceeded error budget
+		// and re-enable those that pass the read/write test; zero disables
+		ProbeInterval cos.Duration `json:"probe_interval,omitempty"`
+		Enabled       bool         `json:"enabled"`
 	}
 	FSHCConfToUpdate struct {
-		TestFileCount *int  `json:"test_files,omitempty"`
-		ErrorLimit    *int  `json:"error_limit,omitempty"`
-		Enabled       *bool `json:"enabled,omitempty"`
+		TestFileCount   *int          `json:"test_files,omitempty"`
+		ErrorLimit      *int          `json:"error_limit,omitempty"`
+		SmartInterval   *cos.Duration `json:"smart_interval,omitempty"`
+		SmartErrorLimit *int64        `json:"smart_error_limit,omitempty"`
+		SmartDisable    *bool         `json:"smart_disable,omitempty"`
+		IOErrLimit      *int          `json:"io_err_limit,omitempty"`
+		IOErrWindow     *cos.Duration `json:"io_err_window,omitempty"`
+		ProbeInterval   *cos.Duration `json:"probe_interval,omitempty"`
+		Enabled         *bool         `json:"enabled,omitempty"`
 	}
 
 	AuthConf struct {
-		Secret  string `json:"secret"`
-		Enabled bool   `json:"enabled"`
+		Secret string `json:"secret"`
+		// audit (gateways): log each authenticated request along with its AuthN token,
+		// and raise alerts upon anomalies (see apc.AuthAlert)
+		Audit bool `json:"audit,omitempty"`
+		// alert when a single user issues more than this number of DELETE requests
+		// per minute (zero: default; negative: disabled)
+		DeleteSpike int64 `json:"delete_spike,omitempty"`
+		Enabled     bool  `json:"enabled"`
 	}
 	AuthConfToUpdate struct {
-		Secret  *string `json:"secret,omitempty"`
-		Enabled *bool   `json:"enabled,omitempty"`
+		Secret      *string `json:"secret,omitempty"`
+		Audit       *bool   `json:"audit,omitempty"`
+		DeleteSpike *int64  `json:"delete_spike,omitempty"`
+		Enabled     *bool   `json:"enabled,omitempty"`
 	}
 
 	// keepalive tracker
@@ -488,8 +632,21 @@ type (
 		SbundleMult         *int          `json:"bundle_multiplier,omitempty"`
 	}
 
+	// Each mountpath may have an optional (user-defined) label, e.g. "ssd" or "hdd", configured as
+	// "fspaths": {"/mpath1": "ssd", "/mpath2": {}} - buckets can then pin their data to the mountpaths
+	// with a given label (see PlacementConf).
+	// In addition, a mountpath may have a capacity quota - either percentage or size, e.g.:
+	// "fspaths": {"/mpath3": {"label": "hdd", "quota": "60%"}, "/mpath4": {"quota": "2TiB"}} -
+	// to limit the used capacity of its (possibly, shared) filesystem below the global watermarks.
 	FSPConf struct {
-		Paths cos.StrSet `json:"paths,omitempty" list:"readonly"`
+		Paths  cos.StrSet `json:"paths,omitempty" list:"readonly"`
+		Labels cos.StrKVs `json:"-"` // mountpath => label
+		Quotas cos.StrKVs `json:"-"` // mountpath => quota
+	}
+	// (see FSPConf)
+	fspOpts struct {
+		Label string `json:"label,omitempty"`
+		Quota string `json:"quota,omitempty"`
 	}
 
 	TransportConf struct {
@@ -505,6 +662,19 @@ type (
 		// fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
 		LZ4BlockMaxSize  cos.SizeIEC `json:"lz4_block"`
 		LZ4FrameChecksum bool        `json:"lz4_frame_checksum"`
+		// default compressor: apc.LZ4Compression (default) or apc.ZstdCompression
+		// (can be overridden on a per stream bundle basis - see transport.Extra)
+		Compressor string `json:"compressor"`
+		// zstd compression level [1, 22]; 0 (zero) - zstd default
+		ZstdLevel int `json:"zstd_level"`
+		// relay streams via proxy when target-to-target connections fail (see transport/relay.go)
+		Relay bool `json:"relay"`
+		// PDU-based streams: checksum each PDU ("crc32c" or "xxhash"), verify upon receipt,
+		// and retransmit the affected objects (see transport/cksum.go); empty or "none" - disabled
+		PDUChecksum string `json:"pdu_checksum"`
+		// intra-cluster link capacity (bytes/sec) - streams with adaptive compression (apc.CompressAdaptive)
+		// compress only when the offered throughput approaches it; zero - compress upon congestion
+		LinkCapacity cos.SizeIEC `json:"link_capacity"`
 	}
 	TransportConfToUpdate struct {
 		MaxHeaderSize    *int          `json:"max_header,omitempty" list:"readonly"`
@@ -513,6 +683,11 @@ type (
 		QuiesceTime      *cos.Duration `json:"quiescent,omitempty"`
 		LZ4BlockMaxSize  *cos.SizeIEC  `json:"lz4_block,omitempty"`
 		LZ4FrameChecksum *bool         `json:"lz4_frame_checksum,omitempty"`
+		Compressor       *string       `json:"compressor,omitempty"`
+		ZstdLevel        *int          `json:"zstd_level,omitempty"`
+		Relay            *bool         `json:"relay,omitempty"`
+		PDUChecksum      *string       `json:"pdu_checksum,omitempty"`
+		LinkCapacity     *cos.SizeIEC  `json:"link_capacity,omitempty"`
 	}
 
 	MemsysConf struct {
@@ -522,7 +697,21 @@ type (
 		HousekeepTime  cos.Duration `json:"hk_time"`
 		MinPctTotal    int          `json:"min_pct_total"`
 		MinPctFree     int          `json:"min_pct_free"`
+		// per-NUMA-node slab pools (no-op on single-node systems); takes effect upon restart
+		NUMA bool `json:"numa,omitempty"`
+		// back the largest slabs with transparent huge pages; takes effect upon restart
+		HugePages bool `json:"huge_pages,omitempty"`
+	}
+	// CPU-bound work (checksumming, EC encoding) offload (see cos.Offload)
+	CPUConf struct {
+		// max number of concurrent CPU-bound tasks per core; zero disables offloading
+		// (the tasks run inline); takes effect upon restart
+		OffloadPerCore int `json:"offload_per_core,omitempty"`
+	}
+	CPUConfToUpdate struct {
+		OffloadPerCore *int `json:"offload_per_core,omitempty"`
 	}
+
 	MemsysConfToUpdate struct {
 		MinFree        *cos.SizeIEC  `json:"min_free,omitempty"`
 		DefaultBufSize *cos.SizeIEC  `json:"default_buf,omitempty"`
@@ -530,15 +719,33 @@ type (
 		HousekeepTime  *cos.Duration `json:"hk_time,omitempty"`
 		MinPctTotal    *int          `json:"min_pct_total,omitempty"`
 		MinPctFree     *int          `json:"min_pct_free,omitempty"`
+		NUMA           *bool         `json:"numa,omitempty"`
+		HugePages      *bool         `json:"huge_pages,omitempty"`
 	}
 
 	TCBConf struct {
 		Compression string `json:"compression"`       // enum { CompressAlways, ... } in api/apc/compression.go
 		SbundleMult int    `json:"bundle_multiplier"` // stream-bundle multiplier: num streams to destination
+		// (optional) max aggregate bandwidth (bytes/sec) of a given copy (transform) job's data
+		// streams from a given target; zero (default) - no limit
+		Bandwidth cos.SizeIEC `json:"bandwidth,omitempty"`
 	}
 	TCBConfToUpdate struct {
-		Compression *string `json:"compression,omitempty"`
-		SbundleMult *int    `json:"bundle_multiplier,omitempty"`
+		Compression *string      `json:"compression,omitempty"`
+		SbundleMult *int         `json:"bundle_multiplier,omitempty"`
+		Bandwidth   *cos.SizeIEC `json:"bandwidth,omitempty"`
+	}
+
+	// each target archives its finished xactions in its local database (see xreg.History)
+	XactHistoryConf struct {
+		MaxAge  cos.Duration `json:"max_age"` // remove older records (zero: DfltXactHistoryAge)
+		MaxNum  int          `json:"max_num"` // max records per target (zero: DfltXactHistoryNum)
+		Enabled bool         `json:"enabled"`
+	}
+	XactHistoryConfToUpdate struct {
+		MaxAge  *cos.Duration `json:"max_age,omitempty"`
+		MaxNum  *int          `json:"max_num,omitempty"`
+		Enabled *bool         `json:"enabled,omitempty"`
 	}
 
 	WritePolicyConf struct {
@@ -549,6 +756,21 @@ type (
 		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
+
+	// Objects stored on the mountpaths labeled `label` (see FSPConf) get encrypted
+	// with AES-256-GCM. The key is cluster-wide (so that encrypted objects can be
+	// migrated and replicated as is) and gets loaded - hex-encoded - either from the
+	// node-local `key_file` or from the `kms_url` (HTTP GET).
+	EncryptionConf struct {
+		Label   string `json:"label,omitempty"`
+		KeyFile string `json:"key_file,omitempty"`
+		KMSURL  string `json:"kms_url,omitempty"`
+	}
+	EncryptionConfToUpdate struct {
+		Label   *string `json:"label,omitempty"`
+		KeyFile *string `json:"key_file,omitempty"`
+		KMSURL  *string `json:"kms_url,omitempty"`
+	}
 )
 
 // read-mostly and most often used timeouts: assign at startup to reduce the number of GCO.Get() calls
@@ -578,6 +800,12 @@ const (
 
 var SupportedReactions = []string{IgnoreReaction, WarnReaction, AbortReaction}
 
+// log.format
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
 //
 // config meta-versioning & serialization
 //
@@ -603,23 +831,30 @@ var (
 	_ Validator = (*SpaceConf)(nil)
 	_ Validator = (*MirrorConf)(nil)
 	_ Validator = (*ECConf)(nil)
+	_ Validator = (*ECRepairConf)(nil)
 	_ Validator = (*VersionConf)(nil)
 	_ Validator = (*KeepaliveConf)(nil)
+	_ Validator = (*FSHCConf)(nil)
 	_ Validator = (*PeriodConf)(nil)
 	_ Validator = (*TimeoutConf)(nil)
 	_ Validator = (*ClientConf)(nil)
 	_ Validator = (*RebalanceConf)(nil)
 	_ Validator = (*ResilverConf)(nil)
 	_ Validator = (*NetConf)(nil)
+	_ Validator = (*ProxyConf)(nil)
 	_ Validator = (*DownloaderConf)(nil)
 	_ Validator = (*DSortConf)(nil)
 	_ Validator = (*TransportConf)(nil)
 	_ Validator = (*MemsysConf)(nil)
+	_ Validator = (*CPUConf)(nil)
 	_ Validator = (*TCBConf)(nil)
+	_ Validator = (*XactHistoryConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
+	_ Validator = (*EncryptionConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
+	_ PropsValidator = (*LRUConf)(nil)
 	_ PropsValidator = (*MirrorConf)(nil)
 	_ PropsValidator = (*ECConf)(nil)
 	_ PropsValidator = (*WritePolicyConf)(nil)
@@ -688,7 +923,9 @@ func (c *Config) TestingEnv() bool {
 	return c.LocalConfig.TestingEnv()
 }
 
-func (c *Config) FastV(verbosity, fl int) bool { return c.Log.Level.FastV(verbosity, fl) }
+func (c *Config) FastV(verbosity, fl int) bool {
+	return c.Log.Level.FastV(verbosity, fl) || c.Log.ModuleLevels.FastV(verbosity, fl)
+}
 
 ///////////////////
 // ClusterConfig //
@@ -713,14 +950,28 @@ func (c *LocalConfig) TestingEnv() bool {
 	return c.TestFSP.Count > 0
 }
 
-func (c *LocalConfig) AddPath(mpath string) {
+func (c *LocalConfig) AddPath(mpath, label, quota string) {
 	debug.Assert(!c.TestingEnv())
 	c.FSP.Paths.Set(mpath)
+	if label != "" {
+		if c.FSP.Labels == nil {
+			c.FSP.Labels = make(cos.StrKVs, 1)
+		}
+		c.FSP.Labels[mpath] = label
+	}
+	if quota != "" {
+		if c.FSP.Quotas == nil {
+			c.FSP.Quotas = make(cos.StrKVs, 1)
+		}
+		c.FSP.Quotas[mpath] = quota
+	}
 }
 
 func (c *LocalConfig) DelPath(mpath string) {
 	debug.Assert(!c.TestingEnv())
 	c.FSP.Paths.Delete(mpath)
+	delete(c.FSP.Labels, mpath)
+	delete(c.FSP.Quotas, mpath)
 }
 
 ////////////////
@@ -766,9 +1017,24 @@ func (c *LogConf) Validate() error {
 	if c.StatsTime.D() > 10*time.Minute {
 		return fmt.Errorf("invalid log.stats_time=%s (expected range [log.stats_time, 10m])", c.StatsTime)
 	}
+	if err := c.ModuleLevels.Validate(); err != nil {
+		return err
+	}
+	if c.Format != "" && c.Format != LogFormatText && c.Format != LogFormatJSON {
+		return fmt.Errorf("invalid log.format=%q (expecting %q or %q)", c.Format, LogFormatText, LogFormatJSON)
+	}
+	if c.Sampling < 0 {
+		return fmt.Errorf("invalid log.sampling=%d (expecting non-negative number of messages per second)", c.Sampling)
+	}
 	return nil
 }
 
+// propagate runtime-updatable settings to the logger
+func (c *LogConf) apply() {
+	nlog.SetJSON(c.Format == LogFormatJSON)
+	nlog.SetSampling(c.Sampling)
+}
+
 ////////////////
 // ClientConf //
 ////////////////
@@ -940,6 +1206,18 @@ func (c BackendConfAIS) String() (s string) {
 // DiskConf //
 //////////////
 
+const (
+	MaxWalkWorkers      = 64
+	DfltHotplugInterval = 30 * time.Second
+)
+
+func (c *DiskConf) HotplugIval() time.Duration {
+	if c.HotplugInterval > 0 {
+		return c.HotplugInterval.D()
+	}
+	return DfltHotplugInterval
+}
+
 func (c *DiskConf) Validate() (err error) {
 	lwm, hwm, maxwm := c.DiskUtilLowWM, c.DiskUtilHighWM, c.DiskUtilMaxWM
 	if lwm <= 0 || hwm <= lwm || maxwm <= hwm || maxwm > 100 {
@@ -955,6 +1233,20 @@ func (c *DiskConf) Validate() (err error) {
 		return fmt.Errorf("disk.iostat_time_long %v shorter than disk.iostat_time_short %v",
 			c.IostatTimeLong, c.IostatTimeShort)
 	}
+	if c.WalkWorkers < 0 || c.WalkWorkers > MaxWalkWorkers {
+		return fmt.Errorf("invalid disk.walk_workers %d (expecting 0 to %d)", c.WalkWorkers, MaxWalkWorkers)
+	}
+	if c.HotplugPattern != "" {
+		if !filepath.IsAbs(c.HotplugPattern) {
+			return fmt.Errorf("invalid disk.hotplug_pattern %q: expecting absolute path", c.HotplugPattern)
+		}
+		if _, err := filepath.Match(c.HotplugPattern, ""); err != nil {
+			return fmt.Errorf("invalid disk.hotplug_pattern %q: %v", c.HotplugPattern, err)
+		}
+	}
+	if c.HotplugInterval < 0 {
+		return fmt.Errorf("invalid disk.hotplug_interval %v", c.HotplugInterval)
+	}
 	return nil
 }
 
@@ -962,38 +1254,145 @@ func (c *DiskConf) Validate() (err error) {
 // SpaceConf //
 ///////////////
 
+const (
+	DfltWorkfileAge  = time.Hour
+	DfltMptAge       = 24 * time.Hour
+	DfltETLAge       = time.Hour
+	DfltDemoteAge    = 24 * time.Hour
+	DfltCapAlertDays = 7
+)
+
 func (c *SpaceConf) Validate() (err error) {
 	if c.CleanupWM <= 0 || c.LowWM < c.CleanupWM || c.HighWM < c.LowWM || c.OOS < c.HighWM || c.OOS > 100 {
-		err = fmt.Errorf("invalid %s (expecting: 0 < cleanup < low < high < OOS < 100)", c)
+		return fmt.Errorf("invalid %s (expecting: 0 < cleanup < low < high < OOS < 100)", c)
+	}
+	if c.WorkfileAge < 0 || c.MptAge < 0 || c.ETLAge < 0 {
+		return fmt.Errorf("invalid %s (expecting non-negative janitor ages)", c)
+	}
+	if c.WorkfileAge == 0 {
+		c.WorkfileAge = cos.Duration(DfltWorkfileAge)
+	}
+	if c.MptAge == 0 {
+		c.MptAge = cos.Duration(DfltMptAge)
+	}
+	if c.ETLAge == 0 {
+		c.ETLAge = cos.Duration(DfltETLAge)
+	}
+	if err = ValidateMpathLabel(c.FastTier); err != nil {
+		return fmt.Errorf("invalid space.fast_tier: %v", err)
+	}
+	if c.DemoteAge < 0 {
+		return fmt.Errorf("invalid space.demote_age=%v (expecting non-negative)", c.DemoteAge)
+	}
+	if c.FastTier != "" && c.DemoteAge == 0 {
+		c.DemoteAge = cos.Duration(DfltDemoteAge)
+	}
+	if err = ValidateMpathLabel(c.Scratch); err != nil {
+		return fmt.Errorf("invalid space.scratch: %v", err)
+	}
+	if c.Scratch != "" && c.Scratch == c.FastTier {
+		return fmt.Errorf("invalid %s (scratch and fast tier must be different mountpaths)", c)
+	}
+	if c.TrashRetention < 0 {
+		return fmt.Errorf("invalid space.trash_retention=%v (expecting non-negative)", c.TrashRetention)
 	}
 	return
 }
 
 func (c *SpaceConf) ValidateAsProps(...any) error { return c.Validate() }
 
+func (c *SpaceConf) CapAlertDays() int64 {
+	if c.AlertDays == 0 {
+		return DfltCapAlertDays
+	}
+	return c.AlertDays
+}
+
 func (c *SpaceConf) String() string {
-	return fmt.Sprintf("space config: cleanup=%d%%, low=%d%%, high=%d%%, OOS=%d%%",
-		c.CleanupWM, c.LowWM, c.HighWM, c.OOS)
+	s := fmt.Sprintf("space config: cleanup=%d%%, low=%d%%, high=%d%%, OOS=%d%%, workfile=%v, mpt=%v, etl=%v",
+		c.CleanupWM, c.LowWM, c.HighWM, c.OOS, c.WorkfileAge, c.MptAge, c.ETLAge)
+	if c.FastTier != "" {
+		s += fmt.Sprintf(", fast-tier=%q, demote=%v", c.FastTier, c.DemoteAge)
+	}
+	if c.Scratch != "" {
+		s += fmt.Sprintf(", scratch=%q", c.Scratch)
+	}
+	if c.TrashRetention > 0 {
+		s += fmt.Sprintf(", trash=%v", c.TrashRetention)
+	}
+	return s
 }
 
 /////////////
 // LRUConf //
 /////////////
 
+const (
+	DfltEvictWeight = 100 // (lru.evict_weight = 0)
+	MaxEvictWeight  = 10000
+)
+
 func (c *LRUConf) String() string {
 	if !c.Enabled {
 		return "Disabled"
 	}
-	return fmt.Sprintf("lru.dont_evict_time=%v, lru.capacity_upd_time=%v", c.DontEvictTime, c.CapacityUpdTime)
+	s := fmt.Sprintf("lru.dont_evict_time=%v, lru.capacity_upd_time=%v", c.DontEvictTime, c.CapacityUpdTime)
+	if c.LowWM > 0 || c.HighWM > 0 {
+		s += fmt.Sprintf(", lru.lowwm=%d, lru.highwm=%d", c.LowWM, c.HighWM)
+	}
+	if c.EvictWeight > 0 {
+		s += fmt.Sprintf(", lru.evict_weight=%d", c.EvictWeight)
+	}
+	return s
 }
 
 func (c *LRUConf) Validate() (err error) {
 	if c.CapacityUpdTime.D() < 10*time.Second {
-		err = fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
+		return fmt.Errorf("invalid %s (expecting: lru.capacity_upd_time >= 10s)", c)
+	}
+	return c.ValidateAsProps()
+}
+
+func (c *LRUConf) ValidateAsProps(...any) error {
+	if c.LowWM < 0 || c.HighWM < 0 || c.LowWM >= 100 || c.HighWM >= 100 {
+		return fmt.Errorf("invalid lru.lowwm=%d, lru.highwm=%d (expecting: 0 <= watermark < 100)", c.LowWM, c.HighWM)
+	}
+	if c.LowWM > 0 && c.HighWM > 0 && c.LowWM >= c.HighWM {
+		return fmt.Errorf("invalid lru.lowwm=%d, lru.highwm=%d (expecting: low < high)", c.LowWM, c.HighWM)
+	}
+	if c.EvictWeight < 0 || c.EvictWeight > MaxEvictWeight {
+		return fmt.Errorf("invalid lru.evict_weight=%d (expecting: 0 <= weight <= %d)", c.EvictWeight, MaxEvictWeight)
+	}
+	return nil
+}
+
+// effective (bucket-level or else cluster-wide) watermarks
+func (c *LRUConf) Watermarks(space *SpaceConf) (lwm, hwm int64) {
+	lwm, hwm = space.LowWM, space.HighWM
+	if c.HighWM > 0 {
+		hwm = c.HighWM
+	}
+	if c.LowWM > 0 {
+		lwm = c.LowWM
+	}
+	if lwm >= hwm { // e.g., only one of the two is overridden
+		lwm = hwm * space.LowWM / space.HighWM
 	}
 	return
 }
 
+func (c *LRUConf) Weight() int64 {
+	if c.EvictWeight == 0 {
+		return DfltEvictWeight
+	}
+	return c.EvictWeight
+}
+
+// dont-evict time scaled by eviction weight
+func (c *LRUConf) DontEvict(dontEvictTime time.Duration) time.Duration {
+	return dontEvictTime * time.Duration(DfltEvictWeight) / time.Duration(c.Weight())
+}
+
 ///////////////
 // CksumConf //
 ///////////////
@@ -1153,6 +1552,33 @@ func (c *ECConf) RequiredRestoreTargets() int {
 	return c.DataSlices
 }
 
+//////////////////
+// ECRepairConf //
+//////////////////
+
+const (
+	DfltECRepairWorkers = 1 // (ec_repair.workers = 0)
+	MaxECRepairWorkers  = 32
+)
+
+func (c *ECRepairConf) Validate() error {
+	if c.Workers < 0 || c.Workers > MaxECRepairWorkers {
+		return fmt.Errorf("invalid ec_repair.workers: %d (expected range [0, %d])", c.Workers, MaxECRepairWorkers)
+	}
+	if c.Rate < 0 {
+		return fmt.Errorf("invalid ec_repair.rate: %d (expected >=0)", c.Rate)
+	}
+	return nil
+}
+
+// number of concurrent restorations per mountpath
+func (c *ECRepairConf) NumWorkers() int {
+	if c.Workers == 0 {
+		return DfltECRepairWorkers
+	}
+	return c.Workers
+}
+
 /////////////////////
 // WritePolicyConf //
 /////////////////////
@@ -1170,6 +1596,72 @@ func (c *WritePolicyConf) Validate() (err error) {
 
 func (c *WritePolicyConf) ValidateAsProps(...any) error { return c.Validate() }
 
+////////////////////
+// EncryptionConf //
+////////////////////
+
+// NOTE: the key may remain configured with no label - to keep reading encrypted objects
+func (c *EncryptionConf) Validate() error {
+	if c.KeyFile != "" && c.KMSURL != "" {
+		return fmt.Errorf("invalid encryption config %+v: expecting either key_file or kms_url (but not both)", *c)
+	}
+	if c.Label != "" && c.KeyFile == "" && c.KMSURL == "" {
+		return fmt.Errorf("invalid encryption config %+v: label requires either key_file or kms_url", *c)
+	}
+	if c.KMSURL != "" {
+		if _, err := url.ParseRequestURI(c.KMSURL); err != nil {
+			return fmt.Errorf("invalid encryption.kms_url %q: %v", c.KMSURL, err)
+		}
+	}
+	return nil
+}
+
+//////////////
+// FSHCConf //
+//////////////
+
+const (
+	MinSmartInterval = time.Minute
+
+	DfltIOErrLimit   = 10          // (fshc.io_err_limit = 0)
+	DfltIOErrWindow  = time.Minute // (fshc.io_err_window = 0)
+	MinProbeInterval = 10 * time.Second
+)
+
+func (c *FSHCConf) Validate() error {
+	if j := c.SmartInterval.D(); j != 0 && j < MinSmartInterval {
+		return fmt.Errorf("invalid fshc.smart_interval=%s (expecting zero (disabled) or at least %s)", j, MinSmartInterval)
+	}
+	if c.SmartErrorLimit < 0 {
+		return fmt.Errorf("invalid fshc.smart_error_limit=%d (expecting non-negative)", c.SmartErrorLimit)
+	}
+	if c.IOErrLimit < 0 {
+		return fmt.Errorf("invalid fshc.io_err_limit=%d (expecting non-negative)", c.IOErrLimit)
+	}
+	if c.IOErrWindow < 0 {
+		return fmt.Errorf("invalid fshc.io_err_window=%s (expecting non-negative)", c.IOErrWindow)
+	}
+	if j := c.ProbeInterval.D(); j != 0 && j < MinProbeInterval {
+		return fmt.Errorf("invalid fshc.probe_interval=%s (expecting zero (disabled) or at least %s)", j, MinProbeInterval)
+	}
+	return nil
+}
+
+// max number of I/O errors tolerated within ErrWindow()
+func (c *FSHCConf) ErrLimit() int {
+	if c.IOErrLimit == 0 {
+		return DfltIOErrLimit
+	}
+	return c.IOErrLimit
+}
+
+func (c *FSHCConf) ErrWindow() time.Duration {
+	if c.IOErrWindow == 0 {
+		return DfltIOErrWindow
+	}
+	return c.IOErrWindow.D()
+}
+
 ///////////////////
 // KeepaliveConf //
 ///////////////////
@@ -1208,9 +1700,27 @@ func (c *NetConf) Validate() (err error) {
 	if c.HTTP.UseHTTPS {
 		c.HTTP.Proto = httpsProto
 	}
+	if c.HTTP.UseQUIC && !c.HTTP.UseHTTPS {
+		return errors.New("HTTP/3 (QUIC) requires TLS: expecting net.http.use_https=true")
+	}
+	if c.HTTP.ClientCA != "" && !c.HTTP.UseHTTPS {
+		return errors.New("mutual TLS requires TLS: expecting net.http.use_https=true")
+	}
 	return nil
 }
 
+///////////////
+// ProxyConf //
+///////////////
+
+func (c *ProxyConf) Validate() error {
+	if c.Discovery == "" {
+		return nil
+	}
+	_, _, err := parseDiscovery(c.Discovery)
+	return err
+}
+
 ////////////////////
 // LocalNetConfig //
 ////////////////////
@@ -1318,18 +1828,60 @@ func (c *DSortConf) ValidateWithOpts(allowEmpty bool) (err error) {
 // FSPConf //
 /////////////
 
+// mountpath => {} (no label), "label", or {"label": "label", "quota": "quota"}
 func (c *FSPConf) UnmarshalJSON(data []byte) (err error) {
-	m := cos.NewStrSet()
-	err = jsoniter.Unmarshal(data, &m)
-	if err != nil {
+	var m map[string]jsoniter.RawMessage
+	if err = jsoniter.Unmarshal(data, &m); err != nil {
 		return
 	}
-	c.Paths = m
+	c.Paths, c.Labels, c.Quotas = make(cos.StrSet, len(m)), nil, nil
+	for mpath, v := range m {
+		var opts fspOpts
+		c.Paths.Set(mpath)
+		switch {
+		case len(v) == 0:
+			continue
+		case v[0] == '"':
+			err = jsoniter.Unmarshal(v, &opts.Label)
+		default:
+			err = jsoniter.Unmarshal(v, &opts)
+		}
+		if err != nil {
+			return
+		}
+		if opts.Label != "" {
+			if c.Labels == nil {
+				c.Labels = make(cos.StrKVs, 1)
+			}
+			c.Labels[mpath] = opts.Label
+		}
+		if opts.Quota != "" {
+			if c.Quotas == nil {
+				c.Quotas = make(cos.StrKVs, 1)
+			}
+			c.Quotas[mpath] = opts.Quota
+		}
+	}
 	return
 }
 
 func (c *FSPConf) MarshalJSON() (data []byte, err error) {
-	return cos.MustMarshal(c.Paths), nil
+	if len(c.Labels) == 0 && len(c.Quotas) == 0 {
+		return cos.MustMarshal(c.Paths), nil
+	}
+	m := make(map[string]any, len(c.Paths))
+	for mpath := range c.Paths {
+		opts := fspOpts{Label: c.Labels[mpath], Quota: c.Quotas[mpath]}
+		switch {
+		case opts.Quota != "":
+			m[mpath] = opts
+		case opts.Label != "":
+			m[mpath] = opts.Label
+		default:
+			m[mpath] = struct{}{}
+		}
+	}
+	return cos.MustMarshal(m), nil
 }
 
 func (c *FSPConf) Validate(contextConfig *Config) error {
@@ -1344,12 +1896,34 @@ func (c *FSPConf) Validate(contextConfig *Config) error {
 		return NewErrInvalidFSPathsConf(ErrNoMountpaths)
 	}
 
-	cleanMpaths := make(map[string]struct{})
+	var (
+		cleanMpaths = make(map[string]struct{})
+		cleanLabels cos.StrKVs
+		cleanQuotas cos.StrKVs
+	)
 	for fspath := range c.Paths {
 		mpath, err := ValidateMpath(fspath)
 		if err != nil {
 			return err
 		}
+		if label, ok := c.Labels[fspath]; ok {
+			if err := ValidateMpathLabel(label); err != nil {
+				return NewErrInvalidFSPathsConf(fmt.Errorf("mountpath %q: %v", mpath, err))
+			}
+			if cleanLabels == nil {
+				cleanLabels = make(cos.StrKVs, len(c.Labels))
+			}
+			cleanLabels[mpath] = label
+		}
+		if quota, ok := c.Quotas[fspath]; ok {
+			if err := ValidateMpathQuota(quota); err != nil {
+				return NewErrInvalidFSPathsConf(fmt.Errorf("mountpath %q: %v", mpath, err))
+			}
+			if cleanQuotas == nil {
+				cleanQuotas = make(cos.StrKVs, len(c.Quotas))
+			}
+			cleanQuotas[mpath] = quota
+		}
 		l := len(mpath)
 		// disallow mountpath nesting
 		for mpath2 := range cleanMpaths {
@@ -1363,7 +1937,7 @@ func (c *FSPConf) Validate(contextConfig *Config) error {
 		}
 		cleanMpaths[mpath] = struct{}{}
 	}
-	c.Paths = cleanMpaths
+	c.Paths, c.Labels, c.Quotas = cleanMpaths, cleanLabels, cleanQuotas
 	return nil
 }
 
@@ -1439,6 +2013,29 @@ func ValidateMpath(mpath string) (string, error) {
 	return cleanMpath, nil
 }
 
+// (see FSPConf)
+func ValidateMpathLabel(label string) error {
+	if label == "" || cos.IsAlphaNice(label) {
+		return nil
+	}
+	return fmt.Errorf("invalid label %q (expecting letters, numbers, dashes, and underscores)", label)
+}
+
+// percentage of the filesystem capacity, e.g. "60%", or size, e.g. "2TiB" (see FSPConf)
+func ValidateMpathQuota(quota string) error {
+	if quota == "" {
+		return nil
+	}
+	pq, err := cos.ParseQuantity(quota)
+	if err != nil {
+		return fmt.Errorf("invalid quota %q: %v", quota, err)
+	}
+	if pq.Value == 0 {
+		return fmt.Errorf("invalid quota %q: must be positive", quota)
+	}
+	return nil
+}
+
 ////////////////
 // MemsysConf //
 ////////////////
@@ -1468,6 +2065,19 @@ func (c *MemsysConf) Validate() (err error) {
 	return nil
 }
 
+/////////////
+// CPUConf //
+/////////////
+
+const MaxOffloadPerCore = 16
+
+func (c *CPUConf) Validate() error {
+	if c.OffloadPerCore < 0 || c.OffloadPerCore > MaxOffloadPerCore {
+		return fmt.Errorf("invalid cpu.offload_per_core=%d (expecting range [0, %d])", c.OffloadPerCore, MaxOffloadPerCore)
+	}
+	return nil
+}
+
 ///////////////////
 // TransportConf //
 ///////////////////
@@ -1479,6 +2089,22 @@ func (c *TransportConf) Validate() (err error) {
 		return fmt.Errorf("invalid transport.block_size %s (expected one of: [64K, 256K, 1MB, 4MB])",
 			c.LZ4BlockMaxSize)
 	}
+	if c.Compressor != "" && c.Compressor != apc.LZ4Compression && c.Compressor != apc.ZstdCompression {
+		return fmt.Errorf("invalid transport.compressor %q (expected one of: [%s, %s])",
+			c.Compressor, apc.LZ4Compression, apc.ZstdCompression)
+	}
+	if c.ZstdLevel < 0 || c.ZstdLevel > 22 {
+		return fmt.Errorf("invalid transport.zstd_level: %d (expected range [0, 22])", c.ZstdLevel)
+	}
+	switch c.PDUChecksum {
+	case "", cos.ChecksumNone, cos.ChecksumCRC32C, cos.ChecksumXXHash:
+	default:
+		return fmt.Errorf("invalid transport.pdu_checksum %q (expected one of: [%s, %s, %s])",
+			c.PDUChecksum, cos.ChecksumNone, cos.ChecksumCRC32C, cos.ChecksumXXHash)
+	}
+	if c.LinkCapacity < 0 {
+		return fmt.Errorf("invalid transport.link_capacity: %d (expecting non-negative)", c.LinkCapacity)
+	}
 	if c.Burst < 0 {
 		return fmt.Errorf("invalid transport.burst_buffer: %v (expected >0)", c.Burst)
 	}
@@ -1509,9 +2135,42 @@ func (c *TCBConf) Validate() error {
 		return fmt.Errorf("invalid tcb.compression: %q (expecting one of: %v)",
 			c.Compression, apc.SupportedCompression)
 	}
+	if c.Bandwidth < 0 {
+		return fmt.Errorf("invalid tcb.bandwidth: %d (expecting non-negative)", c.Bandwidth)
+	}
+	return nil
+}
+
+/////////////////////
+// XactHistoryConf //
+/////////////////////
+
+const (
+	DfltXactHistoryAge = 30 * 24 * time.Hour
+	DfltXactHistoryNum = 10000
+)
+
+func (c *XactHistoryConf) Validate() error {
+	if j := c.MaxAge.D(); j != 0 && j < time.Hour {
+		return fmt.Errorf("invalid xact_history.max_age=%s (expecting at least 1h)", j)
+	}
+	if c.MaxNum < 0 || c.MaxNum > 1_000_000 {
+		return fmt.Errorf("invalid xact_history.max_num: %d (expected range [0, 1000000])", c.MaxNum)
+	}
 	return nil
 }
 
+func (c *XactHistoryConf) Limits() (maxAge time.Duration, maxNum int) {
+	maxAge, maxNum = c.MaxAge.D(), c.MaxNum
+	if maxAge == 0 {
+		maxAge = DfltXactHistoryAge
+	}
+	if maxNum == 0 {
+		maxNum = DfltXactHistoryNum
+	}
+	return
+}
+
 /////////////////
 // TimeoutConf //
 /////////////////
@@ -1564,6 +2223,9 @@ func (c *DownloaderConf) Validate() error {
 // RebalanceConf //
 ///////////////////
 
+// default hysteresis of the capacity-driven rebalance (see `RebalanceConf.CapDiffPct`)
+const DfltCapDiffTime = 30 * time.Minute
+
 func (c *RebalanceConf) Validate() error {
 	if j := c.DestRetryTime.D(); j < time.Second || j > 10*time.Minute {
 		return fmt.Errorf("invalid rebalance.dest_retry_time=%s (expected range [1s, 10m])", j)
@@ -1575,6 +2237,19 @@ func (c *RebalanceConf) Validate() error {
 		return fmt.Errorf("invalid rebalance.compression: %q (expecting one of: %v)",
 			c.Compression, apc.SupportedCompression)
 	}
+	if c.CapDiffPct < 0 || c.CapDiffPct > 100 {
+		return fmt.Errorf("invalid rebalance.cap_diff_pct: %d (expected range [0, 100])", c.CapDiffPct)
+	}
+	if c.Bandwidth < 0 {
+		return fmt.Errorf("invalid rebalance.bandwidth: %d (expecting non-negative)", c.Bandwidth)
+	}
+	if c.CapDiffPct > 0 {
+		if c.CapDiffTime == 0 {
+			c.CapDiffTime = cos.Duration(DfltCapDiffTime)
+		} else if j := c.CapDiffTime.D(); j < time.Minute {
+			return fmt.Errorf("invalid rebalance.cap_diff_time=%s (expecting at least 1m)", j)
+		}
+	}
 	return nil
 }
 
@@ -13,10 +13,13 @@ const (
 	ContentMsgPack        = "application/msgpack"
 	ContentXML            = "application/xml"
 	ContentBinary         = "application/octet-stream"
+	ContentEventStream    = "text/event-stream" // server-sent events
+
+	ContentTar  = "application/x-tar" // not present in IANA reg, mozilla.org has it though
+	ContentGzip = "application/gzip"
 
 	// not currently used:
 	ContentZip = "application/zip"
-	ContentTar = "application/x-tar" // not present in IANA reg, mozilla.org has it though
 )
 
 // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers
@@ -29,10 +32,11 @@ const (
 	HdrContentRangeValPrefix = "bytes " // Ref: https://tools.ietf.org/html/rfc7233#section-4.2
 	HdrAcceptRanges          = "Accept-Ranges"
 
-	// content length & type
+	// content length, type & encoding
 	HdrContentType        = "Content-Type"
 	HdrContentTypeOptions = "X-Content-Type-Options"
 	HdrContentLength      = "Content-Length"
+	HdrContentEncoding    = "Content-Encoding"
 
 	// misc. gen
 	HdrUserAgent = "User-Agent"
@@ -40,6 +44,12 @@ const (
 	HdrLocation  = "Location"
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Hdrs/ETag
+
+	HdrCacheControl = "Cache-Control"
+
+	// conditional requests (see cmn.Precond)
+	HdrIfMatch     = "If-Match"
+	HdrIfNoneMatch = "If-None-Match"
 )
 
 // provider-specific headers (=> custom props, and more)
@@ -58,6 +68,10 @@ const (
 	S3HdrMptCnt        = "x-amz-mp-parts-count"
 	S3HdrContentSHA256 = "x-amz-content-sha256"
 	S3HdrBckRegion     = "x-amz-bucket-region"
+	S3HdrTagging       = "x-amz-tagging"
+	S3HdrTaggingCnt    = "x-amz-tagging-count"
+	S3HdrObjAttrs      = "x-amz-object-attributes"
+	S3HdrStorageClass  = "x-amz-storage-class"
 
 	S3ChecksumCRC32  = "x-amz-checksum-crc32"
 	S3ChecksumCRC32C = "x-amz-checksum-crc32c"
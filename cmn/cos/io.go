@@ -14,6 +14,7 @@ import (
 	"os/user"
 	"path/filepath"
 	"strconv"
+	"syscall"
 
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -441,17 +442,32 @@ func CreateFile(fqn string) (*os.File, error) {
 // (creates destination directory if doesn't exist)
 func Rename(src, dst string) (err error) {
 	err = os.Rename(src, dst)
-	if err == nil || !os.IsNotExist(err) {
-		return
+	if err != nil && os.IsNotExist(err) {
+		// create and retry (slow path)
+		if err = CreateDir(filepath.Dir(dst)); err == nil {
+			err = os.Rename(src, dst)
+		}
 	}
-	// create and retry (slow path)
-	err = CreateDir(filepath.Dir(dst))
-	if err == nil {
-		err = os.Rename(src, dst)
+	if err != nil && errors.Is(err, syscall.EXDEV) {
+		err = renameXdev(src, dst)
 	}
 	return
 }
 
+// across filesystems (e.g., workfile on the scratch mountpath): copy next to the destination,
+// rename, and remove the source
+func renameXdev(src, dst string) error {
+	tmp := dst + ".xdev." + GenTie()
+	if _, _, err := CopyFile(src, tmp, nil, ChecksumNone); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		RemoveFile(tmp)
+		return err
+	}
+	return RemoveFile(src)
+}
+
 // RemoveFile removes path; returns nil upon success or if the path does not exist.
 func RemoveFile(path string) (err error) {
 	err = os.Remove(path)
@@ -677,3 +693,16 @@ func fflush(file *os.File) (err error) {
 	}
 	return file.Sync()
 }
+
+// makes a prior rename (create, remove) of a file in the directory durable
+func FsyncDir(dir string) error {
+	fh, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = fh.Sync()
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	return err
+}
@@ -7,6 +7,8 @@ package cos
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	ratomic "sync/atomic"
 
 	"github.com/NVIDIA/aistore/cmn/debug"
 )
@@ -37,7 +39,10 @@ const (
 	_smoduleLast
 )
 
-const maxLevel = 5
+const (
+	maxLevel   = 5
+	numModules = 16 // len(Smodules)
+)
 
 // NOTE: keep in-sync
 var Smodules = []string{
@@ -99,3 +104,73 @@ func (l LogLevel) String() (s string) {
 	s += " (module" + Plural(n) + ": " + ms[1:] + ")"
 	return
 }
+
+//
+// per-module levels, e.g. "ec:4,reb:5" (see LogConf.ModuleLevels)
+//
+
+type (
+	LogModuleLevels string
+	modLevels       struct {
+		src    LogModuleLevels
+		levels [numModules]uint8 // (index: bit position of the Smodule* flag)
+	}
+)
+
+// the most recently parsed; updated lazily - upon first use of changed (via config API) modules
+var modCache ratomic.Pointer[modLevels]
+
+func (lm LogModuleLevels) parse() (ml *modLevels, err error) {
+	ml = &modLevels{src: lm}
+	if lm == "" {
+		return
+	}
+	for _, kv := range strings.Split(string(lm), ",") {
+		name, v, ok := strings.Cut(strings.TrimSpace(kv), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid log.module_levels %q: expecting comma-separated <module>:<level> pairs", lm)
+		}
+		level, errV := strconv.Atoi(v)
+		if errV != nil || level < 0 || level > maxLevel {
+			return nil, fmt.Errorf("invalid log.module_levels %q: level %q (expected range [0, %d])", lm, v, maxLevel)
+		}
+		i := -1
+		for j, sm := range Smodules {
+			if sm == name {
+				i = j
+				break
+			}
+		}
+		if i < 0 {
+			return nil, fmt.Errorf("invalid log.module_levels %q: unknown module %q (expecting one of %v)", lm, name, Smodules)
+		}
+		ml.levels[i] = uint8(level)
+	}
+	return
+}
+
+func (lm LogModuleLevels) Validate() (err error) {
+	_, err = lm.parse()
+	return
+}
+
+// returns true if any of the modules in `fl` is configured with level >= verbosity
+func (lm LogModuleLevels) FastV(verbosity, fl int) bool {
+	if lm == "" {
+		return false
+	}
+	ml := modCache.Load()
+	if ml == nil || ml.src != lm {
+		var err error
+		if ml, err = lm.parse(); err != nil {
+			return false
+		}
+		modCache.Store(ml)
+	}
+	for i := range ml.levels {
+		if fl&(1<<i) != 0 && int(ml.levels[i]) >= verbosity {
+			return true
+		}
+	}
+	return false
+}
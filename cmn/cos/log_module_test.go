@@ -0,0 +1,30 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLogModules(t *testing.T) {
+	for _, s := range []LogModuleLevels{"", "ec:4", "ec:4, reb:5,fs:0"} {
+		tassert.Errorf(t, s.Validate() == nil, "expecting %q to be valid", s)
+	}
+	for _, s := range []LogModuleLevels{"ec", "ec:6", "ec:-1", "nonexistent:3", "ec:4,"} {
+		tassert.Errorf(t, s.Validate() != nil, "expecting %q to be invalid", s)
+	}
+
+	lm := LogModuleLevels("ec:4,reb:5")
+	tassert.Errorf(t, lm.FastV(4, SmoduleEC), "ec:4")
+	tassert.Errorf(t, !lm.FastV(5, SmoduleEC), "ec:4 vs 5")
+	tassert.Errorf(t, lm.FastV(5, SmoduleReb|SmoduleFS), "reb:5")
+	tassert.Errorf(t, !lm.FastV(1, SmoduleFS), "fs not configured")
+
+	// runtime update
+	lm = "fs:3"
+	tassert.Errorf(t, lm.FastV(3, SmoduleFS) && !lm.FastV(1, SmoduleEC), "fs:3")
+}
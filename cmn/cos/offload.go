@@ -0,0 +1,142 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"io"
+	"sync"
+)
+
+// CPU-bound work (checksumming, EC encoding) offload: a bounded pool of workers
+// that executes the work on behalf of the (blocked) callers - to keep bursts of such
+// work from saturating the CPUs and starving the request-serving goroutines.
+// Foreground (e.g., PUT) tasks take precedence over background (e.g., EC encoding)
+// ones; in addition, background tasks never occupy more than half of the workers
+// (rounded up).
+// When not initialized (see InitOffload), all tasks run inline.
+
+const (
+	PrioFg = iota // foreground
+	PrioBg        // background
+)
+
+type (
+	offTask struct {
+		fn   func()
+		done chan struct{}
+	}
+	offPool struct {
+		fg   chan *offTask
+		bg   chan *offTask
+		stop StopCh
+	}
+	// hashes (or, generally, writes) via the offload pool
+	offWriter struct {
+		w    io.Writer
+		prio int
+	}
+)
+
+var (
+	offp     *offPool
+	offTasks = sync.Pool{New: func() any { return &offTask{done: make(chan struct{}, 1)} }}
+)
+
+// InitOffload starts `n` workers; must be called at most once (at startup)
+func InitOffload(n int) {
+	if n <= 0 {
+		return
+	}
+	p := &offPool{fg: make(chan *offTask, n), bg: make(chan *offTask, n)}
+	p.stop.Init()
+	for i := 0; i < n; i++ {
+		if i < n/2 {
+			go p.fgWorker()
+		} else {
+			go p.anyWorker()
+		}
+	}
+	offp = p
+}
+
+func StopOffload() {
+	if offp != nil {
+		offp.stop.Close()
+	}
+}
+
+func OffloadEnabled() bool { return offp != nil }
+
+// Offload executes `fn` on one of the pool's workers and waits for it to finish
+func Offload(prio int, fn func()) {
+	p := offp
+	if p == nil {
+		fn()
+		return
+	}
+	t := offTasks.Get().(*offTask)
+	t.fn = fn
+	if prio == PrioFg {
+		p.fg <- t
+	} else {
+		p.bg <- t
+	}
+	<-t.done
+	t.fn = nil
+	offTasks.Put(t)
+}
+
+func (p *offPool) fgWorker() {
+	for {
+		select {
+		case t := <-p.fg:
+			t.run()
+		case <-p.stop.Listen():
+			return
+		}
+	}
+}
+
+func (p *offPool) anyWorker() {
+	for {
+		// foreground first
+		select {
+		case t := <-p.fg:
+			t.run()
+			continue
+		default:
+		}
+		select {
+		case t := <-p.fg:
+			t.run()
+		case t := <-p.bg:
+			t.run()
+		case <-p.stop.Listen():
+			return
+		}
+	}
+}
+
+func (t *offTask) run() {
+	t.fn()
+	t.done <- struct{}{}
+}
+
+///////////////
+// offWriter //
+///////////////
+
+// NewOffloadWriter returns `w` itself unless offloading is enabled
+func NewOffloadWriter(w io.Writer, prio int) io.Writer {
+	if offp == nil {
+		return w
+	}
+	return &offWriter{w: w, prio: prio}
+}
+
+func (ow *offWriter) Write(p []byte) (n int, err error) {
+	Offload(ow.prio, func() { n, err = ow.w.Write(p) })
+	return n, err
+}
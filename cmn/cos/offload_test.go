@@ -0,0 +1,68 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestOffload(t *testing.T) {
+	const (
+		numWorkers = 4
+		numTasks   = 64
+	)
+	InitOffload(numWorkers)
+	t.Cleanup(func() {
+		StopOffload()
+		offp = nil
+	})
+
+	var (
+		wg               sync.WaitGroup
+		cur, curBg       atomic.Int32
+		maxCur, maxCurBg atomic.Int32
+		cnt              atomic.Int32
+	)
+	setMax := func(m *atomic.Int32, v int32) {
+		for {
+			old := m.Load()
+			if v <= old || m.CAS(old, v) {
+				return
+			}
+		}
+	}
+	for i := 0; i < numTasks; i++ {
+		prio := PrioFg
+		if i%2 == 0 {
+			prio = PrioBg
+		}
+		wg.Add(1)
+		go func(prio int) {
+			defer wg.Done()
+			Offload(prio, func() {
+				setMax(&maxCur, cur.Inc())
+				if prio == PrioBg {
+					setMax(&maxCurBg, curBg.Inc())
+				}
+				time.Sleep(time.Millisecond)
+				if prio == PrioBg {
+					curBg.Dec()
+				}
+				cur.Dec()
+				cnt.Inc()
+			})
+		}(prio)
+	}
+	wg.Wait()
+
+	tassert.Errorf(t, cnt.Load() == numTasks, "expected %d tasks to run, got %d", numTasks, cnt.Load())
+	tassert.Errorf(t, maxCur.Load() <= numWorkers, "concurrency %d exceeds %d workers", maxCur.Load(), numWorkers)
+	tassert.Errorf(t, maxCurBg.Load() <= numWorkers/2, "background concurrency %d exceeds %d", maxCurBg.Load(), numWorkers/2)
+}
@@ -18,6 +18,9 @@ import (
 // 1. bash (or shell) brace expansion:
 //    * `prefix-{0..100}-suffix`
 //    * `prefix-{00001..00010..2}-gap-{001..100..2}-suffix`
+//    * `prefix-{a..f}-suffix` (single-letter sequence, with an optional step)
+//    * `prefix-{train,val{1..3},test}-suffix` (comma-separated alternatives, possibly nested)
+//    * `prefix-{[0-9a-f]}-suffix` (character class: single characters and ranges thereof)
 // 2. at style:
 //    * `prefix-@100-suffix`
 //    * `prefix-@00001-gap-@100-suffix`
@@ -27,10 +30,16 @@ import (
 //
 // NOTE: if none of the above applies, `NewParsedTemplate()` simply returns
 //       `ParsedTemplate{Prefix = original template string}` with nil Ranges
+//
+// NOTE: numeric ranges are iterated lazily, while alternatives and character classes
+//       are expanded at parse time - up to `maxTemplateAlts` values per (top-level) brace.
+//
+// Use ExpandTemplate() to validate a template and preview its expansion.
 
 type (
 	TemplateRange struct {
-		Gap        string // characters after the range (to the next range or end of the string)
+		Gap        string   // characters after the range (to the next range or end of the string)
+		Values     []string // alternatives and character classes: Start..End index Values
 		Start      int64
 		End        int64
 		Step       int64
@@ -56,8 +65,11 @@ const (
 	startAfterEnd   = "invalid '%s' template %q: 'start' cannot be greater than 'end'"
 	negativeStart   = "invalid '%s' template %q: 'start' is negative"
 	nonPositiveStep = "invalid '%s' template %q: 'step' is non-positive"
+	tooManyAlts     = "invalid 'bash' template %q: brace %q expands to more than %d values"
 )
 
+const maxTemplateAlts = 64 * 1024
+
 var (
 	ErrEmptyTemplate = errors.New("empty range template")
 
@@ -98,11 +110,32 @@ func NewParsedTemplate(template string) (parsed ParsedTemplate, err error) {
 	return ParsedTemplate{Prefix: template}, nil
 }
 
+// ExpandTemplate parses and validates a template (any of the supported styles) and
+// returns the total number of names it expands to, along with up to `limit` first names.
+// Meant to be used prior to starting (possibly, multi-million-object) prefetch,
+// download, dsort, and other multi-object jobs.
+// NOTE: a "pure" prefix (no ranges) is returned with zero count and nil preview.
+func ExpandTemplate(template string, limit int) (count int64, preview []string, err error) {
+	var pt ParsedTemplate
+	if pt, err = NewParsedTemplate(template); err != nil || len(pt.Ranges) == 0 {
+		return
+	}
+	count = pt.Count()
+	if limit > 0 {
+		preview = pt.ToSlice(int(MinI64(count, int64(limit))))
+	}
+	return
+}
+
+// (saturates at math.MaxInt64)
 func (pt *ParsedTemplate) Count() int64 {
 	count := int64(1)
 	for _, tr := range pt.Ranges {
-		step := (tr.End-tr.Start)/tr.Step + 1
-		count *= step
+		n := (tr.End-tr.Start)/tr.Step + 1
+		if count > math.MaxInt64/n {
+			return math.MaxInt64
+		}
+		count *= n
 	}
 	return count
 }
@@ -150,7 +183,12 @@ func (pt *ParsedTemplate) Next() (string, bool) {
 	}
 	pt.buf.WriteString(pt.Prefix)
 	for i, tr := range pt.Ranges {
-		pt.buf.WriteString(fmt.Sprintf("%0*d%s", tr.DigitCount, pt.at[i], tr.Gap))
+		if tr.Values != nil {
+			pt.buf.WriteString(tr.Values[pt.at[i]])
+		} else {
+			pt.buf.WriteString(fmt.Sprintf("%0*d", tr.DigitCount, pt.at[i]))
+		}
+		pt.buf.WriteString(tr.Gap)
 	}
 	pt.at[pt.rangesCount-1] += pt.Ranges[pt.rangesCount-1].Step
 	return pt.buf.String(), true
@@ -215,86 +253,207 @@ func ParseFmtTemplate(template string) (pt ParsedTemplate, err error) {
 // examples
 // - single-range: "prefix{0001..0010}suffix"
 // - multi-range:  "prefix-{00001..00010..2}-gap-{001..100..2}-suffix"
+// - alternatives: "prefix-{a,b{1..3},c}-{[xyz]}-suffix"
 // (both prefix and suffix are optional, here and elsewhere)
+// NOTE: character classes must be enclosed in braces - a standalone '[' is a literal
 func ParseBashTemplate(template string) (pt ParsedTemplate, err error) {
-	left := strings.IndexByte(template, '{')
-	if left == -1 {
-		err = errTemplateNotBash
-		return
-	}
-	right := strings.LastIndexByte(template, '}')
-	if right == -1 {
+	if strings.IndexByte(template, '{') == -1 {
 		err = errTemplateNotBash
 		return
 	}
-	if right < left {
-		err = newErrTemplateInvalid(invalidBash, template)
-		return
-	}
-	pt.Prefix = template[:left]
-
-	for {
-		tr := TemplateRange{}
-
-		left := strings.IndexByte(template, '{')
-		if left == -1 {
-			break
-		}
+	return parseBash(template, template)
+}
 
-		right := strings.IndexByte(template, '}')
-		if right == -1 {
-			err = newErrTemplateInvalid(invalidBash, template)
-			return
-		}
-		if right < left {
-			err = newErrTemplateInvalid(invalidBash, template)
-			return
+// parse `s` (which is either the entire template or one of its comma-separated alternatives)
+func parseBash(template, s string) (pt ParsedTemplate, err error) {
+	var lit strings.Builder
+	flush := func() {
+		if len(pt.Ranges) == 0 {
+			pt.Prefix = lit.String()
+		} else {
+			pt.Ranges[len(pt.Ranges)-1].Gap = lit.String()
 		}
-		inside := template[left+1 : right]
-
-		numbers := strings.Split(inside, "..")
-		if len(numbers) < 2 || len(numbers) > 3 {
-			err = newErrTemplateInvalid(invalidBash, template)
-			return
-		} else if len(numbers) == 2 { // {0001..0999} case
-			if tr.Start, err = strconv.ParseInt(numbers[0], 10, 64); err != nil {
+		lit.Reset()
+	}
+	for i := 0; i < len(s); {
+		switch c := s[i]; c {
+		case '{':
+			j := matchBrace(s, i)
+			if j == -1 {
+				err = newErrTemplateInvalid(invalidBash, template)
 				return
 			}
-			if tr.End, err = strconv.ParseInt(numbers[1], 10, 64); err != nil {
+			var tr TemplateRange
+			if tr, err = parseBrace(template, s[i+1:j]); err != nil {
 				return
 			}
-			tr.Step = 1
-			tr.DigitCount = Min(len(numbers[0]), len(numbers[1]))
-		} else if len(numbers) == 3 { // {0001..0999..2} case
-			if tr.Start, err = strconv.ParseInt(numbers[0], 10, 64); err != nil {
+			flush()
+			pt.Ranges = append(pt.Ranges, tr)
+			i = j + 1
+		case '}':
+			// unmatched: a literal only when trailing (no ranges to follow)
+			if strings.IndexByte(s[i:], '{') != -1 {
+				err = newErrTemplateInvalid(invalidBash, template)
 				return
 			}
-			if tr.End, err = strconv.ParseInt(numbers[1], 10, 64); err != nil {
+			lit.WriteByte(c)
+			i++
+		default:
+			lit.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return
+}
+
+// returns the index of the '}' that matches the '{' at s[left], or -1
+func matchBrace(s string, left int) int {
+	depth := 0
+	for i := left; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// the part inside braces: numeric range, letter sequence, character class, or comma-separated alternatives
+func parseBrace(template, inside string) (tr TemplateRange, err error) {
+	if l := len(inside); l > 2 && inside[0] == '[' && inside[l-1] == ']' {
+		values := parseCharClass(inside[1 : l-1])
+		if values == nil {
+			err = newErrTemplateInvalid(invalidBash, template)
+			return
+		}
+		tr = TemplateRange{End: int64(len(values) - 1), Step: 1, Values: values}
+		return
+	}
+	if alts := splitAlts(inside); len(alts) > 1 {
+		var values []string
+		for _, alt := range alts {
+			var expanded []string
+			if expanded, err = expandAlt(template, alt); err != nil {
 				return
 			}
-			if tr.Step, err = strconv.ParseInt(numbers[2], 10, 64); err != nil {
+			values = append(values, expanded...)
+			if len(values) > maxTemplateAlts {
+				err = newErrTemplateInvalid(tooManyAlts, template, inside, maxTemplateAlts)
 				return
 			}
-			tr.DigitCount = Min(len(numbers[0]), len(numbers[1]))
 		}
-		if err = validateBoundaries("bash", template, tr.Start, tr.End, tr.Step); err != nil {
+		tr = TemplateRange{End: int64(len(values) - 1), Step: 1, Values: values}
+		return
+	}
+
+	numbers := strings.Split(inside, "..")
+	if len(numbers) < 2 || len(numbers) > 3 {
+		err = newErrTemplateInvalid(invalidBash, template)
+		return
+	}
+	tr.Step = 1
+	if len(numbers) == 3 { // {0001..0999..2} case
+		if tr.Step, err = strconv.ParseInt(numbers[2], 10, 64); err != nil {
+			return
+		}
+	}
+	if isLetter(numbers[0]) && isLetter(numbers[1]) { // {a..z} case
+		if err = validateBoundaries("bash", template, 0, int64(numbers[1][0])-int64(numbers[0][0]), tr.Step); err != nil {
 			return
 		}
+		for c := int64(numbers[0][0]); c <= int64(numbers[1][0]); c += tr.Step {
+			tr.Values = append(tr.Values, string(rune(c)))
+		}
+		tr.End = int64(len(tr.Values) - 1)
+		tr.Step = 1
+		return
+	}
+	// {0001..0999} case
+	if tr.Start, err = strconv.ParseInt(numbers[0], 10, 64); err != nil {
+		return
+	}
+	if tr.End, err = strconv.ParseInt(numbers[1], 10, 64); err != nil {
+		return
+	}
+	tr.DigitCount = Min(len(numbers[0]), len(numbers[1]))
+	err = validateBoundaries("bash", template, tr.Start, tr.End, tr.Step)
+	return
+}
 
-		// apply gap (either to next range or end of the template)
-		template = template[right+1:]
-		right = strings.Index(template, "{")
-		if right >= 0 {
-			tr.Gap = template[:right]
-		} else {
-			tr.Gap = template
+// split by top-level (not nested) commas
+func splitAlts(inside string) (alts []string) {
+	var depth, start int
+	for i := 0; i < len(inside); i++ {
+		switch inside[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				alts = append(alts, inside[start:i])
+				start = i + 1
+			}
 		}
+	}
+	if alts != nil {
+		alts = append(alts, inside[start:])
+	}
+	return
+}
 
-		pt.Ranges = append(pt.Ranges, tr)
+func expandAlt(template, alt string) ([]string, error) {
+	if !strings.ContainsAny(alt, "{}") {
+		return []string{alt}, nil
+	}
+	pt, err := parseBash(template, alt)
+	if err != nil {
+		return nil, err
+	}
+	if len(pt.Ranges) == 0 {
+		return []string{pt.Prefix}, nil
+	}
+	if pt.Count() > maxTemplateAlts {
+		return nil, newErrTemplateInvalid(tooManyAlts, template, alt, maxTemplateAlts)
+	}
+	return pt.ToSlice(), nil
+}
+
+// e.g. "0-9a-f", "xyz"; returns nil if not a valid character class
+func parseCharClass(class string) (values []string) {
+	var seen [256]bool
+	for i := 0; i < len(class); i++ {
+		lo, hi := class[i], class[i]
+		if i+2 < len(class) && class[i+1] == '-' {
+			hi = class[i+2]
+			i += 2
+		}
+		if !isAlnum(lo) || !isAlnum(hi) || lo > hi || isDigit(lo) != isDigit(hi) {
+			return nil
+		}
+		for c := int(lo); c <= int(hi); c++ {
+			if isAlnum(byte(c)) && !seen[c] {
+				seen[c] = true
+				values = append(values, string(rune(c)))
+			}
+		}
 	}
 	return
 }
 
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+func isAlnum(c byte) bool { return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+
+func isLetter(s string) bool {
+	return len(s) == 1 && isAlnum(s[0]) && !isDigit(s[0])
+}
+
 // e.g.:
 // - multi range:  "prefix-@00001-gap-@100-suffix"
 // - single range: "prefix@00100suffix"
@@ -219,6 +219,14 @@ var _ = Describe("Template", func() {
 			Entry("nested templates", "{{}}"),
 			Entry("nested templates with numbers", "{{1..2}}"),
 			Entry("interleaving templates", "{1..2{3..4}}"),
+			Entry("invalid nested alternative", "prefix-{a,b{2..1}}-suffix"),
+			Entry("letter sequence backwards", "prefix-{z..a}-suffix"),
+			Entry("unbalanced alternatives", "prefix-{a,b{c,d}-suffix"),
+			Entry("unmatched }", "prefix-}{0001..0111}-suffix"),
+			Entry("unmatched } between ranges", "prefix-{a,b}}-{1..2}"),
+			Entry("character class backwards", "prefix-{[z-a]}-suffix"),
+			Entry("character class mixing digits and letters", "prefix-{[0-z]}-suffix"),
+			Entry("character class with non-alphanumeric", "prefix-{[a-]}-suffix"),
 		)
 	})
 
@@ -319,6 +327,51 @@ var _ = Describe("Template", func() {
 				"large step", "prefix-{0010..0013..2}-gap-{1..2..3}-suffix",
 				"prefix-0010-gap-1-suffix", "prefix-0012-gap-1-suffix",
 			),
+			Entry(
+				"alternatives", "{train,val}-{1..2}",
+				"train-1", "train-2", "val-1", "val-2",
+			),
+			Entry(
+				"nested alternatives", "x-{a,b{01..03..2},}.tar",
+				"x-a.tar", "x-b01.tar", "x-b03.tar", "x-.tar",
+			),
+			Entry(
+				"letter sequence with step", "{a..e..2}-{8..9}",
+				"a-8", "a-9", "c-8", "c-9", "e-8", "e-9",
+			),
+			Entry(
+				"character classes", "shard-{[0-1x]}{[ab]}",
+				"shard-0a", "shard-0b", "shard-1a", "shard-1b", "shard-xa", "shard-xb",
+			),
+			Entry(
+				"character class in alternatives", "{x,y{[a-b]}}.tar",
+				"x.tar", "ya.tar", "yb.tar",
+			),
+			Entry(
+				"literal brackets", "shard-[ab]-{1..2}",
+				"shard-[ab]-1", "shard-[ab]-2",
+			),
 		)
+
+		It("should count and preview expansion", func() {
+			count, preview, err := cos.ExpandTemplate("prefix-{0000..9999}-{a,b}-{[0-9]}", 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(int64(200_000)))
+			Expect(preview).To(Equal([]string{"prefix-0000-a-0", "prefix-0000-a-1", "prefix-0000-a-2"}))
+
+			count, preview, err = cos.ExpandTemplate("prefix-%06d", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(int64(math.MaxInt64)))
+			Expect(preview).To(BeNil())
+
+			_, _, err = cos.ExpandTemplate("prefix-{3..1}", 10)
+			Expect(err).To(HaveOccurred())
+
+			// no braces: brackets are literal
+			count, preview, err = cos.ExpandTemplate("prefix-[0-9]", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeZero())
+			Expect(preview).To(BeNil())
+		})
 	})
 })
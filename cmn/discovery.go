@@ -0,0 +1,175 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Bootstrap discovery of the primary: `config.proxy.discovery` is a spec of the form
+// "<resolver>://<name>" that resolves to one or more proxy URLs - the candidates that a
+// joining node will try in addition to the configured primary, discovery, and original URLs.
+// (Any proxy will do since non-primary proxies forward registrations to the primary.)
+//
+// Built-in resolvers:
+//   - "dns-srv://_ais._tcp.example.com" - DNS SRV records;
+//   - "aws-tag://<key>"  - EC2 instance metadata tag that contains comma-separated URLs;
+//   - "gcp-attr://<key>" - GCE instance metadata attribute, ditto;
+//   - "k8s://<service>[:<port>]" - Kubernetes service (registered by cmn/k8s).
+//
+// Additional resolvers can be plugged in via RegDiscovery.
+
+type DiscoveryResolver func(ctx context.Context, name string, useHTTPS bool) ([]string, error)
+
+const discoverySep = "://"
+
+const (
+	awsMetadataURL = "http://169.254.169.254/latest"
+	gcpMetadataURL = "http://metadata.google.internal/computeMetadata/v1/instance/attributes/"
+)
+
+var discovery = struct {
+	resolvers map[string]DiscoveryResolver
+	mu        sync.RWMutex
+}{
+	resolvers: map[string]DiscoveryResolver{
+		"dns-srv":  resolveSRV,
+		"aws-tag":  resolveAWSTag,
+		"gcp-attr": resolveGCPAttr,
+	},
+}
+
+func RegDiscovery(resolver string, fn DiscoveryResolver) {
+	discovery.mu.Lock()
+	discovery.resolvers[resolver] = fn
+	discovery.mu.Unlock()
+}
+
+func parseDiscovery(spec string) (fn DiscoveryResolver, name string, err error) {
+	resolver, name, ok := strings.Cut(spec, discoverySep)
+	if !ok || resolver == "" || name == "" {
+		return nil, "", fmt.Errorf("invalid discovery spec %q (expecting \"<resolver>://<name>\")", spec)
+	}
+	discovery.mu.RLock()
+	fn, ok = discovery.resolvers[resolver]
+	discovery.mu.RUnlock()
+	if !ok {
+		err = fmt.Errorf("invalid discovery spec %q: unknown resolver %q", spec, resolver)
+	}
+	return
+}
+
+// Discover returns proxy URLs (possibly, none) as per `spec` (see above)
+func Discover(spec string, useHTTPS bool, timeout time.Duration) ([]string, error) {
+	fn, name, err := parseDiscovery(spec)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	urls, err := fn(ctx, name, useHTTPS)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("discovery %q: %w", spec, err)
+	}
+	return urls, nil
+}
+
+func DiscoveryURL(host string, port int, useHTTPS bool) string {
+	scheme := "http"
+	if useHTTPS {
+		scheme = "https"
+	}
+	return scheme + "://" + net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+//
+// built-in resolvers
+//
+
+func resolveSRV(ctx context.Context, name string, useHTTPS bool) ([]string, error) {
+	_, addrs, err := net.DefaultResolver.LookupSRV(ctx, "", "", name)
+	if err != nil {
+		return nil, err
+	}
+	urls := make([]string, 0, len(addrs))
+	for _, addr := range addrs { // (sorted by priority and randomized by weight)
+		urls = append(urls, DiscoveryURL(strings.TrimSuffix(addr.Target, "."), int(addr.Port), useHTTPS))
+	}
+	return urls, nil
+}
+
+// IMDSv2 (session token)
+func resolveAWSTag(ctx context.Context, key string, _ bool) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, awsMetadataURL+"/api/token", http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token-ttl-seconds", "60")
+	token, err := _metadata(req)
+	if err != nil {
+		return nil, err
+	}
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, awsMetadataURL+"/meta-data/tags/instance/"+key, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token", token)
+	value, err := _metadata(req)
+	if err != nil {
+		return nil, err
+	}
+	return _splitURLs(value), nil
+}
+
+func resolveGCPAttr(ctx context.Context, key string, _ bool) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcpMetadataURL+key, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	value, err := _metadata(req)
+	if err != nil {
+		return nil, err
+	}
+	return _splitURLs(value), nil
+}
+
+func _metadata(req *http.Request) (string, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s %s: %s", req.Method, req.URL.Path, resp.Status)
+	}
+	if len(b) == 0 {
+		return "", errors.New(req.URL.Path + ": empty")
+	}
+	return string(b), nil
+}
+
+func _splitURLs(value string) (urls []string) {
+	for _, u := range strings.Split(value, ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return
+}
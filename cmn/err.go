@@ -59,6 +59,8 @@ type (
 		RemoteAddr string `json:"remote_addr"`
 		Caller     string `json:"caller"`
 		Node       string `json:"node"`
+		ReqID      string `json:"req_id,omitempty"`
+		cause      error  // client side: typed (sentinel) error, if any - see SetCause
 		trace      []byte
 		Status     int `json:"status"`
 	}
@@ -89,6 +91,17 @@ type (
 	ErrInvalidBackendProvider struct {
 		bck Bck
 	}
+	ErrSchemaViolation struct {
+		bck     Bck
+		ObjName string
+		Rule    string // one of the SchemaRule* enum (schema.go)
+		Detail  string
+	}
+	ErrPreconditionFailed struct {
+		cname   string
+		precond Precond
+		etag    string
+	}
 	ErrCapExceeded struct {
 		totalBytes     uint64
 		totalBytesUsed uint64
@@ -315,6 +328,43 @@ func IsErrBckNotFound(err error) bool {
 	return ok
 }
 
+// ErrSchemaViolation
+
+func NewErrSchemaViolation(bck *Bck, objName, rule, format string, a ...any) *ErrSchemaViolation {
+	return &ErrSchemaViolation{bck: *bck, ObjName: objName, Rule: rule, Detail: fmt.Sprintf(format, a...)}
+}
+
+func (e *ErrSchemaViolation) Error() string {
+	return fmt.Sprintf("PUT %s rejected by bucket schema (%s): %s", e.bck.Cname(e.ObjName), e.Rule, e.Detail)
+}
+
+func IsErrSchemaViolation(err error) bool {
+	var e *ErrSchemaViolation
+	return errors.As(err, &e)
+}
+
+// ErrPreconditionFailed
+
+func NewErrPreconditionFailed(cname string, pc *Precond, etag string) *ErrPreconditionFailed {
+	return &ErrPreconditionFailed{cname: cname, precond: *pc, etag: etag}
+}
+
+func (e *ErrPreconditionFailed) Error() string {
+	s := fmt.Sprintf("%s: precondition failed (ETag %q", e.cname, e.etag)
+	if e.precond.IfMatch != "" {
+		s += ", If-Match " + e.precond.IfMatch
+	}
+	if e.precond.IfNoneMatch != "" {
+		s += ", If-None-Match " + e.precond.IfNoneMatch
+	}
+	return s + ")"
+}
+
+func IsErrPreconditionFailed(err error) bool {
+	var e *ErrPreconditionFailed
+	return errors.As(err, &e)
+}
+
 // ErrRemoteBucketOffline
 
 func NewErrRemoteBckOffline(bck *Bck) *ErrRemoteBucketOffline {
@@ -847,10 +897,15 @@ func (e *ErrHTTP) init(r *http.Request, err error, errCode int) {
 		e.Method, e.URLPath = r.Method, r.URL.Path
 		e.RemoteAddr = r.RemoteAddr
 		e.Caller = r.Header.Get(apc.HdrCallerName)
+		e.ReqID = nlog.ReqID(r.Context())
 	}
 	e.Node = thisNodeName
 }
 
+// to support errors.Is/As (with respect to the errors the client side can recognize)
+func (e *ErrHTTP) SetCause(err error)  { e.cause = err }
+func (e *ErrHTTP) Unwrap() (err error) { return e.cause }
+
 func (e *ErrHTTP) Error() (s string) {
 	if e.TypeCode != "" && e.TypeCode != "ErrFailedTo" {
 		if !strings.Contains(e.Message, e.TypeCode+":") {
@@ -887,6 +942,9 @@ func (e *ErrHTTP) StringEx() (s string) {
 	if e.Caller != "" {
 		s += " (called by " + e.Caller + ")"
 	}
+	if e.ReqID != "" {
+		s += " [req " + e.ReqID + "]"
+	}
 	if len(e.trace) == 0 {
 		e._trace()
 	}
@@ -23,10 +23,12 @@ const (
 	SkipVC                    // skip loading existing object's metadata, Version and Checksum in particular
 	DontAutoDetectFshare      // when promoting NFS shares to AIS
 	ProvideS3APIviaRoot       // handle s3 compat via `aistore-hostname/` (default: `aistore-hostname/s3`)
-	FsyncPUT                  // when finalizing PUT(obj) fflush prior to (close, rename) sequence
+	FsyncPUT                  // when finalizing PUT(obj) fflush prior to (close, rename) sequence (unless bucket's durability.policy is set)
 	LZ4Block1MB               // .tar.lz4 format, lz4 compression: max uncompressed block size=1MB (default: 256K)
 	LZ4FrameChecksum          // checksum lz4 frames (default: don't)
 	DontAllowPassingFQNtoETL  // do not allow passing fully-qualified name of a locally stored object to (local) ETL containers
+	CacheOpenFiles            // warm GET: keep open (and reuse) file descriptors of recently read objects
+	DirectIO                  // read and write object files with O_DIRECT (bypassing OS page cache)
 )
 
 var All = []string{
@@ -39,6 +41,8 @@ var All = []string{
 	"LZ4-Block-1MB",
 	"LZ4-Frame-Checksum",
 	"Dont-Allow-Passing-FQN-to-ETL",
+	"Cache-Open-Files",
+	"Direct-IO",
 }
 
 func (f Flags) IsSet(flag Flags) bool { return cos.BitFlags(f).IsSet(cos.BitFlags(flag)) }
@@ -32,6 +32,7 @@ func (gco *globalConfigOwner) Get() *Config {
 
 func (gco *globalConfigOwner) Put(config *Config) {
 	gco.c.Store(unsafe.Pointer(config))
+	config.Log.apply()
 }
 
 func (gco *globalConfigOwner) GetOverrideConfig() *ConfigToUpdate {
@@ -86,6 +87,7 @@ func (gco *globalConfigOwner) BeginUpdate() *Config {
 // NOTE: `ais` package must use config-owner to modify config.
 func (gco *globalConfigOwner) CommitUpdate(config *Config) {
 	gco.c.Store(unsafe.Pointer(config))
+	config.Log.apply()
 	gco.mtx.Unlock()
 }
 
@@ -0,0 +1,51 @@
+// Package k8s provides utilities for communicating with Kubernetes cluster.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// "k8s://<service>[:<port>]" resolves to the service's cluster IP and the named (or numbered)
+// port - the first one when not specified (see cmn.Discover)
+func init() { cmn.RegDiscovery("k8s", resolveService) }
+
+func resolveService(_ context.Context, name string, useHTTPS bool) ([]string, error) {
+	svcName, portName, _ := strings.Cut(name, ":")
+	client, err := GetClient()
+	if err != nil {
+		return nil, err
+	}
+	svc, err := client.Service(svcName)
+	if err != nil {
+		return nil, err
+	}
+	if len(svc.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("service %q has no ports", svcName)
+	}
+	host := svc.Spec.ClusterIP
+	if host == "" || host == "None" { // headless
+		host = svc.Name + "." + svc.Namespace + ".svc"
+	}
+	port := int(svc.Spec.Ports[0].Port)
+	if portName != "" {
+		port = 0
+		for _, p := range svc.Spec.Ports {
+			if p.Name == portName || strconv.Itoa(int(p.Port)) == portName {
+				port = int(p.Port)
+				break
+			}
+		}
+		if port == 0 {
+			return nil, fmt.Errorf("service %q has no port %q", svcName, portName)
+		}
+	}
+	return []string{cmn.DiscoveryURL(host, port, useHTTPS)}, nil
+}
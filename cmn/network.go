@@ -51,6 +51,7 @@ type (
 		IdleConnTimeout  time.Duration
 		IdleConnsPerHost int
 		MaxIdleConns     int
+		MaxConnsPerHost  int // 0: no limit
 		SndRcvBufSize    int
 		WriteBufferSize  int
 		ReadBufferSize   int
@@ -59,6 +60,11 @@ type (
 		// For HTTPS mode only: if true, the client does not verify server's
 		// certificate. It is useful for clusters with self-signed certificates.
 		SkipVerify bool
+		// For HTTPS mode only: when set, overrides SkipVerify (see IntraClientTLS)
+		TLS *tls.Config
+		// For HTTPS mode only: negotiate HTTP/2 (with HTTP/1.1 fallback) - multiplexes
+		// concurrent requests over a single connection per host
+		UseHTTP2 bool
 	}
 )
 
@@ -86,6 +92,7 @@ func NewTransport(args TransportArgs) *http.Transport {
 		IdleConnTimeout:       args.IdleConnTimeout,
 		MaxIdleConnsPerHost:   args.IdleConnsPerHost,
 		MaxIdleConns:          args.MaxIdleConns,
+		MaxConnsPerHost:       args.MaxConnsPerHost,
 		WriteBufferSize:       args.WriteBufferSize,
 		ReadBufferSize:        args.ReadBufferSize,
 		DisableCompression:    true, // NOTE: hardcoded - never used
@@ -109,7 +116,12 @@ func NewTransport(args TransportArgs) *http.Transport {
 	}
 
 	if args.UseHTTPS {
-		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: args.SkipVerify}
+		transport.TLSClientConfig = args.TLS
+		if args.TLS == nil {
+			transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: args.SkipVerify}
+		}
+		// (custom dialer and TLS config disable HTTP/2 unless forced)
+		transport.ForceAttemptHTTP2 = args.UseHTTP2
 	}
 	if args.UseHTTPProxyEnv {
 		transport.Proxy = defaultTransport.Proxy
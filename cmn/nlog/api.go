@@ -6,12 +6,15 @@
 package nlog
 
 import (
+	"context"
 	"flag"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn/mono"
 )
 
+type ctxReqID struct{} // context key (see WithReqID)
+
 var (
 	MaxSize int64 = 4 * 1024 * 1024
 )
@@ -21,18 +24,46 @@ func InitFlags(flset *flag.FlagSet) {
 	flset.BoolVar(&alsoToStderr, "alsologtostderr", false, "log to standard error as well as files")
 }
 
-func InfoDepth(depth int, args ...any)    { log(sevInfo, depth, "", args...) }
-func Infoln(args ...any)                  { log(sevInfo, 0, "", args...) }
-func Infof(format string, args ...any)    { log(sevInfo, 0, format, args...) }
-func Warningln(args ...any)               { log(sevWarn, 0, "", args...) }
-func Warningf(format string, args ...any) { log(sevWarn, 0, format, args...) }
-func ErrorDepth(depth int, args ...any)   { log(sevErr, depth, "", args...) }
-func Errorln(args ...any)                 { log(sevErr, 0, "", args...) }
-func Errorf(format string, args ...any)   { log(sevErr, 0, format, args...) }
+func InfoDepth(depth int, args ...any)    { log(sevInfo, depth, "", "", args...) }
+func Infoln(args ...any)                  { log(sevInfo, 0, "", "", args...) }
+func Infof(format string, args ...any)    { log(sevInfo, 0, "", format, args...) }
+func Warningln(args ...any)               { log(sevWarn, 0, "", "", args...) }
+func Warningf(format string, args ...any) { log(sevWarn, 0, "", format, args...) }
+func ErrorDepth(depth int, args ...any)   { log(sevErr, depth, "", "", args...) }
+func Errorln(args ...any)                 { log(sevErr, 0, "", "", args...) }
+func Errorf(format string, args ...any)   { log(sevErr, 0, "", format, args...) }
+
+// request-scoped logging: prefix each line with the request ID (apc.HdrReqID)
+// carried by the context, if any (see WithReqID)
+func InfofCtx(ctx context.Context, format string, args ...any) {
+	log(sevInfo, 0, ReqID(ctx), format, args...)
+}
+func WarningfCtx(ctx context.Context, format string, args ...any) {
+	log(sevWarn, 0, ReqID(ctx), format, args...)
+}
+func ErrorfCtx(ctx context.Context, format string, args ...any) {
+	log(sevErr, 0, ReqID(ctx), format, args...)
+}
+func ErrorlnCtx(ctx context.Context, args ...any) { log(sevErr, 0, ReqID(ctx), "", args...) }
+
+func WithReqID(ctx context.Context, rid string) context.Context {
+	return context.WithValue(ctx, ctxReqID{}, rid)
+}
+
+func ReqID(ctx context.Context) (rid string) {
+	if ctx != nil {
+		rid, _ = ctx.Value(ctxReqID{}).(string)
+	}
+	return
+}
 
 func SetLogDirRole(dir, role string) { logDir, aisrole = dir, role }
 func SetTitle(s string)              { title = s }
 
+// runtime-updatable (see cmn.LogConf)
+func SetJSON(v bool)    { jsonFmt.Store(v) }
+func SetSampling(n int) { sampling.Store(int64(n)) }
+
 func InfoLogName() string { return sname() + ".INFO" }
 func ErrLogName() string  { return sname() + ".ERROR" }
 
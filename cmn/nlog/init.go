@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -42,6 +43,9 @@ var (
 
 	toStderr     bool
 	alsoToStderr bool
+
+	jsonFmt  atomic.Bool  // log.format = "json"
+	sampling atomic.Int64 // log.sampling (info messages per second per line of code)
 )
 
 func init() {
@@ -0,0 +1,105 @@
+// Package nlog - aistore logger, provides buffering, timestamping, writing, and
+// flushing/syncing/rotating
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package nlog
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// JSON output (log.format = "json"): one object per line, e.g.:
+// {"time":"2023-10-16T15:04:05.123456-07:00","level":"info","host":"ais-0","caller":"target:123","msg":"..."}
+// - "caller" is omitted for redacted source files (see redactFnames);
+// - "dropped" (optional) is the number of messages from the same line of code
+//   suppressed by sampling since the previous one (see SetSampling);
+// - "req_id" (optional) is the request ID (see WithReqID).
+// Note that log file headers (see nlog.rotate) remain plain text.
+
+const hex = "0123456789abcdef"
+
+var sevJSON = []string{sevInfo: "info", sevWarn: "warning", sevErr: "error"}
+
+func sprintfJSON(sev severity, depth int, rid, format string, fb *fixed, dropped int64, args ...any) {
+	msg := alloc()
+	if format == "" {
+		fmt.Fprintln(msg, args...)
+	} else {
+		fmt.Fprintf(msg, format, args...)
+	}
+	b := msg.buf[:msg.woff]
+	if l := len(b); l > 0 && b[l-1] == '\n' {
+		b = b[:l-1]
+	}
+
+	fb.writeString(`{"time":"`)
+	fb.writeString(time.Now().Format("2006-01-02T15:04:05.000000Z07:00"))
+	fb.writeString(`","level":"`)
+	fb.writeString(sevJSON[sev])
+	fb.writeString(`","host":"`)
+	fb.writeString(host)
+	fb.writeByte('"')
+	if fn, ln, ok := where(3 + depth); ok {
+		if _, redact := redactFnames[fn]; !redact {
+			fb.writeString(`,"caller":"`)
+			fb.writeString(fn)
+			fb.writeByte(':')
+			fb.writeString(strconv.Itoa(ln))
+			fb.writeByte('"')
+		}
+	}
+	if dropped > 0 {
+		fb.writeString(`,"dropped":`)
+		fb.writeString(strconv.FormatInt(dropped, 10))
+	}
+	if rid != "" {
+		fb.writeString(`,"req_id":"`)
+		escapeJSON(fb, []byte(rid))
+		fb.writeByte('"')
+	}
+	fb.writeString(`,"msg":"`)
+	escapeJSON(fb, b)
+	fb.writeString("\"}\n")
+	free(msg)
+}
+
+// escape and copy; truncate (rather than break the JSON) when running out of buffer
+func escapeJSON(fb *fixed, b []byte) {
+	const reserve = 4 + 6 // closing `"}\n` plus the longest escape sequence
+	for i := 0; i < len(b); {
+		if fb.avail() < reserve {
+			return
+		}
+		c := b[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				fb.writeByte('\\')
+				fb.writeByte(c)
+			case c == '\n':
+				fb.writeString(`\n`)
+			case c == '\t':
+				fb.writeString(`\t`)
+			case c < 0x20:
+				fb.writeString(`\u00`)
+				fb.writeByte(hex[c>>4])
+				fb.writeByte(hex[c&0xf])
+			default:
+				fb.writeByte(c)
+			}
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(b[i:])
+		if r == utf8.RuneError && size == 1 {
+			fb.writeString(`\ufffd`)
+		} else {
+			fb.Write(b[i : i+size])
+		}
+		i += size
+	}
+}
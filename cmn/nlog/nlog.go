@@ -50,21 +50,28 @@ type (
 )
 
 // main function
-func log(sev severity, depth int, format string, args ...any) {
+func log(sev severity, depth int, rid, format string, args ...any) {
+	var dropped int64
 	onceInitFiles.Do(initFiles)
 
+	if sev == sevInfo && sampling.Load() > 0 {
+		var ok bool
+		if dropped, ok = sample(depth); !ok {
+			return
+		}
+	}
 	switch {
 	case !flag.Parsed():
 		os.Stderr.WriteString("Error: logging before flag.Parse: ")
 		fallthrough
 	case toStderr:
 		fb := alloc()
-		sprintf(sev, depth, format, fb, args...)
+		sprintf(sev, depth, rid, format, fb, dropped, args...)
 		fb.flush(os.Stderr)
 		free(fb)
 	case alsoToStderr || sev >= sevWarn:
 		fb := alloc()
-		sprintf(sev, depth, format, fb, args...)
+		sprintf(sev, depth, rid, format, fb, dropped, args...)
 		if alsoToStderr || sev >= sevErr {
 			fb.flush(os.Stderr)
 		}
@@ -81,7 +88,7 @@ func log(sev severity, depth int, format string, args ...any) {
 		free(fb)
 	default:
 		// fast path
-		nlogs[sevInfo].printf(sev, depth, format, args...)
+		nlogs[sevInfo].printf(sev, depth, rid, format, dropped, args...)
 	}
 }
 
@@ -103,10 +110,10 @@ func newNlog(sev severity) *nlog {
 
 func (nlog *nlog) since(now int64) time.Duration { return time.Duration(now - nlog.last.Load()) }
 
-func (nlog *nlog) printf(sev severity, depth int, format string, args ...any) {
+func (nlog *nlog) printf(sev severity, depth int, rid, format string, dropped int64, args ...any) {
 	nlog.mw.Lock()
 	nlog.line.reset()
-	sprintf(sev, depth+1, format, &nlog.line, args...)
+	sprintf(sev, depth+1, rid, format, &nlog.line, dropped, args...)
 	nlog.write(&nlog.line)
 	nlog.mw.Unlock()
 }
@@ -252,9 +259,9 @@ func logfname(tag string, t time.Time) (name, link string) {
 	return name, s + "." + tag
 }
 
-func formatHdr(s severity, depth int, fb *fixed) {
-	const char = "IWE"
-	_, fn, ln, ok := runtime.Caller(3 + depth)
+// returns source file name (sans extension) and line number of the caller
+func where(depth int) (fn string, ln int, ok bool) {
+	_, fn, ln, ok = runtime.Caller(depth + 1)
 	if !ok {
 		return
 	}
@@ -265,6 +272,15 @@ func formatHdr(s severity, depth int, fb *fixed) {
 	if l := len(fn); l > 3 {
 		fn = fn[:l-3]
 	}
+	return
+}
+
+func formatHdr(s severity, depth int, fb *fixed) {
+	const char = "IWE"
+	fn, ln, ok := where(3 + depth)
+	if !ok {
+		return
+	}
 	fb.writeByte(char[s])
 	fb.writeByte(' ')
 	now := time.Now()
@@ -280,8 +296,22 @@ func formatHdr(s severity, depth int, fb *fixed) {
 	fb.writeByte(' ')
 }
 
-func sprintf(sev severity, depth int, format string, fb *fixed, args ...any) {
+func sprintf(sev severity, depth int, rid, format string, fb *fixed, dropped int64, args ...any) {
+	if jsonFmt.Load() {
+		sprintfJSON(sev, depth+1, rid, format, fb, dropped, args...)
+		return
+	}
 	formatHdr(sev, depth+1, fb)
+	if dropped > 0 {
+		fb.writeString("(sampled: ")
+		fb.writeString(strconv.FormatInt(dropped, 10))
+		fb.writeString(" dropped) ")
+	}
+	if rid != "" {
+		fb.writeString("[req ")
+		fb.writeString(rid)
+		fb.writeString("] ")
+	}
 	if format == "" {
 		fmt.Fprintln(fb, args...)
 	} else {
@@ -0,0 +1,87 @@
+// Package nlog - aistore logger, provides buffering, timestamping, writing, and
+// flushing/syncing/rotating
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package nlog
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestJSON(t *testing.T) {
+	SetJSON(true)
+	t.Cleanup(func() { SetJSON(false) })
+
+	tests := []struct {
+		format string
+		args   []any
+		msg    string
+	}{
+		{"", []any{"hello", 1}, "hello 1"},
+		{"%q\t%s", []any{"quoted", "tab"}, "\"quoted\"\ttab"},
+		{"%s", []any{"multi\nline \x01 ☺"}, "multi\nline \x01 ☺"},
+		{"", []any{strings.Repeat("x", 2*maxLineSize)}, ""}, // truncated
+	}
+	for _, test := range tests {
+		fb := &fixed{buf: make([]byte, maxLineSize)}
+		sprintf(sevWarn, -2, "", test.format, fb, 3, test.args...)
+		line := string(fb.buf[:fb.woff])
+		tassert.Errorf(t, strings.HasSuffix(line, "}\n"), "expecting single-line JSON, got %q", line)
+
+		var rec map[string]any
+		err := json.Unmarshal(fb.buf[:fb.woff], &rec)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, rec["level"] == "warning", "level: %v", rec["level"])
+		tassert.Errorf(t, rec["dropped"] == float64(3), "dropped: %v", rec["dropped"])
+		tassert.Errorf(t, strings.HasPrefix(rec["caller"].(string), "nlog_test:"), "caller: %v", rec["caller"])
+		if test.msg != "" {
+			tassert.Errorf(t, rec["msg"] == test.msg, "msg: %q vs %q", rec["msg"], test.msg)
+		}
+	}
+}
+
+func TestReqID(t *testing.T) {
+	ctx := WithReqID(context.Background(), "rid-123")
+	tassert.Fatalf(t, ReqID(ctx) == "rid-123", "expecting request ID, got %q", ReqID(ctx))
+	tassert.Errorf(t, ReqID(context.Background()) == "", "expecting no request ID")
+
+	fb := &fixed{buf: make([]byte, maxLineSize)}
+	sprintf(sevInfo, -2, ReqID(ctx), "%s", fb, 0, "hello")
+	line := string(fb.buf[:fb.woff])
+	tassert.Errorf(t, strings.HasSuffix(line, " [req rid-123] hello\n"), "unexpected line %q", line)
+
+	SetJSON(true)
+	t.Cleanup(func() { SetJSON(false) })
+	fb.reset()
+	sprintf(sevInfo, -2, ReqID(ctx), "%s", fb, 0, "hello")
+	var rec map[string]any
+	err := json.Unmarshal(fb.buf[:fb.woff], &rec)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, rec["req_id"] == "rid-123", "req_id: %v", rec["req_id"])
+	tassert.Errorf(t, rec["msg"] == "hello", "msg: %v", rec["msg"])
+}
+
+func TestSampling(t *testing.T) {
+	const n = 5
+	SetSampling(n)
+	t.Cleanup(func() { SetSampling(0) })
+
+	var logged, dropped int64
+	for i := 0; i < 100; i++ {
+		d, ok := sample(-2)
+		if ok {
+			logged++
+			dropped += d
+		}
+	}
+	// (the loop may straddle a one-second boundary)
+	tassert.Errorf(t, logged >= n && logged <= 2*n, "expecting %d to %d logged, got %d", n, 2*n, logged)
+	d, _ := sample(-2) // different call site
+	tassert.Errorf(t, d == 0, "expecting no drops at a new call site, got %d", d)
+}
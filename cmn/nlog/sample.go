@@ -0,0 +1,58 @@
+// Package nlog - aistore logger, provides buffering, timestamping, writing, and
+// flushing/syncing/rotating
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package nlog
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Sampling of high-frequency (info) messages: when enabled (see SetSampling),
+// each line of code (call site) gets to log at most `sampling` messages per second;
+// the rest are dropped and counted, and the count is then reported by the next
+// message that makes it from the same call site.
+// Warnings and errors are never sampled.
+
+type site struct {
+	sec     int64 // current one-second window
+	cnt     int64 // logged in the window
+	dropped int64 // since the last logged
+}
+
+var (
+	sites   = make(map[uintptr]*site, 64)
+	sitesMu sync.Mutex
+)
+
+func sample(depth int) (dropped int64, ok bool) {
+	var pcs [1]uintptr
+	// skip runtime.Callers, sample, log, and the api (e.g. Infoln)
+	if runtime.Callers(4+depth, pcs[:]) == 0 {
+		return 0, true
+	}
+	sec := mono.NanoTime() / int64(time.Second)
+	sitesMu.Lock()
+	s, exists := sites[pcs[0]]
+	if !exists {
+		s = &site{}
+		sites[pcs[0]] = s
+	}
+	if s.sec != sec {
+		s.sec, s.cnt = sec, 0
+	}
+	s.cnt++
+	if s.cnt > sampling.Load() {
+		s.dropped++
+		sitesMu.Unlock()
+		return 0, false
+	}
+	dropped, s.dropped = s.dropped, 0
+	sitesMu.Unlock()
+	return dropped, true
+}
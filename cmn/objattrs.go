@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -35,6 +36,13 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// at-rest compression (see DictConf): dictionary version and the original size
+	DictVerObjMD  = "dict_ver"
+	DictSizeObjMD = "dict_size"
+
+	// at-rest encryption (see EncryptionConf): the ID of the key
+	EncKeyObjMD = "enc_key"
+
 	// additional backend
 	LastModified = "LastModified"
 )
@@ -60,6 +68,42 @@ type ObjectProps struct {
 	Present bool `json:"present"`
 }
 
+// object provenance: where (and in how many replicas and/or slices) the object is stored,
+// when and by which job(s) it may have been written, and where it came from
+// NOTE: AIS stores a single (the current) version of any given object; previous
+// versions, if any, can only be retained by the (versioned) remote backend
+type (
+	ObjProvenance struct {
+		ObjectProps
+		Target    string           `json:"target"`              // ID of the target that stores the object
+		Mountpath string           `json:"mountpath"`           // the object's mountpath at this target
+		Mtime     int64            `json:"mtime"`               // last written (nanoseconds since UNIX epoch)
+		Source    string           `json:"source,omitempty"`    // backend provider, "web" (download), or original URL
+		Cksums    []ProvCksum      `json:"cksums,omitempty"`    // checksum lineage: stored, erasure-coded, and backend
+		ECSlices  cos.MapStrUint16 `json:"ec-slices,omitempty"` // target ID => EC slice ID (0 - full replica)
+		Jobs      []ProvJob        `json:"jobs,omitempty"`      // jobs that were running at the time of the last write
+	}
+	ProvCksum struct {
+		Origin string `json:"origin"` // one of the ProvCksum* enum (below)
+		Type   string `json:"type"`
+		Value  string `json:"value"`
+	}
+	ProvJob struct {
+		StartTime time.Time `json:"start-time"`
+		EndTime   time.Time `json:"end-time"`
+		ID        string    `json:"id"`
+		Kind      string    `json:"kind"`
+		Status    string    `json:"status"` // xact.HistStatus* enum or "running"
+	}
+)
+
+// ProvCksum origins
+const (
+	ProvCksumStored  = "stored"  // object's checksum (as per bucket's checksum config)
+	ProvCksumEC      = "ec"      // checksum of the original object at erasure-coding time
+	ProvCksumBackend = "backend" // reported by the remote backend (ETag, MD5, CRC32C)
+)
+
 // see also apc.HdrObjAtime et al. @ api/apc/const.go (and note that naming must be consistent)
 type ObjAttrs struct {
 	Cksum    *cos.Cksum `json:"checksum,omitempty"`  // object checksum (cloned)
@@ -147,6 +191,11 @@ func ToHeader(oah cos.OAH, hdr http.Header) {
 	if v := oah.Version(true); v != "" {
 		hdr.Set(apc.HdrObjVersion, v)
 	}
+	if hdr.Get(cos.HdrETag) == "" { // (s3 compat: may be already set)
+		if etag := ObjETag(oah); etag != "" {
+			hdr.Set(cos.HdrETag, etag)
+		}
+	}
 	custom := oah.GetCustomMD()
 	for k, v := range custom {
 		debug.Assert(k != "")
@@ -0,0 +1,118 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object tags: user-defined key/value pairs stored in the object's custom metadata
+// under `ObjTagPrefix`-prefixed keys (and therefore, preserved across copies, migrations,
+// and everything else that preserves custom metadata).
+//
+// Tag filter (see `apc.LsoMsg.Tags`) is a comma-separated list of conditions that must
+// all be satisfied: "key=value" (the object has the tag with the given value) or
+// "key" (the object has the tag, any value).
+
+const (
+	ObjTagPrefix = "tag."
+
+	MaxObjTags      = 64
+	maxObjTagKeyLen = 128
+	maxObjTagValLen = 256
+)
+
+type (
+	tagCond struct {
+		key   string
+		val   string
+		exact bool
+	}
+	TagFilter []tagCond
+)
+
+func ObjTagKey(key string) string { return ObjTagPrefix + key }
+
+// ObjTags returns (unprefixed) tags from the given custom metadata, or nil if there are none
+func ObjTags(md cos.StrKVs) (tags cos.StrKVs) {
+	for k, v := range md {
+		if !strings.HasPrefix(k, ObjTagPrefix) {
+			continue
+		}
+		if tags == nil {
+			tags = make(cos.StrKVs, 4)
+		}
+		tags[k[len(ObjTagPrefix):]] = v
+	}
+	return
+}
+
+func ValidateObjTagKey(key string) error {
+	switch {
+	case key == "":
+		return errors.New("object tag key cannot be empty")
+	case len(key) > maxObjTagKeyLen:
+		return fmt.Errorf("object tag key %q is too long (max %d)", key, maxObjTagKeyLen)
+	case strings.ContainsAny(key, ",="):
+		return fmt.Errorf("object tag key %q contains invalid character (',' or '=')", key)
+	}
+	return nil
+}
+
+func ValidateObjTags(tags cos.StrKVs) error {
+	if len(tags) > MaxObjTags {
+		return fmt.Errorf("too many object tags (%d, max %d)", len(tags), MaxObjTags)
+	}
+	for k, v := range tags {
+		if err := ValidateObjTagKey(k); err != nil {
+			return err
+		}
+		if len(v) > maxObjTagValLen {
+			return fmt.Errorf("value of the object tag %q is too long (max %d)", k, maxObjTagValLen)
+		}
+		if strings.Contains(v, ",") {
+			return fmt.Errorf("value of the object tag %q contains invalid character (',')", k)
+		}
+	}
+	return nil
+}
+
+///////////////
+// TagFilter //
+///////////////
+
+func ParseTagFilter(s string) (TagFilter, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var (
+		conds = strings.Split(s, ",")
+		tf    = make(TagFilter, 0, len(conds))
+	)
+	for _, c := range conds {
+		var tc tagCond
+		tc.key, tc.val, tc.exact = strings.Cut(strings.TrimSpace(c), "=")
+		if err := ValidateObjTagKey(tc.key); err != nil {
+			return nil, fmt.Errorf("invalid tag filter %q: %v", s, err)
+		}
+		tf = append(tf, tc)
+	}
+	return tf, nil
+}
+
+func (tf TagFilter) Match(md cos.StrKVs) bool {
+	for _, tc := range tf {
+		v, ok := md[ObjTagPrefix+tc.key]
+		if !ok || (tc.exact && v != tc.val) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Conditional GET and PUT (RFC 7232): If-Match and If-None-Match preconditions
+// evaluated against the object's ETag.
+//
+// ETag of an object is its checksum value or, when the checksum is not
+// available, the ETag provided by the remote backend (custom metadata) or else
+// object version - whichever comes first.
+
+type Precond struct {
+	IfMatch     string // comma-separated list of ETags or "*"
+	IfNoneMatch string // ditto
+}
+
+func ObjETag(oah cos.OAH) (etag string) {
+	if cksum := oah.Checksum(); !cksum.IsEmpty() {
+		etag = cksum.Val()
+	} else if v, ok := oah.GetCustomMD()[ETag]; ok && v != "" {
+		etag = strings.Trim(v, "\"")
+	} else {
+		etag = oah.Version(true)
+	}
+	if etag != "" {
+		etag = "\"" + etag + "\""
+	}
+	return
+}
+
+func (pc *Precond) FromHeader(hdr http.Header) {
+	pc.IfMatch = hdr.Get(cos.HdrIfMatch)
+	pc.IfNoneMatch = hdr.Get(cos.HdrIfNoneMatch)
+}
+
+func (pc *Precond) IsSet() bool { return pc.IfMatch != "" || pc.IfNoneMatch != "" }
+
+// Check returns:
+// - 0 when all preconditions are satisfied
+// - http.StatusNotModified (GET and HEAD only) when If-None-Match matches
+// - http.StatusPreconditionFailed otherwise
+func (pc *Precond) Check(etag string, exists bool, method string) int {
+	if pc.IfMatch != "" {
+		if !exists || !matchETag(pc.IfMatch, etag) {
+			return http.StatusPreconditionFailed
+		}
+	}
+	if pc.IfNoneMatch != "" && exists && matchETag(pc.IfNoneMatch, etag) {
+		if method == http.MethodGet || method == http.MethodHead {
+			return http.StatusNotModified
+		}
+		return http.StatusPreconditionFailed
+	}
+	return 0
+}
+
+// comparison is weak (that is, ignoring W/ prefix); "*" matches any existing object
+func matchETag(list, etag string) bool {
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		tag = strings.TrimPrefix(tag, "W/")
+		if etag != "" && strings.Trim(tag, "\"") == strings.Trim(etag, "\"") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Presigned object URLs: time-limited GET or PUT links that can be used without
+// AuthN tokens. The signature is HMAC-SHA256 (keyed with the cluster's `auth.secret`)
+// of the HTTP method, URL path (that is, bucket and object names), bucket
+// provider and namespace, and the expiration time.
+
+const MaxPresignExpiry = 7 * 24 * time.Hour
+
+var (
+	ErrPresignExpired   = errors.New("presigned URL has expired")
+	ErrPresignSignature = errors.New("presigned URL: signature mismatch")
+)
+
+func IsPresignMethod(method string) bool { return method == http.MethodGet || method == http.MethodPut }
+
+// returns the query (to be added to the URL) that contains expiration time and signature
+func PresignQuery(secret, method, path string, query url.Values, expires int64) url.Values {
+	q := make(url.Values, 4)
+	if provider := query.Get(apc.QparamProvider); provider != "" {
+		q.Set(apc.QparamProvider, provider)
+	}
+	if ns := query.Get(apc.QparamNamespace); ns != "" {
+		q.Set(apc.QparamNamespace, ns)
+	}
+	exp := strconv.FormatInt(expires, 10)
+	q.Set(apc.QparamExpires, exp)
+	q.Set(apc.QparamSignature, presignSig(secret, method, path, query, exp))
+	return q
+}
+
+// validates presigned request; returns (false, nil) if the request is not presigned
+func CheckPresigned(secret, method, path string, query url.Values) (bool, error) {
+	sig := query.Get(apc.QparamSignature)
+	if sig == "" {
+		return false, nil
+	}
+	exp := query.Get(apc.QparamExpires)
+	expires, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil {
+		return true, ErrPresignSignature
+	}
+	if time.Now().Unix() > expires {
+		return true, ErrPresignExpired
+	}
+	if secret == "" || !hmac.Equal([]byte(sig), []byte(presignSig(secret, method, path, query, exp))) {
+		return true, ErrPresignSignature
+	}
+	return true, nil
+}
+
+func presignSig(secret, method, path string, query url.Values, exp string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(method + "\n" + path + "\n" + query.Get(apc.QparamProvider) + "\n" +
+		query.Get(apc.QparamNamespace) + "\n" + exp))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,56 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Post-rebalance verification report (apc.RebVerifyReport) is signed with HMAC-SHA256 keyed
+// with the cluster's `auth.secret` - the signature covers the entire (JSON-encoded) report.
+// NOTE: using standard library encoding to sort target IDs (map keys) - canonical representation.
+
+var (
+	ErrRebVerifyUnsigned  = errors.New("verification report is not signed")
+	ErrRebVerifySignature = errors.New("verification report: signature mismatch")
+)
+
+func SignRebVerify(secret string, rep *apc.RebVerifyReport) {
+	rep.Signature = ""
+	if secret != "" {
+		rep.Signature = rebVerifySig(secret, rep)
+	}
+}
+
+func CheckRebVerify(secret string, rep *apc.RebVerifyReport) error {
+	if rep.Signature == "" {
+		return ErrRebVerifyUnsigned
+	}
+	sig := rep.Signature
+	rep.Signature = ""
+	expected := rebVerifySig(secret, rep)
+	rep.Signature = sig
+	if secret == "" || !hmac.Equal([]byte(sig), []byte(expected)) {
+		return ErrRebVerifySignature
+	}
+	return nil
+}
+
+func rebVerifySig(secret string, rep *apc.RebVerifyReport) string {
+	b, err := json.Marshal(rep)
+	if err != nil {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(b)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
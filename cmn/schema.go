@@ -0,0 +1,115 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// bucket schema rules (see SchemaConf)
+const (
+	SchemaRuleExt     = "extensions"
+	SchemaRuleCtype   = "content_types"
+	SchemaRuleMD      = "required_md"
+	SchemaRuleMaxSize = "max_size"
+)
+
+// number of leading bytes that content type detection considers
+const SchemaSniffLen = 512
+
+// interface guard
+var _ PropsValidator = (*SchemaConf)(nil)
+
+func (c *SchemaConf) IsSet() bool {
+	return c.Extensions != "" || c.ContentTypes != "" || c.RequiredMD != "" || c.MaxSize > 0
+}
+
+func (c *SchemaConf) ValidateAsProps(...any) error {
+	for _, ext := range splitSchemaList(c.Extensions) {
+		if ext[0] != '.' || len(ext) == 1 {
+			return fmt.Errorf("invalid schema.%s: %q (expecting extension with a leading dot, e.g. \".jpg\")",
+				SchemaRuleExt, ext)
+		}
+	}
+	for _, ctype := range splitSchemaList(c.ContentTypes) {
+		if i := strings.IndexByte(ctype, '/'); i <= 0 || i == len(ctype)-1 {
+			return fmt.Errorf("invalid schema.%s: %q (expecting type/subtype, e.g. \"image/png\" or \"image/*\")",
+				SchemaRuleCtype, ctype)
+		}
+	}
+	if c.MaxSize < 0 {
+		return fmt.Errorf("invalid schema.%s: %d (expecting non-negative)", SchemaRuleMaxSize, c.MaxSize)
+	}
+	return nil
+}
+
+func (c *SchemaConf) CheckName(bck *Bck, objName string) error {
+	exts := splitSchemaList(c.Extensions)
+	if len(exts) == 0 {
+		return nil
+	}
+	name := strings.ToLower(objName)
+	for _, ext := range exts {
+		if strings.HasSuffix(name, strings.ToLower(ext)) {
+			return nil
+		}
+	}
+	return NewErrSchemaViolation(bck, objName, SchemaRuleExt, "extension not in [%s]", c.Extensions)
+}
+
+// size < 0: unknown
+func (c *SchemaConf) CheckSize(bck *Bck, objName string, size int64) error {
+	if c.MaxSize <= 0 || size <= int64(c.MaxSize) {
+		return nil
+	}
+	return NewErrSchemaViolation(bck, objName, SchemaRuleMaxSize, "size %s exceeds %s",
+		cos.ToSizeIEC(size, 2), cos.ToSizeIEC(int64(c.MaxSize), 2))
+}
+
+// `head` holds up to SchemaSniffLen leading bytes of the content
+func (c *SchemaConf) CheckContent(bck *Bck, objName string, head []byte) error {
+	ctypes := splitSchemaList(c.ContentTypes)
+	if len(ctypes) == 0 {
+		return nil
+	}
+	detected, _, _ := strings.Cut(http.DetectContentType(head), ";")
+	for _, ctype := range ctypes {
+		if prefix, ok := strings.CutSuffix(ctype, "/*"); ok {
+			if strings.HasPrefix(detected, prefix+"/") {
+				return nil
+			}
+		} else if strings.EqualFold(detected, ctype) {
+			return nil
+		}
+	}
+	return NewErrSchemaViolation(bck, objName, SchemaRuleCtype, "detected content type %q not in [%s]",
+		detected, c.ContentTypes)
+}
+
+func (c *SchemaConf) CheckMD(bck *Bck, objName string, md cos.StrKVs) error {
+	for _, key := range splitSchemaList(c.RequiredMD) {
+		if _, ok := md[key]; !ok {
+			return NewErrSchemaViolation(bck, objName, SchemaRuleMD, "missing custom metadata %q", key)
+		}
+	}
+	return nil
+}
+
+func splitSchemaList(s string) (out []string) {
+	if s == "" {
+		return nil
+	}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return
+}
@@ -186,4 +186,41 @@ var _ = Describe("API", func() {
 			),
 		)
 	})
+
+	Describe("ExtraProps", func() {
+		DescribeTable("S3 presets",
+			func(aws cmn.ExtraPropsAWS, valid bool) {
+				extra := cmn.ExtraProps{AWS: aws}
+				err := extra.ValidateAsProps(apc.AWS)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("no preset", cmn.ExtraPropsAWS{}, true),
+			Entry("spaces with region", cmn.ExtraPropsAWS{Preset: apc.S3PresetSpaces, CloudRegion: "nyc3"}, true),
+			Entry("spaces with endpoint", cmn.ExtraPropsAWS{Preset: apc.S3PresetSpaces, Endpoint: "https://nyc3.digitaloceanspaces.com"}, true),
+			Entry("spaces without region and endpoint", cmn.ExtraPropsAWS{Preset: apc.S3PresetSpaces}, false),
+			Entry("b2 with region", cmn.ExtraPropsAWS{Preset: apc.S3PresetB2, CloudRegion: "us-west-004"}, true),
+			Entry("b2 with endpoint only", cmn.ExtraPropsAWS{Preset: apc.S3PresetB2, Endpoint: "https://s3.us-west-004.backblazeb2.com"}, false),
+			Entry("unknown preset", cmn.ExtraPropsAWS{Preset: "wasabi", CloudRegion: "us-east-1"}, false),
+		)
+	})
+
+	Describe("Diff", func() {
+		It("should return only the props that have changed", func() {
+			before := cmn.BucketProps{Provider: apc.AIS, Mirror: cmn.MirrorConf{Copies: 1}}
+			after := before.Clone()
+			after.Apply(&cmn.BucketPropsToUpdate{
+				Mirror: &cmn.MirrorConfToUpdate{Enabled: api.Bool(true), Copies: api.Int64(2)},
+				LRU:    &cmn.LRUConfToUpdate{Enabled: api.Bool(false)}, // (unchanged)
+			})
+			Expect(before.Diff(after)).To(Equal([]cmn.PropDiff{
+				{Name: "mirror.copies", Before: "1", After: "2"},
+				{Name: "mirror.enabled", Before: "false", After: "true"},
+			}))
+			Expect(after.Diff(after.Clone())).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,48 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func TestLsbFilter(t *testing.T) {
+	var (
+		props = &cmn.BucketProps{Provider: apc.AIS}
+		bck   = cmn.Bck{Name: "train-2023", Provider: apc.AIS}
+	)
+	props.Mirror.Enabled = true
+	props.Mirror.Copies = 2
+
+	tests := []struct {
+		msg     apc.LsbMsg
+		props   *cmn.BucketProps
+		matches bool
+	}{
+		{msg: apc.LsbMsg{}, props: nil, matches: true},
+		{msg: apc.LsbMsg{NameRegex: "^train-"}, props: nil, matches: true},
+		{msg: apc.LsbMsg{NameRegex: "^test-"}, props: props, matches: false},
+		{msg: apc.LsbMsg{Props: map[string]string{"mirror.enabled": "true"}}, props: props, matches: true},
+		{msg: apc.LsbMsg{Props: map[string]string{"mirror.enabled": "true", "mirror.copies": "2"}}, props: props, matches: true},
+		{msg: apc.LsbMsg{Props: map[string]string{"mirror.copies": "3"}}, props: props, matches: false},
+		{msg: apc.LsbMsg{Props: map[string]string{"no.such.prop": "x"}}, props: props, matches: false},
+		{msg: apc.LsbMsg{Props: map[string]string{"mirror.enabled": "true"}}, props: nil, matches: false},
+	}
+	for i, test := range tests {
+		flt, err := cmn.NewLsbFilter(&test.msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m := flt.Match(&bck, test.props); m != test.matches {
+			t.Errorf("%d: %+v: expected match=%t, got %t", i, test.msg, test.matches, m)
+		}
+	}
+	if _, err := cmn.NewLsbFilter(&apc.LsbMsg{NameRegex: "[a-"}); err == nil {
+		t.Error("expected invalid regex error")
+	}
+}
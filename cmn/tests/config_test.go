@@ -11,8 +11,10 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
 )
 
 func TestConfigTestEnv(t *testing.T) {
@@ -52,6 +54,23 @@ func TestConfigFSPaths(t *testing.T) {
 	for p := range mpaths {
 		tassert.Fatalf(t, newConfig.FSP.Paths.Contains(p), "%q not in config FSP", p)
 	}
+
+	// labels (legacy "{}" values mean no label)
+	tassert.Errorf(t, len(newConfig.FSP.Labels) == 1 && newConfig.FSP.Labels["/tmp/ais/2"] == "ssd",
+		"unexpected mountpath labels %v", newConfig.FSP.Labels)
+	var fsp cmn.FSPConf
+	err = jsoniter.Unmarshal(cos.MustMarshal(&newConfig.FSP), &fsp)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(fsp.Paths) == len(mpaths) && fsp.Labels["/tmp/ais/2"] == "ssd",
+		"failed to marshal/unmarshal mountpath labels: %v, %v", fsp.Paths, fsp.Labels)
+
+	// quotas
+	tassert.Errorf(t, len(newConfig.FSP.Quotas) == 1 && newConfig.FSP.Quotas["/tmp/ais/3"] == "60%",
+		"unexpected mountpath quotas %v", newConfig.FSP.Quotas)
+	tassert.Errorf(t, fsp.Quotas["/tmp/ais/3"] == "60%", "failed to marshal/unmarshal mountpath quotas: %v", fsp.Quotas)
+	for _, quota := range []string{"0%", "100%", "0", "abc"} {
+		tassert.Errorf(t, cmn.ValidateMpathQuota(quota) != nil, "expected invalid quota %q", quota)
+	}
 }
 
 func thisFileDir(t *testing.T) string {
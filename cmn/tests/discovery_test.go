@@ -0,0 +1,44 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestDiscovery(t *testing.T) {
+	cmn.RegDiscovery("test", func(_ context.Context, name string, useHTTPS bool) ([]string, error) {
+		if name == "none" {
+			return nil, errors.New("not found")
+		}
+		return []string{cmn.DiscoveryURL(name, 8080, useHTTPS)}, nil
+	})
+
+	for _, spec := range []string{"", "dns-srv://_ais._tcp.example.com", "aws-tag://ais-primary", "test://proxy"} {
+		conf := cmn.ProxyConf{Discovery: spec}
+		tassert.Errorf(t, conf.Validate() == nil, "expected %q to be valid", spec)
+	}
+	for _, spec := range []string{"proxy", "test://", "://proxy", "unknown://proxy"} {
+		conf := cmn.ProxyConf{Discovery: spec}
+		tassert.Errorf(t, conf.Validate() != nil, "expected %q to be invalid", spec)
+	}
+
+	urls, err := cmn.Discover("test://10.0.0.1", true, time.Second)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(urls) == 1 && urls[0] == "https://10.0.0.1:8080", "unexpected %v", urls)
+
+	urls, err = cmn.Discover("test://fe80::1", false, time.Second)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(urls) == 1 && urls[0] == "http://[fe80::1]:8080", "unexpected %v", urls)
+
+	_, err = cmn.Discover("test://none", false, time.Second)
+	tassert.Errorf(t, err != nil, "expected discovery to fail")
+}
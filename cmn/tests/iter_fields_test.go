@@ -90,16 +90,39 @@ var _ = Describe("IterFields", func() {
 					"lru.enabled":           false,
 					"lru.dont_evict_time":   cos.Duration(0),
 					"lru.capacity_upd_time": cos.Duration(0),
+					"lru.lowwm":             int64(0),
+					"lru.highwm":            int64(0),
+					"lru.evict_weight":      int64(0),
 
 					"extra.aws.cloud_region": "us-central",
 					"extra.aws.endpoint":     "",
 					"extra.aws.profile":      "",
+					"extra.aws.preset":       "",
 
 					"access":  apc.AccessAttrs(0),
 					"created": int64(0),
 
 					"write_policy.data": apc.WritePolicy(""),
 					"write_policy.md":   apc.WritePolicy(""),
+
+					"schema.extensions":    "",
+					"schema.content_types": "",
+					"schema.required_md":   "",
+					"schema.max_size":      cos.SizeIEC(0),
+
+					"dict.max_size": cos.SizeIEC(0),
+					"dict.enabled":  false,
+
+					"union.members": "",
+
+					"scratch.ttl":     cos.Duration(0),
+					"scratch.enabled": false,
+
+					"placement.label": "",
+
+					"durability.policy": apc.Durability(""),
+
+					"direct_io.enabled": false,
 				},
 			),
 			Entry("list BucketPropsToUpdate fields",
@@ -145,16 +168,39 @@ var _ = Describe("IterFields", func() {
 					"lru.enabled":           (*bool)(nil),
 					"lru.dont_evict_time":   (*cos.Duration)(nil),
 					"lru.capacity_upd_time": (*cos.Duration)(nil),
+					"lru.lowwm":             (*int64)(nil),
+					"lru.highwm":            (*int64)(nil),
+					"lru.evict_weight":      (*int64)(nil),
 
 					"access": api.AccessAttrs(1024),
 
 					"write_policy.data": (*apc.WritePolicy)(nil),
 					"write_policy.md":   api.WritePolicy(apc.WriteDelayed),
 
+					"schema.extensions":    (*string)(nil),
+					"schema.content_types": (*string)(nil),
+					"schema.required_md":   (*string)(nil),
+					"schema.max_size":      (*cos.SizeIEC)(nil),
+
+					"dict.max_size": (*cos.SizeIEC)(nil),
+					"dict.enabled":  (*bool)(nil),
+
+					"union.members": (*string)(nil),
+
+					"scratch.ttl":     (*cos.Duration)(nil),
+					"scratch.enabled": (*bool)(nil),
+
+					"placement.label": (*string)(nil),
+
+					"durability.policy": (*apc.Durability)(nil),
+
+					"direct_io.enabled": (*bool)(nil),
+
 					"extra.hdfs.ref_directory": (*string)(nil),
 					"extra.aws.cloud_region":   (*string)(nil),
 					"extra.aws.endpoint":       (*string)(nil),
 					"extra.aws.profile":        (*string)(nil),
+					"extra.aws.preset":         (*string)(nil),
 					"extra.http.original_url":  (*string)(nil),
 				},
 			),
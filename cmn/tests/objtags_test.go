@@ -0,0 +1,53 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestObjTagFilter(t *testing.T) {
+	md := cos.StrKVs{
+		cmn.ObjTagKey("split"):    "train",
+		cmn.ObjTagKey("reviewed"): "",
+		cmn.ETag:                  "abc",
+	}
+	if tags := cmn.ObjTags(md); len(tags) != 2 || tags["split"] != "train" {
+		t.Fatalf("unexpected tags %v", tags)
+	}
+	tests := []struct {
+		filter string
+		match  bool
+	}{
+		{"", true},
+		{"split", true},
+		{"split=train", true},
+		{"split=val", false},
+		{"split=train, reviewed", true},
+		{"split=train,reviewed=yes", false},
+		{"lang", false},
+		{"ETag", false},
+	}
+	for _, test := range tests {
+		tf, err := cmn.ParseTagFilter(test.filter)
+		if err != nil {
+			t.Fatalf("%q: %v", test.filter, err)
+		}
+		if match := tf.Match(md); match != test.match {
+			t.Errorf("%q: expected match=%t, got %t", test.filter, test.match, match)
+		}
+	}
+	for _, bad := range []string{"=train", "split=train,,reviewed"} {
+		if _, err := cmn.ParseTagFilter(bad); err == nil {
+			t.Errorf("%q: expected error", bad)
+		}
+	}
+	if err := cmn.ValidateObjTags(cos.StrKVs{"a=b": "c"}); err == nil {
+		t.Error("expected invalid tag key error")
+	}
+}
@@ -0,0 +1,50 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestPrecondCheck(t *testing.T) {
+	oa := &cmn.ObjAttrs{Cksum: cos.NewCksum(cos.ChecksumXXHash, "a1b2c3")}
+	etag := cmn.ObjETag(oa)
+	if etag != `"a1b2c3"` {
+		t.Fatalf("unexpected ETag %s", etag)
+	}
+	tests := []struct {
+		name   string
+		pc     cmn.Precond
+		exists bool
+		method string
+		code   int
+	}{
+		{"none", cmn.Precond{}, true, http.MethodGet, 0},
+		{"if-match", cmn.Precond{IfMatch: etag}, true, http.MethodGet, 0},
+		{"if-match-weak-list", cmn.Precond{IfMatch: `"xyz", W/"a1b2c3"`}, true, http.MethodPut, 0},
+		{"if-match-mismatch", cmn.Precond{IfMatch: `"xyz"`}, true, http.MethodPut, http.StatusPreconditionFailed},
+		{"if-match-any-missing", cmn.Precond{IfMatch: "*"}, false, http.MethodPut, http.StatusPreconditionFailed},
+		{"if-none-match-get", cmn.Precond{IfNoneMatch: etag}, true, http.MethodGet, http.StatusNotModified},
+		{"if-none-match-put", cmn.Precond{IfNoneMatch: etag}, true, http.MethodPut, http.StatusPreconditionFailed},
+		{"if-none-match-changed", cmn.Precond{IfNoneMatch: `"xyz"`}, true, http.MethodGet, 0},
+		{"create-only", cmn.Precond{IfNoneMatch: "*"}, false, http.MethodPut, 0},
+		{"create-only-exists", cmn.Precond{IfNoneMatch: "*"}, true, http.MethodPut, http.StatusPreconditionFailed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var e string
+			if test.exists {
+				e = etag
+			}
+			if code := test.pc.Check(e, test.exists, test.method); code != test.code {
+				t.Errorf("expected %d, got %d", test.code, code)
+			}
+		})
+	}
+}
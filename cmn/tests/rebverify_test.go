@@ -0,0 +1,61 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestRebVerifySignature(t *testing.T) {
+	const secret = "s3cr3t"
+	rep := &apc.RebVerifyReport{
+		ID: "verify-g12",
+		Targets: map[string]*apc.RebVerifyRes{
+			"t1": {RebVerifyCnt: apc.RebVerifyCnt{Objs: 10, Size: 1024}},
+			"t2": {RebVerifyCnt: apc.RebVerifyCnt{Objs: 20, Size: 2048, MisplacedT: 1, Samples: []string{"ais://b/o: misplaced"}}},
+			"t3": {RebVerifyCnt: apc.RebVerifyCnt{Objs: 30, Size: 4096}},
+		},
+		SmapVersion: 7,
+		BMDVersion:  3,
+		Time:        1700000000,
+	}
+	for _, tres := range rep.Targets {
+		rep.Total.Add(&tres.RebVerifyCnt)
+	}
+	if rep.Total.Objs != 60 || rep.Total.Errs() != 1 {
+		t.Fatalf("unexpected total %+v", rep.Total)
+	}
+
+	if err := cmn.CheckRebVerify(secret, rep); err != cmn.ErrRebVerifyUnsigned {
+		t.Fatalf("expected %v, got %v", cmn.ErrRebVerifyUnsigned, err)
+	}
+	cmn.SignRebVerify(secret, rep)
+	if err := cmn.CheckRebVerify(secret, rep); err != nil {
+		t.Fatal(err)
+	}
+
+	// round-trip (target IDs in any order)
+	var rep2 apc.RebVerifyReport
+	if err := jsoniter.Unmarshal(cos.MustMarshal(rep), &rep2); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmn.CheckRebVerify(secret, &rep2); err != nil {
+		t.Fatalf("round-trip: %v", err)
+	}
+
+	// tampered or wrong key
+	rep2.Targets["t2"].MisplacedT = 0
+	if err := cmn.CheckRebVerify(secret, &rep2); err != cmn.ErrRebVerifySignature {
+		t.Fatalf("tampered: expected %v, got %v", cmn.ErrRebVerifySignature, err)
+	}
+	if err := cmn.CheckRebVerify("other", rep); err != cmn.ErrRebVerifySignature {
+		t.Fatalf("wrong key: expected %v, got %v", cmn.ErrRebVerifySignature, err)
+	}
+}
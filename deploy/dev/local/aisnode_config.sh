@@ -28,6 +28,10 @@ cat > $AIS_CONF_FILE <<EOL
 		"enabled":		${AIS_EC_ENABLED:-false},
 		"disk_only":		false
 	},
+	"ec_repair": {
+		"workers":	0,
+		"rate":		"0"
+	},
 	"log": {
 		"level":     "${AIS_LOG_LEVEL:-3}",
 		"max_size":  "4mb",
@@ -63,7 +67,10 @@ cat > $AIS_CONF_FILE <<EOL
 		"cleanupwm":         65,
 		"lowwm":             75,
 		"highwm":            90,
-		"out_of_space":      95
+		"out_of_space":      95,
+		"workfile_age":      "1h",
+		"mpt_age":           "24h",
+		"etl_age":           "1h"
 	},
 	"lru": {
 		"dont_evict_time":   "120m",
@@ -81,6 +88,7 @@ cat > $AIS_CONF_FILE <<EOL
 		"dest_retry_time":	"2m",
 		"compression":     	"${AIS_REBALANCE_COMPRESSION:-never}",
 		"bundle_multiplier":	${AIS_REBALANCE_BUNDLE_MULTIPLIER:-2},
+		"bandwidth":		"${AIS_REBALANCE_BANDWIDTH:-0}",
 		"enabled":         	true
 	},
 	"resilver": {
@@ -99,7 +107,12 @@ cat > $AIS_CONF_FILE <<EOL
 		"idle_teardown":	"${AIS_TRANSPORT_IDLE_TEARDOWN:-4s}",
 		"quiescent":		"${AIS_TRANSPORT_QUIESCENT:-10s}",
 		"lz4_block":		"${AIS_TRANSPORT_LZ4_BLOCK:-256kb}",
-		"lz4_frame_checksum":	${AIS_TRANSPORT_LZ4_FRAME_CHECKSUM:-false}
+		"lz4_frame_checksum":	${AIS_TRANSPORT_LZ4_FRAME_CHECKSUM:-false},
+		"compressor":		"${AIS_TRANSPORT_COMPRESSOR:-lz4}",
+		"zstd_level":		${AIS_TRANSPORT_ZSTD_LEVEL:-0},
+		"relay":		${AIS_TRANSPORT_RELAY:-false},
+		"pdu_checksum":		"${AIS_TRANSPORT_PDU_CHECKSUM:-none}",
+		"link_capacity":	"${AIS_TRANSPORT_LINK_CAPACITY:-0}"
 	},
 	"memsys": {
 		"min_free":		"2gb",
@@ -122,10 +135,12 @@ cat > $AIS_CONF_FILE <<EOL
 			"use_https":         ${AIS_USE_HTTPS:-false},
 			"server_crt":        "${AIS_SERVER_CRT:-server.crt}",
 			"server_key":        "${AIS_SERVER_KEY:-server.key}",
+			"client_ca_tls":     "${AIS_CLIENT_CA_TLS:-}",
 			"write_buffer_size": ${HTTP_WRITE_BUFFER_SIZE:-0},
 			"read_buffer_size":  ${HTTP_READ_BUFFER_SIZE:-0},
 			"chunked_transfer":  ${AIS_HTTP_CHUNKED_TRANSFER:-true},
-			"skip_verify":       ${AIS_SKIP_VERIFY_CRT:-false}
+			"skip_verify":       ${AIS_SKIP_VERIFY_CRT:-false},
+			"use_quic":          ${AIS_USE_QUIC:-false}
 		}
 	},
 	"fshc": {
@@ -166,7 +181,13 @@ cat > $AIS_CONF_FILE <<EOL
 	},
 	"tcb": {
 		"compression":		"never",
-		"bundle_multiplier":	2
+		"bundle_multiplier":	2,
+		"bandwidth":		"${AIS_TCB_BANDWIDTH:-0}"
+	},
+	"xact_history": {
+		"max_age":	"720h",
+		"max_num":	10000,
+		"enabled":	true
 	},
 	"write_policy": {
 		"data": "${WRITE_POLICY_DATA:-}",
@@ -5,6 +5,7 @@
 package ec
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sync"
@@ -160,7 +161,7 @@ func (r *XactBckEncode) bckEncode(lom *cluster.LOM, _ []byte) error {
 	// After Walk finishes, the xaction waits until counter drops to zero.
 	// That means all objects have been processed and xaction can finalize.
 	r.beforeECObj()
-	if err = ECM.EncodeObject(lom, r.afterECObj); err != nil {
+	if err = ECM.EncodeObject(context.Background(), lom, r.afterECObj); err != nil {
 		// something went wrong: abort xaction
 		r.afterECObj(lom, err)
 		if err != errSkipped {
@@ -6,6 +6,7 @@ package ec
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -121,6 +122,10 @@ const (
 	// EC switches to disk from SGL when memory pressure is high and the amount of
 	// memory required to encode an object exceeds the limit
 	objSizeHighMem = 50 * cos.MiB
+
+	// when compressed, EC streams (that mostly carry requests and metadata) train their
+	// own zstd dictionary upon so many header and payload samples (see transport/zdict.go)
+	dictSamples = 256
 )
 
 type (
@@ -130,6 +135,7 @@ type (
 		Action   string      // what to do with the object (see Act* consts)
 		ErrCh    chan error  // for final EC result (used only in restore)
 		Callback cluster.OnFinishObj
+		ctx      context.Context // request context (request ID - see nlog.WithReqID), if any
 
 		putTime time.Time // time when the object is put into main queue
 		tm      time.Time // to measure different steps
@@ -174,6 +180,7 @@ type (
 		size     int64              // size of the data
 		obj      *slice             // internal info about SGL slice
 		metadata *Metadata          // object's metadata
+		rid      string             // request ID, if any (see transport.ObjHdr.ReqID)
 		isSlice  bool               // is it slice or replica
 		reqType  intraReqType       // request's type, slice/meta request/response
 	}
@@ -205,6 +212,14 @@ func freeReq(req *request) {
 	reqPool.Put(req)
 }
 
+// logging context that carries the request ID received with (or sent in) the transport header
+func hdrCtx(hdr *transport.ObjHdr) context.Context {
+	if hdr.ReqID == "" {
+		return context.Background()
+	}
+	return nlog.WithReqID(context.Background(), hdr.ReqID)
+}
+
 // Free allocated memory and removes slice's temporary file
 func (s *slice) free() {
 	freeObject(s.obj)
@@ -436,7 +451,7 @@ func WriteSliceAndMeta(t cluster.Target, hdr *transport.ObjHdr, args *WriteArgs)
 			return nil
 		}
 	}
-	tmpFQN := ct.Make(fs.WorkfileType)
+	tmpFQN := fs.CSM.GenScratch(ct, fs.WorkfileType, "")
 	if err := ct.Write(t, args.Reader, hdr.ObjAttrs.Size, tmpFQN); err != nil {
 		return err
 	}
@@ -33,8 +33,11 @@ type (
 		client *http.Client
 		mpath  string // Mountpath that the jogger manages
 
-		workCh chan *request // Channel to request TOP priority operation (restore)
-		stopCh cos.StopCh    // Jogger management channel: to stop it
+		workCh   chan *request     // Channel to request TOP priority operation (restore)
+		rq       *repairQ          // prepared requests ordered by remaining redundancy (see repair.go)
+		repairFn func(*repairItem) // repair worker's callback (c.ec)
+		stopCh   cos.StopCh        // Jogger management channel: to stop it
+		wg       sync.WaitGroup    // the producer (run) and, via abortQueued, repair workers
 	}
 	restoreCtx struct {
 		lom      *cluster.LOM         // replica
@@ -80,32 +83,25 @@ func (c *getJogger) newCtx(req *request) (*restoreCtx, error) {
 	return ctx, err
 }
 
+// remaining redundancy: number of available slices (replicas) over the minimum required to restore
+func (ctx *restoreCtx) redundancy() int {
+	if ctx.meta.IsCopy {
+		return len(ctx.nodes) - 1
+	}
+	return len(ctx.nodes) - ctx.meta.Data
+}
+
 func (*getJogger) freeCtx(ctx *restoreCtx) {
 	cluster.FreeLOM(ctx.lom)
 	freeRestoreCtx(ctx)
 }
 
-func (c *getJogger) run() {
-	nlog.Infof("started EC for mountpath: %s, bucket %s", c.mpath, c.parent.bck)
-
-	for {
-		select {
-		case req := <-c.workCh:
-			c.parent.stats.updateWaitTime(time.Since(req.tm))
-			req.tm = time.Now()
-			c.parent.IncPending()
-			c.ec(req)
-			c.parent.DecPending()
-			freeReq(req)
-		case <-c.stopCh.Listen():
-			return
-		}
-	}
-}
-
+// stop and wait for the restorations in progress, if any, to finish writing
+// slices and metafiles
 func (c *getJogger) stop() {
 	nlog.Infof("stopping EC for mountpath: %s, bucket: %s", c.mpath, c.parent.bck)
 	c.stopCh.Close()
+	c.wg.Wait()
 }
 
 // Finalize the EC restore: report an error to a caller, do housekeeping.
@@ -121,12 +117,13 @@ func (*getJogger) finalizeReq(req *request, err error) {
 	}
 }
 
-func (c *getJogger) ec(req *request) {
+func (c *getJogger) ec(it *repairItem) {
+	var (
+		req, ctx, err = it.req, it.ctx, it.err
+	)
 	debug.Assert(req.Action == ActRestore)
-	ctx, err := c.newCtx(req)
-	if ctx == nil {
-		debug.Assert(err != nil)
-		return
+	if err == nil {
+		err = c.pace(ctx.meta.Size)
 	}
 	if err == nil {
 		err = c.restore(ctx)
@@ -136,8 +133,12 @@ func (c *getJogger) ec(req *request) {
 		c.parent.stats.updateObjTime(time.Since(req.putTime))
 		err = ctx.lom.Persist()
 	}
-	c.freeCtx(ctx)
+	if ctx != nil {
+		c.freeCtx(ctx)
+	}
 	c.finalizeReq(req, err)
+	c.parent.DecPending()
+	freeReq(req)
 }
 
 // The final step of replica restoration process: the main target detects which
@@ -265,7 +266,7 @@ func (c *getJogger) restoreReplicatedFromDisk(ctx *restoreCtx) error {
 		mm     = c.parent.t.ByteMM()
 	)
 	// Try to read a replica from targets one by one until the replica is downloaded
-	tmpFQN := fs.CSM.Gen(ctx.lom, fs.WorkfileType, "ec-restore-repl")
+	tmpFQN := fs.CSM.GenScratch(ctx.lom, fs.WorkfileType, "ec-restore-repl")
 
 	for node := range ctx.nodes {
 		uname := unique(node, ctx.lom.Bck(), ctx.lom.ObjName)
@@ -356,7 +357,7 @@ func (c *getJogger) requestSlices(ctx *restoreCtx) error {
 		var writer *slice
 		if ctx.toDisk {
 			prefix := fmt.Sprintf("ec-restore-%d", v.SliceID)
-			fqn := fs.CSM.Gen(ctx.lom, fs.WorkfileType, prefix)
+			fqn := fs.CSM.GenScratch(ctx.lom, fs.WorkfileType, prefix)
 			fh, err := ctx.lom.CreateFile(fqn)
 			if err != nil {
 				return err
@@ -412,7 +413,7 @@ func newSliceWriter(ctx *restoreCtx, writers []io.Writer, restored []*slice,
 	cksums []*cos.CksumHash, cksumType string, idx int, sliceSize int64) error {
 	if ctx.toDisk {
 		prefix := fmt.Sprintf("ec-rebuild-%d", idx)
-		fqn := fs.CSM.Gen(ctx.lom, fs.WorkfileType, prefix)
+		fqn := fs.CSM.GenScratch(ctx.lom, fs.WorkfileType, prefix)
 		file, err := ctx.lom.CreateFile(fqn)
 		if err != nil {
 			return err
@@ -529,7 +530,9 @@ func (c *getJogger) restoreMainObj(ctx *restoreCtx) ([]*slice, error) {
 		return restored, err
 	}
 
-	if err := stream.Reconstruct(readers, writers); err != nil {
+	// (restoring on behalf of a user GET - foreground)
+	cos.Offload(cos.PrioFg, func() { err = stream.Reconstruct(readers, writers) })
+	if err != nil {
 		return restored, err
 	}
 
@@ -778,22 +781,11 @@ func (c *getJogger) restoreEncoded(ctx *restoreCtx) error {
 }
 
 // Entry point: restores main objects and slices if possible
+// (expects prepared context - see requestMeta)
 func (c *getJogger) restore(ctx *restoreCtx) error {
-	if ctx.lom.Bprops() == nil || !ctx.lom.Bprops().EC.Enabled {
-		return ErrorECDisabled
-	}
-
 	if c.parent.config.FastV(4, cos.SmoduleEC) {
 		nlog.Infof("Restoring %s", ctx.lom)
 	}
-	err := c.requestMeta(ctx)
-	if c.parent.config.FastV(4, cos.SmoduleEC) {
-		nlog.Infof("Found meta for %s: %d, err: %v", ctx.lom, len(ctx.nodes), err)
-	}
-	if err != nil {
-		return err
-	}
-
 	ctx.lom.SetAtimeUnix(time.Now().UnixNano())
 	if ctx.meta.IsCopy {
 		if ctx.toDisk {
@@ -812,7 +804,18 @@ func (c *getJogger) restore(ctx *restoreCtx) error {
 
 // Broadcast request for object's metadata. The function returns the list of
 // nodes(with their EC metadata) that have the lastest object version
-func (c *getJogger) requestMeta(ctx *restoreCtx) error {
+func (c *getJogger) requestMeta(ctx *restoreCtx) (err error) {
+	if ctx.lom.Bprops() == nil || !ctx.lom.Bprops().EC.Enabled {
+		return ErrorECDisabled
+	}
+	err = c._requestMeta(ctx)
+	if c.parent.config.FastV(4, cos.SmoduleEC) {
+		nlog.Infof("Found meta for %s: %d, err: %v", ctx.lom, len(ctx.nodes), err)
+	}
+	return
+}
+
+func (c *getJogger) _requestMeta(ctx *restoreCtx) error {
 	var (
 		tmap     = c.parent.smap.Get().Tmap
 		wg       = cos.NewLimitedWaitGroup(meta.MaxBcastParallel(), 8)
@@ -145,13 +145,16 @@ func (r *XactGet) newGetJogger(mpath string) *getJogger {
 		Timeout:    r.config.Client.Timeout.D(),
 		UseHTTPS:   r.config.Net.HTTP.UseHTTPS,
 		SkipVerify: r.config.Net.HTTP.SkipVerify,
+		TLS:        cmn.IntraClientTLS(&r.config.Net.HTTP),
 	})
 	j := &getJogger{
 		parent: r,
 		mpath:  mpath,
 		client: client,
 		workCh: make(chan *request, requestBufSizeFS),
+		rq:     newRepairQ(),
 	}
+	j.repairFn = j.ec
 	j.stopCh.Init()
 	return j
 }
@@ -179,7 +182,7 @@ func (r *XactGet) dispatchRequest(req *request, lom *cluster.LOM) error {
 func (r *XactGet) Run(*sync.WaitGroup) {
 	nlog.Infoln(r.Name())
 	for _, jog := range r.getJoggers {
-		go jog.run()
+		jog.start()
 	}
 
 	ticker := time.NewTicker(r.config.Periodic.StatsTime.D())
@@ -283,7 +286,7 @@ func (r *XactGet) addMpath(mpath string) {
 	}
 	getJog := r.newGetJogger(mpath)
 	r.getJoggers[mpath] = getJog
-	go getJog.run()
+	getJog.start()
 }
 
 func (r *XactGet) removeMpath(mpath string) {
@@ -5,6 +5,7 @@
 package ec
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -96,19 +97,23 @@ func (mgr *Manager) initECBundles() error {
 		client      = transport.NewIntraDataClient()
 		config      = cmn.GCO.Get()
 		compression = config.EC.Compression
-		extraReq    = transport.Extra{Callback: cbReq, Compression: compression}
+		extraResp   = transport.Extra{Compression: compression, DictSamples: dictSamples, Priority: transport.PrioBackground}
+		extraReq    = extraResp
 	)
+	extraReq.Callback = cbReq
 	reqSbArgs := bundle.Args{
 		Multiplier: config.EC.SbundleMult,
 		Extra:      &extraReq,
 		Net:        mgr.netReq,
 		Trname:     ReqStreamName,
+		Reliable:   true,
 	}
 	respSbArgs := bundle.Args{
 		Multiplier: config.EC.SbundleMult,
 		Trname:     RespStreamName,
 		Net:        mgr.netResp,
-		Extra:      &transport.Extra{Compression: compression},
+		Extra:      &extraResp,
+		Reliable:   true,
 	}
 
 	sowner := mgr.t.Sowner()
@@ -272,10 +277,10 @@ func (mgr *Manager) recvResponse(hdr transport.ObjHdr, object io.Reader, err err
 }
 
 // EncodeObject generates slices using Reed-Solom algorithm:
+//   - ctx - request context (to propagate request ID to other targets and log it)
 //   - lom - object to encode
-//   - intra - if true, it is internal request and has low priority
 //   - cb - optional callback that is called after the object is encoded
-func (mgr *Manager) EncodeObject(lom *cluster.LOM, cb ...cluster.OnFinishObj) error {
+func (mgr *Manager) EncodeObject(ctx context.Context, lom *cluster.LOM, cb ...cluster.OnFinishObj) error {
 	if !lom.Bprops().EC.Enabled {
 		return ErrorECDisabled
 	}
@@ -296,6 +301,7 @@ func (mgr *Manager) EncodeObject(lom *cluster.LOM, cb ...cluster.OnFinishObj) er
 	}
 
 	req := allocateReq(ActSplit, lom.LIF())
+	req.ctx = ctx
 	req.IsCopy = IsECCopy(lom.SizeBytes(), &lom.Bprops().EC)
 	if len(cb) != 0 {
 		req.rebuild = true
@@ -307,12 +313,13 @@ func (mgr *Manager) EncodeObject(lom *cluster.LOM, cb ...cluster.OnFinishObj) er
 	return nil
 }
 
-func (mgr *Manager) CleanupObject(lom *cluster.LOM) {
+func (mgr *Manager) CleanupObject(ctx context.Context, lom *cluster.LOM) {
 	if !lom.Bprops().EC.Enabled {
 		return
 	}
 	debug.Assert(lom.FQN != "" && lom.Mountpath().Path != "")
 	req := allocateReq(ActDelete, lom.LIF())
+	req.ctx = ctx
 	mgr.RestoreBckPutXact(lom.Bck()).cleanup(req, lom)
 }
 
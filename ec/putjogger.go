@@ -6,6 +6,7 @@ package ec
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -39,6 +40,7 @@ type (
 		cksums       []*cos.CksumHash // checksums of parity slices (filled by reed-solomon)
 		slices       []*slice         // all EC slices (in the order of slice IDs)
 		targets      []*meta.Snode    // target list (in the order of slice IDs: targets[i] receives slices[i])
+		reqCtx       context.Context  // request context (request ID), if any
 	}
 
 	// a mountpath putJogger: processes PUT/DEL requests to one mountpath
@@ -134,7 +136,7 @@ func (c *putJogger) processRequest(req *request) {
 	req.tm = time.Now()
 	if err = c.ec(req, lom); err != nil {
 		err = fmt.Errorf("%s: failed to %s %s: %w", c.parent.t, req.Action, lom.StringEx(), err)
-		nlog.Errorln(err)
+		nlog.ErrorlnCtx(req.ctx, err)
 		c.parent.AddErr(err)
 	}
 }
@@ -173,7 +175,7 @@ func (c *putJogger) ec(req *request, lom *cluster.LOM) (err error) {
 		}
 		c.parent.stats.updateEncodeTime(time.Since(req.tm), err != nil)
 	case ActDelete:
-		err = c.cleanup(lom)
+		err = c.cleanup(req.ctx, lom)
 		c.parent.stats.updateDeleteTime(time.Since(req.tm), err != nil)
 	default:
 		err = fmt.Errorf("invalid EC action for putJogger: %v", req.Action)
@@ -189,7 +191,7 @@ func (c *putJogger) replicate(ctx *encodeCtx) error {
 	err := c.createCopies(ctx)
 	if err != nil {
 		ctx.freeReplica()
-		c.cleanup(ctx.lom)
+		c.cleanup(ctx.reqCtx, ctx.lom)
 	}
 	return err
 }
@@ -204,7 +206,7 @@ func (c *putJogger) splitAndDistribute(ctx *encodeCtx) error {
 		if err != errSliceSendFailed {
 			freeSlices(ctx.slices)
 		}
-		c.cleanup(ctx.lom)
+		c.cleanup(ctx.reqCtx, ctx.lom)
 	}
 	return err
 }
@@ -216,7 +218,7 @@ func (c *putJogger) encode(req *request, lom *cluster.LOM) error {
 		ecConf                = lom.Bprops().EC
 	)
 	if c.parent.config.FastV(4, cos.SmoduleEC) {
-		nlog.Infof("Encoding %q...", lom.FQN)
+		nlog.InfofCtx(req.ctx, "Encoding %q...", lom.FQN)
 	}
 	if lom.Checksum() != nil {
 		cksumType, cksumValue = lom.Checksum().Get()
@@ -254,6 +256,7 @@ func (c *putJogger) encode(req *request, lom *cluster.LOM) error {
 	if err != nil {
 		return err
 	}
+	ctx.reqCtx = req.ctx
 
 	targets, err := cluster.HrwTargetList(ctx.lom.Uname(), c.parent.smap.Get(), reqTargets)
 	if err != nil {
@@ -283,7 +286,7 @@ func (c *putJogger) encode(req *request, lom *cluster.LOM) error {
 	}
 	if _, exists := c.parent.t.Bowner().Get().Get(ctMeta.Bck()); !exists {
 		if errRm := cos.RemoveFile(ctMeta.FQN()); errRm != nil {
-			nlog.Errorf("nested error: encode -> remove metafile: %v", errRm)
+			nlog.ErrorfCtx(req.ctx, "nested error: encode -> remove metafile: %v", errRm)
 		}
 		return fmt.Errorf("%s metafile saved while bucket %s was being destroyed", ctMeta.ObjectName(), ctMeta.Bucket())
 	}
@@ -293,14 +296,14 @@ func (c *putJogger) encode(req *request, lom *cluster.LOM) error {
 func (c *putJogger) ctSendCallback(hdr transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
 	c.parent.t.ByteMM().Free(hdr.Opaque)
 	if err != nil {
-		nlog.Errorf("failed to send o[%s]: %v", hdr.Cname(), err)
+		nlog.ErrorfCtx(hdrCtx(&hdr), "failed to send o[%s]: %v", hdr.Cname(), err)
 	}
 	c.parent.DecPending()
 }
 
 // Remove slices and replicas across the cluster: remove local metafile
 // if exists and broadcast the request to other targets
-func (c *putJogger) cleanup(lom *cluster.LOM) error {
+func (c *putJogger) cleanup(reqCtx context.Context, lom *cluster.LOM) error {
 	ctMeta := cluster.NewCTFromLOM(lom, fs.ECMetaType)
 	md, err := LoadMetadata(ctMeta.FQN())
 	if err != nil {
@@ -318,7 +321,7 @@ func (c *putJogger) cleanup(lom *cluster.LOM) error {
 	mm := c.parent.t.ByteMM()
 	request := newIntraReq(reqDel, nil, lom.Bck()).NewPack(mm)
 	o := transport.AllocSend()
-	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqDel}
+	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqDel, ReqID: nlog.ReqID(reqCtx)}
 	o.Hdr.Bck.Copy(lom.Bucket())
 	o.Callback = c.ctSendCallback
 	c.parent.IncPending()
@@ -339,21 +342,24 @@ func (c *putJogger) createCopies(ctx *encodeCtx) error {
 		reader:   ctx.fh,
 		size:     ctx.lom.SizeBytes(),
 		metadata: ctx.meta,
+		rid:      nlog.ReqID(ctx.reqCtx),
 		reqType:  reqPut,
 	}
 	return c.parent.writeRemote(nodes, ctx.lom, src, nil)
 }
 
-func checksumDataSlices(ctx *encodeCtx, cksmReaders []io.Reader, cksumType string) error {
+func checksumDataSlices(ctx *encodeCtx, cksmReaders []io.Reader, cksumType string) (err error) {
 	debug.Assert(cksumType != "") // caller checks for 'none'
-	for i, reader := range cksmReaders {
-		_, cksum, err := cos.CopyAndChecksum(io.Discard, reader, nil, cksumType)
-		if err != nil {
-			return err
+	cos.Offload(cos.PrioBg, func() {
+		for i, reader := range cksmReaders {
+			var cksum *cos.CksumHash
+			if _, cksum, err = cos.CopyAndChecksum(io.Discard, reader, nil, cksumType); err != nil {
+				return
+			}
+			ctx.slices[i].cksum = cksum.Clone()
 		}
-		ctx.slices[i].cksum = cksum.Clone()
-	}
-	return nil
+	})
+	return err
 }
 
 // generateSlicesToMemory gets FQN to the original file and encodes it into EC slices
@@ -451,7 +457,7 @@ func generateSlicesToDisk(ctx *encodeCtx) error {
 
 	cksumType := ctx.lom.CksumType()
 	for i := 0; i < ctx.paritySlices; i++ {
-		workFQN := fs.CSM.Gen(ctx.lom, fs.WorkfileType, fmt.Sprintf("ec-write-%d", i))
+		workFQN := fs.CSM.GenScratch(ctx.lom, fs.WorkfileType, fmt.Sprintf("ec-write-%d", i))
 		writer, err := ctx.lom.CreateFile(workFQN)
 		if err != nil {
 			return err
@@ -491,15 +497,17 @@ func (c *putJogger) sendSlice(ctx *encodeCtx, data *slice, node *meta.Snode, idx
 		size:     ctx.sliceSize,
 		obj:      data,
 		metadata: mcopy,
+		rid:      nlog.ReqID(ctx.reqCtx),
 		isSlice:  true,
 		reqType:  reqPut,
 	}
+	reqCtx := ctx.reqCtx // (ctx is reused upon return)
 	sentCB := func(hdr transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
 		if data != nil {
 			data.release()
 		}
 		if err != nil {
-			nlog.Errorf("Failed to send %s: %v", hdr.Cname(), err)
+			nlog.ErrorfCtx(reqCtx, "Failed to send %s: %v", hdr.Cname(), err)
 		}
 	}
 
@@ -509,11 +517,14 @@ func (c *putJogger) sendSlice(ctx *encodeCtx, data *slice, node *meta.Snode, idx
 // Copies the constructed EC slices to remote targets.
 func (c *putJogger) sendSlices(ctx *encodeCtx) (err error) {
 	// load the data slices from original object and construct parity ones
-	if c.toDisk {
-		err = generateSlicesToDisk(ctx)
-	} else {
-		err = generateSlicesToMemory(ctx)
-	}
+	// (background CPU-bound work - see cos.Offload)
+	cos.Offload(cos.PrioBg, func() {
+		if c.toDisk {
+			err = generateSlicesToDisk(ctx)
+		} else {
+			err = generateSlicesToMemory(ctx)
+		}
+	})
 
 	if err != nil {
 		return err
@@ -539,11 +550,11 @@ func (c *putJogger) sendSlices(ctx *encodeCtx) (err error) {
 	}
 
 	if copyErr != nil {
-		nlog.Errorf("Error while copying (data=%d, parity=%d) for %q: %v",
+		nlog.ErrorfCtx(ctx.reqCtx, "Error while copying (data=%d, parity=%d) for %q: %v",
 			ctx.dataSlices, ctx.paritySlices, ctx.lom.ObjName, copyErr)
 		err = errSliceSendFailed
 	} else if c.parent.config.FastV(4, cos.SmoduleEC) {
-		nlog.Infof("EC created (data=%d, parity=%d) for %q",
+		nlog.InfofCtx(ctx.reqCtx, "EC created (data=%d, parity=%d) for %q",
 			ctx.dataSlices, ctx.paritySlices, ctx.lom.ObjName)
 	}
 
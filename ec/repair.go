@@ -0,0 +1,259 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Restoration (repair) scheduling:
+// - each mountpath jogger runs `ec_repair.workers` concurrent restorations;
+// - prior to being queued, each request gets "prepared": the jogger collects
+//   object's EC metadata from other targets and computes its remaining
+//   redundancy - the number of available slices (or replicas) over and above
+//   the minimum required to restore;
+// - queued requests are then restored in the order of increasing redundancy,
+//   so that the most at-risk objects get repaired first;
+// - finally, `ec_repair.rate` paces all restorations on a given target
+//   (independently of, and in addition to, rebalance and resilver).
+
+type (
+	repairItem struct {
+		req        *request
+		ctx        *restoreCtx
+		err        error // failed to prepare
+		redundancy int
+	}
+	repairQ struct {
+		cond    *sync.Cond
+		spaceCh chan struct{}       // signals (the producer) that the queue is no longer full
+		busy    map[string]struct{} // unames that are being restored (to serialize same-object requests)
+		items   []*repairItem
+		wg      sync.WaitGroup // repair workers
+		mu      sync.Mutex
+		stopped bool
+	}
+	repairPacer struct {
+		mu   sync.Mutex
+		next int64 // mono-time when the next restoration can start
+	}
+)
+
+var (
+	errRepairStopped = errors.New("EC restoration stopped")
+
+	pacer repairPacer // target-wide
+)
+
+/////////////
+// repairQ //
+/////////////
+
+func newRepairQ() *repairQ {
+	q := &repairQ{
+		spaceCh: make(chan struct{}, 1),
+		busy:    make(map[string]struct{}, 4),
+		items:   make([]*repairItem, 0, 16),
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+func (q *repairQ) full() bool {
+	q.mu.Lock()
+	n := len(q.items)
+	q.mu.Unlock()
+	return n >= requestBufSizeFS
+}
+
+func (q *repairQ) push(it *repairItem) {
+	q.mu.Lock()
+	q.items = append(q.items, it)
+	q.mu.Unlock()
+	q.cond.Signal()
+}
+
+// returns the least redundant item that is not being restored (FIFO among equals),
+// or nil when stopped
+func (q *repairQ) pop() (it *repairItem) {
+	q.mu.Lock()
+	for it == nil {
+		if q.stopped {
+			q.mu.Unlock()
+			return nil
+		}
+		idx := -1
+		for i, x := range q.items {
+			if _, ok := q.busy[x.req.LIF.Uname]; ok {
+				continue
+			}
+			if idx < 0 || x.redundancy < q.items[idx].redundancy {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			q.cond.Wait()
+			continue
+		}
+		it = q.items[idx]
+		q.items = append(q.items[:idx], q.items[idx+1:]...)
+		q.busy[it.req.LIF.Uname] = struct{}{}
+	}
+	q.mu.Unlock()
+
+	select {
+	case q.spaceCh <- struct{}{}:
+	default:
+	}
+	return
+}
+
+// start `n` workers, each restoring (via `fn`) one queued item at a time
+func (q *repairQ) startWorkers(n int, fn func(*repairItem)) {
+	q.wg.Add(n)
+	for i := 0; i < n; i++ {
+		go q.worker(fn)
+	}
+}
+
+func (q *repairQ) worker(fn func(*repairItem)) {
+	defer q.wg.Done()
+	for {
+		it := q.pop()
+		if it == nil {
+			return
+		}
+		fn(it)
+		q.done(it)
+	}
+}
+
+// wait for the workers to finish their current restorations (and exit) - see stop
+func (q *repairQ) wait() { q.wg.Wait() }
+
+func (q *repairQ) done(it *repairItem) {
+	q.mu.Lock()
+	delete(q.busy, it.req.LIF.Uname)
+	q.mu.Unlock()
+	q.cond.Broadcast() // (same-object request may now proceed)
+}
+
+// stop all workers and return the remaining (not yet restored) items
+func (q *repairQ) stop() (items []*repairItem) {
+	q.mu.Lock()
+	q.stopped = true
+	items, q.items = q.items, nil
+	q.mu.Unlock()
+	q.cond.Broadcast()
+	return
+}
+
+/////////////////
+// repairPacer //
+/////////////////
+
+// reserve the time to restore `size` bytes at the configured rate
+// and return the delay before the restoration can start
+func (p *repairPacer) delay(size, rate int64) time.Duration {
+	now := mono.NanoTime()
+	p.mu.Lock()
+	start := cos.MaxI64(p.next, now) // (no bursting after idle)
+	p.next = start + int64(float64(size)/float64(rate)*float64(time.Second))
+	p.mu.Unlock()
+	return time.Duration(start - now)
+}
+
+/////////////////////////////////
+// getJogger: restore requests //
+/////////////////////////////////
+
+func (c *getJogger) start() {
+	c.wg.Add(1)
+	go c.run()
+}
+
+// the (single) producer: receives restore requests, prepares, and queues them
+func (c *getJogger) run() {
+	defer c.wg.Done()
+	nworkers := c.parent.config.ECRepair.NumWorkers()
+	nlog.Infof("started EC for mountpath: %s, bucket %s (repair workers: %d)", c.mpath, c.parent.bck, nworkers)
+
+	c.rq.startWorkers(nworkers, c.repairFn)
+	for {
+		if c.rq.full() {
+			select {
+			case <-c.rq.spaceCh:
+				continue
+			case <-c.stopCh.Listen():
+				c.abortQueued()
+				return
+			}
+		}
+		select {
+		case req := <-c.workCh:
+			c.parent.stats.updateWaitTime(time.Since(req.tm))
+			req.tm = time.Now()
+			c.parent.IncPending()
+			c.rq.push(c.prepare(req))
+		case <-c.stopCh.Listen():
+			c.abortQueued()
+			return
+		}
+	}
+}
+
+// fail all queued requests and wait for the ones that are being restored
+func (c *getJogger) abortQueued() {
+	for _, it := range c.rq.stop() {
+		if it.ctx != nil {
+			c.freeCtx(it.ctx)
+		}
+		c.finalizeReq(it.req, errRepairStopped)
+		c.parent.DecPending()
+		freeReq(it.req)
+	}
+	c.rq.wait()
+}
+
+func (c *getJogger) prepare(req *request) (it *repairItem) {
+	it = &repairItem{req: req}
+	it.ctx, it.err = c.newCtx(req)
+	if it.err == nil {
+		it.err = c.requestMeta(it.ctx)
+	}
+	if it.err != nil {
+		it.redundancy = -1 // fail fast
+		return
+	}
+	it.redundancy = it.ctx.redundancy()
+	return
+}
+
+// wait for the configured (dynamic) `ec_repair.rate`, if any
+func (c *getJogger) pace(size int64) error {
+	rate := cmn.GCO.Get().ECRepair.Rate
+	if rate <= 0 || size <= 0 {
+		return nil
+	}
+	d := pacer.delay(size, int64(rate))
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-c.stopCh.Listen():
+		return errRepairStopped
+	}
+}
@@ -0,0 +1,147 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newRepairItem(uname string, redundancy int) *repairItem {
+	return &repairItem{req: &request{LIF: cluster.LIF{Uname: uname}}, redundancy: redundancy}
+}
+
+func TestRepairQOrder(t *testing.T) {
+	q := newRepairQ()
+	for _, it := range []*repairItem{
+		newRepairItem("a", 2),
+		newRepairItem("b", 0),
+		newRepairItem("c", 1),
+		newRepairItem("d", 0),
+		newRepairItem("e", -1), // failed to prepare
+	} {
+		q.push(it)
+	}
+	// least redundant first, FIFO among equals
+	for _, uname := range []string{"e", "b", "d", "c", "a"} {
+		it := q.pop()
+		tassert.Fatalf(t, it != nil, "expected %q, got nil", uname)
+		tassert.Errorf(t, it.req.LIF.Uname == uname, "expected %q, got %q", uname, it.req.LIF.Uname)
+		q.done(it)
+	}
+}
+
+func TestRepairQBusy(t *testing.T) {
+	q := newRepairQ()
+	q.push(newRepairItem("a", 0))
+	q.push(newRepairItem("a", 0))
+	q.push(newRepairItem("b", 1))
+
+	first := q.pop()
+	tassert.Fatalf(t, first.req.LIF.Uname == "a", "expected %q, got %q", "a", first.req.LIF.Uname)
+	// same object is being restored - skip it
+	it := q.pop()
+	tassert.Fatalf(t, it.req.LIF.Uname == "b", "expected %q, got %q", "b", it.req.LIF.Uname)
+	q.done(it)
+
+	popped := make(chan *repairItem, 1)
+	go func() { popped <- q.pop() }()
+	select {
+	case it = <-popped:
+		t.Fatalf("expected pop to block while %q is busy, got %q", "a", it.req.LIF.Uname)
+	case <-time.After(50 * time.Millisecond):
+	}
+	q.done(first)
+	select {
+	case it = <-popped:
+		tassert.Errorf(t, it.req.LIF.Uname == "a", "expected %q, got %q", "a", it.req.LIF.Uname)
+		q.done(it)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for pop")
+	}
+
+	// stop wakes up waiting workers and returns what's left
+	q.push(newRepairItem("c", 0))
+	q.push(newRepairItem("c", 1))
+	it = q.pop()
+	go func() { popped <- q.pop() }()
+	time.Sleep(50 * time.Millisecond)
+	items := q.stop()
+	q.done(it)
+	tassert.Errorf(t, len(items) == 1, "expected one remaining item, got %d", len(items))
+	select {
+	case it = <-popped:
+		tassert.Errorf(t, it == nil, "expected nil when stopped")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for pop to return upon stop")
+	}
+}
+
+// stopping the jogger must wait for the restoration in progress
+func TestRepairStop(t *testing.T) {
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+		stopped = make(chan struct{})
+		xget    = &XactGet{}
+	)
+	xget.config = cmn.GCO.Get()
+	j := &getJogger{parent: xget, mpath: t.TempDir(), workCh: make(chan *request, 1), rq: newRepairQ()}
+	j.repairFn = func(*repairItem) {
+		close(started)
+		<-release
+	}
+	j.stopCh.Init()
+	j.start()
+
+	j.rq.push(newRepairItem("a", 0))
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for restoration to start")
+	}
+	go func() {
+		j.stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("jogger stopped while restoration is in progress")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for jogger to stop")
+	}
+}
+
+func TestRepairPacer(t *testing.T) {
+	var (
+		p    repairPacer
+		rate = int64(100 * 1024 * 1024) // 100MiB/s
+		size = rate / 10                // 100ms worth
+	)
+	d := p.delay(size, rate)
+	tassert.Errorf(t, d <= 0, "expected the first restoration to start immediately, got %v", d)
+
+	// each subsequent reservation waits for all the previous ones
+	for i := 1; i <= 3; i++ {
+		d = p.delay(size, rate)
+		expected := time.Duration(i) * 100 * time.Millisecond
+		tassert.Errorf(t, d > expected-20*time.Millisecond && d <= expected,
+			"%d: expected delay ~%v, got %v", i, expected, d)
+	}
+
+	// no bursting after idle
+	p.next = 0
+	d = p.delay(size, rate)
+	tassert.Errorf(t, d <= 0, "expected no delay after idle, got %v", d)
+}
@@ -168,7 +168,7 @@ func (r *XactRespond) DispatchReq(iReq intraReq, hdr *transport.ObjHdr, bck *met
 		// object cleanup request: delete replicas, slices and metafiles
 		if err := r.removeObjAndMeta(bck, hdr.ObjName); err != nil {
 			err = fmt.Errorf("%s: failed to delete %s: %w", r.t, bck.Cname(hdr.ObjName), err)
-			nlog.Errorln(err)
+			nlog.ErrorlnCtx(hdrCtx(hdr), err)
 			r.AddErr(err)
 		}
 	case reqGet:
@@ -198,12 +198,12 @@ func (r *XactRespond) DispatchResp(iReq intraReq, hdr *transport.ObjHdr, object
 			meta = iReq.meta
 		)
 		if meta == nil {
-			nlog.Errorf("%s: no metadata for %s", r.t, hdr.Cname())
+			nlog.ErrorfCtx(hdrCtx(hdr), "%s: no metadata for %s", r.t, hdr.Cname())
 			return
 		}
 
 		if r.config.FastV(4, cos.SmoduleEC) {
-			nlog.Infof("Got slice=%t from %s (#%d of %s) v%s, cksum: %s", iReq.isSlice, hdr.SID,
+			nlog.InfofCtx(hdrCtx(hdr), "Got slice=%t from %s (#%d of %s) v%s, cksum: %s", iReq.isSlice, hdr.SID,
 				iReq.meta.SliceID, hdr.Cname(), meta.ObjVersion, meta.CksumValue)
 		}
 		md := meta.NewPack()
@@ -228,7 +228,7 @@ func (r *XactRespond) DispatchResp(iReq intraReq, hdr *transport.ObjHdr, object
 		}
 		if err != nil {
 			r.AddErr(err)
-			nlog.Errorln(err)
+			nlog.ErrorlnCtx(hdrCtx(hdr), err)
 			return
 		}
 		r.ObjsAdd(1, hdr.ObjAttrs.Size)
@@ -192,7 +192,7 @@ func (r *xactECBase) dataResponse(act intraReqType, hdr *transport.ObjHdr, fqn s
 	}
 	debug.Assert((objAttrs.Size == 0 && reader == nil) || (objAttrs.Size != 0 && reader != nil))
 
-	rHdr := transport.ObjHdr{ObjName: objName, ObjAttrs: objAttrs, Opcode: act}
+	rHdr := transport.ObjHdr{ObjName: objName, ObjAttrs: objAttrs, Opcode: act, ReqID: hdr.ReqID}
 	rHdr.Bck.Copy(bck.Bucket())
 	rHdr.Opaque = ireq.NewPack(r.t.ByteMM())
 
@@ -205,7 +205,7 @@ func (r *xactECBase) sendCb(hdr transport.ObjHdr, _ io.ReadCloser, _ any, err er
 	r.t.ByteMM().Free(hdr.Opaque)
 	if err != nil {
 		err = fmt.Errorf("failed to send %s: %w", hdr.Cname(), err)
-		nlog.Errorln(err)
+		nlog.ErrorlnCtx(hdrCtx(&hdr), err)
 		r.AddErr(err)
 	}
 	r.DecPending()
@@ -363,6 +363,7 @@ func (r *xactECBase) writeRemote(daemonIDs []string, lom *cluster.LOM, src *data
 		ObjName:  lom.ObjName,
 		ObjAttrs: objAttrs,
 		Opaque:   putData,
+		ReqID:    src.rid,
 		Opcode:   src.reqType,
 	}
 	hdr.Bck.Copy(lom.Bucket())
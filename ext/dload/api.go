@@ -32,6 +32,12 @@ const PrefixJobID = "dnl-"
 
 const DownloadProgressInterval = 10 * time.Second
 
+// how to use mirrors (alternative links) of a given object (see Base.MirrorMode)
+const (
+	MirrorSeq  = "sequential" // try the links one at a time, in the order given (default)
+	MirrorRace = "race"       // request all links in parallel and keep the first successful response
+)
+
 type (
 	// NOTE: Changing this structure requires changes in `MarshalJSON` and `UnmarshalJSON` methods.
 	Body struct {
@@ -73,18 +79,29 @@ type (
 		BytesPerHour int `json:"bytes_per_hour"`
 	}
 
+	// target-side extraction of downloaded archives (tar, tgz, tar.lz4, zip):
+	// each archived file becomes an object named `Prefix/<filename>`
+	ExtractOpts struct {
+		Prefix      string `json:"prefix,omitempty"`       // destination virtual directory (default: archive name sans extension)
+		Enabled     bool   `json:"enabled"`                // extract upon arrival
+		KeepArchive bool   `json:"keep_archive,omitempty"` // store the archive itself as well
+	}
+
 	Base struct {
-		Description      string  `json:"description"`
-		Bck              cmn.Bck `json:"bucket"`
-		Timeout          string  `json:"timeout"`
-		ProgressInterval string  `json:"progress_interval"`
-		Limits           Limits  `json:"limits"`
+		Description      string      `json:"description"`
+		Bck              cmn.Bck     `json:"bucket"`
+		Timeout          string      `json:"timeout"`
+		ProgressInterval string      `json:"progress_interval"`
+		Limits           Limits      `json:"limits"`
+		Extract          ExtractOpts `json:"extract"`
+		MirrorMode       string      `json:"mirror_mode,omitempty"` // MirrorSeq (default) or MirrorRace
 	}
 
 	SingleObj struct {
-		ObjName    string `json:"object_name"`
-		Link       string `json:"link"`
-		FromRemote bool   `json:"from_remote"`
+		ObjName    string   `json:"object_name"`
+		Link       string   `json:"link"`
+		Mirrors    []string `json:"mirrors,omitempty"` // alternative links, in order of preference
+		FromRemote bool     `json:"from_remote"`
 	}
 
 	AdminBody struct {
@@ -127,6 +144,8 @@ type (
 		Subdir   string `json:"subdir"`
 	}
 
+	// objects: either map (object name => link) or array of links, whereby each link
+	// can also be an array of links (mirrors) that all point to the same content
 	MultiBody struct {
 		Base
 		ObjectsPayload any `json:"objects"`
@@ -303,6 +322,19 @@ func (b *Base) Validate() error {
 	if b.Limits.BytesPerHour < 0 {
 		return fmt.Errorf("'limit.bytes_per_hour' must be non-negative (got: %d)", b.Limits.BytesPerHour)
 	}
+	if b.Extract.Prefix != "" {
+		if !b.Extract.Enabled {
+			return fmt.Errorf("'extract.prefix' %q requires 'extract.enabled'", b.Extract.Prefix)
+		}
+		if strings.Contains(b.Extract.Prefix, "../") {
+			return fmt.Errorf("invalid 'extract.prefix' %q", b.Extract.Prefix)
+		}
+	}
+	switch b.MirrorMode {
+	case "", MirrorSeq, MirrorRace:
+	default:
+		return fmt.Errorf("invalid 'mirror_mode' %q (expecting %q or %q)", b.MirrorMode, MirrorSeq, MirrorRace)
+	}
 	return nil
 }
 
@@ -321,6 +353,14 @@ func (b *SingleObj) Validate() error {
 	if b.Link == "" && !b.FromRemote {
 		return errors.New("missing 'link' in the request body")
 	}
+	if len(b.Mirrors) > 0 && b.Link == "" {
+		return errors.New("'mirrors' require 'link'")
+	}
+	for _, link := range b.Mirrors {
+		if link == "" {
+			return errors.New("empty link in 'mirrors'")
+		}
+	}
 	if b.ObjName == "" {
 		return fmt.Errorf("missing 'object_name' in the request body")
 	}
@@ -367,9 +407,9 @@ func (b *SingleBody) Validate() error {
 	return b.SingleObj.Validate()
 }
 
-func (b *SingleBody) ExtractPayload() (cos.StrKVs, error) {
-	objects := make(cos.StrKVs, 1)
-	objects[b.ObjName] = b.Link
+func (b *SingleBody) ExtractPayload() (map[string][]string, error) {
+	objects := make(map[string][]string, 1)
+	objects[b.ObjName] = append([]string{b.Link}, b.Mirrors...)
 	return objects, nil
 }
 
@@ -420,33 +460,32 @@ func (b *MultiBody) Validate() error {
 	return b.Base.Validate()
 }
 
-func (b *MultiBody) ExtractPayload() (cos.StrKVs, error) {
-	objects := make(cos.StrKVs, 10)
+// returns object name => links (the first link followed by its mirrors, if any)
+func (b *MultiBody) ExtractPayload() (map[string][]string, error) {
+	objects := make(map[string][]string, 10)
 	switch ty := b.ObjectsPayload.(type) {
 	case map[string]any:
 		for key, val := range ty {
-			switch v := val.(type) {
-			case string:
-				objects[key] = v
-			default:
-				return nil, fmt.Errorf("values in map should be strings, found: %T", v)
+			links, err := toLinks(val)
+			if err != nil {
+				return nil, fmt.Errorf("values in map should be strings or arrays of strings: %v", err)
 			}
+			objects[key] = links
 		}
 	case []any:
 		// process all links
 		for _, val := range ty {
-			switch link := val.(type) {
-			case string:
-				objName := path.Base(link)
-				if objName == "." || objName == "/" {
-					err := fmt.Errorf("failed to extract object name from the download %q", link)
-					// TODO: ignore and continue?
-					return nil, err
-				}
-				objects[objName] = link
-			default:
-				return nil, fmt.Errorf("expected download link to be a string, got: %T", link)
+			links, err := toLinks(val)
+			if err != nil {
+				return nil, err
+			}
+			objName := path.Base(links[0])
+			if objName == "." || objName == "/" {
+				err := fmt.Errorf("failed to extract object name from the download %q", links[0])
+				// TODO: ignore and continue?
+				return nil, err
 			}
+			objects[objName] = links
 		}
 	default:
 		return nil, fmt.Errorf("JSON body should be map (string -> string) or array of strings, found: %T", ty)
@@ -454,6 +493,28 @@ func (b *MultiBody) ExtractPayload() (cos.StrKVs, error) {
 	return objects, nil
 }
 
+func toLinks(val any) ([]string, error) {
+	switch v := val.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		links := make([]string, 0, len(v))
+		for _, l := range v {
+			link, ok := l.(string)
+			if !ok || link == "" {
+				return nil, fmt.Errorf("expected mirror to be a non-empty string, got: %v (%T)", l, l)
+			}
+			links = append(links, link)
+		}
+		if len(links) == 0 {
+			return nil, errors.New("empty list of mirrors")
+		}
+		return links, nil
+	default:
+		return nil, fmt.Errorf("expected download link to be a string or array of strings (mirrors), got: %T", v)
+	}
+}
+
 func (b *MultiBody) Describe() string {
 	if b.Description != "" {
 		return b.Description
@@ -47,12 +47,14 @@ type (
 	WebResource struct {
 		ObjName string
 		Link    string
+		Mirrors []string
 	}
 
 	DstElement struct {
 		ObjName string
 		Version string
 		Link    string
+		Mirrors []string
 	}
 
 	DiffResolverResult struct {
@@ -168,6 +170,7 @@ func (dr *DiffResolver) PushDst(v any) {
 		d = &DstElement{
 			ObjName: x.ObjName,
 			Link:    x.Link,
+			Mirrors: x.Mirrors,
 		}
 	default:
 		debug.FailTypeCast(v)
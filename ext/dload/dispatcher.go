@@ -249,6 +249,7 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 					diffResolver.PushDst(&WebResource{
 						ObjName: obj.objName,
 						Link:    obj.link,
+						Mirrors: obj.mirrors,
 					})
 				} else {
 					diffResolver.PushDst(&BackendResource{
@@ -271,6 +272,7 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 				obj = dlObj{
 					objName:    dst.ObjName,
 					link:       dst.Link,
+					mirrors:    dst.Mirrors,
 					fromRemote: dst.Link == "",
 				}
 			} else {
@@ -365,7 +367,7 @@ func (d *dispatcher) doSingle(task *singleTask) (ok bool, err error) {
 		return true, err
 	}
 
-	mi, _, err := cluster.HrwMpath(bck.MakeUname(task.obj.objName))
+	mi, _, err := cluster.HrwMpath(bck.MakeUname(task.obj.objName), bck.Bucket().MpathLabel())
 	if err != nil {
 		return false, err
 	}
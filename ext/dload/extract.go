@@ -0,0 +1,220 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Auto-extraction of downloaded archives (see `ExtractOpts`):
+// - tar, tgz, and tar.lz4 get extracted on the fly, directly from the response body
+//   (i.e., the archive itself is never stored);
+// - zip (that requires random access) and `keep_archive` both store the archive first,
+//   and then extract from the stored object.
+// Each extracted file is PUT into the same bucket, either locally or to the
+// designated (HRW) target.
+
+const extractWorkTag = "dl-extract"
+
+// returns archive's mime iff extraction is requested and the link (or object name) looks like an archive
+func (task *singleTask) extractMime() (string, *ExtractOpts) {
+	extr := task.job.extract()
+	if extr == nil || task.obj.fromRemote {
+		return "", nil
+	}
+	mime, err := archive.Mime("", task.obj.objName)
+	if err != nil {
+		if mime, err = archive.Mime("", task.obj.link); err != nil {
+			return "", nil
+		}
+	}
+	return mime, extr
+}
+
+// destination prefix (virtual directory) for the extracted files
+func (task *singleTask) extractPrefix(extr *ExtractOpts, mime string) string {
+	if extr.Prefix != "" {
+		return extr.Prefix
+	}
+	prefix := task.obj.objName
+	if ext := path.Ext(prefix); ext != "" {
+		prefix = strings.TrimSuffix(prefix, mime)
+		prefix = strings.TrimSuffix(prefix, ext) // e.g., ".tgz" that was detected via link
+	}
+	return prefix
+}
+
+// streaming extraction (compare w/ _dput)
+func (task *singleTask) _dextract(r io.Reader, mime string, extr *ExtractOpts) (int, error) {
+	ar, err := archive.NewReader(mime, r)
+	if err != nil {
+		return 0, err
+	}
+	return task.extractAll(ar, task.extractPrefix(extr, mime))
+}
+
+// extract from the (already stored) archive object
+func (task *singleTask) extractLOM(lom *cluster.LOM, mime string, extr *ExtractOpts) (n int, err error) {
+	fh, oah, err := lom.NewUserROC() // (decrypting, if need be)
+	if err != nil {
+		return 0, err
+	}
+	defer cos.Close(fh)
+
+	ar, err := archive.NewReader(mime, fh, oah.SizeBytes())
+	if err != nil {
+		return 0, err
+	}
+	n, err = task.extractAll(ar, task.extractPrefix(extr, mime))
+	if err != nil || extr.KeepArchive {
+		return n, err
+	}
+	_, err = task.xdl.t.DeleteObject(lom, false /*evict*/)
+	return n, err
+}
+
+func (task *singleTask) extractAll(ar archive.Reader, prefix string) (int, error) {
+	return extractRange(ar, prefix, func(objName string, reader cos.ReadCloseSizer) error {
+		if err := task.putExtracted(objName, reader); err != nil {
+			return fmt.Errorf("failed to extract => %s: %w", task.job.Bck().Cname(objName), err)
+		}
+		return nil
+	})
+}
+
+// extract regular files only - skip directories, symlinks, hardlinks, devices, etc.
+func extractRange(ar archive.Reader, prefix string, put func(string, cos.ReadCloseSizer) error) (n int, err error) {
+	_, err = ar.Range("", func(filename string, reader cos.ReadCloseSizer, hdr any) (bool, error) {
+		if !isRegular(hdr) {
+			return false, nil
+		}
+		objName, err := extractName(prefix, filename)
+		if err != nil {
+			return true, err
+		}
+		if err := put(objName, reader); err != nil {
+			return true, err
+		}
+		n++
+		return false, nil
+	})
+	return n, err
+}
+
+func isRegular(hdr any) bool {
+	switch h := hdr.(type) {
+	case *tar.Header:
+		return h.Typeflag == tar.TypeReg
+	case interface{ Mode() iofs.FileMode }: // zip and 7z
+		return h.Mode().IsRegular()
+	default:
+		return false
+	}
+}
+
+// archived filenames come from untrusted sources: reject absolute and parent-relative
+// names that would otherwise resolve outside the bucket ("zip-slip")
+func extractName(prefix, filename string) (string, error) {
+	name := path.Clean(filename)
+	if escapes(name) {
+		return "", fmt.Errorf("invalid archived filename %q", filename)
+	}
+	objName := path.Join(prefix, name)
+	if escapes(objName) {
+		return "", fmt.Errorf("invalid archived filename %q (prefix %q)", filename, prefix)
+	}
+	return objName, nil
+}
+
+func escapes(name string) bool {
+	return path.IsAbs(name) || name == "." || name == ".." || strings.HasPrefix(name, "../")
+}
+
+func (task *singleTask) putExtracted(objName string, reader cos.ReadCloseSizer) error {
+	var (
+		t    = task.xdl.t
+		bck  = task.job.Bck()
+		smap = t.Sowner().Get()
+	)
+	tsi, err := cluster.HrwTarget(bck.MakeUname(objName), smap)
+	if err != nil {
+		return err
+	}
+	if tsi.ID() != t.SID() {
+		return task.sendExtracted(objName, reader, tsi.URL(cmn.NetIntraData))
+	}
+
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return err
+	}
+	params := cluster.AllocPutObjParams()
+	{
+		params.WorkTag = extractWorkTag
+		params.Reader = io.NopCloser(reader)
+		params.OWT = cmn.OwtPut
+		params.Atime = task.started.Load()
+		params.Xact = task.xdl
+	}
+	err = t.PutObject(lom, params)
+	cluster.FreePutObjParams(params)
+	return err
+}
+
+// PUT extracted file => designated target
+func (task *singleTask) sendExtracted(objName string, reader cos.ReadCloseSizer, url string) error {
+	var (
+		bck   = task.job.Bck()
+		query = bck.AddToQuery(nil)
+		hdr   = make(http.Header, 2)
+	)
+	hdr.Set(apc.HdrT2TPutterID, task.xdl.t.SID())
+	query.Set(apc.QparamOWT, cmn.OwtPut.ToS())
+	query.Set(apc.QparamUUID, task.xdl.ID())
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   url,
+		Path:   apc.URLPathObjects.Join(bck.Name, objName),
+		Query:  query,
+		Header: hdr,
+		BodyR:  io.NopCloser(reader),
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(cmn.GCO.Get().Timeout.SendFile.D())
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	req.ContentLength = reader.Size()
+
+	resp, err := task.xdl.t.DataClient().Do(req) //nolint:bodyclose // cos.DrainReader and Close below
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s: status %d", req.URL.Path, resp.StatusCode)
+	}
+	return nil
+}
+
+func (task *singleTask) logExtracted(n int, started time.Time) {
+	nlog.Infof("%s: extracted %d file%s in %v", task, n, cos.Plural(n), time.Since(started))
+}
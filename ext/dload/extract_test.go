@@ -0,0 +1,169 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type extractEntry struct {
+	name    string
+	body    string
+	symlink bool
+	dir     bool
+}
+
+var (
+	extractRegular = []extractEntry{
+		{name: "a.txt", body: "aaa"},
+		{name: "dir/", dir: true},
+		{name: "dir/b.txt", body: "bbbb"},
+		{name: "x/../c.txt", body: "c"}, // (cleaned)
+		{name: "link", body: "/etc/passwd", symlink: true},
+	}
+	extractTraversal = []extractEntry{
+		{name: "a.txt", body: "aaa"},
+		{name: "dir/../../../x.txt", body: "evil"},
+	}
+	extractAbsolute = []extractEntry{
+		{name: "/etc/x.txt", body: "evil"},
+	}
+)
+
+func TestExtractName(t *testing.T) {
+	tests := []struct {
+		prefix, filename, objName string
+	}{
+		{"", "a/b.txt", "a/b.txt"},
+		{"pre", "a/./b.txt", "pre/a/b.txt"},
+		{"pre", "a/../b.txt", "pre/b.txt"},
+		{"pre", "../b.txt", ""},
+		{"pre", "a/../../b.txt", ""},
+		{"pre", "..", ""},
+		{"pre", "/etc/passwd", ""},
+		{"..", "b.txt", ""},
+		{"", "./", ""},
+	}
+	for _, test := range tests {
+		objName, err := extractName(test.prefix, test.filename)
+		if test.objName == "" {
+			tassert.Errorf(t, err != nil, "(%q, %q): expected error, got %q", test.prefix, test.filename, objName)
+			continue
+		}
+		tassert.CheckError(t, err)
+		tassert.Errorf(t, objName == test.objName, "(%q, %q): expected %q, got %q",
+			test.prefix, test.filename, test.objName, objName)
+	}
+}
+
+func TestExtractRange(t *testing.T) {
+	for _, mime := range []string{archive.ExtTar, archive.ExtTgz, archive.ExtZip} {
+		t.Run(mime, func(t *testing.T) {
+			// regular files only (no directories and symlinks)
+			got, err := testExtract(t, mime, extractRegular)
+			tassert.CheckFatal(t, err)
+			names := make([]string, 0, len(got))
+			for name := range got {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			tassert.Fatalf(t, strings.Join(names, ",") == "pfx/a.txt,pfx/c.txt,pfx/dir/b.txt",
+				"unexpected extracted objects: %v", names)
+			tassert.Errorf(t, got["pfx/dir/b.txt"] == "bbbb", "unexpected content %q", got["pfx/dir/b.txt"])
+
+			// must not escape the bucket
+			for _, entries := range [][]extractEntry{extractTraversal, extractAbsolute} {
+				got, err = testExtract(t, mime, entries)
+				tassert.Errorf(t, err != nil, "expected error, extracted %v", got)
+				for name := range got {
+					tassert.Errorf(t, !strings.HasPrefix(name, "..") && !strings.HasPrefix(name, "/"),
+						"extracted %q", name)
+				}
+			}
+		})
+	}
+}
+
+func testExtract(t *testing.T, mime string, entries []extractEntry) (map[string]string, error) {
+	b := makeArchive(t, mime, entries)
+	ar, err := archive.NewReader(mime, bytes.NewReader(b), int64(len(b)))
+	tassert.CheckFatal(t, err)
+	got := make(map[string]string, len(entries))
+	_, err = extractRange(ar, "pfx", func(objName string, reader cos.ReadCloseSizer) error {
+		data, err := io.ReadAll(reader)
+		got[objName] = string(data)
+		return err
+	})
+	return got, err
+}
+
+func makeArchive(t *testing.T, mime string, entries []extractEntry) []byte {
+	var buf bytes.Buffer
+	switch mime {
+	case archive.ExtTar, archive.ExtTgz:
+		var (
+			w  io.Writer = &buf
+			gw *gzip.Writer
+		)
+		if mime == archive.ExtTgz {
+			gw = gzip.NewWriter(&buf)
+			w = gw
+		}
+		tw := tar.NewWriter(w)
+		for _, e := range entries {
+			hdr := &tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.body)), Typeflag: tar.TypeReg}
+			switch {
+			case e.dir:
+				hdr.Typeflag, hdr.Mode = tar.TypeDir, 0o755
+			case e.symlink:
+				hdr.Typeflag, hdr.Linkname, hdr.Size = tar.TypeSymlink, e.body, 0
+			}
+			tassert.CheckFatal(t, tw.WriteHeader(hdr))
+			if hdr.Size > 0 {
+				_, err := tw.Write([]byte(e.body))
+				tassert.CheckFatal(t, err)
+			}
+		}
+		tassert.CheckFatal(t, tw.Close())
+		if gw != nil {
+			tassert.CheckFatal(t, gw.Close())
+		}
+	case archive.ExtZip:
+		zw := zip.NewWriter(&buf)
+		for _, e := range entries {
+			hdr := &zip.FileHeader{Name: e.name, Method: zip.Deflate}
+			switch {
+			case e.dir:
+				hdr.SetMode(os.ModeDir | 0o755)
+			case e.symlink:
+				hdr.SetMode(os.ModeSymlink | 0o777)
+			default:
+				hdr.SetMode(0o644)
+			}
+			w, err := zw.CreateHeader(hdr)
+			tassert.CheckFatal(t, err)
+			if !e.dir {
+				_, err = w.Write([]byte(e.body))
+				tassert.CheckFatal(t, err)
+			}
+		}
+		tassert.CheckFatal(t, zw.Close())
+	default:
+		t.Fatalf("unexpected mime %q", mime)
+	}
+	return buf.Bytes()
+}
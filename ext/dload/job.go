@@ -38,6 +38,7 @@ type (
 	dlObj struct {
 		objName    string
 		link       string
+		mirrors    []string // alternative links (see Base.MirrorMode)
 		fromRemote bool
 	}
 
@@ -68,6 +69,12 @@ type (
 		// via tryAcquire and release
 		throttler() *throttler
 
+		// archive extraction options (nil if disabled)
+		extract() *ExtractOpts
+
+		// request all mirrors in parallel (MirrorRace)
+		raceMirrors() bool
+
 		// job cleanup
 		cleanup()
 	}
@@ -80,6 +87,8 @@ type (
 		description string
 		timeout     time.Duration
 		throt       throttler
+		extr        ExtractOpts
+		race        bool
 	}
 
 	sliceDlJob struct {
@@ -135,19 +144,22 @@ type (
 // baseDlJob //
 ///////////////
 
-func (j *baseDlJob) init(t cluster.Target, id string, bck *meta.Bck, timeout, desc string, limits Limits, xdl *Xact) {
+func (j *baseDlJob) init(t cluster.Target, id string, bck *meta.Bck, base *Base, desc string, xdl *Xact) {
 	// TODO: this might be inaccurate if we download 1 or 2 objects because then
 	//  other targets will have limits but will not use them.
+	limits := base.Limits
 	if limits.BytesPerHour > 0 {
 		limits.BytesPerHour /= t.Sowner().Get().CountActiveTs()
 	}
-	td, _ := time.ParseDuration(timeout)
+	td, _ := time.ParseDuration(base.Timeout)
 	{
 		j.id = id
 		j.bck = bck
 		j.timeout = td
 		j.description = desc
 		j.throt.init(limits)
+		j.extr = base.Extract
+		j.race = base.MirrorMode == MirrorRace
 		j.xdl = xdl
 	}
 }
@@ -192,6 +204,15 @@ func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
 func (*baseDlJob) checkObj(string) bool    { debug.Assert(false); return false }
 func (j *baseDlJob) throttler() *throttler { return &j.throt }
 
+func (j *baseDlJob) extract() *ExtractOpts {
+	if !j.extr.Enabled {
+		return nil
+	}
+	return &j.extr
+}
+
+func (j *baseDlJob) raceMirrors() bool { return j.race }
+
 func (j *baseDlJob) cleanup() {
 	j.throttler().stop()
 	err := dlStore.markFinished(j.ID())
@@ -206,7 +227,7 @@ func (j *baseDlJob) cleanup() {
 // sliceDlJob -- multiDlJob -- singleDlJob
 //
 
-func (j *sliceDlJob) init(t cluster.Target, bck *meta.Bck, objects cos.StrKVs) error {
+func (j *sliceDlJob) init(t cluster.Target, bck *meta.Bck, objects map[string][]string) error {
 	objs, err := buildDlObjs(t, bck, objects)
 	if err != nil {
 		return err
@@ -233,10 +254,10 @@ func (j *sliceDlJob) genNext() (objs []dlObj, ok bool, err error) {
 }
 
 func newMultiDlJob(t cluster.Target, id string, bck *meta.Bck, payload *MultiBody, xdl *Xact) (mj *multiDlJob, err error) {
-	var objs cos.StrKVs
+	var objs map[string][]string
 
 	mj = &multiDlJob{}
-	mj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	mj.baseDlJob.init(t, id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -248,10 +269,10 @@ func newMultiDlJob(t cluster.Target, id string, bck *meta.Bck, payload *MultiBod
 func (j *multiDlJob) String() (s string) { return "multi-" + j.baseDlJob.String() }
 
 func newSingleDlJob(t cluster.Target, id string, bck *meta.Bck, payload *SingleBody, xdl *Xact) (sj *singleDlJob, err error) {
-	var objs cos.StrKVs
+	var objs map[string][]string
 
 	sj = &singleDlJob{}
-	sj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	sj.baseDlJob.init(t, id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -274,7 +295,7 @@ func newRangeDlJob(t cluster.Target, id string, bck *meta.Bck, payload *RangeBod
 	if rj.pt, err = cos.ParseBashTemplate(payload.Template); err != nil {
 		return nil, err
 	}
-	rj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	rj.baseDlJob.init(t, id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if rj.count, err = countObjects(t, rj.pt, payload.Subdir, rj.bck); err != nil {
 		return nil, err
@@ -338,7 +359,7 @@ func newBackendDlJob(t cluster.Target, id string, bck *meta.Bck, payload *Backen
 		return nil, errors.New("bucket download does not support HTTP buckets")
 	}
 	bj = &backendDlJob{}
-	bj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	bj.baseDlJob.init(t, id, bck, &payload.Base, payload.Describe(), xdl)
 	{
 		bj.t = t
 		bj.sync = payload.Sync
@@ -15,6 +15,7 @@ import (
 
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -28,6 +29,7 @@ const (
 
 const (
 	retryCnt         = 10  // number of retries to external resource
+	mirrorRetryCnt   = 3   // ditto, when the object has mirrors to fall back on (see Base.MirrorMode)
 	reqTimeoutFactor = 1.2 // newTimeout = prevTimeout * reqTimeoutFactor
 	internalErrorMsg = "internal server error"
 )
@@ -103,15 +105,37 @@ func (task *singleTask) download(lom *cluster.LOM, config *cmn.Config) {
 	task.xdl.ObjsAdd(1, task.currentSize.Load())
 }
 
-func (task *singleTask) _dlocal(lom *cluster.LOM, timeout time.Duration) (bool /*err is fatal*/, error) {
+// GET from one of the `links` (more than one when racing the mirrors)
+func (task *singleTask) _dlocal(lom *cluster.LOM, timeout time.Duration, links []string) (bool /*err is fatal*/, error) {
+	var (
+		resp  *http.Response
+		link  = links[0]
+		fatal bool
+		err   error
+	)
 	ctx, cancel := context.WithTimeout(task.downloadCtx, timeout)
 	defer cancel()
 
 	task.getCtx = ctx
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, task.obj.link, http.NoBody)
+	if len(links) == 1 {
+		resp, fatal, err = task._get(ctx, link) //nolint:bodyclose // cos.Close
+	} else {
+		resp, link, err = task._race(ctx, links) //nolint:bodyclose // ditto
+	}
 	if err != nil {
-		return true, err
+		return fatal, err
+	}
+	fatal, err = task._dput(lom, link, resp)
+	cos.Close(resp.Body)
+	return fatal, err
+}
+
+// non-2xx responses are returned as errors (with the body closed)
+func (*singleTask) _get(ctx context.Context, link string) (*http.Response, bool /*err is fatal*/, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return nil, true, err
 	}
 
 	// Set "User-Agent" header when doing requests to Google Cloud Storage.
@@ -120,30 +144,83 @@ func (task *singleTask) _dlocal(lom *cluster.LOM, timeout time.Duration) (bool /
 		req.Header.Add("User-Agent", gcsUA)
 	}
 
-	resp, err := clientForURL(task.obj.link).Do(req) //nolint:bodyclose // cos.Close
+	resp, err := clientForURL(link).Do(req) //nolint:bodyclose // cos.Close
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
-
-	fatal, err := task._dput(lom, req, resp)
-	cos.Close(resp.Body)
-	return fatal, err
-}
-
-func (task *singleTask) _dput(lom *cluster.LOM, req *http.Request, resp *http.Response) (bool /*err is fatal*/, error) {
 	if resp.StatusCode >= http.StatusBadRequest {
+		cos.Close(resp.Body)
 		if resp.StatusCode == http.StatusNotFound {
-			return false, cmn.NewErrHTTP(req, fmt.Errorf("%q does not exist", task.obj.link), http.StatusNotFound)
+			return nil, false, cmn.NewErrHTTP(req, fmt.Errorf("%q does not exist", link), http.StatusNotFound)
 		}
-		return false, cmn.NewErrHTTP(req,
-			fmt.Errorf("failed to download %q: status %d", task.obj.link, resp.StatusCode),
+		return nil, false, cmn.NewErrHTTP(req,
+			fmt.Errorf("failed to download %q: status %d", link, resp.StatusCode),
 			resp.StatusCode)
 	}
+	return resp, false, nil
+}
 
+// MirrorRace: GET all links at once, keep the first successful response and cancel the others;
+// when all fail, return the error of the first (primary) link
+func (task *singleTask) _race(ctx context.Context, links []string) (*http.Response, string, error) {
+	type result struct {
+		resp *http.Response
+		err  error
+		idx  int
+	}
+	var (
+		resp    *http.Response
+		ch      = make(chan result, len(links))
+		cancels = make([]context.CancelFunc, len(links))
+		errs    = make([]error, len(links))
+		win     = -1
+	)
+	for i, link := range links {
+		var cctx context.Context
+		cctx, cancels[i] = context.WithCancel(ctx) // (the winner's - when the parent gets canceled)
+		go func(i int, link string) {
+			resp, _, err := task._get(cctx, link) //nolint:bodyclose // see below
+			ch <- result{resp: resp, err: err, idx: i}
+		}(i, link)
+	}
+	for range links {
+		r := <-ch
+		switch {
+		case r.err != nil:
+			errs[r.idx] = r.err
+		case win < 0:
+			win, resp = r.idx, r.resp
+			for i, cancel := range cancels {
+				if i != win {
+					cancel()
+				}
+			}
+		default:
+			cos.Close(r.resp.Body) // lost the race
+		}
+	}
+	if win < 0 {
+		return nil, "", errs[0]
+	}
+	return resp, links[win], nil
+}
+
+func (task *singleTask) _dput(lom *cluster.LOM, link string, resp *http.Response) (bool /*err is fatal*/, error) {
 	r := task.wrapReader(resp.Body)
-	size := attrsFromLink(task.obj.link, resp, lom)
+	size := attrsFromLink(link, resp, lom)
 	task.setTotalSize(size)
 
+	mime, extr := task.extractMime()
+	if extr != nil && mime != archive.ExtZip && !extr.KeepArchive {
+		started := time.Now()
+		n, err := task._dextract(r, mime, extr)
+		if err != nil {
+			return true, err
+		}
+		task.logExtracted(n, started)
+		return false, nil
+	}
+
 	params := cluster.AllocPutObjParams()
 	{
 		params.WorkTag = "dl"
@@ -160,16 +237,47 @@ func (task *singleTask) _dput(lom *cluster.LOM, req *http.Request, resp *http.Re
 	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
 		return true, err
 	}
+	if extr != nil {
+		started := time.Now()
+		n, err := task.extractLOM(lom, mime, extr)
+		if err != nil {
+			return true, err
+		}
+		task.logExtracted(n, started)
+	}
 	return false, nil
 }
 
+// when the object has mirrors, try them one at a time - each with a few retries -
+// or, with MirrorRace, all at once
 func (task *singleTask) downloadLocal(lom *cluster.LOM) (err error) {
+	if len(task.obj.mirrors) == 0 {
+		return task._retry(lom, []string{task.obj.link}, retryCnt)
+	}
+	links := append([]string{task.obj.link}, task.obj.mirrors...)
+	if task.job.raceMirrors() {
+		return task._retry(lom, links, mirrorRetryCnt)
+	}
+	for i, link := range links {
+		err = task._retry(lom, []string{link}, mirrorRetryCnt)
+		if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, errThrottlerStopped) {
+			return err
+		}
+		if i < len(links)-1 {
+			nlog.Warningf("%s: failed to download from %q (%v) - falling back to %q", task, link, err, links[i+1])
+			task.reset()
+		}
+	}
+	return err
+}
+
+func (task *singleTask) _retry(lom *cluster.LOM, links []string, cnt int) (err error) {
 	var (
 		timeout = task.initialTimeout()
 		fatal   bool
 	)
-	for i := 0; i < retryCnt; i++ {
-		fatal, err = task._dlocal(lom, timeout)
+	for i := 0; i < cnt; i++ {
+		fatal, err = task._dlocal(lom, timeout, links)
 		if err == nil || fatal {
 			return err
 		}
@@ -179,19 +287,19 @@ func (task *singleTask) downloadLocal(lom *cluster.LOM) (err error) {
 		}
 		if errors.Is(err, context.DeadlineExceeded) {
 			nlog.Warningf("%s [retries: %d/%d]: timeout (%v) - increasing and retrying...",
-				task, i, retryCnt, timeout)
+				task, i, cnt, timeout)
 			timeout = time.Duration(float64(timeout) * reqTimeoutFactor)
 		} else if herr := cmn.Err2HTTPErr(err); herr != nil {
-			nlog.Warningf("%s [retries: %d/%d]: failed to perform request: %v (code: %d)", task, i, retryCnt, err, herr.Status)
+			nlog.Warningf("%s [retries: %d/%d]: failed to perform request: %v (code: %d)", task, i, cnt, err, herr.Status)
 			if _, exists := terminalStatuses[herr.Status]; exists {
 				// Nothing we can do...
 				return err
 			}
 			// Otherwise retry...
 		} else if cos.IsRetriableConnErr(err) {
-			nlog.Warningf("%s [retries: %d/%d]: connection failed with (%v), retrying...", task, i, retryCnt, err)
+			nlog.Warningf("%s [retries: %d/%d]: connection failed with (%v), retrying...", task, i, cnt, err)
 		} else {
-			nlog.Warningf("%s [retries: %d/%d]: unexpected error (%v), retrying...", task, i, retryCnt, err)
+			nlog.Warningf("%s [retries: %d/%d]: unexpected error (%v), retrying...", task, i, cnt, err)
 		}
 
 		task.reset()
@@ -59,21 +59,24 @@ func countObjects(t cluster.Target, pt cos.ParsedTemplate, dir string, bck *meta
 }
 
 // buildDlObjs returns list of objects that must be downloaded by target.
-func buildDlObjs(t cluster.Target, bck *meta.Bck, objects cos.StrKVs) ([]dlObj, error) {
+func buildDlObjs(t cluster.Target, bck *meta.Bck, objects map[string][]string) ([]dlObj, error) {
 	var (
 		smap = t.Sowner().Get()
 		sid  = t.SID()
 	)
 
 	objs := make([]dlObj, 0, len(objects))
-	for name, link := range objects {
-		obj, err := makeDlObj(smap, sid, bck, name, link)
+	for name, links := range objects {
+		obj, err := makeDlObj(smap, sid, bck, name, links[0])
 		if err != nil {
 			if err == errInvalidTarget {
 				continue
 			}
 			return nil, err
 		}
+		for _, link := range links[1:] {
+			obj.mirrors = append(obj.mirrors, cmn.PrependProtocol(link))
+		}
 		objs = append(objs, obj)
 	}
 	return objs, nil
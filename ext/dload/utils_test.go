@@ -108,3 +108,24 @@ func prepareObject(t *testing.T) *cluster.LOM {
 	tassert.CheckFatal(t, err)
 	return lom
 }
+
+func TestExtractPayloadMirrors(t *testing.T) {
+	const (
+		primary = "http://example.com/data/train.tgz"
+		mirror  = "http://mirror.example.org/data/train.tgz"
+	)
+	for _, payload := range []any{
+		map[string]any{"train.tgz": []any{primary, mirror}},
+		[]any{[]any{primary, mirror}},
+	} {
+		body := &dload.MultiBody{ObjectsPayload: payload}
+		objects, err := body.ExtractPayload()
+		tassert.CheckFatal(t, err)
+		links := objects["train.tgz"]
+		tassert.Fatalf(t, len(objects) == 1 && len(links) == 2, "expected one object with two links, got %v", objects)
+		tassert.Errorf(t, links[0] == primary && links[1] == mirror, "wrong order of links: %v", links)
+	}
+	body := &dload.MultiBody{ObjectsPayload: map[string]any{"train.tgz": []any{}}}
+	_, err := body.ExtractPayload()
+	tassert.Errorf(t, err != nil, "expected error on empty list of mirrors")
+}
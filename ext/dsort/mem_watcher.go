@@ -1,16 +1,19 @@
 // Package dsort provides APIs for distributed archive file shuffling.
 /*
- * Copyright (c) 2018-2021, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
  */
 package dsort
 
 import (
+	"math"
 	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/sys"
 )
 
@@ -36,6 +39,8 @@ type memoryWatcher struct {
 	reservedMemory    atomic.Uint64
 	memoryUsed        atomic.Uint64 // memory used in specific point in time, it is refreshed once in a while
 	unreserveMemoryCh chan uint64
+	pressure          atomic.Int32  // node-wide memory pressure (via memsys callback)
+	spillCh           chan struct{} // to spill record contents right away
 }
 
 func newSingleMemoryWatcher(interval time.Duration) *singleMemoryWatcher {
@@ -52,6 +57,7 @@ func newMemoryWatcher(m *Manager, maxMemoryUsage uint64) *memoryWatcher {
 		reserved:          newSingleMemoryWatcher(memoryReservedInterval),
 		maxMemoryToUse:    maxMemoryUsage,
 		unreserveMemoryCh: make(chan uint64, unreserveMemoryBufferSize),
+		spillCh:           make(chan struct{}, 1),
 	}
 }
 
@@ -61,6 +67,7 @@ func (mw *memoryWatcher) watch() error {
 		return err
 	}
 	mw.memoryUsed.Store(mem.ActualUsed)
+	g.mm.RegPressureCB(mw.pcbName(), memsys.PressureHigh, mw.pressureCB)
 
 	mw.reserved.wg.Add(1)
 	go mw.watchReserved()
@@ -143,18 +150,10 @@ func (mw *memoryWatcher) watchExcess(memStat sys.MemStat) {
 			if curMem.ActualUsed < mw.maxMemoryToUse {
 				continue
 			}
-
-			storeType := shard.DiskStoreType
-			if mw.m.shardRW.SupportsOffset() {
-				storeType = shard.OffsetStoreType
-			}
-			mw.m.recm.RecordContents().Range(func(key, value any) bool {
-				n := mw.m.recm.FreeMem(key.(string), storeType, value, buf)
-				memExcess -= n
-				return memExcess > 0 // continue if we need more
-			})
-
-			cos.FreeMemToOS(true /*force*/)
+			mw.spill(memExcess, buf)
+		case <-mw.spillCh:
+			// high memory pressure: spill all in-memory record contents
+			mw.spill(math.MaxInt64, buf)
 		case <-mw.m.listenAborted():
 			return
 		case <-mw.excess.stopCh.Listen():
@@ -163,13 +162,43 @@ func (mw *memoryWatcher) watchExcess(memStat sys.MemStat) {
 	}
 }
 
+func (mw *memoryWatcher) spill(memExcess int64, buf []byte) {
+	storeType := shard.DiskStoreType
+	if mw.m.shardRW.SupportsOffset() {
+		storeType = shard.OffsetStoreType
+	}
+	mw.m.recm.RecordContents().Range(func(key, value any) bool {
+		n := mw.m.recm.FreeMem(key.(string), storeType, value, buf)
+		memExcess -= n
+		return memExcess > 0 // continue if we need more
+	})
+
+	cos.FreeMemToOS(true /*force*/)
+}
+
+func (mw *memoryWatcher) pcbName() string { return "dsort-" + mw.m.ManagerUUID }
+
+// memsys callback (must not block)
+func (mw *memoryWatcher) pressureCB(pressure int) {
+	mw.pressure.Store(int32(pressure))
+	if pressure < memsys.PressureHigh {
+		return
+	}
+	nlog.Warningf("%s: %s memory pressure %d - spilling to disk", g.t, mw.m.ManagerUUID, pressure)
+	select {
+	case mw.spillCh <- struct{}{}:
+	default:
+	}
+}
+
+// (under high memory pressure extract directly to disk)
 func (mw *memoryWatcher) reserveMem(toReserve uint64) (exceeding bool) {
 	newReservedMemory := mw.reservedMemory.Add(toReserve)
 	// expected total memory after all objects will be extracted is equal
 	// to: previously reserved memory + uncompressed size of shard + current memory used
 	expectedTotalMemoryUsed := newReservedMemory + mw.memoryUsed.Load()
 
-	exceeding = expectedTotalMemoryUsed >= mw.maxMemoryToUse
+	exceeding = expectedTotalMemoryUsed >= mw.maxMemoryToUse || mw.pressure.Load() >= memsys.PressureHigh
 	return
 }
 
@@ -178,6 +207,7 @@ func (mw *memoryWatcher) unreserveMem(toUnreserve uint64) {
 }
 
 func (mw *memoryWatcher) stopWatchingExcess() {
+	g.mm.UnregPressureCB(mw.pcbName())
 	mw.excess.ticker.Stop()
 	mw.excess.stopCh.Close()
 	mw.excess.wg.Wait()
@@ -53,6 +53,9 @@ type (
 		// with GET requests from users (such as training models and apps)
 		// to perform on-the-fly transformation.
 		OfflineTransform(bck *meta.Bck, objName string, timeout time.Duration) (cos.ReadCloseSizer, error)
+		// OfflineTransformPayload transforms arbitrary payload that is not stored as an object -
+		// e.g., a group of objects in a many-to-one transform (push comm-types only)
+		OfflineTransformPayload(name string, payload io.Reader, size int64, timeout time.Duration) (cos.ReadCloseSizer, error)
 		Stop()
 
 		CommStats
@@ -150,6 +153,13 @@ func (c *baseComm) OutBytes() int64    { return c.boot.xctn.OutBytes() }
 
 func (c *baseComm) Stop() { c.boot.xctn.Finish() }
 
+// ETL container pulls the objects it transforms - cannot be used to transform payloads
+func (c *baseComm) errPayload(name string) error {
+	return cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: c.Name()},
+		"comm-type %q does not support transforming payloads (%s) - use %q or %q",
+		c.boot.msg.CommTypeX, name, Hpush, HpushStdin)
+}
+
 func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
 	if err := c.boot.xctn.AbortErr(); err != nil {
 		return nil, err
@@ -218,11 +228,8 @@ func (pc *pushComm) doRequest(bck *meta.Bck, lom *cluster.LOM, timeout time.Dura
 
 func (pc *pushComm) do(lom *cluster.LOM, timeout time.Duration) (_ cos.ReadCloseSizer, err error) {
 	var (
-		body   io.ReadCloser
-		cancel func()
-		req    *http.Request
-		resp   *http.Response
-		u      string
+		body io.ReadCloser
+		u    string
 	)
 	if err := pc.boot.xctn.AbortErr(); err != nil {
 		return nil, err
@@ -240,18 +247,26 @@ func (pc *pushComm) do(lom *cluster.LOM, timeout time.Duration) (_ cos.ReadClose
 		debug.Assertf(lom.Bck().Ns.IsGlobal(), lom.Bck().Cname("")+" - bucket with namespace")
 		u = pc.boot.uri + "/" + lom.Bck().Name + "/" + lom.ObjName
 
-		fh, err := cos.NewFileHandle(lom.FQN)
+		fh, oah, err := lom.NewUserROC() // (decompressing and/or decrypting, if need be)
 		if err != nil {
 			return nil, err
 		}
-		body = fh
+		body, size = fh, oah.SizeBytes()
 	case ArgTypeFQN:
 		body = http.NoBody
 		u = cos.JoinPath(pc.boot.uri, url.PathEscape(lom.FQN)) // compare w/ rc.redirectURL()
 	default:
 		cos.Assert(false) // is validated at construction time
 	}
+	return pc.put(u, body, size, timeout)
+}
 
+func (pc *pushComm) put(u string, body io.ReadCloser, size int64, timeout time.Duration) (_ cos.ReadCloseSizer, err error) {
+	var (
+		cancel func()
+		req    *http.Request
+		resp   *http.Response
+	)
 	if timeout != 0 {
 		var ctx context.Context
 		ctx, cancel = context.WithTimeout(context.Background(), timeout)
@@ -333,6 +348,23 @@ func (pc *pushComm) OfflineTransform(bck *meta.Bck, objName string, timeout time
 	return
 }
 
+// the payload (e.g., TAR-formatted group of objects) gets PUT as is, under the given name
+func (pc *pushComm) OfflineTransformPayload(name string, payload io.Reader, size int64, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	if err := pc.boot.xctn.AbortErr(); err != nil {
+		return nil, err
+	}
+	if pc.boot.msg.ArgTypeX == ArgTypeFQN {
+		return nil, cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: pc.Name()},
+			"arg-type %q does not support transforming payloads (%s)", ArgTypeFQN, name)
+	}
+	u := pc.boot.uri + "/" + name
+	r, err := pc.put(u, io.NopCloser(payload), size, timeout)
+	if pc.boot.config.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(Hpush, "payload", name, size, err)
+	}
+	return r, err
+}
+
 //////////////////
 // redirectComm: implements Hpull
 //////////////////
@@ -390,6 +422,10 @@ func (rc *redirectComm) OfflineTransform(bck *meta.Bck, objName string, timeout
 	return r, err
 }
 
+func (rc *redirectComm) OfflineTransformPayload(name string, _ io.Reader, _ int64, _ time.Duration) (cos.ReadCloseSizer, error) {
+	return nil, rc.errPayload(name)
+}
+
 //////////////////
 // revProxyComm: implements Hrev
 //////////////////
@@ -431,6 +467,10 @@ func (rp *revProxyComm) OfflineTransform(bck *meta.Bck, objName string, timeout
 	return r, err
 }
 
+func (rp *revProxyComm) OfflineTransformPayload(name string, _ io.Reader, _ int64, _ time.Duration) (cos.ReadCloseSizer, error) {
+	return nil, rp.errPayload(name)
+}
+
 //////////////
 // cbWriter //
 //////////////
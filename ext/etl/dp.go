@@ -5,6 +5,7 @@
 package etl
 
 import (
+	"io"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -27,7 +28,7 @@ type (
 )
 
 // interface guard
-var _ cluster.DP = (*OfflineDP)(nil)
+var _ cluster.PayloadDP = (*OfflineDP)(nil)
 
 func NewOfflineDP(msg *apc.TCBMsg, lsnode *meta.Snode, config *cmn.Config) (*OfflineDP, error) {
 	comm, err := GetCommunicator(msg.Transform.Name, lsnode)
@@ -75,3 +76,22 @@ func (dp *OfflineDP) Reader(lom *cluster.LOM) (cos.ReadOpenCloser, cos.OAH, erro
 	}
 	return cos.NopOpener(r), oah, nil
 }
+
+// Returns reader resulting from the transformation of an arbitrary payload
+// (e.g., TAR-formatted group of objects - see many-to-one transforms).
+// NOTE: unlike Reader() above, no retries - the payload cannot be re-read.
+func (dp *OfflineDP) PayloadReader(name string, payload io.Reader, size int64) (cos.ReadOpenCloser, cos.OAH, error) {
+	r, err := dp.comm.OfflineTransformPayload(name, payload, size, dp.requestTimeout)
+	if dp.config.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln("transform ["+dp.tcbmsg.Transform.Name+"] payload "+name, err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	oah := &cmn.ObjAttrs{
+		Size:  r.Size(),
+		Cksum: cos.NoneCksum,
+		Atime: time.Now().UnixNano(),
+	}
+	return cos.NopOpener(r), oah, nil
+}
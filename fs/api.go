@@ -16,6 +16,7 @@ type (
 		Capacity
 		Disks []string `json:"disks"` // owned disks (ios.FsDisks map => slice)
 		FS    string   `json:"fs"`    // cos.Fs + cos.FsID
+		Trend CapTrend `json:"trend"` // see captrend.go
 	}
 	// Target (cumulative) CDF
 	TargetCDF struct {
@@ -23,5 +24,6 @@ type (
 		PctMax     int32           `json:"pct_max"` // max used (%)
 		PctAvg     int32           `json:"pct_avg"` // avg used (%)
 		CsErr      string          `json:"cs_err"`  // OOS or high-wm error message
+		Trend      CapTrend        `json:"trend"`   // aggregated (see captrend.go)
 	}
 )
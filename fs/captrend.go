@@ -0,0 +1,112 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"time"
+)
+
+// Capacity trend: each mountpath keeps samples of its used capacity (at most one per
+// `trendSampleIval`) within the sliding `trendWindow`. The growth rate is the slope of
+// the least-squares fit, and the projection is the number of days until the used
+// capacity reaches space.highwm at that rate.
+// The target's trend is the sum of the mountpaths' rates and the minimum projection.
+
+const (
+	trendSampleIval = 10 * time.Minute
+	trendWindow     = 7 * 24 * time.Hour
+	trendMinSpan    = time.Hour // not enough data otherwise
+	numTrendSamples = int(trendWindow / trendSampleIval)
+
+	nsPerDay = float64(24 * time.Hour)
+)
+
+type (
+	CapTrend struct {
+		Rate       int64   `json:"rate"`         // growth rate: bytes per day (negative when shrinking)
+		DaysToHigh float64 `json:"days_to_high"` // days until space.highwm at the current rate (-1: never or unknown)
+	}
+	capSample struct {
+		ts   int64 // mono time
+		used uint64
+	}
+	capHist struct {
+		samples []capSample
+	}
+)
+
+func (t *CapTrend) Known() bool { return t.DaysToHigh >= 0 }
+
+// (the target's trend)
+func (t *CapTrend) aggr(mt *CapTrend) {
+	t.Rate += mt.Rate
+	if mt.Known() && (!t.Known() || mt.DaysToHigh < t.DaysToHigh) {
+		t.DaysToHigh = mt.DaysToHigh
+	}
+}
+
+/////////////
+// capHist //
+/////////////
+
+func (h *capHist) add(now int64, used uint64) {
+	l := len(h.samples)
+	if l > 0 && now-h.samples[l-1].ts < int64(trendSampleIval) {
+		return
+	}
+	// slide the window
+	var i int
+	for i < l && (now-h.samples[i].ts > int64(trendWindow) || l-i >= numTrendSamples) {
+		i++
+	}
+	if i > 0 {
+		h.samples = append(h.samples[:0], h.samples[i:]...)
+	}
+	h.samples = append(h.samples, capSample{ts: now, used: used})
+}
+
+// least-squares slope, in bytes per day
+func (h *capHist) rate() (float64, bool) {
+	n := len(h.samples)
+	if n < 2 || h.samples[n-1].ts-h.samples[0].ts < int64(trendMinSpan) {
+		return 0, false
+	}
+	var (
+		t0               = h.samples[0].ts
+		u0               = float64(h.samples[0].used)
+		sx, sy, sxx, sxy float64
+	)
+	for _, s := range h.samples {
+		x := float64(s.ts-t0) / nsPerDay
+		y := float64(s.used) - u0
+		sx += x
+		sy += y
+		sxx += x * x
+		sxy += x * y
+	}
+	fn := float64(n)
+	d := fn*sxx - sx*sx
+	if d == 0 {
+		return 0, false
+	}
+	return (fn*sxy - sx*sy) / d, true
+}
+
+func (h *capHist) trend(c *Capacity, highwm int64) (t CapTrend) {
+	t.DaysToHigh = -1
+	rate, ok := h.rate()
+	if !ok {
+		return
+	}
+	t.Rate = int64(rate)
+	high := float64(c.Used+c.Avail) * float64(highwm) / 100
+	switch {
+	case float64(c.Used) >= high:
+		t.DaysToHigh = 0
+	case rate > 0:
+		t.DaysToHigh = (high - float64(c.Used)) / rate
+	}
+	return
+}
@@ -0,0 +1,69 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestCapTrend(t *testing.T) {
+	const (
+		total = 100 * cos.GiB
+		daily = 2 * cos.GiB
+	)
+	var (
+		h    capHist
+		used = uint64(50 * cos.GiB)
+		now  = int64(time.Hour) // (mono)
+	)
+	// not enough data
+	h.add(now, used)
+	c := Capacity{Used: used, Avail: total - used}
+	if tr := h.trend(&c, 90); tr.Known() {
+		t.Fatalf("expected unknown trend, got %+v", tr)
+	}
+
+	// grow 2GiB a day, sampled every 5 minutes (recorded every 10) for 2 days
+	step := 5 * time.Minute
+	for i := 1; i <= int(48*time.Hour/step); i++ {
+		now += int64(step)
+		used += uint64(daily / int64(24*time.Hour/step))
+		h.add(now, used)
+	}
+	if l := len(h.samples); l != 48*6+1 {
+		t.Fatalf("expected %d samples, got %d", 48*6+1, l)
+	}
+	c = Capacity{Used: used, Avail: total - used}
+	tr := h.trend(&c, 90)
+	if math.Abs(float64(tr.Rate-daily)) > float64(daily)/100 {
+		t.Fatalf("expected rate ~%d, got %d", daily, tr.Rate)
+	}
+	expected := (0.9*total - float64(used)) / daily
+	if math.Abs(tr.DaysToHigh-expected) > 0.1 {
+		t.Fatalf("expected %.2f days to high-wm, got %.2f", expected, tr.DaysToHigh)
+	}
+
+	// no growth for over a week
+	for i := 0; i < numTrendSamples+10; i++ {
+		now += int64(trendSampleIval)
+		h.add(now, used)
+	}
+	if l := len(h.samples); l > numTrendSamples {
+		t.Fatalf("expected at most %d samples, got %d", numTrendSamples, l)
+	}
+	if tr = h.trend(&c, 90); tr.Known() || tr.Rate != 0 {
+		t.Fatalf("expected no growth, got %+v", tr)
+	}
+
+	// above high-wm
+	c = Capacity{Used: 95 * cos.GiB, Avail: 5 * cos.GiB}
+	if tr = h.trend(&c, 90); tr.DaysToHigh != 0 {
+		t.Fatalf("expected zero days (already above high-wm), got %+v", tr)
+	}
+}
@@ -38,6 +38,7 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+	TrashType    = "tr" // deleted objects (see space.trash_retention)
 )
 
 type (
@@ -117,6 +118,18 @@ func (f *contentSpecMgr) Gen(parts PartsFQN, contentType, prefix string) (fqn st
 	return parts.Mountpath().MakePathFQN(parts.Bucket(), contentType, objName)
 }
 
+// GenScratch is Gen that places temporary content (workfiles, dsort spills) on the
+// scratch mountpath, if configured (see Scratch) - and on the parts' own mountpath otherwise
+func (f *contentSpecMgr) GenScratch(parts PartsFQN, contentType, prefix string) string {
+	mi := Scratch()
+	if mi == nil {
+		return f.Gen(parts, contentType, prefix)
+	}
+	spec := f.m[contentType]
+	objName := spec.GenUniqueFQN(parts.ObjectName(), prefix)
+	return mi.MakePathFQN(parts.Bucket(), contentType, objName)
+}
+
 // FileSpec returns the specification/attributes and information about the `fqn`
 // (which must be generated by the Gen)
 func (f *contentSpecMgr) FileSpec(fqn string) (resolver ContentResolver, info *ContentInfo) {
@@ -176,6 +189,7 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+	TrashContentResolver    struct{}
 )
 
 func (*ObjectContentResolver) PermToMove() bool                   { return true }
@@ -244,3 +258,13 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+func (*TrashContentResolver) PermToMove() bool    { return false }
+func (*TrashContentResolver) PermToEvict() bool   { return true }
+func (*TrashContentResolver) PermToProcess() bool { return false }
+
+func (*TrashContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*TrashContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
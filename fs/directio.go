@@ -0,0 +1,158 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Direct I/O (see cmn.DirectIOConf and feat.DirectIO): object files are read and written with
+// the OS page cache bypassed - to avoid caching the same data twice when the working
+// set vastly exceeds RAM. O_DIRECT requires that file offsets, transfer sizes,
+// and memory buffers be aligned, which is what DirectWriter and DirectReader do
+// given a regular (memsys-allocated) buffer.
+
+const DirectIOAlign = 4096 // (logical block size of the vast majority of devices)
+
+type (
+	// writes full aligned blocks; the last (partial) block is zero-padded and
+	// the file gets truncated to its actual size (see Flush)
+	DirectWriter struct {
+		fh   *os.File
+		buf  []byte
+		n    int   // staged (not yet written) bytes
+		size int64 // total bytes written by the caller
+	}
+	// reads aligned blocks at aligned offsets
+	DirectReader struct {
+		fh   *os.File
+		buf  []byte
+		data []byte // read but not yet consumed
+		off  int64  // file offset of the next read
+		size int64
+	}
+)
+
+// interface guard
+var (
+	_ io.Writer = (*DirectWriter)(nil)
+	_ io.Reader = (*DirectReader)(nil)
+)
+
+// returns the largest aligned (both address and length) part of the buffer
+func AlignedBuf(buf []byte) []byte {
+	if len(buf) < DirectIOAlign {
+		return nil
+	}
+	var (
+		addr = uintptr(unsafe.Pointer(&buf[0]))
+		skip = int((DirectIOAlign - addr%DirectIOAlign) % DirectIOAlign)
+	)
+	buf = buf[skip:]
+	return buf[:len(buf)&^(DirectIOAlign-1)]
+}
+
+// TryDirectOpen is DirectOpen that falls back to a regular (buffered) open when
+// the underlying filesystem does not support direct I/O (e.g., tmpfs)
+func TryDirectOpen(path string, flag int, perm os.FileMode) (*os.File, error) {
+	fh, err := DirectOpen(path, flag, perm)
+	if err != nil && errors.Is(err, syscall.EINVAL) {
+		fh, err = os.OpenFile(path, flag, perm)
+	}
+	return fh, err
+}
+
+//////////////////
+// DirectWriter //
+//////////////////
+
+// `buf` must be at least 2*DirectIOAlign in size (e.g., memsys.DefaultBufSize)
+func NewDirectWriter(fh *os.File, buf []byte) *DirectWriter {
+	w := &DirectWriter{fh: fh, buf: AlignedBuf(buf)}
+	debug.Assert(len(w.buf) >= DirectIOAlign, len(buf))
+	return w
+}
+
+func (w *DirectWriter) Write(p []byte) (int, error) {
+	total := len(p)
+	for len(p) > 0 {
+		c := copy(w.buf[w.n:], p)
+		w.n += c
+		w.size += int64(c)
+		p = p[c:]
+		if w.n == len(w.buf) {
+			if _, err := w.fh.Write(w.buf); err != nil {
+				return total - len(p), err
+			}
+			w.n = 0
+		}
+	}
+	return total, nil
+}
+
+// must be called once, upon the last Write
+func (w *DirectWriter) Flush() error {
+	if w.n == 0 {
+		return nil
+	}
+	padded := (w.n + DirectIOAlign - 1) &^ (DirectIOAlign - 1)
+	clear(w.buf[w.n:padded])
+	if _, err := w.fh.Write(w.buf[:padded]); err != nil {
+		return err
+	}
+	partial := padded != w.n
+	w.n = 0
+	if !partial {
+		return nil
+	}
+	return w.fh.Truncate(w.size)
+}
+
+//////////////////
+// DirectReader //
+//////////////////
+
+// reads `size` bytes from the beginning of the file; `buf` - same as NewDirectWriter
+func NewDirectReader(fh *os.File, buf []byte, size int64) *DirectReader {
+	r := &DirectReader{fh: fh, buf: AlignedBuf(buf), size: size}
+	debug.Assert(len(r.buf) >= DirectIOAlign, len(buf))
+	return r
+}
+
+func (r *DirectReader) Read(p []byte) (n int, err error) {
+	if len(r.data) == 0 {
+		if r.off >= r.size {
+			return 0, io.EOF
+		}
+		var nr int
+		nr, err = r.fh.ReadAt(r.buf, r.off)
+		if int64(nr) > r.size-r.off {
+			nr = int(r.size - r.off)
+		}
+		r.off += int64(nr)
+		r.data = r.buf[:nr]
+		if err == io.EOF {
+			err = nil
+			if r.off < r.size {
+				err = io.ErrUnexpectedEOF
+			}
+		}
+		if nr == 0 {
+			if err == nil {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+	}
+	n = copy(p, r.data)
+	r.data = r.data[n:]
+	return n, err
+}
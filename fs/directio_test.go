@@ -0,0 +1,50 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestDirectIO(t *testing.T) {
+	dir := t.TempDir()
+	for _, size := range []int{0, 1, fs.DirectIOAlign, 3*fs.DirectIOAlign + 17, 100*1024 + 1} {
+		t.Run(fmt.Sprintf("size=%d", size), func(t *testing.T) {
+			var (
+				fqn  = filepath.Join(dir, cos.GenTie())
+				data = make([]byte, size)
+			)
+			cos.NowRand().Read(data)
+
+			fh, err := fs.TryDirectOpen(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+			tassert.CheckFatal(t, err)
+			w := fs.NewDirectWriter(fh, make([]byte, 32*1024+1))
+			_, err = io.CopyBuffer(cos.WriterOnly{Writer: w}, bytes.NewReader(data), make([]byte, 1000))
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, w.Flush())
+			tassert.CheckFatal(t, fh.Close())
+
+			finfo, err := os.Stat(fqn)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, finfo.Size() == int64(size), "expected size %d, got %d", size, finfo.Size())
+
+			fh, err = fs.TryDirectOpen(fqn, os.O_RDONLY, 0)
+			tassert.CheckFatal(t, err)
+			defer fh.Close()
+			read, err := io.ReadAll(fs.NewDirectReader(fh, make([]byte, 16*1024+3), int64(size)))
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, bytes.Equal(read, data), "read data differs from written")
+		})
+	}
+}
@@ -32,6 +32,9 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfilePatch        = "patch"          // partial update: received data
+	WorkfilePatchJournal = "patch-journal"  // partial update: original (overwritten) data
+	WorkfileETL          = "etl"            // ETL (offline transform) output
 )
 
 type ParsedFQN struct {
@@ -189,6 +192,8 @@ func CleanPathErr(err error) {
 			what = "ec slice"
 		case ECMetaType:
 			what = "ec metadata"
+		case TrashType:
+			what = "deleted object"
 		default:
 			what = "????"
 		}
@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/OneOfOne/xxhash"
@@ -34,6 +35,7 @@ const nodeXattrID = "user.ais.daemon_id"
 const (
 	FlagBeingDisabled uint64 = 1 << iota
 	FlagBeingDetached
+	FlagReadOnly // serves reads but does not take new writes (see SetReadOnly)
 )
 
 const FlagWaitingDD = FlagBeingDisabled | FlagBeingDetached
@@ -50,6 +52,8 @@ type (
 		lomCaches cos.MultiSyncMap // LOM caches
 		info      string
 		Path      string   // clean path
+		Label     string   // user-defined, optional (see cmn.FSPConf)
+		Quota     string   // ditto
 		cos.FS             // underlying filesystem
 		Disks     []string // owned disks (ios.FsDisks map => slice)
 		bpc       struct {
@@ -57,6 +61,9 @@ type (
 			sync.RWMutex
 		}
 		capacity   Capacity
+		hist       capHist // capacity trend (see captrend.go)
+		quota      cos.ParsedQuantity
+		overQuota  atomic.Bool
 		flags      uint64 // bit flags (set/get atomic)
 		PathDigest uint64 // (HRW logic)
 		cmu        sync.RWMutex
@@ -76,6 +83,10 @@ type (
 		// Disabled mountpaths - mountpaths which for some reason did not pass
 		// the health check and cannot be used for a moment.
 		disabled atomic.Pointer
+		// whether any of the available mountpaths is labeled
+		labeled atomic.Bool
+		// ditto, read-only
+		readOnly atomic.Bool
 
 		// capacity
 		cs        CapStatus
@@ -95,6 +106,8 @@ type (
 		PctAvg     int32  // used average (%)
 		PctMax     int32  // max used (%)
 		OOS        bool
+		OverQuota  bool // at least one mountpath exceeds its capacity quota (see cmn.FSPConf)
+		Trend      CapTrend
 	}
 )
 
@@ -104,7 +117,7 @@ var mfs *MountedFS
 // Mountpath //
 ///////////////////
 
-func NewMountpath(mpath string) (mi *Mountpath, err error) {
+func NewMountpath(mpath, label, quota string) (mi *Mountpath, err error) {
 	var (
 		cleanMpath string
 		fsInfo     cos.FS
@@ -112,6 +125,12 @@ func NewMountpath(mpath string) (mi *Mountpath, err error) {
 	if cleanMpath, err = cmn.ValidateMpath(mpath); err != nil {
 		return
 	}
+	if err = cmn.ValidateMpathLabel(label); err != nil {
+		return
+	}
+	if err = cmn.ValidateMpathQuota(quota); err != nil {
+		return
+	}
 	if err = cos.Stat(cleanMpath); err != nil {
 		return nil, cos.NewErrNotFound("mountpath %q", mpath)
 	}
@@ -120,9 +139,14 @@ func NewMountpath(mpath string) (mi *Mountpath, err error) {
 	}
 	mi = &Mountpath{
 		Path:       cleanMpath,
+		Label:      label,
+		Quota:      quota,
 		FS:         fsInfo,
 		PathDigest: xxhash.ChecksumString64S(cleanMpath, cos.MLCG32),
 	}
+	if quota != "" {
+		mi.quota, _ = cos.ParseQuantity(quota)
+	}
 	mi.bpc.m = make(map[uint64]string, 16)
 	return
 }
@@ -146,6 +170,12 @@ func (mi *Mountpath) String() string {
 		default:
 			mi.info = fmt.Sprintf("mp[%s, %v]", mi.Path, mi.Disks)
 		}
+		if mi.Label != "" {
+			mi.info = mi.info[:len(mi.info)-1] + ", label=" + mi.Label + "]"
+		}
+		if mi.Quota != "" {
+			mi.info = mi.info[:len(mi.info)-1] + ", quota=" + mi.Quota + "]"
+		}
 	}
 	if !mi.IsAnySet(FlagWaitingDD) {
 		return mi.info
@@ -393,6 +423,13 @@ func (mi *Mountpath) _setDisks(fsdisks ios.FsDisks) {
 
 // available/used capacity
 
+func (mi *Mountpath) CapTrend(config *cmn.Config) (t CapTrend) {
+	mi.cmu.RLock()
+	t = mi.hist.trend(&mi.capacity, config.Space.HighWM)
+	mi.cmu.RUnlock()
+	return
+}
+
 func (mi *Mountpath) getCapacity(config *cmn.Config, refresh bool) (c Capacity, err error) {
 	if !refresh {
 		mi.cmu.RLock()
@@ -417,10 +454,34 @@ func (mi *Mountpath) getCapacity(config *cmn.Config, refresh bool) (c Capacity,
 	mi.capacity.Avail = statfs.Bavail * uint64(statfs.Bsize)
 	mi.capacity.PctUsed = int32(pct)
 	c = mi.capacity
+	mi.hist.add(mono.NanoTime(), c.Used)
 	mi.cmu.Unlock()
+	if limit := mi.QuotaSize(statfs.Blocks * uint64(statfs.Bsize)); limit > 0 {
+		mi.overQuota.Store(c.Used > limit)
+	}
 	return
 }
 
+// capacity quota in bytes given the total capacity of the filesystem (0 - no quota)
+func (mi *Mountpath) QuotaSize(total uint64) uint64 {
+	switch mi.quota.Type {
+	case cos.QuantityPercent:
+		return total * mi.quota.Value / 100
+	case cos.QuantityBytes:
+		return mi.quota.Value
+	default:
+		return 0
+	}
+}
+
+// as of the last capacity refresh
+func (mi *Mountpath) OverQuota() bool { return mi.overQuota.Load() }
+
+func (mi *Mountpath) ErrOverQuota() error {
+	c, _ := mi.getCapacity(nil, false)
+	return fmt.Errorf("%s: used capacity %s exceeds the quota (%s)", mi, cos.ToSizeIEC(int64(c.Used), 2), mi.Quota)
+}
+
 //
 // mountpath add/enable helpers - always call under mfs lock
 //
@@ -554,8 +615,48 @@ func NumAvail() int {
 	return len(*availablePaths)
 }
 
-func putAvailMPI(available MPI) { mfs.available.Store(unsafe.Pointer(&available)) }
-func putDisabMPI(disabled MPI)  { mfs.disabled.Store(unsafe.Pointer(&disabled)) }
+func putAvailMPI(available MPI) {
+	var labeled, readOnly bool
+	for _, mi := range available {
+		labeled = labeled || mi.Label != ""
+		readOnly = readOnly || mi.IsReadOnly()
+	}
+	mfs.labeled.Store(labeled)
+	mfs.readOnly.Store(readOnly)
+	mfs.available.Store(unsafe.Pointer(&available))
+}
+
+func putDisabMPI(disabled MPI) { mfs.disabled.Store(unsafe.Pointer(&disabled)) }
+
+// whether any of the available mountpaths is labeled (see cmn.FSPConf)
+func AnyLabeled() bool { return mfs.labeled.Load() }
+
+// whether any of the available mountpaths is read-only (see SetReadOnly)
+func AnyReadOnly() bool { return mfs.readOnly.Load() }
+
+// ScratchLabel returns the configured label of the scratch mountpath (see cmn.SpaceConf) -
+// but only if there are labeled mountpaths
+func ScratchLabel() string {
+	if !AnyLabeled() {
+		return ""
+	}
+	return cmn.GCO.Get().Space.Scratch
+}
+
+// Scratch returns the (writable) scratch mountpath, if configured and available - nil otherwise
+func Scratch() *Mountpath {
+	label := ScratchLabel()
+	if label == "" {
+		return nil
+	}
+	availablePaths := GetAvail()
+	for _, mi := range availablePaths {
+		if mi.Label == label && !mi.IsAnySet(FlagWaitingDD|FlagReadOnly) {
+			return mi
+		}
+	}
+	return nil
+}
 
 func PutMPI(available, disabled MPI) {
 	putAvailMPI(available)
@@ -572,16 +673,31 @@ func MountpathsToLists() (mpl *apc.MountpathList) {
 	for _, mi := range availablePaths {
 		if mi.IsAnySet(FlagWaitingDD) {
 			mpl.WaitingDD = append(mpl.WaitingDD, mi.Path)
-		} else {
-			mpl.Available = append(mpl.Available, mi.Path)
+			continue
+		}
+		mpl.Available = append(mpl.Available, mi.Path)
+		if mi.IsReadOnly() {
+			mpl.ReadOnly = append(mpl.ReadOnly, mi.Path)
 		}
 	}
 	for mpath := range disabledPaths {
 		mpl.Disabled = append(mpl.Disabled, mpath)
 	}
+	for _, mpis := range []MPI{availablePaths, disabledPaths} {
+		for mpath, mi := range mpis {
+			if mi.Label == "" {
+				continue
+			}
+			if mpl.Labels == nil {
+				mpl.Labels = make(map[string]string, len(mpis))
+			}
+			mpl.Labels[mpath] = mi.Label
+		}
+	}
 	sort.Strings(mpl.Available)
 	sort.Strings(mpl.WaitingDD)
 	sort.Strings(mpl.Disabled)
+	sort.Strings(mpl.ReadOnly)
 	return
 }
 
@@ -604,7 +720,7 @@ func cloneMPI() (availableCopy, disabledCopy MPI) {
 
 // (used only in _unit_ tests - compare with AddMpath below)
 func Add(mpath, tid string) (mi *Mountpath, err error) {
-	mi, err = NewMountpath(mpath)
+	mi, err = NewMountpath(mpath, "" /*label*/, "" /*quota*/)
 	if err != nil {
 		return
 	}
@@ -617,9 +733,9 @@ func Add(mpath, tid string) (mi *Mountpath, err error) {
 
 // Add adds new mountpath to the target's `availablePaths`
 // TODO: extend `force=true` to disregard "filesystem sharing"
-func AddMpath(mpath, tid string, cb func(), force bool) (mi *Mountpath, err error) {
+func AddMpath(mpath, label, quota, tid string, cb func(), force bool) (mi *Mountpath, err error) {
 	debug.Assert(tid != "")
-	mi, err = NewMountpath(mpath)
+	mi, err = NewMountpath(mpath, label, quota)
 	if err != nil {
 		return
 	}
@@ -874,6 +990,49 @@ func Disable(mpath string, cb ...func()) (disabledMpath *Mountpath, err error) {
 	return nil, cmn.NewErrMountpathNotFound(mpath, "" /*fqn*/, false /*disabled*/)
 }
 
+// SetReadOnly sets (or clears) read-only mode of an available mountpath.
+// A read-only mountpath keeps serving existing content while new writes
+// are redirected to (HRW-selected) writable mountpaths.
+// Returns nil mountpath when there's nothing to do.
+func SetReadOnly(mpath string, readOnly bool, cb func()) (*Mountpath, error) {
+	cleanMpath, err := cmn.ValidateMpath(mpath)
+	if err != nil {
+		return nil, err
+	}
+
+	mfs.mu.Lock()
+	defer mfs.mu.Unlock()
+
+	availablePaths, disabledPaths := Get()
+	mi, ok := availablePaths[cleanMpath]
+	if !ok {
+		if _, ok = disabledPaths[cleanMpath]; ok {
+			return nil, cmn.NewErrMountpathNotFound(mpath, "" /*fqn*/, true /*disabled*/)
+		}
+		return nil, cmn.NewErrMountpathNotFound(mpath, "" /*fqn*/, false /*disabled*/)
+	}
+	if mi.IsAnySet(FlagWaitingDD) {
+		return nil, fmt.Errorf("%s is being disabled or detached", mi)
+	}
+	if mi.IsAnySet(FlagReadOnly) == readOnly {
+		return nil, nil // nothing to do
+	}
+	if readOnly {
+		ok = mi.setFlags(FlagReadOnly)
+	} else {
+		ok = cos.ClearfAtomic(&mi.flags, FlagReadOnly)
+	}
+	debug.Assert(ok, mi.String()) // under lock
+	putAvailMPI(_cloneOne(availablePaths))
+	if cb != nil {
+		cb()
+	}
+	nlog.Infof("%s: read-only=%t", mi, readOnly)
+	return mi, nil
+}
+
+func (mi *Mountpath) IsReadOnly() bool { return mi.IsAnySet(FlagReadOnly) }
+
 // returns both available and disabled mountpaths (compare with GetAvail)
 func Get() (MPI, MPI) {
 	var (
@@ -943,6 +1102,11 @@ func DestroyBucket(op string, bck *cmn.Bck, bid uint64) (err error) {
 		} else {
 			n++
 		}
+		if mi.sidecars() {
+			if errMv := mi.MoveToDeleted(mi.scDir(dir)); errMv != nil {
+				nlog.Errorf("%s %q: failed to rm sidecars of %q: %v", op, bck, dir, errMv)
+			}
+		}
 	}
 	if n < count {
 		err = fmt.Errorf("%s %q: failed to destroy %d out of %d dirs", op, bck, count-n, count)
@@ -965,6 +1129,9 @@ func RenameBucketDirs(bidFrom uint64, bckFrom, bckTo *cmn.Bck) (err error) {
 		if err = os.Rename(fromPath, toPath); err != nil {
 			break
 		}
+		if mi.sidecars() {
+			mi.renameSidecars(fromPath, toPath)
+		}
 		renamed = append(renamed, mi)
 	}
 
@@ -976,6 +1143,8 @@ func RenameBucketDirs(bidFrom uint64, bckFrom, bckTo *cmn.Bck) (err error) {
 		toPath := mi.MakePathBck(bckFrom)
 		if erd := os.Rename(fromPath, toPath); erd != nil {
 			nlog.Errorln(erd)
+		} else if mi.sidecars() {
+			mi.renameSidecars(fromPath, toPath)
 		}
 	}
 	return
@@ -1103,6 +1272,7 @@ func CapRefresh(config *cmn.Config, tcdf *TargetCDF) (cs CapStatus, err error) {
 		config = cmn.GCO.Get()
 	}
 	high, oos := config.Space.HighWM, config.Space.OOS
+	cs.Trend.DaysToHigh = -1
 	for path, mi := range availablePaths {
 		if c, err = mi.getCapacity(config, true); err != nil {
 			nlog.Errorf("%s: %v", mi, err)
@@ -1112,6 +1282,9 @@ func CapRefresh(config *cmn.Config, tcdf *TargetCDF) (cs CapStatus, err error) {
 		cs.TotalAvail += c.Avail
 		cs.PctMax = cos.MaxI32(cs.PctMax, c.PctUsed)
 		cs.PctAvg += c.PctUsed
+		cs.OverQuota = cs.OverQuota || mi.OverQuota()
+		mt := mi.CapTrend(config)
+		cs.Trend.aggr(&mt)
 		if tcdf == nil {
 			continue
 		}
@@ -1121,6 +1294,7 @@ func CapRefresh(config *cmn.Config, tcdf *TargetCDF) (cs CapStatus, err error) {
 			cdf = tcdf.Mountpaths[path]
 		}
 		cdf.Capacity = c
+		cdf.Trend = mt
 		cdf.Disks = mi.Disks
 		cdf.FS = mi.FS.String()
 	}
@@ -1132,6 +1306,7 @@ func CapRefresh(config *cmn.Config, tcdf *TargetCDF) (cs CapStatus, err error) {
 	}
 	if tcdf != nil {
 		tcdf.PctMax, tcdf.PctAvg = cs.PctMax, cs.PctAvg
+		tcdf.Trend = cs.Trend
 		tcdf.CsErr = errmsg
 	}
 	// cached cap state
@@ -1174,6 +1349,9 @@ func (cs *CapStatus) String() (str string) {
 		totalAvail = cos.ToSizeIEC(int64(cs.TotalAvail), 1)
 	)
 	str = fmt.Sprintf("cap(used=%s, avail=%s, avg-use=%d%%, max-use=%d%%", totalUsed, totalAvail, cs.PctAvg, cs.PctMax)
+	if cs.OverQuota {
+		str += ", over-quota"
+	}
 	if cs.Err != nil {
 		if cs.OOS {
 			str += ", OOS"
@@ -36,6 +36,16 @@ func makeFsInfo(mpath string) (fsInfo cos.FS, err error) {
 	}, nil
 }
 
+// DevID returns the ID of the device containing the named file (directory) -
+// different from its parent's when the directory is a mount point
+func DevID(path string) (uint64, error) {
+	var st syscall.Stat_t
+	if err := syscall.Stat(path, &st); err != nil {
+		return 0, err
+	}
+	return uint64(st.Dev), nil
+}
+
 // DirectOpen opens a file with direct disk access (with OS caching disabled).
 func DirectOpen(path string, flag int, perm os.FileMode) (*os.File, error) {
 	file, err := os.OpenFile(path, flag, perm)
@@ -42,6 +42,16 @@ func makeFsInfo(mpath string) (fsInfo cos.FS, err error) {
 	return cos.FS{Fs: fs, FsType: fsType, FsID: fsStats.Fsid.X__val}, nil
 }
 
+// DevID returns the ID of the device containing the named file (directory) -
+// different from its parent's when the directory is a mount point
+func DevID(path string) (uint64, error) {
+	var st syscall.Stat_t
+	if err := syscall.Stat(path, &st); err != nil {
+		return 0, err
+	}
+	return st.Dev, nil
+}
+
 // DirectOpen opens a file with direct disk access (with OS caching disabled).
 func DirectOpen(path string, flag int, perm os.FileMode) (*os.File, error) {
 	return os.OpenFile(path, syscall.O_DIRECT|flag, perm)
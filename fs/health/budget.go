@@ -0,0 +1,184 @@
+// Package health provides a basic mountpath health monitor.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package health
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Per-mountpath I/O error budget: every I/O error reported via OnErr is counted -
+// by type (read, write, other) - within a sliding time window (config.fshc.io_err_window).
+// Once the mountpath's errors within the window exceed config.fshc.io_err_limit
+// the mountpath gets disabled.
+//
+// Mountpaths disabled this way are then periodically (config.fshc.probe_interval)
+// probed with the same read/write test and get re-enabled once they pass it.
+
+const (
+	errRead = iota
+	errWrite
+	errOther
+	numErrTypes
+)
+
+var errTypeNames = [numErrTypes]string{"read", "write", "other"}
+
+type (
+	ioErr struct {
+		fqn string
+		typ int
+	}
+	// timestamps (mono) of the errors within the window, by type
+	errWindow struct {
+		ts [numErrTypes][]int64
+	}
+)
+
+// classify by the failed syscall (os.PathError and friends), if available
+func errType(err error) int {
+	var (
+		perr *os.PathError
+		lerr *os.LinkError
+	)
+	switch {
+	case errors.As(err, &perr):
+		return opType(perr.Op)
+	case errors.As(err, &lerr):
+		return opType(lerr.Op)
+	}
+	return errOther
+}
+
+func opType(op string) int {
+	switch {
+	case strings.HasPrefix(op, "read"), op == "pread", op == "getxattr", op == "stat", op == "lstat":
+		return errRead
+	case strings.HasPrefix(op, "write"), op == "pwrite", op == "sync", op == "fsync", op == "setxattr",
+		op == "truncate", op == "mkdir", op == "rename", op == "remove", op == "unlinkat":
+		return errWrite
+	}
+	return errOther
+}
+
+///////////////
+// errWindow //
+///////////////
+
+// add the error and return the number of errors (all types) within the window
+func (w *errWindow) add(typ int, now int64, window time.Duration) (total int) {
+	oldest := now - int64(window)
+	w.ts[typ] = append(w.ts[typ], now)
+	for i := range w.ts {
+		ts := w.ts[i]
+		j := 0
+		for j < len(ts) && ts[j] <= oldest {
+			j++
+		}
+		if j > 0 {
+			w.ts[i] = append(ts[:0], ts[j:]...)
+		}
+		total += len(w.ts[i])
+	}
+	return total
+}
+
+func (w *errWindow) String() string {
+	var sb strings.Builder
+	for i := range w.ts {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(errTypeNames[i])
+		sb.WriteByte(':')
+		sb.WriteString(strconv.Itoa(len(w.ts[i])))
+	}
+	return sb.String()
+}
+
+//////////
+// FSHC //
+//////////
+
+// (runs in the FSHC goroutine)
+func (f *FSHC) onErr(config *cmn.Config, e ioErr) {
+	mi, err := fs.Path2Mpath(e.fqn)
+	if err != nil {
+		// not a mountpath or the one that's already disabled
+		if config.FastV(4, cos.SmoduleFS) {
+			nlog.Infoln(err)
+		}
+		return
+	}
+	w, ok := f.errs[mi.Path]
+	if !ok {
+		w = &errWindow{}
+		f.errs[mi.Path] = w
+	}
+	var (
+		window = config.FSHC.ErrWindow()
+		limit  = config.FSHC.ErrLimit()
+		total  = w.add(e.typ, mono.NanoTime(), window)
+	)
+	if total <= limit {
+		return
+	}
+	reason := fmt.Sprintf("exceeded I/O error budget: %s within %v (limit %d)", w, window, limit)
+	delete(f.errs, mi.Path)
+
+	nlog.Errorf("Disabling mountpath %s: %s", mi, reason)
+	if err := f.dispatcher.DisableMpath(mi.Path, reason); err != nil {
+		nlog.Errorf("Failed to disable mountpath: %v", err)
+		return
+	}
+	f.probes[mi.Path] = struct{}{}
+}
+
+// probe mountpaths disabled due to exceeded error budget (above)
+func (f *FSHC) probeDisabled(config *cmn.Config) {
+	_, disabled := fs.Get()
+	for mpath := range f.probes {
+		if _, ok := disabled[mpath]; !ok {
+			// enabled or detached in the meantime
+			delete(f.probes, mpath)
+			continue
+		}
+		if !f.probe(config, mpath) {
+			continue
+		}
+		nlog.Infof("Re-enabling mountpath %s (passed the test)", mpath)
+		if err := f.dispatcher.EnableMpath(mpath); err != nil {
+			nlog.Errorf("Failed to re-enable mountpath %s: %v", mpath, err)
+			continue
+		}
+		delete(f.probes, mpath)
+	}
+}
+
+func (*FSHC) probe(config *cmn.Config, mpath string) bool {
+	readErrs, writeErrs, exists := testMountpath(config, "", mpath, fshcFileSize)
+	passed, err := isTestPassed(mpath, readErrs, writeErrs, exists)
+	if !passed {
+		nlog.Warningf("Mountpath %s remains disabled: %v", mpath, err)
+	}
+	return passed
+}
+
+func probeNext(config *cmn.Config) time.Duration {
+	if j := config.FSHC.ProbeInterval.D(); j != 0 {
+		return j
+	}
+	return cmn.MinProbeInterval
+}
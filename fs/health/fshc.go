@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -25,19 +26,25 @@ const (
 	fshcTemp = "fshc"
 )
 
-// When an IO error is triggered, it runs a few tests to make sure that the
-// failed mountpath is healthy. Once the mountpath is considered faulty the
-// mountpath is disabled and removed from the list.
+// Every (severe) IO error is accounted against the corresponding mountpath's
+// error budget (see budget.go). Once the budget is exceeded the mountpath is
+// disabled and removed from the list - and may later get re-enabled
+// if it passes the read/write test (below).
 //
 // for mountpath definition, see fs/mountfs.go
 type (
 	fspathDispatcher interface {
 		DisableMpath(mpath, reason string) (err error)
+		EnableMpath(mpath string) (err error)          // re-enable upon passing the test (see budget.go)
+		SuspectMpath(mpath, reason string) (err error) // make read-only (see smart.go)
 	}
 	FSHC struct {
-		dispatcher fspathDispatcher // listener is notified upon mountpath events (disabled, etc.)
-		fileListCh chan string
-		stopCh     cos.StopCh
+		dispatcher   fspathDispatcher // listener is notified upon mountpath events (disabled, etc.)
+		errCh        chan ioErr
+		errs         map[string]*errWindow // mountpath => recent IO errors
+		probes       map[string]struct{}   // mountpaths disabled due to exceeded error budget
+		stopCh       cos.StopCh
+		smartUnavail bool // smartctl not installed
 	}
 )
 
@@ -49,7 +56,12 @@ type (
 var _ cos.Runner = (*FSHC)(nil)
 
 func NewFSHC(dispatcher fspathDispatcher) (f *FSHC) {
-	f = &FSHC{dispatcher: dispatcher, fileListCh: make(chan string, 100)}
+	f = &FSHC{
+		dispatcher: dispatcher,
+		errCh:      make(chan ioErr, 100),
+		errs:       make(map[string]*errWindow, 4),
+		probes:     make(map[string]struct{}, 2),
+	}
 	f.stopCh.Init()
 	return
 }
@@ -59,16 +71,27 @@ func (*FSHC) Name() string { return "fshc" }
 func (f *FSHC) Run() error {
 	nlog.Infof("Starting %s", f.Name())
 
+	config := cmn.GCO.Get()
+	smartTimer := time.NewTimer(smartNext(config))
+	defer smartTimer.Stop()
+	probeTimer := time.NewTimer(probeNext(config))
+	defer probeTimer.Stop()
 	for {
 		select {
-		case filePath := <-f.fileListCh:
-			mi, err := fs.Path2Mpath(filePath)
-			if err != nil {
-				nlog.Errorln(err)
-				break
+		case <-smartTimer.C:
+			config := cmn.GCO.Get()
+			if config.FSHC.Enabled && config.FSHC.SmartInterval != 0 && !f.smartUnavail {
+				f.checkSmart(config)
 			}
-
-			f.runMpathTest(mi.Path, filePath)
+			smartTimer.Reset(smartNext(config))
+		case <-probeTimer.C:
+			config := cmn.GCO.Get()
+			if config.FSHC.Enabled && config.FSHC.ProbeInterval != 0 && len(f.probes) > 0 {
+				f.probeDisabled(config)
+			}
+			probeTimer.Reset(probeNext(config))
+		case e := <-f.errCh:
+			f.onErr(cmn.GCO.Get(), e)
 		case <-f.stopCh.Listen():
 			return nil
 		}
@@ -80,11 +103,11 @@ func (f *FSHC) Stop(err error) {
 	f.stopCh.Close()
 }
 
-func (f *FSHC) OnErr(fqn string) {
+func (f *FSHC) OnErr(err error, fqn string) {
 	if !cmn.GCO.Get().FSHC.Enabled {
 		return
 	}
-	f.fileListCh <- fqn
+	f.errCh <- ioErr{fqn: fqn, typ: errType(err)}
 }
 
 func isTestPassed(mpath string, readErrors, writeErrors int, available bool) (passed bool, err error) {
@@ -106,22 +129,6 @@ func isTestPassed(mpath string, readErrors, writeErrors int, available bool) (pa
 	return passed, err
 }
 
-func (f *FSHC) runMpathTest(mpath, filepath string) {
-	var (
-		config    = cmn.GCO.Get()
-		whyFailed error
-		passed    bool
-	)
-	readErrs, writeErrs, exists := testMountpath(config, filepath, mpath, fshcFileSize)
-	if passed, whyFailed = isTestPassed(mpath, readErrs, writeErrs, exists); passed {
-		return
-	}
-	nlog.Errorf("Disabling mountpath %s...", mpath)
-	if err := f.dispatcher.DisableMpath(mpath, whyFailed.Error()); err != nil {
-		nlog.Errorf("Failed to disable mountpath: %s", err.Error())
-	}
-}
-
 // reads the entire file content
 func tryReadFile(fqn string) error {
 	file, err := fs.DirectOpen(fqn, os.O_RDONLY, 0)
@@ -138,14 +145,12 @@ func tryReadFile(fqn string) error {
 // Creates a random file in a random directory inside a mountpath.
 func tryWriteFile(mpath string, fileSize int64) error {
 	const ftag = "temp file"
-	// Do not test a mountpath if it is already disabled. To avoid a race
-	// when a lot of PUTs fail and each one calls FSHC, FSHC disables
-	// the mountpath on the first run, so all other tryWriteFile are redundant
+	// (disabled mountpaths included - see probeDisabled)
 	available, disabled := fs.Get()
-	if _, ok := disabled[mpath]; ok {
-		return nil
-	}
 	mi, ok := available[mpath]
+	if !ok {
+		mi, ok = disabled[mpath]
+	}
 	if !ok {
 		nlog.Warningf("Tried to write %s to non-existing mountpath %q", ftag, mpath)
 		return nil
@@ -7,7 +7,9 @@ package health
 import (
 	"fmt"
 	"os"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -51,12 +53,14 @@ func updateTestConfig() {
 	config.FSHC.Enabled = true
 	config.FSHC.ErrorLimit = 2
 	config.FSHC.TestFileCount = 4
+	config.FSHC.IOErrLimit = 2
 	config.Log.Level = "3"
 	cmn.GCO.CommitUpdate(config)
 }
 
 type MockFSDispatcher struct {
 	faultyPaths   []string
+	enabled       []string
 	faultDetected bool
 }
 
@@ -74,6 +78,15 @@ func (d *MockFSDispatcher) DisableMpath(mpath, reason string) (err error) {
 	return
 }
 
+func (d *MockFSDispatcher) EnableMpath(mpath string) error {
+	d.enabled = append(d.enabled, mpath)
+	return nil
+}
+
+func (d *MockFSDispatcher) SuspectMpath(mpath, reason string) error {
+	return d.DisableMpath(mpath, reason)
+}
+
 func setupTests(t *testing.T) {
 	updateTestConfig()
 	initMountpaths(t)
@@ -99,11 +112,51 @@ func TestFSCheckerFailedMountpath(t *testing.T) {
 		dispatcher = newMockFSDispatcher(failedMpath)
 		fshc       = NewFSHC(dispatcher)
 	)
-	// Failed mountpath must be disabled.
-	fshc.runMpathTest(failedMpath, failedMpath+"/dir/testfile")
+	// Failed mountpath must be disabled once the error budget is exceeded.
+	config := cmn.GCO.Get()
+	for i := 0; i < config.FSHC.ErrLimit(); i++ {
+		fshc.onErr(config, ioErr{fqn: failedMpath + "/dir/testfile", typ: errRead})
+	}
+	tassert.Errorf(t, !dispatcher.faultDetected, "mountpath %s disabled prematurely", failedMpath)
+	fshc.onErr(config, ioErr{fqn: failedMpath + "/dir/testfile", typ: errWrite})
 	tassert.Errorf(t, dispatcher.faultDetected, "faulty mountpath %s was not detected", failedMpath)
 }
 
+func TestFSCheckerErrWindow(t *testing.T) {
+	var (
+		w      errWindow
+		window = time.Minute
+		now    = int64(time.Hour)
+	)
+	tassert.Errorf(t, w.add(errRead, now, window) == 1, "expected 1 error")
+	tassert.Errorf(t, w.add(errWrite, now+int64(window/2), window) == 2, "expected 2 errors")
+	// the first error slides out of the window
+	total := w.add(errOther, now+int64(window), window)
+	tassert.Errorf(t, total == 2, "expected 2 errors, got %d (%s)", total, &w)
+	tassert.Errorf(t, w.String() == "read:0, write:1, other:1", "unexpected %q", w.String())
+
+	tassert.Errorf(t, errType(&os.PathError{Op: "read", Err: syscall.EIO}) == errRead, "expected read")
+	tassert.Errorf(t, errType(&os.PathError{Op: "write", Err: syscall.EIO}) == errWrite, "expected write")
+	tassert.Errorf(t, errType(syscall.EIO) == errOther, "expected other")
+}
+
+func TestFSCheckerProbeDisabled(t *testing.T) {
+	setupTests(t)
+
+	var (
+		disabledMpath = fsCheckerTmpDir + "/4"
+
+		dispatcher = newMockFSDispatcher()
+		fshc       = NewFSHC(dispatcher)
+	)
+	fshc.probes[disabledMpath] = struct{}{}
+	fshc.probes[fsCheckerTmpDir+"/1"] = struct{}{} // (not disabled)
+	fshc.probeDisabled(cmn.GCO.Get())
+	tassert.Fatalf(t, len(dispatcher.enabled) == 1 && dispatcher.enabled[0] == disabledMpath,
+		"expected %s to be re-enabled, got %v", disabledMpath, dispatcher.enabled)
+	tassert.Errorf(t, len(fshc.probes) == 0, "expected no mountpaths to probe, got %d", len(fshc.probes))
+}
+
 func TestFSCheckerDecisionFn(t *testing.T) {
 	updateTestConfig()
 
@@ -157,3 +210,49 @@ func TestFSCheckerTryWriteFile(t *testing.T) {
 	err := tryWriteFile(mpath, cos.KiB)
 	tassert.CheckFatal(t, err)
 }
+
+func TestFSCheckerParseSmart(t *testing.T) {
+	const limit = 8
+	testList := []struct {
+		title  string
+		out    string
+		err    bool // parsing error
+		passed bool // check(limit)
+	}{
+		{"ATA healthy",
+			`{"smartctl":{"exit_status":0},"smart_status":{"passed":true},
+			"ata_smart_attributes":{"table":[{"id":5,"raw":{"value":2}},{"id":9,"raw":{"value":40000}},{"id":197,"raw":{"value":1}}]}}`,
+			false, true},
+		{"ATA too many bad sectors",
+			`{"smartctl":{"exit_status":0},"smart_status":{"passed":true},
+			"ata_smart_attributes":{"table":[{"id":5,"raw":{"value":6}},{"id":197,"raw":{"value":3}}]}}`,
+			false, false},
+		{"NVMe media errors",
+			`{"smartctl":{"exit_status":0},"smart_status":{"passed":true},"nvme_smart_health_information_log":{"media_errors":100}}`,
+			false, false},
+		{"SCSI grown defects",
+			`{"smartctl":{"exit_status":0},"smart_status":{"passed":true},"scsi_grown_defect_list":3}`,
+			false, true},
+		{"Failing health (status bit 3)",
+			`{"smartctl":{"exit_status":8},"smart_status":{"passed":false}}`,
+			false, false},
+		{"Device open failed",
+			`{"smartctl":{"exit_status":2}}`,
+			true, false},
+		{"No SMART support",
+			`{"smartctl":{"exit_status":4}}`,
+			true, false},
+	}
+	for _, tst := range testList {
+		t.Run(tst.title, func(t *testing.T) {
+			stat, err := parseSmart([]byte(tst.out))
+			if tst.err {
+				tassert.Errorf(t, err != nil, "expected parsing error")
+				return
+			}
+			tassert.CheckFatal(t, err)
+			err = stat.check(limit)
+			tassert.Errorf(t, (err == nil) == tst.passed, "expected passed=%t, got %v (%+v)", tst.passed, err, stat)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Package health provides a basic mountpath health monitor.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package health
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Proactive (SMART-based) disk health monitoring: periodically (config.fshc.smart_interval)
+// run `smartctl` for each disk of each available mountpath and, if the disk reports
+// failing health or too many reallocated/pending sectors (NVMe: media errors),
+// mark the mountpath read-only or disable it (config.fshc.smart_disable) -
+// before hard I/O errors start corrupting stored objects.
+
+const smartctlBin = "smartctl"
+
+// ATA attribute IDs
+const (
+	ataReallocated = 5
+	ataPending     = 197
+)
+
+type (
+	smartStat struct {
+		Passed      bool
+		Reallocated int64 // ATA: reallocated sectors; SCSI: grown defects
+		Pending     int64 // ATA: current pending sectors
+		MediaErrs   int64 // NVMe
+	}
+	// (subset of `smartctl --json` output)
+	smartctlOut struct {
+		Smartctl struct {
+			ExitStatus int `json:"exit_status"`
+		} `json:"smartctl"`
+		SmartStatus *struct {
+			Passed bool `json:"passed"`
+		} `json:"smart_status"`
+		ATA *struct {
+			Table []struct {
+				ID  int `json:"id"`
+				Raw struct {
+					Value int64 `json:"value"`
+				} `json:"raw"`
+			} `json:"table"`
+		} `json:"ata_smart_attributes"`
+		NVMe *struct {
+			MediaErrors int64 `json:"media_errors"`
+		} `json:"nvme_smart_health_information_log"`
+		SCSIGrownDefects *int64 `json:"scsi_grown_defect_list"`
+	}
+)
+
+// (can be overridden in tests)
+var smartctl = func(disk string) ([]byte, error) {
+	out, err := exec.Command(smartctlBin, "--json", "-H", "-A", "/dev/"+disk).Output()
+	if ee := (*exec.ExitError)(nil); errors.As(err, &ee) && len(out) > 0 {
+		err = nil // non-zero exit status is a bitmask (see parseSmart)
+	}
+	return out, err
+}
+
+func parseSmart(b []byte) (*smartStat, error) {
+	var out smartctlOut
+	if err := jsoniter.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	// bits 0 and 1: command line did not parse or device open failed
+	if out.Smartctl.ExitStatus&0x3 != 0 {
+		return nil, fmt.Errorf("%s exit status %#x", smartctlBin, out.Smartctl.ExitStatus)
+	}
+	if out.SmartStatus == nil {
+		return nil, errors.New("SMART status not available")
+	}
+	stat := &smartStat{Passed: out.SmartStatus.Passed}
+	if out.ATA != nil {
+		for _, attr := range out.ATA.Table {
+			switch attr.ID {
+			case ataReallocated:
+				stat.Reallocated = attr.Raw.Value
+			case ataPending:
+				stat.Pending = attr.Raw.Value
+			}
+		}
+	}
+	if out.NVMe != nil {
+		stat.MediaErrs = out.NVMe.MediaErrors
+	}
+	if out.SCSIGrownDefects != nil {
+		stat.Reallocated = *out.SCSIGrownDefects
+	}
+	return stat, nil
+}
+
+func (s *smartStat) check(limit int64) error {
+	if !s.Passed {
+		return errors.New("SMART overall-health self-assessment failed")
+	}
+	if n := s.Reallocated + s.Pending + s.MediaErrs; n > limit {
+		return fmt.Errorf("SMART: %d reallocated, %d pending sectors, %d media errors (limit %d)",
+			s.Reallocated, s.Pending, s.MediaErrs, limit)
+	}
+	return nil
+}
+
+// (when disabled, keep checking config at the minimum interval)
+func smartNext(config *cmn.Config) time.Duration {
+	if j := config.FSHC.SmartInterval.D(); j != 0 {
+		return j
+	}
+	return cmn.MinSmartInterval
+}
+
+func (f *FSHC) checkSmart(config *cmn.Config) {
+	for mpath, mi := range fs.GetAvail() {
+		if mi.IsAnySet(fs.FlagWaitingDD) || (mi.IsReadOnly() && !config.FSHC.SmartDisable) {
+			continue
+		}
+		for _, disk := range mi.Disks {
+			b, err := smartctl(disk)
+			if err != nil {
+				if errors.Is(err, exec.ErrNotFound) {
+					nlog.Errorf("%s: %v - disabling SMART monitoring", f.Name(), err)
+					f.smartUnavail = true
+					return
+				}
+				nlog.Warningf("%s: %s (%s): %v", f.Name(), mi, disk, err)
+				continue
+			}
+			stat, err := parseSmart(b)
+			if err != nil {
+				nlog.Warningf("%s: %s (%s): %v", f.Name(), mi, disk, err)
+				continue
+			}
+			if err := stat.check(config.FSHC.SmartErrorLimit); err != nil {
+				f.smartFailed(config, mpath, disk, err)
+				break
+			}
+		}
+	}
+}
+
+func (f *FSHC) smartFailed(config *cmn.Config, mpath, disk string, err error) {
+	reason := fmt.Sprintf("disk %s: %v", disk, err)
+	if config.FSHC.SmartDisable {
+		nlog.Errorf("%s: disabling mountpath %s (%s)", f.Name(), mpath, reason)
+		err = f.dispatcher.DisableMpath(mpath, reason)
+	} else {
+		nlog.Errorf("%s: making mountpath %s read-only (%s)", f.Name(), mpath, reason)
+		err = f.dispatcher.SuspectMpath(mpath, reason)
+	}
+	if err != nil {
+		nlog.Errorln(err)
+	}
+}
@@ -212,6 +212,39 @@ func TestMountpathEnableAlreadyEnabled(t *testing.T) {
 	tools.AssertMountpathCount(t, 1, 0)
 }
 
+func TestMountpathSetReadOnly(t *testing.T) {
+	initFS()
+
+	mpath := "/tmp/abc"
+	tools.AddMpath(t, mpath)
+
+	_, err := fs.SetReadOnly("/nonexistingpath", true, nil)
+	tassert.Errorf(t, err != nil, "setting non-existing mountpath read-only succeeded")
+
+	mi, err := fs.SetReadOnly(mpath, true, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, mi != nil && mi.IsReadOnly(), "expected read-only mountpath")
+	tassert.Errorf(t, fs.AnyReadOnly(), "expected any-read-only")
+	mpl := fs.MountpathsToLists()
+	tassert.Errorf(t, len(mpl.Available) == 1 && len(mpl.ReadOnly) == 1, "unexpected %+v", mpl)
+
+	mi, err = fs.SetReadOnly(mpath, true, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, mi == nil, "setting already read-only mountpath should not be successful")
+
+	mi, err = fs.SetReadOnly(mpath, false, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, mi != nil && !mi.IsReadOnly(), "expected writable mountpath")
+	tassert.Errorf(t, !fs.AnyReadOnly(), "expected none read-only")
+
+	_, err = fs.Disable(mpath)
+	tassert.CheckFatal(t, err)
+	_, err = fs.SetReadOnly(mpath, true, nil)
+	tassert.Errorf(t, cmn.IsErrMountpathNotFound(err), "expected mountpath-not-found error, got %v", err)
+
+	tools.AssertMountpathCount(t, 0, 1)
+}
+
 func TestMountpathsAddMultipleWithSameFSID(t *testing.T) {
 	fs.TestNew(mock.NewIOS())
 
@@ -0,0 +1,152 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"io"
+	"os"
+	"syscall"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// io_uring (Linux only, `iouring` build tag - see uring_on.go and uring_off.go): large sequential
+// reads and writes (GET and PUT of objects, EC slices) are split into up to `uringDepth`
+// chunks that get submitted to the kernel as a single batch - one system call per
+// buffer, with the chunks transferred concurrently.
+// When not built with the tag (or when not supported by the kernel), URingAvail is false.
+
+const (
+	URingMinSize = 4 * cos.MiB // objects (slices) of this size and larger
+	uringDepth   = 8
+)
+
+type (
+	uringIO struct {
+		fh     *os.File
+		buf    []byte
+		chunks [][]byte
+		res    [uringDepth]int32
+		off    int64 // file offset of the next batch
+	}
+	URingReader struct {
+		data []byte // read but not yet consumed
+		size int64
+		uringIO
+	}
+	URingWriter struct {
+		n int // staged (not yet written) bytes
+		uringIO
+	}
+)
+
+// interface guard
+var (
+	_ io.Reader = (*URingReader)(nil)
+	_ io.Writer = (*URingWriter)(nil)
+)
+
+// splits `b` into (up to uringDepth) consecutive chunks
+func (u *uringIO) split(b []byte) {
+	var (
+		l     = len(b)
+		chunk = max((l+uringDepth-1)/uringDepth, 1)
+	)
+	u.chunks = u.chunks[:0]
+	for i := 0; i < l; i += chunk {
+		u.chunks = append(u.chunks, b[i:min(i+chunk, l)])
+	}
+}
+
+func (u *uringIO) batch(write bool) (n int, err error) {
+	if err = uringRW(write, u.fh, u.chunks, u.off, u.res[:len(u.chunks)]); err != nil {
+		return
+	}
+	for i, chunk := range u.chunks {
+		res := int(u.res[i])
+		if res < 0 {
+			return n, syscall.Errno(-res)
+		}
+		n += res
+		if res < len(chunk) {
+			break // short read (EOF) or write
+		}
+	}
+	u.off += int64(n)
+	return
+}
+
+/////////////////
+// URingReader //
+/////////////////
+
+// reads `size` bytes from the beginning of the file
+func NewURingReader(fh *os.File, buf []byte, size int64) *URingReader {
+	debug.Assert(URingAvail())
+	return &URingReader{uringIO: uringIO{fh: fh, buf: buf, chunks: make([][]byte, 0, uringDepth)}, size: size}
+}
+
+func (r *URingReader) Read(p []byte) (n int, err error) {
+	if len(r.data) == 0 {
+		remain := r.size - r.off
+		if remain <= 0 {
+			return 0, io.EOF
+		}
+		b := r.buf
+		if int64(len(b)) > remain {
+			b = b[:remain]
+		}
+		r.split(b)
+		if n, err = r.batch(false /*write*/); err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		r.data = b[:n]
+	}
+	n = copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+/////////////////
+// URingWriter //
+/////////////////
+
+func NewURingWriter(fh *os.File, buf []byte) *URingWriter {
+	debug.Assert(URingAvail())
+	return &URingWriter{uringIO: uringIO{fh: fh, buf: buf, chunks: make([][]byte, 0, uringDepth)}}
+}
+
+func (w *URingWriter) Write(p []byte) (int, error) {
+	total := len(p)
+	for len(p) > 0 {
+		c := copy(w.buf[w.n:], p)
+		w.n += c
+		p = p[c:]
+		if w.n == len(w.buf) {
+			if err := w.Flush(); err != nil {
+				return total - len(p), err
+			}
+		}
+	}
+	return total, nil
+}
+
+// must be called upon the last Write
+func (w *URingWriter) Flush() error {
+	if w.n == 0 {
+		return nil
+	}
+	w.split(w.buf[:w.n])
+	n, err := w.batch(true /*write*/)
+	if err == nil && n < w.n {
+		err = io.ErrShortWrite
+	}
+	w.n = 0
+	return err
+}
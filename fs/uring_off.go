@@ -0,0 +1,18 @@
+//go:build !linux || !iouring
+
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"errors"
+	"os"
+)
+
+func URingAvail() bool { return false }
+
+func uringRW(bool, *os.File, [][]byte, int64, []int32) error {
+	return errors.New("io_uring: not supported (build with `iouring` tag)")
+}
@@ -0,0 +1,257 @@
+//go:build linux && iouring
+
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"golang.org/x/sys/unix"
+)
+
+// minimal io_uring: no SQ polling, no registered buffers or files; each ring is used
+// by one goroutine at a time (see uringPool) to submit a batch and wait for its completion
+
+const (
+	uringOpRead  = 22 // IORING_OP_READ (Linux 5.6)
+	uringOpWrite = 23 // IORING_OP_WRITE
+
+	uringEnterGetEvents = 1 // IORING_ENTER_GETEVENTS
+	uringFeatSingleMmap = 1 // IORING_FEAT_SINGLE_MMAP
+
+	uringOffSQRing = 0          // IORING_OFF_SQ_RING
+	uringOffCQRing = 0x8000000  // IORING_OFF_CQ_RING
+	uringOffSQEs   = 0x10000000 // IORING_OFF_SQES
+
+	uringEntries  = uringDepth
+	uringPoolSize = 64
+)
+
+type (
+	// (layouts below must match include/uapi/linux/io_uring.h)
+	uringSQOffsets struct {
+		head, tail, ringMask, ringEntries, flags, dropped, array, resv1 uint32
+		resv2                                                           uint64
+	}
+	uringCQOffsets struct {
+		head, tail, ringMask, ringEntries, overflow, cqes, flags, resv1 uint32
+		resv2                                                           uint64
+	}
+	uringParams struct {
+		sqEntries, cqEntries, flags, sqThreadCPU, sqThreadIdle, features, wqFd uint32
+		resv                                                                   [3]uint32
+		sqOff                                                                  uringSQOffsets
+		cqOff                                                                  uringCQOffsets
+	}
+	uringSQE struct {
+		opcode      uint8
+		flags       uint8
+		ioprio      uint16
+		fd          int32
+		off         uint64
+		addr        uint64
+		len         uint32
+		rwFlags     uint32
+		userData    uint64
+		bufIndex    uint16
+		personality uint16
+		spliceFdIn  int32
+		pad         [2]uint64
+	}
+	uringCQE struct {
+		userData uint64
+		res      int32
+		flags    uint32
+	}
+	uring struct {
+		sqRing, cqRing, sqeMem []byte
+		sqTail, sqMask         *uint32
+		cqHead, cqTail, cqMask *uint32
+		sqArray                []uint32
+		sqes                   []uringSQE
+		cqes                   []uringCQE
+		fd                     int
+	}
+)
+
+var (
+	uringPool  = make(chan *uring, uringPoolSize)
+	uringOnce  sync.Once
+	uringAvail bool
+)
+
+// probe once: set up a ring and read /dev/zero
+func URingAvail() bool {
+	uringOnce.Do(func() {
+		fh, err := os.Open("/dev/zero")
+		if err == nil {
+			var (
+				b   = make([]byte, 8)
+				res = make([]int32, 1)
+			)
+			err = uringRW(false, fh, [][]byte{b}, 0, res)
+			if err == nil && int(res[0]) != len(b) {
+				err = unix.Errno(-res[0])
+			}
+			fh.Close()
+		}
+		if err != nil {
+			nlog.Warningln("io_uring not available, using regular I/O:", err)
+			return
+		}
+		uringAvail = true
+	})
+	return uringAvail
+}
+
+func uringRW(write bool, fh *os.File, bufs [][]byte, off int64, res []int32) error {
+	var r *uring
+	select {
+	case r = <-uringPool:
+	default:
+		var err error
+		if r, err = newURing(); err != nil {
+			return err
+		}
+	}
+	op := uint8(uringOpRead)
+	if write {
+		op = uringOpWrite
+	}
+	err := r.rw(op, int(fh.Fd()), bufs, off, res)
+	runtime.KeepAlive(fh)
+	runtime.KeepAlive(bufs)
+	if err != nil {
+		r.close() // (in an unknown state)
+		return err
+	}
+	select {
+	case uringPool <- r:
+	default:
+		r.close()
+	}
+	return nil
+}
+
+///////////
+// uring //
+///////////
+
+func newURing() (r *uring, err error) {
+	var p uringParams
+	fd, _, errno := unix.Syscall(unix.SYS_IO_URING_SETUP, uringEntries, uintptr(unsafe.Pointer(&p)), 0)
+	if errno != 0 {
+		return nil, errno
+	}
+	r = &uring{fd: int(fd)}
+	var (
+		sqSize = int(p.sqOff.array + p.sqEntries*4)
+		cqSize = int(p.cqOff.cqes + p.cqEntries*uint32(unsafe.Sizeof(uringCQE{})))
+		single = p.features&uringFeatSingleMmap != 0
+		prot   = unix.PROT_READ | unix.PROT_WRITE
+		flags  = unix.MAP_SHARED | unix.MAP_POPULATE
+	)
+	if single {
+		sqSize = max(sqSize, cqSize)
+	}
+	if r.sqRing, err = unix.Mmap(r.fd, uringOffSQRing, sqSize, prot, flags); err != nil {
+		r.close()
+		return nil, err
+	}
+	if single {
+		r.cqRing = r.sqRing
+	} else if r.cqRing, err = unix.Mmap(r.fd, uringOffCQRing, cqSize, prot, flags); err != nil {
+		r.close()
+		return nil, err
+	}
+	sqeSize := int(p.sqEntries) * int(unsafe.Sizeof(uringSQE{}))
+	if r.sqeMem, err = unix.Mmap(r.fd, uringOffSQEs, sqeSize, prot, flags); err != nil {
+		r.close()
+		return nil, err
+	}
+	r.sqTail = (*uint32)(unsafe.Pointer(&r.sqRing[p.sqOff.tail]))
+	r.sqMask = (*uint32)(unsafe.Pointer(&r.sqRing[p.sqOff.ringMask]))
+	r.sqArray = unsafe.Slice((*uint32)(unsafe.Pointer(&r.sqRing[p.sqOff.array])), p.sqEntries)
+	r.sqes = unsafe.Slice((*uringSQE)(unsafe.Pointer(&r.sqeMem[0])), p.sqEntries)
+	r.cqHead = (*uint32)(unsafe.Pointer(&r.cqRing[p.cqOff.head]))
+	r.cqTail = (*uint32)(unsafe.Pointer(&r.cqRing[p.cqOff.tail]))
+	r.cqMask = (*uint32)(unsafe.Pointer(&r.cqRing[p.cqOff.ringMask]))
+	r.cqes = unsafe.Slice((*uringCQE)(unsafe.Pointer(&r.cqRing[p.cqOff.cqes])), p.cqEntries)
+	return r, nil
+}
+
+// submit reads (writes) of `bufs` at consecutive file offsets starting at `off`
+// and wait for all of them to complete; res[i] is the result of the i-th
+func (r *uring) rw(op uint8, fd int, bufs [][]byte, off int64, res []int32) error {
+	var (
+		n    = uint32(len(bufs))
+		tail = atomic.LoadUint32(r.sqTail)
+		mask = *r.sqMask
+	)
+	for i, b := range bufs {
+		idx := tail & mask
+		r.sqes[idx] = uringSQE{
+			opcode:   op,
+			fd:       int32(fd),
+			off:      uint64(off),
+			addr:     uint64(uintptr(unsafe.Pointer(&b[0]))),
+			len:      uint32(len(b)),
+			userData: uint64(i),
+		}
+		r.sqArray[idx] = idx
+		off += int64(len(b))
+		tail++
+	}
+	atomic.StoreUint32(r.sqTail, tail)
+
+	var submit, reaped uint32 = n, 0
+	for reaped < n {
+		ret, _, errno := unix.Syscall6(unix.SYS_IO_URING_ENTER, uintptr(r.fd), uintptr(submit), uintptr(n-reaped),
+			uringEnterGetEvents, 0, 0)
+		switch errno {
+		case 0:
+			submit -= min(uint32(ret), submit)
+		case unix.EINTR:
+		default:
+			return errno
+		}
+		reaped += r.reap(res)
+	}
+	return nil
+}
+
+func (r *uring) reap(res []int32) (cnt uint32) {
+	var (
+		head = atomic.LoadUint32(r.cqHead)
+		tail = atomic.LoadUint32(r.cqTail)
+		mask = *r.cqMask
+	)
+	for ; head != tail; head++ {
+		cqe := &r.cqes[head&mask]
+		res[cqe.userData] = cqe.res
+		cnt++
+	}
+	atomic.StoreUint32(r.cqHead, head)
+	return
+}
+
+func (r *uring) close() {
+	if r.sqeMem != nil {
+		unix.Munmap(r.sqeMem)
+	}
+	if r.cqRing != nil && &r.cqRing[0] != &r.sqRing[0] {
+		unix.Munmap(r.cqRing)
+	}
+	if r.sqRing != nil {
+		unix.Munmap(r.sqRing)
+	}
+	unix.Close(r.fd)
+}
@@ -0,0 +1,49 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestURing(t *testing.T) {
+	if !fs.URingAvail() {
+		t.Skip("io_uring not available (requires Linux and `iouring` build tag)")
+	}
+	dir := t.TempDir()
+	for _, size := range []int{1, 1000, 128 * 1024, 1024*1024 + 3} {
+		t.Run(fmt.Sprintf("size=%d", size), func(t *testing.T) {
+			var (
+				fqn  = filepath.Join(dir, cos.GenTie())
+				data = make([]byte, size)
+			)
+			cos.NowRand().Read(data)
+
+			fh, err := os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+			tassert.CheckFatal(t, err)
+			w := fs.NewURingWriter(fh, make([]byte, 64*1024))
+			_, err = io.CopyBuffer(cos.WriterOnly{Writer: w}, bytes.NewReader(data), make([]byte, 1000))
+			tassert.CheckFatal(t, err)
+			tassert.CheckFatal(t, w.Flush())
+			tassert.CheckFatal(t, fh.Close())
+
+			fh, err = os.Open(fqn)
+			tassert.CheckFatal(t, err)
+			defer fh.Close()
+			read, err := io.ReadAll(fs.NewURingReader(fh, make([]byte, 48*1024+5), int64(size)))
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, bytes.Equal(read, data), "read data differs from written")
+		})
+	}
+}
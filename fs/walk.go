@@ -42,6 +42,9 @@ type (
 		Dir      string
 		CTs      []string
 		Sorted   bool
+		// when > 1 (and not sorted), walk in parallel - see walkpar.go;
+		// the callback must then be safe for concurrent use
+		Workers int
 	}
 
 	errCallbackWrapper struct {
@@ -122,6 +125,9 @@ func Walk(opts *WalkOpts) error {
 			}
 		}
 	}
+	if opts.Workers > 1 && !opts.Sorted {
+		return walkParallel(opts, fqns)
+	}
 	scratch, slab := memsys.PageMM().AllocSize(memsys.DefaultBufSize)
 	gOpts := &godirwalk.Options{
 		ErrorCallback: ew.PathErrToAction, // "halts the walk" or "skips the node" (detailed comment above)
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"sort"
+	"sync"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -193,3 +194,39 @@ func TestWalkBckSkipDir(t *testing.T) {
 	}
 	tassert.Fatalf(t, expectedTotal == len(fqns), "expected %d objects, got %d", expectedTotal, len(fqns))
 }
+
+func TestWalkParallel(t *testing.T) {
+	topDir, fileNames := tools.PrepareDirTree(t, tools.DirTreeDesc{
+		InitDir: t.TempDir(),
+		Dirs:    rand.Int()%100 + 10,
+		Files:   rand.Int()%100 + 10,
+		Depth:   rand.Int()%4 + 2,
+		Empty:   true,
+	})
+	sort.Strings(fileNames)
+
+	for _, workers := range []int{2, 4, 16} {
+		var (
+			mu   sync.Mutex
+			fqns = make([]string, 0, len(fileNames))
+		)
+		err := fs.Walk(&fs.WalkOpts{
+			Dir: topDir,
+			Callback: func(fqn string, de fs.DirEntry) error {
+				if de.IsDir() {
+					return nil
+				}
+				mu.Lock()
+				fqns = append(fqns, fqn)
+				mu.Unlock()
+				return nil
+			},
+			Workers: workers,
+		})
+		tassert.CheckFatal(t, err)
+
+		sort.Strings(fqns)
+		tassert.Fatalf(t, reflect.DeepEqual(fqns, fileNames), "workers=%d: found %d files, expected %d",
+			workers, len(fqns), len(fileNames))
+	}
+}
@@ -0,0 +1,255 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/karrick/godirwalk"
+)
+
+// Parallel (unsorted) walk: `WalkOpts.Workers` goroutines share the traversal of
+// the same set of root directories.
+// Each worker owns a queue of (discovered but not yet read) directories: it takes
+// its own work from the tail (depth-first, to keep the queues short) and, when idle,
+// steals from the head of the others' queues (breadth-first, larger chunks of work).
+//
+// The callback semantics are the same as in godirwalk.Walk, with two differences:
+// - the callback gets called concurrently and must be safe for that;
+// - symbolic links are never followed.
+
+type (
+	walkPar struct {
+		opts    *WalkOpts
+		queues  []*walkQ
+		err     error
+		ew      errCallbackWrapper
+		mu      sync.Mutex // protects err; cond
+		cond    *sync.Cond
+		pending atomic.Int64 // dirs in the queues plus those being read
+		idle    atomic.Int32
+		stopped atomic.Bool
+	}
+	walkQ struct {
+		dirs []string
+		mu   sync.Mutex
+	}
+)
+
+func walkParallel(opts *WalkOpts, fqns []string) error {
+	wp := &walkPar{opts: opts, queues: make([]*walkQ, opts.Workers)}
+	wp.cond = sync.NewCond(&wp.mu)
+	for i := range wp.queues {
+		wp.queues[i] = &walkQ{}
+	}
+	// roots: visit (same as godirwalk) and distribute round-robin
+	for i, fqn := range fqns {
+		de, err := godirwalk.NewDirent(fqn)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				wp.halt(err)
+			}
+			continue
+		}
+		if wp.visit(fqn, de) == visitDir {
+			wp.push(i%len(wp.queues), fqn)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := range wp.queues {
+		wg.Add(1)
+		go wp.run(i, &wg)
+	}
+	wg.Wait()
+
+	err := wp.err
+	switch {
+	case err == nil || os.IsNotExist(err):
+		return nil
+	case cmn.IsErrMountpathNotFound(err): // mountpath is getting detached or disabled
+		nlog.Errorln(err)
+		return nil
+	case cmn.IsErrAborted(err), err == context.Canceled:
+	default:
+		nlog.Errorln(err)
+	}
+	return err
+}
+
+func (wp *walkPar) run(i int, wg *sync.WaitGroup) {
+	scratch, slab := memsys.PageMM().AllocSize(memsys.DefaultBufSize)
+	for {
+		dir, ok := wp.next(i)
+		if !ok {
+			break
+		}
+		wp.readDir(i, dir, scratch)
+		if wp.pending.Dec() == 0 {
+			wp.mu.Lock()
+			wp.cond.Broadcast()
+			wp.mu.Unlock()
+		}
+	}
+	slab.Free(scratch)
+	wg.Done()
+}
+
+// returns false when there's nothing left to do (or the walk's been halted)
+func (wp *walkPar) next(i int) (string, bool) {
+	for {
+		if wp.stopped.Load() {
+			return "", false
+		}
+		if dir, ok := wp.take(i); ok {
+			return dir, true
+		}
+		// idle
+		wp.mu.Lock()
+		wp.idle.Inc()
+		for !wp.stopped.Load() && wp.pending.Load() > 0 && !wp.hasWork() {
+			wp.cond.Wait()
+		}
+		wp.idle.Dec()
+		done := wp.stopped.Load() || wp.pending.Load() == 0
+		wp.mu.Unlock()
+		if done {
+			return "", false
+		}
+	}
+}
+
+// own queue first (LIFO), then steal (FIFO)
+func (wp *walkPar) take(i int) (dir string, ok bool) {
+	if dir, ok = wp.queues[i].popTail(); ok {
+		return
+	}
+	l := len(wp.queues)
+	for j := 1; j < l; j++ {
+		if dir, ok = wp.queues[(i+j)%l].popHead(); ok {
+			return
+		}
+	}
+	return
+}
+
+func (wp *walkPar) hasWork() bool {
+	for _, q := range wp.queues {
+		q.mu.Lock()
+		l := len(q.dirs)
+		q.mu.Unlock()
+		if l > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (wp *walkPar) push(i int, dir string) {
+	wp.pending.Inc()
+	wp.queues[i].push(dir)
+	if wp.idle.Load() > 0 {
+		wp.mu.Lock()
+		wp.cond.Signal()
+		wp.mu.Unlock()
+	}
+}
+
+func (wp *walkPar) halt(err error) {
+	wp.mu.Lock()
+	if wp.err == nil {
+		wp.err = err
+	}
+	wp.stopped.Store(true)
+	wp.cond.Broadcast()
+	wp.mu.Unlock()
+}
+
+func (wp *walkPar) readDir(i int, dir string, scratch []byte) {
+	children, err := godirwalk.ReadDirents(dir, scratch)
+	if err != nil {
+		if wp.ew.PathErrToAction(dir, err) == godirwalk.Halt {
+			wp.halt(err)
+		}
+		return
+	}
+	for _, de := range children {
+		if wp.stopped.Load() {
+			return
+		}
+		fqn := filepath.Join(dir, de.Name())
+		switch wp.visit(fqn, de) {
+		case visitDir:
+			wp.push(i, fqn)
+		case visitSkipSiblings:
+			return
+		}
+	}
+}
+
+const (
+	visitNext = iota
+	visitDir
+	visitSkipSiblings
+)
+
+// user callback, and then godirwalk semantics for the returned error
+func (wp *walkPar) visit(fqn string, de *godirwalk.Dirent) int {
+	err := wp.opts.Callback(fqn, de)
+	switch {
+	case err == nil:
+		if de.IsDir() {
+			return visitDir
+		}
+		return visitNext
+	case err == filepath.SkipDir || err == godirwalk.SkipThis:
+		if de.IsDir() || err == godirwalk.SkipThis {
+			return visitNext
+		}
+		return visitSkipSiblings
+	case wp.ew.PathErrToAction(fqn, err) == godirwalk.SkipNode:
+		return visitNext
+	default:
+		wp.halt(err)
+		return visitNext
+	}
+}
+
+///////////
+// walkQ //
+///////////
+
+func (q *walkQ) push(dir string) {
+	q.mu.Lock()
+	q.dirs = append(q.dirs, dir)
+	q.mu.Unlock()
+}
+
+func (q *walkQ) popTail() (dir string, ok bool) {
+	q.mu.Lock()
+	if l := len(q.dirs); l > 0 {
+		dir, ok = q.dirs[l-1], true
+		q.dirs = q.dirs[:l-1]
+	}
+	q.mu.Unlock()
+	return
+}
+
+func (q *walkQ) popHead() (dir string, ok bool) {
+	q.mu.Lock()
+	if len(q.dirs) > 0 {
+		dir, ok = q.dirs[0], true
+		q.dirs = q.dirs[1:]
+	}
+	q.mu.Unlock()
+	return
+}
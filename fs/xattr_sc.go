@@ -0,0 +1,221 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"golang.org/x/sys/unix"
+)
+
+// Sidecar metadata store: fallback for filesystems that do not support extended
+// attributes (e.g., NFS, some overlayfs configurations). Selected automatically -
+// upon the first ENOTSUP - on a per-mountpath basis.
+//
+// Each (file, attribute) pair is stored as a separate small file under
+// <mountpath>/.$xattrs that mirrors the mountpath's own directory structure.
+// Sidecar files are prefixed with the inode number of the file they describe,
+// so that a stale sidecar (left behind by, e.g., a file that was replaced out of band)
+// is not taken for the current one - barring inode reuse, which is why removing
+// a file must be accompanied by RemoveSidecar.
+
+const (
+	xattrRoot  = ".$xattrs"
+	scInoSize  = 8
+	scTmpInfix = ".tmp."
+)
+
+var (
+	scMpaths sync.Map    // mountpath root => struct{} (logged once)
+	scAny    atomic.Bool // any mountpath using sidecars
+)
+
+func isErrXattrNotSupported(err error) bool {
+	return errors.Is(err, unix.ENOTSUP) || errors.Is(err, unix.EOPNOTSUPP)
+}
+
+// returns the sidecar path for the given (fqn, attr); when the fqn does not belong to
+// any (available) mountpath - the case of mountpath root at startup - the fqn itself
+// must be a directory that's then considered the root
+func scPath(fqn, attrName string) (string, error) {
+	var root, rel string
+	if mi, relativePath, err := FQN2Mpath(fqn); err == nil {
+		root, rel = mi.Path, relativePath
+	} else if finfo, errS := os.Stat(fqn); errS == nil && finfo.IsDir() {
+		root = filepath.Clean(fqn)
+	} else {
+		return "", err
+	}
+	if _, loaded := scMpaths.LoadOrStore(root, struct{}{}); !loaded {
+		nlog.Warningf("%s: extended attributes not supported - storing metadata in %q", root, xattrRoot)
+		scAny.Store(true)
+	}
+	if rel == "" {
+		return filepath.Join(root, xattrRoot, attrName), nil
+	}
+	return filepath.Join(root, xattrRoot, rel) + "." + attrName, nil
+}
+
+func scIno(fqn string) (uint64, error) {
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return 0, err
+	}
+	return finfo.Sys().(*syscall.Stat_t).Ino, nil
+}
+
+func scGet(fqn, attrName string, buf []byte) ([]byte, error) {
+	ino, err := scIno(fqn)
+	if err != nil {
+		return nil, err
+	}
+	path, err := scPath(fqn, attrName)
+	if err != nil {
+		return nil, err
+	}
+	fh, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = syscall.ENODATA
+		}
+		return nil, err
+	}
+	defer fh.Close()
+
+	var hdr [scInoSize]byte
+	if _, err := fh.ReadAt(hdr[:], 0); err != nil {
+		return nil, syscall.ENODATA // (partially written)
+	}
+	if binary.LittleEndian.Uint64(hdr[:]) != ino {
+		return nil, syscall.ENODATA // stale
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := finfo.Size() - scInoSize
+	if size > int64(len(buf)) {
+		return nil, syscall.ERANGE
+	}
+	n, err := fh.ReadAt(buf[:size], scInoSize)
+	if int64(n) == size {
+		err = nil
+	}
+	return buf[:n], err
+}
+
+func scSet(fqn, attrName string, data []byte) error {
+	ino, err := scIno(fqn)
+	if err != nil {
+		return err
+	}
+	path, err := scPath(fqn, attrName)
+	if err != nil {
+		return err
+	}
+	if err := cos.CreateDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+	b := make([]byte, scInoSize+len(data))
+	binary.LittleEndian.PutUint64(b, ino)
+	copy(b[scInoSize:], data)
+
+	// write and rename (atomic)
+	tmp := path + scTmpInfix + cos.GenTie()
+	if err := os.WriteFile(tmp, b, cos.PermRWR); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+func scRemove(fqn, attrName string) error {
+	path, err := scPath(fqn, attrName)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// RemoveSidecar removes the file's sidecar attribute, if any (no-op when all mountpaths
+// support xattrs); to be called when removing the file itself
+func RemoveSidecar(fqn, attrName string) {
+	if !scAny.Load() {
+		return
+	}
+	if mi, _, err := FQN2Mpath(fqn); err != nil || !mi.sidecars() {
+		return
+	}
+	if err := scRemove(fqn, attrName); err != nil {
+		nlog.Errorf("failed to remove %q sidecar of %s: %v", attrName, fqn, err)
+	}
+}
+
+// RenameSidecar follows the file's rename (within a mountpath) - no-op when
+// all mountpaths support xattrs
+func RenameSidecar(src, dst, attrName string) {
+	if !scAny.Load() {
+		return
+	}
+	if mi, _, err := FQN2Mpath(src); err != nil || !mi.sidecars() {
+		return
+	}
+	from, err := scPath(src, attrName)
+	if err != nil {
+		return
+	}
+	to, err := scPath(dst, attrName)
+	if err != nil {
+		return
+	}
+	if err := cos.CreateDir(filepath.Dir(to)); err != nil {
+		nlog.Errorln(err)
+		return
+	}
+	if err := os.Rename(from, to); err != nil && !os.IsNotExist(err) {
+		nlog.Errorf("failed to rename %q sidecar %q => %q: %v", attrName, from, to, err)
+	}
+}
+
+func (mi *Mountpath) sidecars() bool {
+	if !scAny.Load() {
+		return false
+	}
+	_, ok := scMpaths.Load(mi.Path)
+	return ok
+}
+
+// the sidecar counterpart of a directory that belongs to the mountpath
+func (mi *Mountpath) scDir(dir string) string {
+	rel, err := filepath.Rel(mi.Path, dir)
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(mi.Path, xattrRoot, rel)
+}
+
+func (mi *Mountpath) renameSidecars(fromDir, toDir string) {
+	from, to := mi.scDir(fromDir), mi.scDir(toDir)
+	if err := RemoveAll(to); err != nil {
+		nlog.Errorln(err)
+	}
+	if err := cos.Rename(from, to); err != nil && !os.IsNotExist(err) {
+		nlog.Errorf("%s: failed to rename sidecars %q => %q: %v", mi, from, to, err)
+	}
+}
@@ -0,0 +1,61 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// sidecar store is exercised directly - the local filesystem does support xattrs
+func TestXattrSidecar(t *testing.T) {
+	TestNew(nil)
+
+	mpath := t.TempDir()
+	oldMPs := setAvailableMountPaths(t, mpath)
+	defer setAvailableMountPaths(t, oldMPs...)
+
+	var (
+		fqn  = filepath.Join(mpath, "@ais", "bck", "%ob", "dir", "obj")
+		attr = "user.ais.test"
+		buf  = make([]byte, 128)
+	)
+	createDirs(filepath.Dir(fqn))
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte("data"), cos.PermRWR))
+
+	_, err := scGet(fqn, attr, buf)
+	tassert.Fatalf(t, cos.IsErrXattrNotFound(err), "expected not-found, got %v", err)
+
+	tassert.CheckFatal(t, scSet(fqn, attr, []byte("value")))
+	b, err := scGet(fqn, attr, buf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == "value", "expected %q, got %q", "value", b)
+	tassert.Errorf(t, cos.Stat(filepath.Join(mpath, xattrRoot, "@ais", "bck", "%ob", "dir", "obj."+attr)) == nil,
+		"expected sidecar under %q", xattrRoot)
+
+	_, err = scGet(fqn, attr, make([]byte, 2))
+	tassert.Errorf(t, err != nil, "expected ERANGE")
+
+	// replaced file (new inode) must not inherit the stale sidecar
+	tassert.CheckFatal(t, os.Rename(fqn, fqn+".old")) // (keeping the inode in use)
+	tassert.CheckFatal(t, os.WriteFile(fqn+".new", []byte("other"), cos.PermRWR))
+	tassert.CheckFatal(t, os.Rename(fqn+".new", fqn))
+	_, err = scGet(fqn, attr, buf)
+	tassert.Errorf(t, cos.IsErrXattrNotFound(err), "expected stale sidecar to be ignored, got %v", err)
+
+	// mountpath root
+	tassert.CheckFatal(t, scSet(mpath, nodeXattrID, []byte("tid")))
+	b, err = scGet(mpath, nodeXattrID, buf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == "tid", "expected %q, got %q", "tid", b)
+
+	tassert.CheckFatal(t, scRemove(mpath, nodeXattrID))
+	_, err = scGet(mpath, nodeXattrID, buf)
+	tassert.Errorf(t, cos.IsErrXattrNotFound(err), "expected not-found after remove, got %v", err)
+}
@@ -27,18 +27,27 @@ func GetXattrBuf(fqn, attrName string, buf []byte) (b []byte, err error) {
 	n, err = unix.Getxattr(fqn, attrName, buf)
 	if err == nil { // returns ERANGE if len(buf) is not enough
 		b = buf[:n]
+	} else if isErrXattrNotSupported(err) {
+		b, err = scGet(fqn, attrName, buf) // (see xattr_sc.go)
 	}
 	return
 }
 
 // SetXattr sets xattr name = value
 func SetXattr(fqn, attrName string, data []byte) (err error) {
-	return unix.Setxattr(fqn, attrName, data, 0)
+	err = unix.Setxattr(fqn, attrName, data, 0)
+	if err != nil && isErrXattrNotSupported(err) {
+		err = scSet(fqn, attrName, data)
+	}
+	return
 }
 
 // removeXattr removes xattr
 func removeXattr(fqn, attrName string) error {
 	err := unix.Removexattr(fqn, attrName)
+	if err != nil && isErrXattrNotSupported(err) {
+		err = scRemove(fqn, attrName)
+	}
 	if err != nil && !cos.IsErrXattrNotFound(err) {
 		nlog.Errorf("failed to remove %q from %s: %v", attrName, fqn, err)
 		return err
@@ -109,6 +109,95 @@ func Test_NoSleep(*testing.T) {
 	mem.Terminate(false)
 }
 
+// same as above with per-NUMA-node slab pools (on a single-node system - a single pool)
+func Test_NUMA(t *testing.T) {
+	if testing.Short() {
+		duration = 4 * time.Second
+	}
+
+	mem := &memsys.MMSA{Name: "nmem", TimeIval: time.Second * 20, MinPctTotal: 5, NUMA: true}
+	mem.Init(0)
+
+	wg := &sync.WaitGroup{}
+	random := cos.NowRand()
+	for i := 0; i < 100; i++ {
+		siz := random.Int63n(cos.MiB) + cos.KiB
+		tot := random.Int63n(cos.DivCeil(cos.KiB*100, siz))*siz + cos.KiB
+		wg.Add(1)
+		go memstress(mem, i, time.Millisecond, siz, tot, wg)
+	}
+	for i := 0; i < 3; i++ {
+		time.Sleep(duration / 4)
+		mem.FreeSpec(memsys.FreeSpec{IdleDuration: 1, MinSize: cos.MiB})
+	}
+	wg.Wait()
+
+	// alloc/free across goroutines (and, possibly, nodes)
+	slab, err := mem.GetSlab(memsys.DefaultBufSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bufs := make(chan []byte, 64)
+	go func() {
+		for i := 0; i < 1000; i++ {
+			bufs <- slab.Alloc()
+		}
+		close(bufs)
+	}()
+	for buf := range bufs {
+		if int64(len(buf)) != memsys.DefaultBufSize {
+			t.Fatalf("invalid buffer size %d", len(buf))
+		}
+		slab.Free(buf)
+	}
+	mem.FreeSpec(memsys.FreeSpec{Totally: true, ToOS: true, MinSize: cos.MiB})
+	mem.Terminate(false)
+}
+
+func Test_HugePages(t *testing.T) {
+	mem := &memsys.MMSA{Name: "hmem", TimeIval: time.Second * 20, MinPctTotal: 5, HugePages: true}
+	mem.Init(0)
+	defer mem.Terminate(false)
+
+	for _, size := range []int64{memsys.MaxPageSlabSize, 68 * cos.KiB, memsys.DefaultBufSize} {
+		slab, err := mem.GetSlab(size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bufs := make([][]byte, 0, 200)
+		for i := 0; i < cap(bufs); i++ {
+			buf := slab.Alloc()
+			if int64(len(buf)) != size || int64(cap(buf)) != size {
+				t.Fatalf("invalid buffer: len %d, cap %d (expected %d)", len(buf), cap(buf), size)
+			}
+			for j := range buf {
+				buf[j] = byte(i)
+			}
+			bufs = append(bufs, buf)
+		}
+		// no overlaps
+		for i, buf := range bufs {
+			for _, b := range buf {
+				if b != byte(i) {
+					t.Fatalf("buffer %d (size %d) overwritten", i, size)
+				}
+			}
+			slab.Free(buf)
+		}
+	}
+
+	sgl := mem.NewSGL(10*cos.MiB, memsys.MaxPageSlabSize)
+	data := make([]byte, 10*cos.MiB+1)
+	if _, err := sgl.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if sgl.Size() != int64(len(data)) {
+		t.Fatalf("sgl size %d, expected %d", sgl.Size(), len(data))
+	}
+	sgl.Free()
+	mem.FreeSpec(memsys.FreeSpec{Totally: true, ToOS: true, MinSize: cos.MiB})
+}
+
 func printMaxRingLen(mem *memsys.MMSA, c chan struct{}) {
 	for i := 0; i < 100; i++ {
 		select {
@@ -0,0 +1,52 @@
+// Package memsys provides memory management and slab/SGL allocation with io.Reader and io.Writer interfaces
+// on top of scatter-gather lists of reusable buffers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package memsys
+
+import (
+	"unsafe"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Huge-page backing (MMSA.HugePages) for the largest page slabs (bufSize >= hugeMinBufSize):
+// - the slab grows by allocating hugeChunkSize chunks and carving them into buffers;
+// - the 2MiB-aligned part of each chunk is advised (madvise(2)) to be backed by
+//   transparent huge pages (THP) - at least one huge page per chunk;
+// - chunks remain regular Go memory: a chunk gets garbage-collected once all its buffers
+//   are dropped (reduced or cleaned up) - not sooner.
+
+const (
+	hugePageSize   = 2 * cos.MiB
+	hugeChunkSize  = 2 * hugePageSize
+	hugeMinBufSize = 64 * cos.KiB
+)
+
+func (s *Slab) growHuge(p *slabPool, cnt int) {
+	size := int(s.Size())
+	for cnt > 0 {
+		chunk := make([]byte, hugeChunkSize)
+		adviseHuge(chunk)
+		for off := 0; off+size <= len(chunk); off += size {
+			buf := chunk[off : off+size : off+size]
+			s.track(p, buf)
+			p.put = append(p.put, buf)
+			cnt--
+		}
+	}
+}
+
+// the 2MiB-aligned subrange of the chunk (empty if there's none)
+func hugeAligned(b []byte) []byte {
+	var (
+		addr  = uintptr(unsafe.Pointer(&b[0]))
+		start = (addr + hugePageSize - 1) &^ (hugePageSize - 1)
+		end   = (addr + uintptr(len(b))) &^ (hugePageSize - 1)
+	)
+	if end <= start {
+		return nil
+	}
+	return b[start-addr : end-addr]
+}
@@ -0,0 +1,10 @@
+// Package memsys provides memory management and slab/SGL allocation with io.Reader and io.Writer interfaces
+// on top of scatter-gather lists of reusable buffers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package memsys
+
+func hugeAvail() bool { return false }
+
+func adviseHuge([]byte) {}
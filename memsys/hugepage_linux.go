@@ -0,0 +1,38 @@
+// Package memsys provides memory management and slab/SGL allocation with io.Reader and io.Writer interfaces
+// on top of scatter-gather lists of reusable buffers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package memsys
+
+import (
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"golang.org/x/sys/unix"
+)
+
+const thpEnabledPath = "/sys/kernel/mm/transparent_hugepage/enabled"
+
+// THP must be enabled in either "always" or "madvise" mode
+func hugeAvail() bool {
+	line, err := cos.ReadOneLine(thpEnabledPath)
+	if err != nil {
+		nlog.Warningln("huge pages:", err)
+		return false
+	}
+	if strings.Contains(line, "[never]") {
+		nlog.Warningln("huge pages: transparent huge pages are disabled (" + thpEnabledPath + ": " + line + ")")
+		return false
+	}
+	return true
+}
+
+func adviseHuge(chunk []byte) {
+	if b := hugeAligned(chunk); len(b) > 0 {
+		if err := unix.Madvise(b, unix.MADV_HUGEPAGE); err != nil && verbose {
+			nlog.Warningln("madvise(huge):", err)
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/sys"
 )
 
 const (
@@ -41,6 +42,8 @@ func Init(gmmName, smmName string, config *cmn.Config) {
 	gmm.MinFree = uint64(config.Memsys.MinFree)
 	gmm.MinPctTotal = config.Memsys.MinPctTotal
 	gmm.MinPctFree = config.Memsys.MinPctFree
+	gmm.NUMA = config.Memsys.NUMA
+	gmm.HugePages = config.Memsys.HugePages
 
 	// hk config
 	if config.Memsys.SizeToGC != 0 {
@@ -54,7 +57,7 @@ func Init(gmmName, smmName string, config *cmn.Config) {
 	nlog.InfoDepth(1, gmm.Str(&gmm.mem))
 
 	// byte mmsa:
-	smm = &MMSA{Name: smmName + ".smm", defBufSize: DefaultSmallBufSize, slabIncStep: SmallSlabIncStep}
+	smm = &MMSA{Name: smmName + ".smm", defBufSize: DefaultSmallBufSize, slabIncStep: SmallSlabIncStep, NUMA: gmm.NUMA}
 	smm.Init(0)
 	smm.sibling = gmm
 	gmm.sibling = smm
@@ -205,6 +208,11 @@ func (r *MMSA) Init(maxUse int64) (err error) {
 	}
 	r.slabStats = &slabStats{}
 	r.statsSnapshot = &Stats{}
+	numPools := 1
+	if r.NUMA {
+		numPools = sys.NumaNodes()
+	}
+	huge := r.HugePages && r.isPage() && hugeAvail()
 	r.rings = make([]*Slab, r.numSlabs)
 	r.sorted = make([]*Slab, r.numSlabs)
 	for i := 0; i < r.numSlabs; i++ {
@@ -213,13 +221,19 @@ func (r *MMSA) Init(maxUse int64) (err error) {
 			m:       r,
 			tag:     r.Name + "." + cos.ToSizeIEC(bufSize, 0),
 			bufSize: bufSize,
-			get:     make([][]byte, 0, optDepth),
-			put:     make([][]byte, 0, optDepth),
 		}
+		slab.init(numPools)
+		slab.huge = huge && bufSize >= hugeMinBufSize
 		slab.pMinDepth = &r.optDepth
 		r.rings[i] = slab
 		r.sorted[i] = slab
 	}
+	if numPools > 1 {
+		cos.Infof("%s: NUMA-aware, %d slab pools", r.Name, numPools)
+	}
+	if huge {
+		cos.Infof("%s: slabs >= %s are backed by huge pages", r.Name, cos.ToSizeIEC(hugeMinBufSize, 0))
+	}
 	return
 }
 
@@ -98,11 +98,12 @@ func (z *SGL) IsNil() bool { return z == nil || z.slab == nil }
 
 // grows on demand upon writing
 func (z *SGL) grow(toSize int64) {
-	z.slab.muget.Lock()
+	p := z.slab.pool()
+	p.muget.Lock()
 	for z.Cap() < toSize {
-		z.sgl = append(z.sgl, z.slab._alloc())
+		z.sgl = append(z.sgl, z.slab._alloc(p))
 	}
-	z.slab.muget.Unlock()
+	p.muget.Unlock()
 }
 
 func (z *SGL) ReadFrom(r io.Reader) (n int64, err error) {
@@ -264,15 +265,16 @@ func (*SGL) Close() error { return nil } // NOTE: no-op
 func (z *SGL) Free() {
 	debug.Assert(z.slab != nil)
 	s := z.slab
-	s.muput.Lock()
+	p := s.pool()
+	p.muput.Lock()
 	for _, buf := range z.sgl {
 		size := cap(buf)
 		debug.Assert(int64(size) == s.Size())
 		b := buf[:size] // always freeing original (fixed buffer) size
 		deadbeef(b)
-		s.put = append(s.put, b)
+		p.put = append(p.put, b)
 	}
-	s.muput.Unlock()
+	p.muput.Unlock()
 	_freeSGL(z, z.slab.m.isPage())
 }
 
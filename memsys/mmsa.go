@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -135,6 +136,8 @@ type (
 		MinPctTotal int           // same, via percentage of total
 		MinPctFree  int           // ditto, as % of free at init time
 		Name        string
+		NUMA        bool // per-NUMA-node slab pools (see slab.go); no-op on single-node systems
+		HugePages   bool // back the largest page slabs with (transparent) huge pages (see hugepage.go)
 		// private
 		info          string
 		sibling       *MMSA
@@ -148,6 +151,8 @@ type (
 		defBufSize    int64
 		mem           sys.MemStat
 		numSlabs      int
+		pcbs          []*pressureSub // memory-pressure callbacks (see pressure.go)
+		pcbsMu        sync.Mutex
 		// atomic state
 		toGC     atomic.Int64 // accumulates over time and triggers GC upon reaching spec-ed limit
 		optDepth atomic.Int64 // ring "depth", i.e., num free bufs we trend to (see grow())
@@ -230,11 +235,12 @@ func (r *MMSA) NewSGL(immediateSize int64, sbufSize ...int64) *SGL {
 	} else {
 		z.sgl = z.sgl[:n]
 	}
-	slab.muget.Lock()
+	p := slab.pool()
+	p.muget.Lock()
 	for i := 0; i < int(n); i++ {
-		z.sgl[i] = slab._alloc()
+		z.sgl[i] = slab._alloc(p)
 	}
-	slab.muget.Unlock()
+	p.muget.Unlock()
 	return z
 }
 
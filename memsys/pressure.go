@@ -1,7 +1,7 @@
 // Package memsys provides memory management and slab/SGL allocation with io.Reader and io.Writer interfaces
 // on top of scatter-gather lists of reusable buffers.
 /*
- * Copyright (c) 2018-2021, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
  */
 package memsys
 
@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/sys"
 )
 
@@ -96,3 +98,68 @@ func (r *MMSA) pressure2S(p int) (sp string) {
 	}
 	return
 }
+
+//
+// memory-pressure callbacks
+//
+
+// Subsystems (e.g., dsort, ETL) register to be notified when memory pressure reaches
+// a given level, so they could shed load - spill to disk, pause, shrink batches -
+// rather than all reacting independently (and often too late) to OOM-adjacent conditions.
+// Once the level is reached, the callback gets invoked upon every change of the pressure,
+// including the final one - back below the level - to resume normal operation.
+// Callbacks are invoked by the housekeeper (see hkcb), one at a time, and must not block.
+
+type (
+	PressureCB  func(pressure int)
+	pressureSub struct {
+		cb    PressureCB
+		name  string
+		level int
+		last  int // last delivered (housekeeper only)
+	}
+)
+
+// `name` must be unique; `level` is one of the enumerated pressure values (above)
+func (r *MMSA) RegPressureCB(name string, level int, cb PressureCB) {
+	debug.Assert(level > PressureLow && level <= OOM, level)
+	r.pcbsMu.Lock()
+	for _, sub := range r.pcbs {
+		debug.Assert(sub.name != name, "duplicate pressure callback ", name)
+	}
+	r.pcbs = append(r.pcbs, &pressureSub{cb: cb, name: name, level: level, last: PressureLow})
+	r.pcbsMu.Unlock()
+}
+
+func (r *MMSA) UnregPressureCB(name string) {
+	r.pcbsMu.Lock()
+	for i, sub := range r.pcbs {
+		if sub.name == name {
+			r.pcbs = append(r.pcbs[:i], r.pcbs[i+1:]...)
+			break
+		}
+	}
+	r.pcbsMu.Unlock()
+}
+
+func (r *MMSA) notifyPressure(pressure int) {
+	r.pcbsMu.Lock()
+	if len(r.pcbs) == 0 {
+		r.pcbsMu.Unlock()
+		return
+	}
+	subs := make([]*pressureSub, len(r.pcbs))
+	copy(subs, r.pcbs) // (to allow callbacks to unregister)
+	r.pcbsMu.Unlock()
+
+	for _, sub := range subs {
+		if pressure == sub.last || (pressure < sub.level && sub.last < sub.level) {
+			continue
+		}
+		if verbose {
+			nlog.Infof("%s: %s => %s", r, sub.name, r.pressure2S(pressure))
+		}
+		sub.last = pressure
+		sub.cb(pressure)
+	}
+}
@@ -0,0 +1,36 @@
+// Package memsys provides memory management and slab/SGL allocation with io.Reader and io.Writer interfaces
+// on top of scatter-gather lists of reusable buffers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package memsys
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPressureCB(t *testing.T) {
+	var (
+		r          = &MMSA{Name: "test-pcb"}
+		high, extr []int
+	)
+	r.RegPressureCB("high", PressureHigh, func(p int) { high = append(high, p) })
+	r.RegPressureCB("extreme", PressureExtreme, func(p int) { extr = append(extr, p) })
+
+	for _, p := range []int{PressureLow, PressureModerate, PressureHigh, PressureHigh, PressureExtreme,
+		PressureModerate, PressureLow, PressureModerate} {
+		r.notifyPressure(p)
+	}
+	expHigh := []int{PressureHigh, PressureExtreme, PressureModerate}
+	tassert.Errorf(t, reflect.DeepEqual(high, expHigh), "expected %v, got %v", expHigh, high)
+	expExtr := []int{PressureExtreme, PressureModerate}
+	tassert.Errorf(t, reflect.DeepEqual(extr, expExtr), "expected %v, got %v", expExtr, extr)
+
+	r.UnregPressureCB("high")
+	r.notifyPressure(OOM)
+	tassert.Errorf(t, len(high) == len(expHigh), "unregistered callback invoked: %v", high)
+	tassert.Errorf(t, len(extr) == len(expExtr)+1 && extr[len(extr)-1] == OOM, "expected OOM, got %v", extr)
+}
@@ -8,115 +8,213 @@ package memsys
 import (
 	"sync"
 	"time"
+	"unsafe"
 
 	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/sys"
+	"golang.org/x/sys/cpu"
 )
 
-type Slab struct {
-	m         *MMSA
-	pMinDepth *atomic.Int64
-	tag       string
-	get       [][]byte
-	put       [][]byte
-	bufSize   int64
-	pos       int
-	muget     sync.Mutex
-	muput     sync.Mutex
-}
+// Each Slab maintains one pool of free buffers per NUMA node (see MMSA.numa) or,
+// by default, a single pool. Allocations come from the pool of the node the calling
+// goroutine runs on - the node is cached per P (see numaHints) rather than queried
+// on every call. Newly grown buffers are first touched (zeroed) on that same node
+// and therefore are, likely, local to it. Freed buffers always go back to the pool
+// they were grown in (see Slab.owners), regardless of where the goroutine
+// runs at the time. Goroutines may migrate, and so NUMA-locality is best-effort.
+
+type (
+	Slab struct {
+		m         *MMSA
+		pMinDepth *atomic.Int64
+		tag       string
+		pools     []slabPool
+		owners    sync.Map // buffer => owning pool (multi-pool only)
+		bufSize   int64
+		huge      bool // huge-page backed (see hugepage.go)
+	}
+	slabPool struct {
+		get   [][]byte
+		put   [][]byte
+		pos   int
+		muget sync.Mutex
+		muput sync.Mutex
+		_     cpu.CacheLinePad // (adjacent pools)
+	}
+	numaHint struct {
+		node int
+	}
+)
+
+// per-P cache of the NUMA node: sync.Pool items are P-local and get dropped
+// by GC, which also serves as a periodic refresh
+var numaHints = sync.Pool{New: func() any { return &numaHint{node: sys.NumaNode()} }}
 
 func (s *Slab) Size() int64 { return s.bufSize }
 func (s *Slab) Tag() string { return s.tag }
 func (s *Slab) MMSA() *MMSA { return s.m }
 
+func (s *Slab) init(numPools int) {
+	s.pools = make([]slabPool, numPools)
+	for i := range s.pools {
+		s.pools[i].get = make([][]byte, 0, optDepth)
+		s.pools[i].put = make([][]byte, 0, optDepth)
+	}
+}
+
+// the calling goroutine's pool
+func (s *Slab) pool() (p *slabPool) {
+	if len(s.pools) == 1 {
+		return &s.pools[0]
+	}
+	h := numaHints.Get().(*numaHint)
+	p = &s.pools[h.node%len(s.pools)]
+	numaHints.Put(h)
+	return
+}
+
+// the pool the buffer was grown in
+func (s *Slab) owner(buf []byte) *slabPool {
+	if len(s.pools) == 1 {
+		return &s.pools[0]
+	}
+	if v, ok := s.owners.Load(bufKey(buf)); ok {
+		return v.(*slabPool)
+	}
+	debug.Assert(false, s.tag, ": not owned")
+	return s.pool()
+}
+
+func (s *Slab) track(p *slabPool, buf []byte) {
+	if len(s.pools) > 1 {
+		s.owners.Store(bufKey(buf), p)
+	}
+}
+
+func (s *Slab) untrack(buf []byte) {
+	if len(s.pools) > 1 {
+		s.owners.Delete(bufKey(buf))
+	}
+}
+
+// (uintptr - not to keep buffers that were never freed from being garbage collected)
+func bufKey(buf []byte) uintptr { return uintptr(unsafe.Pointer(unsafe.SliceData(buf[:cap(buf)]))) }
+
+// per-pool depth
+func (s *Slab) depth(depth int) int {
+	if n := len(s.pools); n > 1 {
+		depth = cos.Max(depth/n, minDepth)
+	}
+	return depth
+}
+
 func (s *Slab) Alloc() (buf []byte) {
-	s.muget.Lock()
-	buf = s._alloc()
-	s.muget.Unlock()
+	p := s.pool()
+	p.muget.Lock()
+	buf = s._alloc(p)
+	p.muget.Unlock()
 	return
 }
 
 func (s *Slab) Free(buf []byte) {
-	s.muput.Lock()
+	p := s.owner(buf)
+	p.muput.Lock()
 	debug.Assert(int64(cap(buf)) == s.Size())
 	deadbeef(buf[:cap(buf)])
-	s.put = append(s.put, buf[:cap(buf)]) // always freeing the original size
-	s.muput.Unlock()
+	p.put = append(p.put, buf[:cap(buf)]) // always freeing the original size
+	p.muput.Unlock()
 }
 
-func (s *Slab) _alloc() (buf []byte) {
-	if len(s.get) > s.pos { // fast path
-		buf = s.get[s.pos]
-		s.pos++
+func (s *Slab) _alloc(p *slabPool) (buf []byte) {
+	if len(p.get) > p.pos { // fast path
+		buf = p.get[p.pos]
+		p.pos++
 		s.hitsInc()
 		return
 	}
-	return s._allocSlow()
+	return s._allocSlow(p)
 }
 
-func (s *Slab) _allocSlow() (buf []byte) {
-	curMinDepth := int(s.pMinDepth.Load())
+func (s *Slab) _allocSlow(p *slabPool) (buf []byte) {
+	curMinDepth := s.depth(int(s.pMinDepth.Load()))
 	debug.Assert(curMinDepth > 0)
-	debug.Assert(len(s.get) == s.pos)
-	s.muput.Lock()
-	lput := len(s.put)
+	debug.Assert(len(p.get) == p.pos)
+	p.muput.Lock()
+	lput := len(p.put)
 	if cnt := (curMinDepth - lput) >> 1; cnt > 0 {
 		if verbose {
-			nlog.Infof("%s: grow by %d to %d, caps=(%d, %d)", s.tag, cnt, lput+cnt, cap(s.get), cap(s.put))
+			nlog.Infof("%s: grow by %d to %d, caps=(%d, %d)", s.tag, cnt, lput+cnt, cap(p.get), cap(p.put))
 		}
-		s.grow(cnt)
+		s.grow(p, cnt)
 	}
-	s.get, s.put = s.put, s.get
+	p.get, p.put = p.put, p.get
 
-	debug.Assert(len(s.put) == s.pos)
+	debug.Assert(len(p.put) == p.pos)
 
-	s.put = s.put[:0]
-	s.muput.Unlock()
+	p.put = p.put[:0]
+	p.muput.Unlock()
 
-	s.pos = 0
-	buf = s.get[s.pos]
-	s.pos++
+	p.pos = 0
+	buf = p.get[p.pos]
+	p.pos++
 	s.hitsInc()
 	return
 }
 
-func (s *Slab) grow(cnt int) {
+func (s *Slab) grow(p *slabPool, cnt int) {
+	if s.huge {
+		s.growHuge(p, cnt)
+		return
+	}
 	for ; cnt > 0; cnt-- {
 		buf := make([]byte, s.Size())
-		s.put = append(s.put, buf)
+		s.track(p, buf)
+		p.put = append(p.put, buf)
 	}
 }
 
-func (s *Slab) reduce(todepth int) int64 {
+func (s *Slab) reduce(todepth int) (freed int64) {
+	todepth = s.depth(todepth)
+	for i := range s.pools {
+		freed += s._reduce(&s.pools[i], todepth)
+	}
+	return
+}
+
+func (s *Slab) _reduce(p *slabPool, todepth int) int64 {
 	var pfreed, gfreed int64
-	s.muput.Lock()
-	lput := len(s.put)
+	p.muput.Lock()
+	lput := len(p.put)
 	cnt := lput - todepth
 	if cnt > 0 {
 		for ; cnt > 0; cnt-- {
 			lput--
-			s.put[lput] = nil
+			s.untrack(p.put[lput])
+			p.put[lput] = nil
 			pfreed += s.Size()
 		}
-		s.put = s.put[:lput]
+		p.put = p.put[:lput]
 	}
-	s.muput.Unlock()
+	p.muput.Unlock()
 	if pfreed > 0 && verbose {
 		nlog.Infof("%s: reduce lput %d to %d (freed %dB)", s.tag, lput, lput-cnt, pfreed)
 	}
 
-	s.muget.Lock()
-	lget := len(s.get) - s.pos
+	p.muget.Lock()
+	lget := len(p.get) - p.pos
 	cnt = lget - todepth
 	if cnt > 0 {
 		for ; cnt > 0; cnt-- {
-			s.get[s.pos] = nil
-			s.pos++
+			s.untrack(p.get[p.pos])
+			p.get[p.pos] = nil
+			p.pos++
 			gfreed += s.Size()
 		}
 	}
-	s.muget.Unlock()
+	p.muget.Unlock()
 	if gfreed > 0 && verbose {
 		nlog.Infof("%s: reduce lget %d to %d (freed %dB)", s.tag, lget, lget-cnt, gfreed)
 	}
@@ -124,31 +222,40 @@ func (s *Slab) reduce(todepth int) int64 {
 }
 
 func (s *Slab) cleanup() (freed int64) {
-	s.muget.Lock()
-	s.muput.Lock()
-	for i := s.pos; i < len(s.get); i++ {
-		s.get[i] = nil
+	for i := range s.pools {
+		freed += s._cleanup(&s.pools[i])
+	}
+	return
+}
+
+func (s *Slab) _cleanup(p *slabPool) (freed int64) {
+	p.muget.Lock()
+	p.muput.Lock()
+	for i := p.pos; i < len(p.get); i++ {
+		s.untrack(p.get[i])
+		p.get[i] = nil
 		freed += s.Size()
 	}
-	for i := range s.put {
-		s.put[i] = nil
+	for i := range p.put {
+		s.untrack(p.put[i])
+		p.put[i] = nil
 		freed += s.Size()
 	}
-	if cap(s.get) > maxDepth {
-		s.get = make([][]byte, 0, optDepth)
+	if cap(p.get) > maxDepth {
+		p.get = make([][]byte, 0, optDepth)
 	} else {
-		s.get = s.get[:0]
+		p.get = p.get[:0]
 	}
-	if cap(s.put) > maxDepth {
-		s.put = make([][]byte, 0, optDepth)
+	if cap(p.put) > maxDepth {
+		p.put = make([][]byte, 0, optDepth)
 	} else {
-		s.put = s.put[:0]
+		p.put = p.put[:0]
 	}
-	s.pos = 0
+	p.pos = 0
 
-	debug.Assert(len(s.get) == 0 && len(s.put) == 0)
-	s.muput.Unlock()
-	s.muget.Unlock()
+	debug.Assert(len(p.get) == 0 && len(p.put) == 0)
+	p.muput.Unlock()
+	p.muget.Unlock()
 	return
 }
 
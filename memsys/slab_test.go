@@ -0,0 +1,73 @@
+// Package memsys provides memory management and slab/SGL allocation with io.Reader and io.Writer interfaces
+// on top of scatter-gather lists of reusable buffers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package memsys
+
+import (
+	"strconv"
+	"testing"
+)
+
+// to run: go test -bench=BenchmarkSlabPools -benchmem -run=XXX ./memsys/
+
+func BenchmarkSlabPools(b *testing.B) {
+	for _, numPools := range []int{1, 2, 4} {
+		b.Run("pools-"+strconv.Itoa(numPools), func(b *testing.B) {
+			benchSlabPools(b, numPools)
+		})
+	}
+}
+
+func benchSlabPools(b *testing.B, numPools int) {
+	mem := &MMSA{Name: "bmem", MinPctFree: 50}
+	mem.Init(0)
+	defer mem.Terminate(false)
+	slab, err := mem.GetSlab(DefaultBufSize)
+	if err != nil {
+		b.Fatal(err)
+	}
+	slab.init(numPools) // (regardless of the number of NUMA nodes)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			buf := slab.Alloc()
+			slab.Free(buf)
+		}
+	})
+}
+
+// freed buffers go back to the pool they were allocated from
+func TestSlabOwner(t *testing.T) {
+	mem := &MMSA{Name: "omem", MinPctFree: 50}
+	mem.Init(0)
+	defer mem.Terminate(false)
+	slab, err := mem.GetSlab(DefaultBufSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slab.init(4)
+
+	p := &slab.pools[1]
+	p.muget.Lock()
+	buf := slab._alloc(p)
+	p.muget.Unlock()
+	if owner := slab.owner(buf); owner != p {
+		t.Fatalf("expected pool 1, got %p", owner)
+	}
+	slab.Free(buf[:10])
+	if l := len(p.put); l != 1 {
+		t.Fatalf("expected the buffer to be freed into its own pool, got len(put) = %d", l)
+	}
+
+	// reduced buffers are not tracked
+	slab.cleanup()
+	var n int
+	slab.owners.Range(func(_, _ any) bool { n++; return true })
+	if n != 0 {
+		t.Fatalf("expected no tracked buffers after cleanup, got %d", n)
+	}
+}
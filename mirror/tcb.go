@@ -41,11 +41,15 @@ type (
 		t    cluster.Target
 		dm   *bundle.DataMover
 		args xreg.TCBArgs
+		lim  xact.Limiter // (apc.XactLimits)
+		xf   *xform       // cross-object transforms (see tcbx.go)
 		// starting up
 		wg sync.WaitGroup
 		// finishing
 		rxlast atomic.Int64
 		refc   atomic.Int32
+		// ETL: memory pressure (see pressureCB)
+		mpress atomic.Int32
 	}
 )
 
@@ -53,9 +57,12 @@ const OpcTxnDone = 27182
 
 const etlBucketParallelCnt = 2
 
+const etlPauseIval = time.Second // (under memory pressure)
+
 // interface guard
 var (
 	_ cluster.Xact   = (*XactTCB)(nil)
+	_ xact.Limited   = (*XactTCB)(nil)
 	_ xreg.Renewable = (*tcbFactory)(nil)
 )
 
@@ -76,6 +83,11 @@ func (e *tcbFactory) Start() error {
 	)
 	debug.AssertNoErr(err)
 	e.xctn = newXactTCB(e, slab, config)
+	if e.args.Msg.Xform != "" {
+		if e.xctn.xf, err = newXform(e.args.Msg, e.args.DP); err != nil {
+			return err
+		}
+	}
 
 	// refcount OpcTxnDone; this target must ve active (ref: ignoreMaintenance)
 	smap := e.T.Sowner().Get()
@@ -101,6 +113,7 @@ func (e *tcbFactory) newDM(config *cmn.Config, uuid string, sizePDU int32) error
 		Compression: config.TCB.Compression,
 		Multiplier:  config.TCB.SbundleMult,
 		SizePDU:     sizePDU,
+		Bandwidth:   func() int64 { return int64(cmn.GCO.Get().TCB.Bandwidth) },
 	}
 	dm, err := bundle.NewDataMover(e.T, trname+"-"+uuid, e.xctn.recv, cmn.OwtPut, dmExtra)
 	if err != nil {
@@ -164,9 +177,19 @@ func newXactTCB(e *tcbFactory, slab *memsys.Slab, config *cmn.Config) (r *XactTC
 	}
 	mpopts.Bck.Copy(e.args.BckFrom.Bucket())
 	r.BckJog.Init(e.UUID(), e.kind, e.args.BckTo, mpopts, config)
+	if limits := &e.args.Msg.Limits; limits.IsSet() {
+		r.SetLimits(limits)
+	}
 	return
 }
 
+// apply this target's share of the (cluster-wide) limits; can be called at any time
+func (r *XactTCB) SetLimits(limits *apc.XactLimits) {
+	nat := r.t.Sowner().Get().CountActiveTs()
+	r.lim.Set(limits.Share(nat))
+	nlog.Infoln(r.Name(), "limits:", *limits)
+}
+
 func (r *XactTCB) WaitRunning() { r.wg.Wait() }
 
 func (r *XactTCB) Run(wg *sync.WaitGroup) {
@@ -176,6 +199,9 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 
 	r.wg.Done()
 
+	if r.Kind() == apc.ActETLBck {
+		r.t.PageMM().RegPressureCB(r.ID(), memsys.PressureHigh, r.pressureCB)
+	}
 	r.BckJog.Run()
 	nlog.Infoln(r.Name())
 
@@ -190,13 +216,42 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 		r.AddErr(fmt.Errorf("%s: %v", r, cmn.ErrQuiesceTimeout))
 	}
 
+	// many-to-one: all senders are done - transform the groups
+	if r.xf != nil && r.xf.groups != nil {
+		if err == nil && r.Err() == nil {
+			r.flush()
+		}
+		r.xf.cleanup()
+	}
+
 	// close
 	r.dm.Close(err)
 	r.dm.UnregRecv()
+	if r.Kind() == apc.ActETLBck {
+		r.t.PageMM().UnregPressureCB(r.ID())
+	}
 
 	r.Finish()
 }
 
+// memsys callback: ETL pauses under high memory pressure (must not block)
+func (r *XactTCB) pressureCB(pressure int) {
+	if prev := r.mpress.Swap(int32(pressure)); prev < memsys.PressureHigh && pressure >= memsys.PressureHigh {
+		nlog.Warningln(r.Name(), "pausing: memory pressure", pressure)
+	}
+}
+
+func (r *XactTCB) pause() bool {
+	for r.mpress.Load() >= memsys.PressureHigh {
+		select {
+		case <-r.ChanAbort():
+			return false
+		case <-time.After(etlPauseIval):
+		}
+	}
+	return true
+}
+
 func (r *XactTCB) qcb(tot time.Duration) cluster.QuiRes {
 	// TODO -- FIXME =======================
 	if cnt := r.ErrCnt(); cnt > 0 {
@@ -221,6 +276,12 @@ func (r *XactTCB) qcb(tot time.Duration) cluster.QuiRes {
 }
 
 func (r *XactTCB) copyObject(lom *cluster.LOM, buf []byte) (err error) {
+	if !r.lim.Wait(lom.SizeBytes(), r.ChanAbort()) || !r.pause() {
+		return r.AbortErr()
+	}
+	if r.xf != nil {
+		return r.xobj(lom, buf)
+	}
 	objNameTo := r.args.Msg.ToName(lom.ObjName)
 	if r.BckJog.Config.FastV(5, cos.SmoduleMirror) {
 		nlog.Infof("%s: %s => %s", r.Base.Name(), lom.Cname(), r.args.BckTo.Cname(objNameTo))
@@ -261,6 +322,11 @@ func (r *XactTCB) recv(hdr transport.ObjHdr, objReader io.Reader, err error) err
 		debug.Assert(refc >= 0)
 		return nil
 	}
+	if hdr.Opcode == OpcXformMember {
+		err = r.recvMember(&hdr, objReader)
+		transport.DrainAndFreeReader(objReader)
+		return err
+	}
 
 	debug.Assert(hdr.Opcode == 0)
 	lom := cluster.AllocLOM(hdr.ObjName)
@@ -280,6 +346,9 @@ func (r *XactTCB) _recv(hdr transport.ObjHdr, objReader io.Reader, lom *cluster.
 	params := cluster.AllocPutObjParams()
 	{
 		params.WorkTag = fs.WorkfilePut
+		if r.Kind() == apc.ActETLBck {
+			params.WorkTag = fs.WorkfileETL
+		}
 		params.Reader = io.NopCloser(objReader)
 		params.Cksum = hdr.ObjAttrs.Cksum
 		params.Xact = r
@@ -328,5 +397,8 @@ func (r *XactTCB) Snap() (snap *cluster.Snap) {
 	snap.IdleX = r.IsIdle()
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
+	if r.xf != nil {
+		snap.Ext = r.xstats()
+	}
 	return
 }
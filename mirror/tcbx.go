@@ -0,0 +1,424 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+// Cross-object (offline) transforms - apc.XformMany2One and apc.XformOne2Many.
+//
+// many-to-one:
+// - each source object maps to a group (by the group key - see apc.Transform.GroupBy);
+// - the group's output is named via apc.TCBMsg.XformName and, therefore, maps to a
+//   (HRW) destination target that also becomes the group's "owner";
+// - all the other targets send their members to the owner where the members get staged
+//   (in memory) until all senders are done;
+// - finally, the owner transforms each group, in one shot: the ETL receives the group
+//   as a single TAR-formatted payload (members sorted by name) and returns the output.
+// one-to-many:
+// - the ETL returns TAR-formatted result; each (regular file) entry becomes a separate
+//   destination object named via apc.TCBMsg.XformName.
+
+const OpcXformMember = OpcTxnDone + 1
+
+type (
+	xform struct {
+		re     *regexp.Regexp     // group-by (many-to-one)
+		groups map[string]*xgroup // group key => staged members (many-to-one)
+		mu     sync.Mutex         // protects groups
+		stats  struct{ in, out, skipped atomic.Int64 }
+	}
+	xgroup struct {
+		members []xmember
+		size    int64
+	}
+	xmember struct {
+		sgl  *memsys.SGL
+		name string
+	}
+
+	// (see Snap.Ext)
+	XformStats struct {
+		Mode    string `json:"mode"`
+		In      int64  `json:"in"`      // source objects (transformed or, in many-to-one, staged)
+		Out     int64  `json:"out"`     // produced outputs
+		Skipped int64  `json:"skipped"` // many-to-one: source objects that did not match group-by
+		Groups  int    `json:"groups"`  // many-to-one: staged (not yet transformed) groups
+	}
+)
+
+func newXform(msg *apc.TCBMsg, dp cluster.DP) (*xform, error) {
+	xf := &xform{}
+	if msg.Xform != apc.XformMany2One {
+		return xf, nil
+	}
+	if _, ok := dp.(cluster.PayloadDP); !ok {
+		return nil, fmt.Errorf("%q transform: data provider %T does not support payloads", msg.Xform, dp)
+	}
+	if msg.GroupBy != "" {
+		re, err := regexp.Compile(msg.GroupBy)
+		if err != nil {
+			return nil, err
+		}
+		xf.re = re
+	}
+	xf.groups = make(map[string]*xgroup, 64)
+	return xf, nil
+}
+
+func (xf *xform) key(objName string) (string, bool) { return xformKey(xf.re, objName) }
+
+// group key: the first submatch, if defined, or the entire match;
+// by default, the object name without extension(s) (e.g., "a/b/sample1.seg.png" => "a/b/sample1")
+func xformKey(re *regexp.Regexp, objName string) (string, bool) {
+	if re == nil {
+		dir, base := path.Split(objName)
+		if i := strings.IndexByte(base, '.'); i > 0 {
+			base = base[:i]
+		}
+		return dir + base, true
+	}
+	m := re.FindStringSubmatch(objName)
+	switch {
+	case len(m) == 0:
+		return "", false
+	case len(m) > 1 && m[1] != "":
+		return m[1], true
+	default:
+		return m[0], m[0] != ""
+	}
+}
+
+func (xf *xform) add(key, name string, sgl *memsys.SGL) {
+	xf.mu.Lock()
+	g, ok := xf.groups[key]
+	if !ok {
+		g = &xgroup{}
+		xf.groups[key] = g
+	}
+	g.members = append(g.members, xmember{sgl: sgl, name: name})
+	g.size += sgl.Size()
+	xf.mu.Unlock()
+	xf.stats.in.Inc()
+}
+
+func (xf *xform) cleanup() {
+	xf.mu.Lock()
+	for key, g := range xf.groups {
+		g.free()
+		delete(xf.groups, key)
+	}
+	xf.mu.Unlock()
+}
+
+func (g *xgroup) free() {
+	for i := range g.members {
+		g.members[i].sgl.Free()
+	}
+	g.members = nil
+}
+
+//
+// XactTCB (cross-object)
+//
+
+func (r *XactTCB) xobj(lom *cluster.LOM, buf []byte) (err error) {
+	if r.args.Msg.Xform == apc.XformMany2One {
+		err = r.stage(lom)
+	} else {
+		err = r.fanout(lom, buf)
+	}
+	if err != nil {
+		if cos.IsErrOOS(err) {
+			err = cmn.NewErrAborted(r.Name(), "tcb", err)
+		}
+		r.AddErr(err)
+		if r.BckJog.Config.FastV(5, cos.SmoduleMirror) {
+			nlog.Infof("Error: %v", err)
+		}
+	}
+	return
+}
+
+// many-to-one: stage locally or send the member to its group's owner
+func (r *XactTCB) stage(lom *cluster.LOM) error {
+	key, ok := r.xf.key(lom.ObjName)
+	if !ok {
+		r.xf.stats.skipped.Inc()
+		return nil
+	}
+	var (
+		objNameTo = r.args.Msg.XformName(key, "", 0)
+		smap      = r.t.Sowner().Get()
+	)
+	tsi, err := cluster.HrwTarget(r.args.BckTo.MakeUname(objNameTo), smap)
+	if err != nil {
+		return err
+	}
+	ldp := &cluster.LDP{}
+	reader, oah, err := ldp.Reader(lom)
+	if err != nil {
+		if cmn.IsObjNotExist(err) {
+			err = nil
+		}
+		return err
+	}
+	if r.args.Msg.DryRun {
+		n, err := io.Copy(io.Discard, reader)
+		cos.Close(reader)
+		r.xf.stats.in.Inc()
+		r.ObjsAdd(1, n)
+		return err
+	}
+	if tsi.ID() == r.t.SID() {
+		err = r.addMember(key, lom.ObjName, reader, oah.SizeBytes())
+		cos.Close(reader)
+		return err
+	}
+	o := transport.AllocSend()
+	hdr := &o.Hdr
+	{
+		hdr.Opcode = OpcXformMember
+		hdr.Bck.Copy(lom.Bucket())
+		hdr.ObjName = lom.ObjName
+		hdr.ObjAttrs.CopyFrom(oah)
+		hdr.Opaque = []byte(key)
+	}
+	r.xf.stats.in.Inc()
+	return r.dm.Send(o, reader, tsi)
+}
+
+func (r *XactTCB) addMember(key, name string, reader io.Reader, size int64) error {
+	if size < 0 {
+		size = 0 // unknown
+	}
+	sgl := r.t.PageMM().NewSGL(size)
+	if _, err := io.Copy(sgl, reader); err != nil {
+		sgl.Free()
+		return err
+	}
+	r.xf.add(key, name, sgl)
+	return nil
+}
+
+// many-to-one: all members are here - transform all staged groups, one group at a time
+func (r *XactTCB) flush() {
+	r.xf.mu.Lock()
+	keys := make([]string, 0, len(r.xf.groups))
+	for key := range r.xf.groups {
+		keys = append(keys, key)
+	}
+	r.xf.mu.Unlock()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := r.AbortErr(); err != nil {
+			break
+		}
+		r.xf.mu.Lock()
+		g := r.xf.groups[key]
+		delete(r.xf.groups, key)
+		r.xf.mu.Unlock()
+
+		err := r.transformGroup(key, g)
+		g.free()
+		if err != nil {
+			r.AddErr(err)
+			nlog.Errorln(r.Name(), "group", key, err)
+			if cos.IsErrOOS(err) {
+				break
+			}
+		}
+	}
+}
+
+func (r *XactTCB) transformGroup(key string, g *xgroup) error {
+	sort.Slice(g.members, func(i, j int) bool { return g.members[i].name < g.members[j].name })
+
+	// payload
+	sgl := r.t.PageMM().NewSGL(g.size + int64(len(g.members)+2)*archive.TarBlockSize)
+	defer sgl.Free()
+	aw := archive.NewWriter(archive.ExtTar, sgl, nil /*cksum*/, nil /*opts*/)
+	for i := range g.members {
+		m := &g.members[i]
+		oah := &cmn.ObjAttrs{Size: m.sgl.Size(), Atime: time.Now().UnixNano()}
+		if err := aw.Write(m.name, oah, m.sgl); err != nil {
+			aw.Fini()
+			return err
+		}
+	}
+	aw.Fini()
+
+	// transform
+	dp := r.args.DP.(cluster.PayloadDP)
+	reader, _, err := dp.PayloadReader(key+archive.ExtTar, sgl, sgl.Size())
+	if err != nil {
+		return err
+	}
+	objNameTo := r.args.Msg.XformName(key, "", 0)
+	size, err := r.putOutput(objNameTo, reader)
+	if err == nil {
+		r.ObjsAdd(1, size)
+		r.xf.stats.out.Inc()
+		if r.BckJog.Config.FastV(5, cos.SmoduleMirror) {
+			nlog.Infof("%s: group %q (%d) => %s", r.Base.Name(), key, len(g.members), r.args.BckTo.Cname(objNameTo))
+		}
+	}
+	return err
+}
+
+// one-to-many: transform and split the (TAR-formatted) result
+func (r *XactTCB) fanout(lom *cluster.LOM, buf []byte) error {
+	reader, _, err := r.args.DP.Reader(lom)
+	if err != nil {
+		if cmn.IsObjNotExist(err) {
+			err = nil
+		}
+		return err
+	}
+	defer cos.Close(reader)
+
+	ar, err := archive.NewReader(archive.ExtTar, reader)
+	if err != nil {
+		return fmt.Errorf("%s: transformed %s is not a valid TAR: %w", r, lom.Cname(), err)
+	}
+	var (
+		key, _ = xformKey(nil, lom.ObjName)
+		idx    int
+	)
+	_, err = ar.Range("", func(entry string, rd cos.ReadCloseSizer, hdr any) (bool, error) {
+		if h, ok := hdr.(*tar.Header); ok && h.Typeflag != tar.TypeReg {
+			return false, nil
+		}
+		objNameTo := r.args.Msg.XformName(key, entry, idx)
+		idx++
+		return false, r.output(objNameTo, rd, buf)
+	})
+	if err == nil && idx == 0 {
+		err = errors.New("transformed " + lom.Cname() + " contains no outputs")
+	}
+	r.xf.stats.in.Inc()
+	return err
+}
+
+// one-to-many: local or remote destination
+func (r *XactTCB) output(objNameTo string, rd cos.ReadCloseSizer, buf []byte) error {
+	if r.args.Msg.DryRun {
+		n, err := io.CopyBuffer(io.Discard, rd, buf)
+		r.ObjsAdd(1, n)
+		return err
+	}
+	tsi, err := cluster.HrwTarget(r.args.BckTo.MakeUname(objNameTo), r.t.Sowner().Get())
+	if err != nil {
+		return err
+	}
+	if tsi.ID() == r.t.SID() {
+		size, err := r.putOutput(objNameTo, io.NopCloser(rd))
+		if err == nil {
+			r.ObjsAdd(1, size)
+			r.xf.stats.out.Inc()
+		}
+		return err
+	}
+
+	// the entry must be read in its entirety prior to moving on to the next one
+	sgl := r.t.PageMM().NewSGL(rd.Size())
+	if _, err := io.CopyBuffer(sgl, rd, buf); err != nil {
+		sgl.Free()
+		return err
+	}
+	o := transport.AllocSend()
+	hdr := &o.Hdr
+	{
+		hdr.Bck.Copy(r.args.BckTo.Bucket())
+		hdr.ObjName = objNameTo
+		hdr.ObjAttrs.Size = sgl.Size()
+		hdr.ObjAttrs.Atime = time.Now().UnixNano()
+	}
+	o.Callback = func(_ transport.ObjHdr, _ io.ReadCloser, _ any, _ error) { sgl.Free() }
+	if err := r.dm.Send(o, memsys.NewReader(sgl), tsi); err != nil {
+		return err
+	}
+	r.xf.stats.out.Inc()
+	return nil
+}
+
+// store (transformed) output locally
+func (r *XactTCB) putOutput(objNameTo string, reader io.ReadCloser) (size int64, err error) {
+	lom := cluster.AllocLOM(objNameTo)
+	defer cluster.FreeLOM(lom)
+	if err = lom.InitBck(r.args.BckTo.Bucket()); err != nil {
+		cos.Close(reader)
+		return
+	}
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	params := cluster.AllocPutObjParams()
+	{
+		params.WorkTag = fs.WorkfileETL
+		params.Reader = reader
+		params.Cksum = cos.NoneCksum
+		params.Xact = r
+		params.OWT = cmn.OwtPut // (see _recv)
+		params.Atime = lom.Atime()
+	}
+	err = r.t.PutObject(lom, params)
+	cluster.FreePutObjParams(params)
+	if err == nil {
+		size = lom.SizeBytes()
+	}
+	return
+}
+
+// Rx: many-to-one member
+func (r *XactTCB) recvMember(hdr *transport.ObjHdr, objReader io.Reader) error {
+	if r.xf == nil || r.xf.groups == nil {
+		err := fmt.Errorf("%s: unexpected group member %s", r, hdr.Cname())
+		r.AddErr(err)
+		return err
+	}
+	if err := r.addMember(string(hdr.Opaque), hdr.ObjName, objReader, hdr.ObjAttrs.Size); err != nil {
+		r.AddErr(err)
+		nlog.Errorln(err)
+		return err
+	}
+	r.rxlast.Store(mono.NanoTime())
+	return nil
+}
+
+func (r *XactTCB) xstats() *XformStats {
+	xf := r.xf
+	xf.mu.Lock()
+	n := len(xf.groups)
+	xf.mu.Unlock()
+	return &XformStats{
+		Mode:    r.args.Msg.Xform,
+		In:      xf.stats.in.Load(),
+		Out:     xf.stats.out.Load(),
+		Skipped: xf.stats.skipped.Load(),
+		Groups:  n,
+	}
+}
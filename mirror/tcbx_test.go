@@ -0,0 +1,62 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestXformKey(t *testing.T) {
+	tests := []struct {
+		groupBy, name, key string
+		ok                 bool
+	}{
+		{"", "a/b/sample1.cls", "a/b/sample1", true},
+		{"", "a/b/sample1.seg.png", "a/b/sample1", true},
+		{"", "a.b/sample2", "a.b/sample2", true},
+		{`^(shard-\d+)-`, "shard-01-img.jpg", "shard-01", true},
+		{`^(shard-\d+)-`, "other.jpg", "", false},
+		{`^[a-z]+`, "abc123", "abc", true},
+	}
+	for _, test := range tests {
+		var re *regexp.Regexp
+		if test.groupBy != "" {
+			re = regexp.MustCompile(test.groupBy)
+		}
+		key, ok := xformKey(re, test.name)
+		tassert.Errorf(t, key == test.key && ok == test.ok, "%q, %q: expected (%q, %t), got (%q, %t)",
+			test.groupBy, test.name, test.key, test.ok, key, ok)
+	}
+}
+
+func TestXformName(t *testing.T) {
+	msg := &apc.TCBMsg{Transform: apc.Transform{Name: "etl", Xform: apc.XformOne2Many}}
+	tassert.CheckFatal(t, msg.Validate(true))
+	name := msg.XformName("a/sample1", "part-1.bin", 0)
+	tassert.Errorf(t, name == "a/sample1/part-1.bin", "got %q", name)
+
+	msg.OutName = "{key}-{idx}.bin"
+	msg.Prepend = "out/"
+	tassert.CheckFatal(t, msg.Validate(true))
+	name = msg.XformName("a/sample1", "part-1.bin", 3)
+	tassert.Errorf(t, name == "out/a/sample1-3.bin", "got %q", name)
+
+	msg = &apc.TCBMsg{Transform: apc.Transform{Name: "etl", Xform: apc.XformMany2One, OutName: "{key}.rec"}}
+	tassert.CheckFatal(t, msg.Validate(true))
+	name = msg.XformName("a/sample1", "", 0)
+	tassert.Errorf(t, name == "a/sample1.rec", "got %q", name)
+
+	// invalid
+	msg.OutName = "{key}/{entry}"
+	tassert.Errorf(t, msg.Validate(true) != nil, "expected many-to-one with {entry} to fail validation")
+	msg.OutName, msg.GroupBy = "", "(unclosed"
+	tassert.Errorf(t, msg.Validate(true) != nil, "expected invalid group-by to fail validation")
+	msg.GroupBy = ""
+	tassert.Errorf(t, msg.Validate(false) != nil, "expected cross-object copy (no ETL) to fail validation")
+}
@@ -0,0 +1 @@
+from aistore.dali.source import AISExternalSource
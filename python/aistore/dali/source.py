@@ -0,0 +1,105 @@
+"""
+AIS Plugin for NVIDIA DALI
+
+External source that feeds DALI pipelines with data streamed from AIS.
+
+Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+"""
+
+from typing import List, Tuple, Union
+
+import numpy as np
+
+from aistore.sdk.dataset_reader import (
+    DatasetReader,
+    Source,
+    DEFAULT_NUM_WORKERS,
+    DEFAULT_PREFETCH,
+)
+
+
+# pylint: disable=unused-variable,too-many-instance-attributes
+class AISExternalSource:
+    """
+    Batch iterator over objects (or the files packed in shards) in AIS, to be used with
+    `nvidia.dali.fn.external_source(source=..., num_outputs=2, batch=True)`.
+    Each batch is a tuple of two lists: sample contents and sample names (object URLs),
+    both as 1D uint8 numpy arrays. Objects are fetched with parallel GET requests and
+    prefetched ahead of the pipeline; the last batch of an epoch may be partial.
+    If `etl_name` is provided, that ETL must already exist on the AIStore cluster.
+
+    Args:
+        client_url (str): AIS endpoint URL
+        sources (str, AISSource, or List of either): URL prefixes, e.g. "ais://bucket1/train/",
+            and/or types implementing the AISSource interface: Bucket, ObjectGroup, Object, etc.
+        batch_size (int): Number of samples per batch
+        prefix (str, optional): Only include objects with names starting with this prefix
+        etl_name (str, optional): Optional etl on the AIS cluster to apply to each object
+        shards (bool, optional): Selected objects are (.tar) shards - yield the files packed inside
+        shard_id (int, optional): Index of this pipeline, same as in DALI readers
+        num_shards (int, optional): Total number of pipelines to split objects between
+        shuffle (bool, optional): Shuffle the (per-pipeline) list of objects at the start of each epoch
+        seed (int, optional): Seed for shuffling
+        num_workers (int, optional): Number of parallel GET requests
+        prefetch (int, optional): Maximum number of objects fetched ahead of the pipeline
+
+    Example:
+        >>> from nvidia.dali import pipeline_def, fn
+        >>> from aistore.dali import AISExternalSource
+        >>> source = AISExternalSource("http://ais-gateway-url:8080", "ais://bucket1/train/", batch_size=64)
+        >>> @pipeline_def(batch_size=64, num_threads=4, device_id=0)
+        >>> def pipe():
+        >>>     jpegs, names = fn.external_source(source=source, num_outputs=2, batch=True)
+        >>>     return fn.decoders.image(jpegs, device="mixed"), names
+    """
+
+    def __init__(
+        self,
+        client_url: str,
+        sources: Union[Source, List[Source]],
+        batch_size: int,
+        prefix: str = "",
+        etl_name: str = None,
+        shards: bool = False,
+        shard_id: int = 0,
+        num_shards: int = 1,
+        shuffle: bool = False,
+        seed: int = None,
+        num_workers: int = DEFAULT_NUM_WORKERS,
+        prefetch: int = DEFAULT_PREFETCH,
+    ):
+        if batch_size < 1:
+            raise ValueError(f"invalid batch size {batch_size}")
+        self.batch_size = batch_size
+        self._reader = DatasetReader(
+            client_url,
+            sources,
+            prefix=prefix,
+            etl_name=etl_name,
+            shards=shards,
+            num_shards=num_shards,
+            shard_index=shard_id,
+            shuffle=shuffle,
+            seed=seed,
+            num_workers=num_workers,
+            prefetch=prefetch,
+        )
+        self._iter = None
+
+    def __iter__(self):
+        self._iter = iter(self._reader)
+        return self
+
+    def __next__(self) -> Tuple[List[np.ndarray], List[np.ndarray]]:
+        if self._iter is None:
+            iter(self)
+        data, names = [], []
+        for url, content in self._iter:
+            data.append(np.frombuffer(content, dtype=np.uint8))
+            names.append(np.frombuffer(url.encode(), dtype=np.uint8))
+            if len(data) == self.batch_size:
+                break
+        if not data:
+            self._iter = None  # end of epoch
+            raise StopIteration
+        return data, names
@@ -0,0 +1,163 @@
+#
+# Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+#
+import io
+import random
+import tarfile
+from collections import deque
+from concurrent.futures import ThreadPoolExecutor
+from typing import Iterator, List, Tuple, Union
+from urllib.parse import urlparse
+
+import requests
+
+from aistore.sdk.ais_source import AISSource
+from aistore.sdk.client import Client
+from aistore.sdk.utils import handle_errors
+
+DEFAULT_NUM_WORKERS = 8
+DEFAULT_PREFETCH = 32
+
+Source = Union[str, AISSource]
+
+
+# pylint: disable=too-many-instance-attributes,too-few-public-methods
+class DatasetReader:
+    """
+    Framework-agnostic reader that streams AIS objects (or the files packed in AIS shards)
+    for the TensorFlow and DALI integrations - see aistore.tensorflow and aistore.dali.
+
+    The reader lists the selected objects, assigns every `num_shards`-th object to this worker
+    (`shard_index`), and fetches object contents with up to `num_workers` parallel GET requests,
+    keeping at most `prefetch` objects in flight. Iteration preserves listing order (unless
+    `shuffle` is set) and yields (url, bytes) tuples.
+
+    Args:
+        client_url (str): AIS endpoint URL
+        sources (str, AISSource, or List of either): URL prefixes, e.g. "ais://bucket1/train/",
+            and/or types implementing the AISSource interface: Bucket, ObjectGroup, Object, etc.
+        prefix (str, optional): Only include objects with names starting with this prefix
+        etl_name (str, optional): Pre-existing ETL on AIS to apply to all selected objects
+        shards (bool, optional): Selected objects are (.tar) shards - yield the files packed inside,
+            with urls in the form "<shard url>#<file name>"
+        num_shards (int, optional): Total number of workers (e.g., data-parallel ranks) to split objects between
+        shard_index (int, optional): Index of this worker, 0 <= shard_index < num_shards
+        shuffle (bool, optional): Shuffle the (per-worker) list of objects at the start of each epoch
+        seed (int, optional): Seed for shuffling
+        num_workers (int, optional): Number of parallel GET requests
+        prefetch (int, optional): Maximum number of objects fetched ahead of the consumer
+    """
+
+    def __init__(
+        self,
+        client_url: str,
+        sources: Union[Source, List[Source]],
+        prefix: str = "",
+        etl_name: str = None,
+        shards: bool = False,
+        num_shards: int = 1,
+        shard_index: int = 0,
+        shuffle: bool = False,
+        seed: int = None,
+        num_workers: int = DEFAULT_NUM_WORKERS,
+        prefetch: int = DEFAULT_PREFETCH,
+    ):
+        if num_shards < 1 or not 0 <= shard_index < num_shards:
+            raise ValueError(
+                f"invalid shard index {shard_index} (number of shards {num_shards})"
+            )
+        if num_workers < 1 or prefetch < 1:
+            raise ValueError(
+                f"invalid num_workers {num_workers} or prefetch {prefetch}"
+            )
+        self._client = Client(client_url)
+        self._sources = sources if isinstance(sources, list) else [sources]
+        self._prefix = prefix
+        self._etl_name = etl_name
+        self._shards = shards
+        self._num_shards = num_shards
+        self._shard_index = shard_index
+        self._shuffle = shuffle
+        self._rng = random.Random(seed)
+        self._num_workers = num_workers
+        self._prefetch = max(prefetch, num_workers)
+        self._session = requests.session()
+        self._urls = None
+
+    def urls(self) -> List[str]:
+        """
+        Get the URLs of the objects (shards) assigned to this worker; listed once, upon the first call
+
+        Returns:
+            List of object URLs
+        """
+        if self._urls is None:
+            urls = []
+            for source in self._sources:
+                urls.extend(
+                    self._ais_source(source).list_urls(
+                        prefix=self._prefix_of(source), etl_name=self._etl_name
+                    )
+                )
+            self._urls = urls[self._shard_index :: self._num_shards]
+        return self._urls
+
+    def read(self, url: str) -> bytes:
+        """
+        Read the contents of a single object
+
+        Args:
+            url (str): Full object URL, as returned by `urls`
+
+        Returns:
+            Object contents
+        """
+        resp = self._session.get(url)
+        if resp.status_code < 200 or resp.status_code >= 300:
+            handle_errors(resp)
+        return resp.content
+
+    def __len__(self) -> int:
+        """Number of objects (shards) assigned to this worker"""
+        return len(self.urls())
+
+    def __iter__(self) -> Iterator[Tuple[str, bytes]]:
+        urls = self.urls()
+        if self._shuffle:
+            urls = urls.copy()
+            self._rng.shuffle(urls)
+        with ThreadPoolExecutor(max_workers=self._num_workers) as executor:
+            inflight = deque()
+            try:
+                for url in urls:
+                    inflight.append((url, executor.submit(self.read, url)))
+                    if len(inflight) < self._prefetch:
+                        continue
+                    yield from self._unpack(*inflight.popleft())
+                while inflight:
+                    yield from self._unpack(*inflight.popleft())
+            finally:
+                for _, future in inflight:
+                    future.cancel()
+
+    def _unpack(self, url: str, future) -> Iterator[Tuple[str, bytes]]:
+        content = future.result()
+        if not self._shards:
+            yield url, content
+            return
+        with tarfile.open(fileobj=io.BytesIO(content), mode="r|*") as tar:
+            for member in tar:
+                if not member.isfile():
+                    continue
+                yield f"{url}#{member.name}", tar.extractfile(member).read()
+
+    def _ais_source(self, source: Source) -> AISSource:
+        if isinstance(source, AISSource):
+            return source
+        parsed = urlparse(source)
+        return self._client.bucket(bck_name=parsed.netloc, provider=parsed.scheme)
+
+    def _prefix_of(self, source: Source) -> str:
+        if isinstance(source, AISSource):
+            return self._prefix
+        return urlparse(source).path.lstrip("/") + self._prefix
@@ -0,0 +1,221 @@
+#
+# Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+#
+import io
+import sys
+from typing import Callable, Dict, Iterator, List
+
+import cloudpickle
+
+from aistore.sdk.etl import Etl, _get_default_runtime, _validate_comm_type
+from aistore.sdk.etl_const import (
+    DEFAULT_ETL_COMM,
+    DEFAULT_ETL_TIMEOUT,
+    ETL_COMM_CODE,
+    ETL_COMM_HPULL,
+    ETL_COMM_IO,
+)
+
+ETL_ARG_TYPE_URL = "url"
+
+
+# pylint: disable=too-many-instance-attributes
+class EtlTransform:
+    """
+    A user transform function packaged as an AIS ETL (see `etl_transform` decorator).
+
+    The object remains callable (as the original function) and, in addition, can be:
+     - executed locally, without a cluster (see `run_local` and `run_local_objects`), and
+     - initialized in the cluster (see `init`).
+
+    Args:
+        transform (Callable): Transform function of the ETL
+        name (str): Name of the ETL
+        other args: see `Etl.init_code`
+    """
+
+    # pylint: disable=too-many-arguments
+    def __init__(
+        self,
+        transform: Callable,
+        name: str,
+        dependencies: List[str] = None,
+        preimported_modules: List[str] = None,
+        runtime: str = _get_default_runtime(),
+        communication_type: str = DEFAULT_ETL_COMM,
+        timeout: str = DEFAULT_ETL_TIMEOUT,
+        chunk_size: int = None,
+        arg_type: str = "",
+    ):
+        _validate_comm_type(communication_type, ETL_COMM_CODE)
+        if arg_type == ETL_ARG_TYPE_URL and communication_type != ETL_COMM_HPULL:
+            raise ValueError(
+                f"arg_type '{ETL_ARG_TYPE_URL}' requires '{ETL_COMM_HPULL}' communication type"
+            )
+        self._transform = transform
+        self._name = name
+        self._dependencies = dependencies
+        self._preimported_modules = preimported_modules
+        self._runtime = runtime
+        self._communication_type = communication_type
+        self._timeout = timeout
+        self._chunk_size = chunk_size
+        self._arg_type = arg_type
+
+    def __call__(self, *args, **kwargs):
+        return self._transform(*args, **kwargs)
+
+    @property
+    def name(self) -> str:
+        """Name of the ETL"""
+        return self._name
+
+    @property
+    def transform(self) -> Callable:
+        """The (original) transform function"""
+        return self._transform
+
+    def init(self, client: "Client") -> str:
+        """
+        Initializes the ETL in the cluster (see `Etl.init_code`).
+
+        Args:
+            client (Client): AIS client
+        Returns:
+            Job ID string associated with this ETL
+        """
+        dependencies = list(self._dependencies) if self._dependencies else None
+        return client.etl(self._name).init_code(
+            transform=self._transform,
+            dependencies=dependencies,
+            preimported_modules=self._preimported_modules,
+            runtime=self._runtime,
+            communication_type=self._communication_type,
+            timeout=self._timeout,
+            chunk_size=self._chunk_size,
+            arg_type=self._arg_type,
+        )
+
+    def package(self) -> str:
+        """
+        Returns the (base64-encoded) code that `init` sends to the cluster - e.g., to inspect it
+        or to make sure the transform is serializable.
+        """
+        # pylint: disable=protected-access
+        return Etl._encode_transform(
+            self._transform, self._preimported_modules, self._communication_type
+        )
+
+    def run_local(self, data: bytes = b"", url: str = "", pickled: bool = True) -> bytes:
+        """
+        Runs the transform locally (no cluster required) the way the ETL runtime would:
+         - default: `transform(data) -> bytes`;
+         - `chunk_size`: `transform(reader, writer)`, where the reader yields chunks of `chunk_size` bytes;
+         - `io` communication type: `transform()` reads stdin and writes stdout;
+         - `url` argument type: `transform(url) -> bytes`.
+
+        Args:
+            data (bytes): Object content
+            url (str): Object URL (`url` argument type only)
+            pickled (bool): Round-trip the transform through cloudpickle first - same as the cluster
+                does - to catch serialization issues early
+        Returns:
+            Transformed content
+        """
+        transform = self._transform
+        if pickled:
+            transform = cloudpickle.loads(cloudpickle.dumps(transform))
+        if self._arg_type == ETL_ARG_TYPE_URL:
+            return _to_bytes(transform(url))
+        if self._communication_type == ETL_COMM_IO:
+            return _run_io(transform, data)
+        if self._chunk_size:
+            writer = io.BytesIO()
+            transform(_iter_chunks(data, self._chunk_size), writer)
+            return writer.getvalue()
+        return _to_bytes(transform(data))
+
+    def run_local_objects(
+        self, objects: Dict[str, bytes], pickled: bool = True
+    ) -> Dict[str, bytes]:
+        """
+        Runs the transform locally against sample objects.
+
+        Args:
+            objects (Dict[str, bytes]): Object name => content
+            pickled (bool): See `run_local`
+        Returns:
+            Object name => transformed content
+        """
+        return {
+            name: self.run_local(data=data, url=name, pickled=pickled)
+            for name, data in objects.items()
+        }
+
+
+# pylint: disable=too-many-arguments
+def etl_transform(
+    name: str,
+    dependencies: List[str] = None,
+    preimported_modules: List[str] = None,
+    runtime: str = _get_default_runtime(),
+    communication_type: str = DEFAULT_ETL_COMM,
+    timeout: str = DEFAULT_ETL_TIMEOUT,
+    chunk_size: int = None,
+    arg_type: str = "",
+) -> Callable[[Callable], EtlTransform]:
+    """
+    Decorator that packages the decorated function as an AIS ETL. Example:
+
+        @etl_transform("etl-md5")
+        def md5(data):
+            return hashlib.md5(data).hexdigest().encode()
+
+        assert md5.run_local(b"test") == hashlib.md5(b"test").hexdigest().encode()
+        md5.init(client)
+
+    Args:
+        name (str): Name of the ETL
+        other args: see `Etl.init_code`
+    Returns:
+        Decorator that returns EtlTransform
+    """
+
+    def decorator(transform: Callable) -> EtlTransform:
+        return EtlTransform(
+            transform,
+            name,
+            dependencies=dependencies,
+            preimported_modules=preimported_modules,
+            runtime=runtime,
+            communication_type=communication_type,
+            timeout=timeout,
+            chunk_size=chunk_size,
+            arg_type=arg_type,
+        )
+
+    return decorator
+
+
+def _iter_chunks(data: bytes, chunk_size: int) -> Iterator[bytes]:
+    for i in range(0, len(data), chunk_size):
+        yield data[i : i + chunk_size]
+
+
+def _run_io(transform: Callable, data: bytes) -> bytes:
+    stdin, stdout = sys.stdin, sys.stdout
+    out = io.BytesIO()
+    sys.stdin = io.TextIOWrapper(io.BytesIO(data))
+    sys.stdout = io.TextIOWrapper(out, write_through=True)
+    try:
+        transform()
+        sys.stdout.flush()
+        return out.getvalue()
+    finally:
+        sys.stdin, sys.stdout = stdin, stdout
+
+
+def _to_bytes(res) -> bytes:
+    if isinstance(res, str):
+        return res.encode()
+    return bytes(res)
@@ -21,6 +21,8 @@ class ListObjectFlag(Enum):
     DONT_ADD_REMOTE = 8
     USE_CACHE = 9
     ONLY_REMOTE_PROPS = 10
+    NO_RECURSION = 11
+    PREFETCH_MD = 12
 
     @staticmethod
     def join_flags(flags: List[ListObjectFlag]) -> int:
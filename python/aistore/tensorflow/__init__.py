@@ -0,0 +1 @@
+from aistore.tensorflow.dataset import ais_dataset
@@ -0,0 +1,82 @@
+"""
+AIS Plugin for TensorFlow
+
+tf.data Dataset for AIS.
+
+Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+"""
+
+from typing import List, Union
+
+import tensorflow as tf
+
+from aistore.sdk.dataset_reader import (
+    DatasetReader,
+    Source,
+    DEFAULT_NUM_WORKERS,
+    DEFAULT_PREFETCH,
+)
+
+
+# pylint: disable=unused-variable,too-many-arguments
+def ais_dataset(
+    client_url: str,
+    sources: Union[Source, List[Source]],
+    prefix: str = "",
+    etl_name: str = None,
+    shards: bool = False,
+    input_context: tf.distribute.InputContext = None,
+    shuffle: bool = False,
+    seed: int = None,
+    num_workers: int = DEFAULT_NUM_WORKERS,
+    prefetch: int = DEFAULT_PREFETCH,
+) -> tf.data.Dataset:
+    """
+    Create a tf.data.Dataset of (url, content) string tensors streamed from AIS.
+    Objects are fetched with parallel GET requests and prefetched ahead of the consumer;
+    the returned dataset can be further transformed (map, batch, etc.) as usual.
+    If `etl_name` is provided, that ETL must already exist on the AIStore cluster.
+
+    Args:
+        client_url (str): AIS endpoint URL
+        sources (str, AISSource, or List of either): URL prefixes, e.g. "ais://bucket1/train/",
+            and/or types implementing the AISSource interface: Bucket, ObjectGroup, Object, etc.
+        prefix (str, optional): Only include objects with names starting with this prefix
+        etl_name (str, optional): Optional etl on the AIS cluster to apply to each object
+        shards (bool, optional): Selected objects are (.tar) shards - yield the files packed inside
+        input_context (tf.distribute.InputContext, optional): When given (e.g., via
+            `tf.distribute.Strategy.distribute_datasets_from_function`), split objects between
+            the input pipelines
+        shuffle (bool, optional): Shuffle the (per-pipeline) list of objects at the start of each epoch
+        seed (int, optional): Seed for shuffling
+        num_workers (int, optional): Number of parallel GET requests
+        prefetch (int, optional): Maximum number of objects fetched ahead of the consumer
+
+    Returns:
+        tf.data.Dataset
+    """
+    num_shards, shard_index = 1, 0
+    if input_context is not None:
+        num_shards = input_context.num_input_pipelines
+        shard_index = input_context.input_pipeline_id
+    reader = DatasetReader(
+        client_url,
+        sources,
+        prefix=prefix,
+        etl_name=etl_name,
+        shards=shards,
+        num_shards=num_shards,
+        shard_index=shard_index,
+        shuffle=shuffle,
+        seed=seed,
+        num_workers=num_workers,
+        prefetch=prefetch,
+    )
+    dataset = tf.data.Dataset.from_generator(
+        lambda: iter(reader),
+        output_signature=(
+            tf.TensorSpec(shape=(), dtype=tf.string),
+            tf.TensorSpec(shape=(), dtype=tf.string),
+        ),
+    )
+    return dataset.prefetch(tf.data.AUTOTUNE)
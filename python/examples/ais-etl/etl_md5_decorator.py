@@ -0,0 +1,32 @@
+"""
+ETL to calculate md5 of an object, packaged with the `etl_transform` decorator
+and tested locally (no cluster required) prior to initialization.
+Communication Type: hpush://
+
+Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+"""
+import hashlib
+from aistore import Client
+from aistore.sdk import Bucket
+from aistore.sdk.etl_transform import etl_transform
+
+
+@etl_transform("etl-md5-decorator")
+def transform(input_bytes):
+    md5 = hashlib.md5()
+    md5.update(input_bytes)
+    return md5.hexdigest().encode()
+
+
+# local test harness: run the transform against sample objects
+samples = {"a.jpg": b"aaa", "b.jpg": b"bbb"}
+for name, out in transform.run_local_objects(samples).items():
+    assert out == hashlib.md5(samples[name]).hexdigest().encode(), name
+
+client = Client("http://192.168.49.2:8080")
+transform.init(client)
+
+job_id = client.bucket("from-bck").transform(
+    etl_name=transform.name, to_bck=Bucket("to-bck"), ext={"jpg": "txt"}
+)
+client.job(job_id).wait()
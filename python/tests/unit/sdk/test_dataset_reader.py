@@ -0,0 +1,97 @@
+import io
+import tarfile
+import unittest
+from unittest.mock import Mock, patch
+
+from aistore.sdk.ais_source import AISSource
+from aistore.sdk.dataset_reader import DatasetReader
+
+
+# pylint: disable=unused-variable
+class TestDatasetReader(unittest.TestCase):
+    def setUp(self) -> None:
+        self.endpoint = "http://ais-gateway-url:8080"
+        self.urls = [f"url-{i}" for i in range(10)]
+        self.source = Mock(AISSource)
+        self.source.list_urls.return_value = self.urls
+        self.mock_session = Mock()
+        self.mock_session.get.side_effect = self._get
+        session_patch = patch(
+            "aistore.sdk.dataset_reader.requests.session",
+            return_value=self.mock_session,
+        )
+        session_patch.start()
+        self.addCleanup(session_patch.stop)
+        self.contents = {}
+
+    def _get(self, url):
+        return Mock(status_code=200, content=self.contents.get(url, url.encode()))
+
+    def test_invalid_args(self):
+        with self.assertRaises(ValueError):
+            DatasetReader(self.endpoint, self.source, num_shards=2, shard_index=2)
+        with self.assertRaises(ValueError):
+            DatasetReader(self.endpoint, self.source, num_workers=0)
+
+    def test_iter(self):
+        prefix = "obj-prefix-"
+        etl_name = "test-etl"
+        reader = DatasetReader(
+            self.endpoint, self.source, prefix=prefix, etl_name=etl_name, prefetch=3
+        )
+
+        self.assertEqual(len(self.urls), len(reader))
+        self.assertEqual([(url, url.encode()) for url in self.urls], list(reader))
+        self.source.list_urls.assert_called_once_with(prefix=prefix, etl_name=etl_name)
+        self.assertEqual(len(self.urls), self.mock_session.get.call_count)
+
+    def test_iter_shard(self):
+        num_shards = 3
+        all_urls = []
+        for shard_index in range(num_shards):
+            reader = DatasetReader(
+                self.endpoint,
+                [self.source],
+                num_shards=num_shards,
+                shard_index=shard_index,
+            )
+            urls = [url for url, _ in reader]
+            self.assertEqual(self.urls[shard_index::num_shards], urls)
+            all_urls.extend(urls)
+        self.assertEqual(sorted(self.urls), sorted(all_urls))
+
+    def test_iter_shuffle(self):
+        reader = DatasetReader(self.endpoint, self.source, shuffle=True, seed=42)
+        urls = [url for url, _ in reader]
+        self.assertNotEqual(self.urls, urls)
+        self.assertEqual(sorted(self.urls), sorted(urls))
+
+    def test_iter_tar_shards(self):
+        files = {"a.jpg": b"image-a", "a.cls": b"1", "b.jpg": b"image-b"}
+        buf = io.BytesIO()
+        with tarfile.open(fileobj=buf, mode="w") as tar:
+            for name, data in files.items():
+                info = tarfile.TarInfo(name)
+                info.size = len(data)
+                tar.addfile(info, io.BytesIO(data))
+        self.source.list_urls.return_value = ["shard-0"]
+        self.contents["shard-0"] = buf.getvalue()
+
+        reader = DatasetReader(self.endpoint, self.source, shards=True)
+
+        expected = [(f"shard-0#{name}", data) for name, data in files.items()]
+        self.assertEqual(expected, list(reader))
+
+    @patch("aistore.sdk.dataset_reader.Client")
+    def test_url_prefix_source(self, mock_client_class):
+        bucket = Mock(AISSource)
+        bucket.list_urls.return_value = self.urls
+        mock_client_class.return_value.bucket.return_value = bucket
+
+        reader = DatasetReader(self.endpoint, "gcp://bucket-name/train/", prefix="x-")
+
+        self.assertEqual(self.urls, reader.urls())
+        mock_client_class.return_value.bucket.assert_called_with(
+            bck_name="bucket-name", provider="gcp"
+        )
+        bucket.list_urls.assert_called_with(prefix="train/x-", etl_name=None)
@@ -0,0 +1,94 @@
+import hashlib
+import sys
+import unittest
+from unittest.mock import Mock
+
+from aistore.sdk.etl_const import ETL_COMM_HPULL, ETL_COMM_IO
+from aistore.sdk.etl_transform import EtlTransform, etl_transform
+
+
+class TestEtlTransform(unittest.TestCase):  # pylint: disable=unused-variable
+    def setUp(self) -> None:
+        self.etl_name = "etl-name"
+        self.content = b"some object content"
+
+    def test_decorator(self):
+        @etl_transform(self.etl_name)
+        def transform(data):
+            return hashlib.md5(data).hexdigest().encode()
+
+        self.assertIsInstance(transform, EtlTransform)
+        self.assertEqual(self.etl_name, transform.name)
+        expected = hashlib.md5(self.content).hexdigest().encode()
+        self.assertEqual(expected, transform(self.content))
+        self.assertEqual(expected, transform.run_local(self.content))
+
+    def test_decorator_invalid_comm(self):
+        with self.assertRaises(ValueError):
+            etl_transform(self.etl_name, communication_type="invalid")(lambda x: x)
+
+    def test_decorator_invalid_arg_type(self):
+        with self.assertRaises(ValueError):
+            etl_transform(self.etl_name, arg_type="url")(lambda x: x)
+
+    def test_run_local_chunks(self):
+        chunks = []
+
+        @etl_transform(self.etl_name, chunk_size=4)
+        def transform(reader, writer):
+            for chunk in reader:
+                chunks.append(chunk)
+                writer.write(chunk.upper())
+
+        self.assertEqual(
+            self.content.upper(), transform.run_local(self.content, pickled=False)
+        )
+        self.assertTrue(all(len(chunk) <= 4 for chunk in chunks))
+        self.assertEqual(self.content, b"".join(chunks))
+
+    def test_run_local_io(self):
+        @etl_transform(self.etl_name, communication_type=ETL_COMM_IO)
+        def transform():
+            data = sys.stdin.buffer.read()
+            sys.stdout.buffer.write(data[::-1])
+
+        stdout = sys.stdout
+        self.assertEqual(self.content[::-1], transform.run_local(self.content))
+        self.assertIs(stdout, sys.stdout)
+
+    def test_run_local_url(self):
+        @etl_transform(
+            self.etl_name, communication_type=ETL_COMM_HPULL, arg_type="url"
+        )
+        def transform(url):
+            return url
+
+        self.assertEqual(b"obj-url", transform.run_local(url="obj-url"))
+
+    def test_run_local_objects(self):
+        @etl_transform(self.etl_name)
+        def transform(data):
+            return data * 2
+
+        objects = {"obj1": b"a", "obj2": b"bc"}
+        self.assertEqual(
+            {"obj1": b"aa", "obj2": b"bcbc"}, transform.run_local_objects(objects)
+        )
+
+    def test_init(self):
+        @etl_transform(self.etl_name, dependencies=["numpy"], chunk_size=32)
+        def transform(reader, writer):
+            for chunk in reader:
+                writer.write(chunk)
+
+        mock_client = Mock()
+        mock_etl = Mock()
+        mock_client.etl.return_value = mock_etl
+        mock_etl.init_code.return_value = "job-id"
+
+        self.assertEqual("job-id", transform.init(mock_client))
+        mock_client.etl.assert_called_with(self.etl_name)
+        kwargs = mock_etl.init_code.call_args.kwargs
+        self.assertEqual(transform.transform, kwargs["transform"])
+        self.assertEqual(["numpy"], kwargs["dependencies"])
+        self.assertEqual(32, kwargs["chunk_size"])
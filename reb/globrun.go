@@ -120,6 +120,7 @@ func New(t cluster.Target, config *cmn.Config) *Reb {
 		Timeout:    config.Client.Timeout.D(),
 		UseHTTPS:   config.Net.HTTP.UseHTTPS,
 		SkipVerify: config.Net.HTTP.SkipVerify,
+		TLS:        cmn.IntraClientTLS(&config.Net.HTTP),
 	})
 	reb := &Reb{
 		t:         t,
@@ -131,6 +132,9 @@ func New(t cluster.Target, config *cmn.Config) *Reb {
 		RecvAck:     reb.recvAck,
 		Compression: config.Rebalance.Compression,
 		Multiplier:  config.Rebalance.SbundleMult,
+		Priority:    transport.PrioRebalance,
+		Bandwidth:   func() int64 { return int64(cmn.GCO.Get().Rebalance.Bandwidth) },
+		Reliable:    true,
 	}
 	dm, err := bundle.NewDataMover(t, trname, reb.recvObj, cmn.OwtMigrate, dmExtra)
 	if err != nil {
@@ -232,6 +236,22 @@ func (reb *Reb) RunRebalance(smap *meta.Smap, id int64, notif *xact.NotifXact) {
 	reb.fini(rargs, logHdr, err)
 
 	offGFN()
+
+	if err == nil && !reb.xctn().IsAborted() && rargs.config.Rebalance.Verify {
+		reb.verify(rargs, logHdr)
+	}
+}
+
+// post-rebalance verification: same xaction ID on all targets (see api.GetRebVerifyReport)
+func (reb *Reb) verify(rargs *rebArgs, logHdr string) {
+	rns := xreg.RenewRebVerify(reb.t, xact.RebVerifyID(rargs.id))
+	if rns.Err != nil {
+		nlog.Errorf("%s: failed to start verification: %v", logHdr, rns.Err)
+		return
+	}
+	xctn := rns.Entry.Get()
+	nlog.Infof("%s: verifying (%s)", logHdr, xctn)
+	go xctn.Run(nil)
 }
 
 // To optimize goroutine creation:
@@ -725,12 +745,16 @@ func (rj *rebJogger) jog(mi *fs.Mountpath) {
 		rj.opts.CTs = []string{fs.ObjectType}
 		rj.opts.Callback = rj.visitObj
 		rj.opts.Sorted = false
+		rj.opts.Workers = cmn.GCO.Get().Disk.WalkWorkers
 	}
 	bmd := rj.m.t.Bowner().Get()
 	bmd.Range(nil, nil, rj.walkBck)
 }
 
 func (rj *rebJogger) walkBck(bck *meta.Bck) bool {
+	if bck.Props.Scratch.Enabled {
+		return false // node-local content (see cmn.ScratchConf)
+	}
 	rj.opts.Bck.Copy(bck.Bucket())
 	err := fs.Walk(&rj.opts)
 	if err == nil {
@@ -168,11 +168,11 @@ func (res *Res) wait(jg *mpather.Jgroup, xres *xs.Resilver) (err error) {
 // Copies a slice and its metafile (if exists) to the current mpath. At the
 // end does proper cleanup: removes ether source files(on success), or
 // destination files(on copy failure)
-func (jg *joggerCtx) _mvSlice(ct *cluster.CT, buf []byte) {
+func mvSlice(ct *cluster.CT, buf []byte, xctn cluster.Xact, config *cmn.Config) {
 	uname := ct.Bck().MakeUname(ct.ObjectName())
-	destMpath, _, err := cluster.HrwMpath(uname)
+	destMpath, _, err := cluster.HrwMpath(uname, cluster.MpathLabel(ct.Bucket()))
 	if err != nil {
-		jg.xres.AddErr(err)
+		xctn.AddErr(err)
 		nlog.Warningln(err)
 		return
 	}
@@ -183,24 +183,24 @@ func (jg *joggerCtx) _mvSlice(ct *cluster.CT, buf []byte) {
 	destFQN := destMpath.MakePathFQN(ct.Bucket(), fs.ECSliceType, ct.ObjectName())
 	srcMetaFQN, destMetaFQN, err := _moveECMeta(ct, ct.Mountpath(), destMpath, buf)
 	if err != nil {
-		jg.xres.AddErr(err)
+		xctn.AddErr(err)
 		return
 	}
 	// Slice without metafile - skip it as unusable, let LRU clean it up
 	if srcMetaFQN == "" {
 		return
 	}
-	if jg.config.FastV(4, cos.SmoduleReb) {
-		nlog.Infof("%s: moving %q -> %q", jg.t, ct.FQN(), destFQN)
+	if config.FastV(4, cos.SmoduleReb) {
+		nlog.Infof("%s: moving %q -> %q", xctn, ct.FQN(), destFQN)
 	}
 	if _, _, err = cos.CopyFile(ct.FQN(), destFQN, buf, cos.ChecksumNone); err != nil {
 		errV := fmt.Errorf("failed to copy %q -> %q: %v. Rolling back", ct.FQN(), destFQN, err)
 		nlog.Errorln(errV)
-		jg.xres.AddErr(errV)
+		xctn.AddErr(errV)
 		if err = os.Remove(destMetaFQN); err != nil {
 			errV := fmt.Errorf("failed to cleanup metafile %q: %v", destMetaFQN, err)
 			nlog.Warningln(errV)
-			jg.xres.AddErr(errV)
+			xctn.AddErr(errV)
 		}
 	}
 	errMeta := os.Remove(srcMetaFQN)
@@ -386,6 +386,6 @@ func (jg *joggerCtx) visitCT(ct *cluster.CT, buf []byte) (err error) {
 		// the entire `%ec` directory when EC is disabled for the bucket.
 		return filepath.SkipDir
 	}
-	jg._mvSlice(ct, buf)
+	mvSlice(ct, buf, jg.xres, jg.config)
 	return nil
 }
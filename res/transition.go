@@ -0,0 +1,245 @@
+// Package res provides local volume resilvering upon mountpath-attach and similar
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package res
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Storage-class transition: the bucket's placement label (see cmn.PlacementConf) gets changed
+// via apc.ActTransitionBck, and the xaction below moves the bucket's existing objects
+// to (or off of) the mountpaths labeled accordingly. Unlike resilvering, it is limited
+// to a single bucket and it relocates all the object's copies, EC metafiles, and EC slices,
+// while preserving the number of copies.
+
+type (
+	transFactory struct {
+		xreg.RenewBase
+		xctn *XactTransition
+	}
+	XactTransition struct {
+		xact.BckJog
+		label string
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactTransition)(nil)
+	_ xreg.Renewable = (*transFactory)(nil)
+)
+
+func Xreg() { xreg.RegBckXact(&transFactory{}) }
+
+//////////////////
+// transFactory //
+//////////////////
+
+func (*transFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &transFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *transFactory) Start() error {
+	slab, err := p.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+	p.xctn = newXactTransition(p.Bck, p, slab)
+	return nil
+}
+
+func (*transFactory) Kind() string        { return apc.ActTransitionBck }
+func (p *transFactory) Get() cluster.Xact { return p.xctn }
+
+func (p *transFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	err = fmt.Errorf("%s is currently running, cannot start a new %q", prevEntry.Get(), p.Str(p.Kind()))
+	return
+}
+
+////////////////////
+// XactTransition //
+////////////////////
+
+func newXactTransition(bck *meta.Bck, p *transFactory, slab *memsys.Slab) (r *XactTransition) {
+	r = &XactTransition{label: bck.Props.Placement.MpathLabel()}
+	mpopts := &mpather.JgroupOpts{
+		T:        p.T,
+		CTs:      []string{fs.ObjectType, fs.ECSliceType},
+		VisitObj: r.visitObj,
+		VisitCT:  r.visitCT,
+		Slab:     slab,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(p.UUID(), apc.ActTransitionBck, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactTransition) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	r.BckJog.Run()
+	nlog.Infoln(r.Name())
+	err := r.BckJog.Wait()
+	r.AddErr(err)
+	r.Finish()
+}
+
+func (r *XactTransition) visitObj(lom *cluster.LOM, buf []byte) error {
+	lom.Lock(true)
+	size, err := r.move(lom, buf)
+	lom.Unlock(true)
+	if err != nil {
+		if cmn.IsObjNotExist(err) {
+			return nil
+		}
+		if cos.IsErrOOS(err) {
+			err = cmn.NewErrAborted(r.Name(), "transition", err)
+			r.AddErr(err)
+			return err
+		}
+		r.AddErr(fmt.Errorf("%s: %s: %w", r.Name(), lom, err))
+		return nil
+	}
+	if size > 0 {
+		r.ObjsAdd(1, size)
+		if r.BckJog.Config.FastV(5, cos.SmoduleMirror) {
+			nlog.Infof("%s: %s => %q", r.Base.Name(), lom.Cname(), r.label)
+		}
+	}
+	return nil
+}
+
+// move the object to its HRW mountpath (as per the bucket's current label) and its copies,
+// if any, to other labeled mountpaths; returns the number of bytes moved
+// NOTE: under w-lock
+func (r *XactTransition) move(lom *cluster.LOM, buf []byte) (size int64, err error) {
+	lom.Uncache(false /*delDirty*/)
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	hmi, _, err := cluster.HrwMpath(lom.Uname(), r.label)
+	if err != nil {
+		return
+	}
+	ncopies := lom.NumCopies()
+
+	// 1. the object itself (and its EC metafile)
+	if lom.Mountpath().Path != hmi.Path {
+		var metaOld string
+		hlom := cluster.AllocLOM(lom.ObjName)
+		defer cluster.FreeLOM(hlom)
+		if err = hlom.InitFQN(hmi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName), lom.Bucket()); err != nil {
+			return
+		}
+		if errV := hlom.Load(false /*cache it*/, true /*locked*/); errV == nil && !hlom.Equal(lom) {
+			// written (e.g., PUT) at the new location after the transition has started
+			err = cos.RemoveFile(lom.FQN)
+			return
+		}
+		if lom.Bprops().EC.Enabled {
+			ct := cluster.NewCTFromLOM(lom, fs.ObjectType)
+			if metaOld, _, err = _moveECMeta(ct, lom.Mountpath(), hmi, buf); err != nil {
+				return
+			}
+		}
+		if err = lom.Copy(hmi, buf); err != nil {
+			return
+		}
+		if err = hlom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			return
+		}
+		// one extra copy: remove the source (or else, any other misplaced one)
+		if hlom.NumCopies() > ncopies {
+			victim := lom.FQN
+			if r.label != "" && lom.Mountpath().Label == r.label {
+				for fqn, mi := range hlom.GetCopies() {
+					if fqn != hlom.FQN && mi.Label != r.label {
+						victim = fqn
+						break
+					}
+				}
+			}
+			if err = hlom.DelCopies(victim); err != nil {
+				return
+			}
+			if err = hlom.Persist(); err != nil {
+				return
+			}
+		}
+		if metaOld != "" {
+			if errV := os.Remove(metaOld); errV != nil {
+				nlog.Warningf("%s: failed to cleanup %s old metafile %q: %v", r.Name(), lom, metaOld, errV)
+			}
+		}
+		size, lom = lom.SizeBytes(), hlom
+	}
+
+	// 2. copies
+	if r.label == "" || lom.NumCopies() < 2 {
+		return
+	}
+	var misplaced []string
+	for fqn, mi := range lom.GetCopies() {
+		if fqn != lom.FQN && mi.Label != r.label {
+			misplaced = append(misplaced, fqn)
+		}
+	}
+	for _, fqn := range misplaced {
+		mi := lom.LeastUtilNoCopy()
+		if mi == nil || mi.Label != r.label {
+			break // no more labeled mountpaths - leaving the rest where they are
+		}
+		if err = lom.Copy(mi, buf); err != nil {
+			return
+		}
+		if err = lom.DelCopies(fqn); err != nil {
+			return
+		}
+		if err = lom.Persist(); err != nil {
+			return
+		}
+		size += lom.SizeBytes()
+	}
+	return
+}
+
+func (r *XactTransition) visitCT(ct *cluster.CT, buf []byte) error {
+	debug.Assert(ct.ContentType() == fs.ECSliceType)
+	if !ct.Bck().Props.EC.Enabled {
+		return filepath.SkipDir // (see resilver)
+	}
+	mvSlice(ct, buf, r, r.BckJog.Config)
+	return nil
+}
+
+func (r *XactTransition) String() string {
+	return fmt.Sprintf("%s label=%q", r.Base.String(), r.label)
+}
+
+func (r *XactTransition) Name() string {
+	return fmt.Sprintf("%s label=%q", r.Base.Name(), r.label)
+}
+
+func (r *XactTransition) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -139,6 +139,9 @@ func RunCleanup(ini *IniCln) fs.CapStatus {
 		return fs.CapStatus{}
 	}
 	for mpath, mi := range availablePaths {
+		if mi.IsReadOnly() {
+			continue // (no writes, no removals - see fs.SetReadOnly)
+		}
 		joggers[mpath] = &clnJ{
 			oldWork: make([]string, 0, 64),
 			stopCh:  make(chan struct{}, 1),
@@ -355,6 +358,10 @@ func (j *clnJ) jogBck() (size int64, err error) {
 		Callback: j.walk,
 		Sorted:   false,
 	}
+	// running out of space: trash goes first, retention notwithstanding
+	if trashOverHWM(j.mi, j.config) {
+		opts.CTs = append(opts.CTs, fs.TrashType)
+	}
 	j.now = time.Now().UnixNano()
 	if err = fs.Walk(opts); err != nil {
 		return
@@ -414,6 +421,8 @@ func (j *clnJ) visitCT(parsedFQN fs.ParsedFQN, fqn string) {
 			return
 		}
 		j.oldWork = append(j.oldWork, fqn)
+	case fs.TrashType:
+		j.oldWork = append(j.oldWork, fqn)
 	default:
 		debug.Assertf(false, "Unsupported content type: %s", parsedFQN.ContentType)
 	}
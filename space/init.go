@@ -17,6 +17,7 @@ var verbose bool
 func Xreg(config *cmn.Config) {
 	xreg.RegNonBckXact(&lruFactory{})
 	xreg.RegNonBckXact(&clnFactory{})
+	xreg.RegNonBckXact(&janFactory{})
 
 	verbose = config.FastV(4, cos.SmoduleSpace)
 }
@@ -0,0 +1,395 @@
+// Package space provides storage cleanup and eviction functionality (the latter based on the
+// least recently used cache replacement). It also serves as a built-in garbage-collection
+// mechanism for orphaned workfiles.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package space
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Janitor: garbage-collects temporary content that has outlived its purpose, namely:
+// - workfiles older than space.workfile_age (or left behind by a previous run of the target);
+// - multipart uploads initiated more than space.mpt_age ago, along with their parts;
+// - ETL (offline transform) outputs older than space.etl_age;
+// - objects in scratch buckets not modified during the bucket's scratch.ttl (see cmn.ScratchConf);
+// - deleted objects that have been in the trash longer than space.trash_retention
+//   (or all of them, when the mountpath's used capacity exceeds space.highwm).
+// Other than scratch objects, janitor never touches objects, replicas, and EC slices.
+
+type (
+	IniJanitor struct {
+		T       cluster.Target
+		Config  *cmn.Config
+		Xaction *XactJanitor
+		StatsT  stats.Tracker
+		// multipart uploads (optional):
+		// - abort those that are older than a given age and return removed (parts, bytes)
+		// - check whether a given upload ID is active
+		AbortMpt    func(age time.Duration) (int64, int64)
+		IsActiveMpt func(id string) bool
+		WG          *sync.WaitGroup
+	}
+	XactJanitor struct {
+		xact.Base
+	}
+)
+
+// private
+type (
+	janJ struct {
+		ini  *IniJanitor
+		mi   *fs.Mountpath
+		rm   []string
+		rmo  []*cluster.LOM // scratch objects
+		now  time.Time
+		hwm  bool // used capacity above high watermark: purge trash regardless of retention
+		wg   *sync.WaitGroup
+		cnt  int64
+		size int64
+	}
+	janFactory struct {
+		xreg.RenewBase
+		xctn *XactJanitor
+	}
+)
+
+// interface guard
+var (
+	_ xreg.Renewable = (*janFactory)(nil)
+	_ cluster.Xact   = (*XactJanitor)(nil)
+)
+
+func (*XactJanitor) Run(*sync.WaitGroup) { debug.Assert(false) }
+
+func (r *XactJanitor) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+////////////////
+// janFactory //
+////////////////
+
+func (*janFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &janFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *janFactory) Start() error {
+	p.xctn = &XactJanitor{}
+	p.xctn.InitBase(p.UUID(), apc.ActJanitor, nil)
+	return nil
+}
+
+func (*janFactory) Kind() string        { return apc.ActJanitor }
+func (p *janFactory) Get() cluster.Xact { return p.xctn }
+
+func (*janFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+// RunJanitor runs to completion and returns the number of removed files and
+// reclaimed space, both also reported via xaction (objects, bytes) stats
+func RunJanitor(ini *IniJanitor) (cnt, size int64) {
+	var (
+		xjan           = ini.Xaction
+		availablePaths = fs.GetAvail()
+		joggers        = make([]*janJ, 0, len(availablePaths))
+		wg             = &sync.WaitGroup{}
+	)
+	defer func() {
+		if ini.WG != nil {
+			ini.WG.Done()
+		}
+	}()
+	if len(availablePaths) == 0 {
+		xjan.AddErr(cmn.ErrNoMountpaths)
+		xjan.Finish()
+		nlog.Errorln(cmn.ErrNoMountpaths)
+		return
+	}
+	nlog.Infof("%s started: workfile_age=%v, mpt_age=%v, etl_age=%v", xjan,
+		ini.Config.Space.WorkfileAge, ini.Config.Space.MptAge, ini.Config.Space.ETLAge)
+	if ini.WG != nil {
+		ini.WG.Done()
+		ini.WG = nil
+	}
+
+	// 1. stale multipart uploads (removes parts, so that joggers won't count them twice)
+	if ini.AbortMpt != nil {
+		cnt, size = ini.AbortMpt(ini.Config.Space.MptAge.D())
+		if cnt > 0 {
+			ini.StatsT.Add(stats.CleanupStoreSize, size)
+			ini.StatsT.Add(stats.CleanupStoreCount, cnt)
+			xjan.ObjsAdd(int(cnt), size)
+		}
+	}
+
+	// 2. workfiles and ETL outputs, one jogger per mountpath
+	for _, mi := range availablePaths {
+		j := &janJ{ini: ini, mi: mi, wg: wg, rm: make([]string, 0, 64)}
+		joggers = append(joggers, j)
+		wg.Add(1)
+		go j.run()
+	}
+	wg.Wait()
+	for _, j := range joggers {
+		cnt += j.cnt
+		size += j.size
+	}
+
+	nlog.Infof("%s finished: removed %d file%s, reclaimed %s", xjan, cnt, cos.Plural(int(cnt)), cos.ToSizeIEC(size, 2))
+	xjan.Finish()
+	return
+}
+
+//////////
+// janJ //
+//////////
+
+func (j *janJ) String() string {
+	return fmt.Sprintf("%s: jog-%s", j.ini.Xaction, j.mi)
+}
+
+func (j *janJ) run() {
+	defer j.wg.Done()
+	for _, provider := range apc.Providers.ToSlice() {
+		opts := fs.WalkOpts{Mi: j.mi, Bck: cmn.Bck{Provider: provider, Ns: cmn.NsGlobal}}
+		bcks, err := fs.AllMpathBcks(&opts)
+		if err != nil {
+			j.ini.Xaction.AddErr(err)
+			nlog.Errorln(j.String()+":", err)
+			continue
+		}
+		for i := range bcks {
+			if err := j.jogBck(&bcks[i]); err != nil {
+				j.onErr(err)
+				return
+			}
+		}
+	}
+	bmd := j.ini.T.Bowner().Get()
+	bmd.Range(nil, nil, j.jogScratch)
+}
+
+func (j *janJ) onErr(err error) {
+	if !cmn.IsErrAborted(err) {
+		j.ini.Xaction.AddErr(err)
+		nlog.Errorln(j.String()+":", err)
+	}
+}
+
+func (j *janJ) jogBck(bck *cmn.Bck) error {
+	opts := &fs.WalkOpts{
+		Mi:       j.mi,
+		Bck:      *bck,
+		CTs:      []string{fs.WorkfileType},
+		Callback: j.walk,
+		Sorted:   false,
+	}
+	j.now = time.Now()
+	if err := fs.Walk(opts); err != nil {
+		return err
+	}
+	opts.CTs = []string{fs.TrashType}
+	opts.Callback = j.walkTrash
+	j.hwm = trashOverHWM(j.mi, j.ini.Config)
+	if err := fs.Walk(opts); err != nil {
+		return err
+	}
+	j.rmOld()
+	return nil
+}
+
+// deleted objects: ctime is the time of deletion (see cluster.LOM.MoveToTrash)
+func (j *janJ) walkTrash(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	xjan := j.ini.Xaction
+	if err := xjan.AbortErr(); err != nil {
+		return cmn.NewErrAborted(xjan.Name(), "", err)
+	}
+	if j.hwm {
+		j.rm = append(j.rm, fqn)
+		return nil
+	}
+	finfo, err := os.Stat(fqn)
+	if err == nil && j.now.Sub(ios.GetCTime(finfo)) > j.ini.Config.Space.TrashRetention.D() {
+		j.rm = append(j.rm, fqn)
+	}
+	return nil
+}
+
+// same as LRU: the mountpath's used capacity at or above space.highwm
+func trashOverHWM(mi *fs.Mountpath, config *cmn.Config) bool {
+	usedPct, ok := ios.GetFSUsedPercentage(mi.Path)
+	return ok && usedPct >= config.Space.HighWM
+}
+
+// scratch bucket: remove objects not modified during the last scratch.ttl
+func (j *janJ) jogScratch(bck *meta.Bck) bool {
+	if !bck.Props.Scratch.Enabled {
+		return false
+	}
+	var (
+		ttl  = bck.Props.Scratch.TTLOrDflt()
+		opts = &fs.WalkOpts{
+			Mi:  j.mi,
+			Bck: bck.Clone(),
+			CTs: []string{fs.ObjectType},
+			Callback: func(fqn string, de fs.DirEntry) error {
+				return j.walkScratch(fqn, de, ttl)
+			},
+			Sorted: false,
+		}
+	)
+	j.now = time.Now()
+	err := fs.Walk(opts)
+	j.rmScratch()
+	if err != nil {
+		j.onErr(err)
+		return true // stop
+	}
+	return false
+}
+
+func (j *janJ) walkScratch(fqn string, de fs.DirEntry, ttl time.Duration) error {
+	if de.IsDir() {
+		return nil
+	}
+	xjan := j.ini.Xaction
+	if err := xjan.AbortErr(); err != nil {
+		return cmn.NewErrAborted(xjan.Name(), "", err)
+	}
+	finfo, err := os.Stat(fqn)
+	if err != nil || j.now.Sub(finfo.ModTime()) <= ttl {
+		return nil
+	}
+	lom := cluster.AllocLOM("")
+	if err := lom.InitFQN(fqn, nil); err != nil {
+		cluster.FreeLOM(lom)
+		return nil
+	}
+	j.rmo = append(j.rmo, lom)
+	return nil
+}
+
+func (j *janJ) rmScratch() {
+	var cnt, size int64
+	for _, lom := range j.rmo {
+		if !lom.TryLock(true) {
+			cluster.FreeLOM(lom)
+			continue // must be busy
+		}
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err == nil {
+			if err = lom.Remove(); err == nil {
+				cnt++
+				size += lom.SizeBytes()
+				if verbose {
+					nlog.Infof("%s: rm scratch %s, size=%d", j, lom, lom.SizeBytes())
+				}
+			} else {
+				nlog.Errorf("%s: failed to rm scratch %s: %v", j, lom, err)
+			}
+		}
+		lom.Unlock(true)
+		cluster.FreeLOM(lom)
+	}
+	j.rmo = j.rmo[:0]
+	j.account(cnt, size)
+}
+
+func (j *janJ) walk(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	xjan := j.ini.Xaction
+	if err := xjan.AbortErr(); err != nil {
+		return cmn.NewErrAborted(xjan.Name(), "", err)
+	}
+	if j.isOld(fqn) {
+		j.rm = append(j.rm, fqn)
+	}
+	return nil
+}
+
+// workfile name: <tag>.<orig-name>.<tie>.<pid> (see fs.WorkfileContentResolver)
+func (j *janJ) isOld(fqn string) bool {
+	var (
+		base         = filepath.Base(fqn)
+		_, prev, ok  = fs.CSM.Resolver(fs.WorkfileType).ParseUniqueFQN(base)
+		tag, _, _    = strings.Cut(base, ".")
+		conf         = &j.ini.Config.Space
+		age          = conf.WorkfileAge.D()
+		finfo, errSt = os.Stat(fqn)
+	)
+	if errSt != nil {
+		return false
+	}
+	switch {
+	case ok && prev:
+		return true // created by a previous run of this target
+	case tag == fs.WorkfileETL:
+		age = conf.ETLAge.D()
+	case j.ini.IsActiveMpt != nil && j.ini.IsActiveMpt(tag):
+		return false // part of an active multipart upload (see AbortMpt)
+	}
+	return j.now.Sub(finfo.ModTime()) > age
+}
+
+func (j *janJ) rmOld() {
+	var cnt, size int64
+	for _, fqn := range j.rm {
+		finfo, err := os.Stat(fqn)
+		if err != nil {
+			continue
+		}
+		if err := cos.RemoveFile(fqn); err != nil {
+			nlog.Errorf("%s: failed to rm old work %q: %v", j, fqn, err)
+			continue
+		}
+		fs.RemoveSidecar(fqn, cluster.XattrLOM) // (trash)
+		cnt++
+		size += finfo.Size()
+		if verbose {
+			nlog.Infof("%s: rm old work %q, size=%d", j, fqn, finfo.Size())
+		}
+	}
+	j.rm = j.rm[:0]
+	j.account(cnt, size)
+}
+
+func (j *janJ) account(cnt, size int64) {
+	if cnt == 0 {
+		return
+	}
+	j.cnt += cnt
+	j.size += size
+	j.ini.StatsT.Add(stats.CleanupStoreSize, size)
+	j.ini.StatsT.Add(stats.CleanupStoreCount, cnt)
+	j.ini.Xaction.ObjsAdd(int(cnt), size)
+}
@@ -31,6 +31,14 @@ import (
 // LRU-driven eviction is based on configurable watermarks: config.Space.LowWM and
 // config.Space.HighWM (section "space" in the cluster config).
 //
+// Buckets can override the cluster-wide watermarks (bucket props lru.lowwm and lru.highwm)
+// and, in addition, specify eviction weight (lru.evict_weight): under pressure,
+// buckets with greater weights get evicted first, with their dont-evict time scaled
+// down accordingly - see cmn.LRUConf.
+//
+// Mountpaths can, in turn, have capacity quotas (see cmn.FSPConf) - a mountpath that
+// exceeds its quota gets evicted irrespective of the watermarks.
+//
 // When and if exceeded, AIS target will start gradually evicting objects from its
 // stable storage: oldest first access-time wise.
 //
@@ -86,8 +94,12 @@ type (
 		totalSize int64 // difference between lowWM size and used size
 		newest    int64
 		heap      *minHeap
+		mu        sync.Mutex // protects the above when walking in parallel (see disk.walk_workers)
 		bck       cmn.Bck
 		now       int64
+		// current bucket: effective watermarks and dont-evict time (see cmn.LRUConf)
+		lwm, hwm  int64
+		dontEvict int64
 		// init-time
 		p       *lruP
 		ini     *IniLRU
@@ -99,6 +111,12 @@ type (
 		throttle    bool
 		allowDelObj bool
 	}
+	// bucket to evict from
+	lruB struct {
+		bck    cmn.Bck
+		weight int64
+		size   uint64
+	}
 	lruFactory struct {
 		xreg.RenewBase
 		xctn *XactLRU
@@ -154,6 +172,9 @@ func RunLRU(ini *IniLRU) {
 		return
 	}
 	for mpath, mi := range availablePaths {
+		if mi.IsReadOnly() {
+			continue // (no writes, no removals - see fs.SetReadOnly)
+		}
 		h := make(minHeap, 0, 64)
 		joggers[mpath] = &lruJ{
 			heap:   &h,
@@ -208,22 +229,27 @@ func (j *lruJ) String() string {
 func (j *lruJ) stop() { j.stopCh <- struct{}{} }
 
 func (j *lruJ) run(providers []string) {
-	var err error
+	var (
+		bcks = j.ini.Buckets
+		err  error
+	)
 	defer j.p.wg.Done()
-	// compute the size (bytes) to free up
-	if err = j.evictSize(); err != nil {
-		goto ex
-	}
-	if j.totalSize < minEvictThresh {
-		nlog.Infof("%s: used cap below threshold, nothing to do", j)
-		return
-	}
-	if len(j.ini.Buckets) != 0 {
-		nlog.Infof("%s: freeing-up %s", j, cos.ToSizeIEC(j.totalSize, 2))
-		err = j.jogBcks(j.ini.Buckets, j.ini.Force)
-	} else {
-		err = j.jog(providers)
+	if len(bcks) == 0 {
+		for _, provider := range providers { // NOTE: ordering is random
+			var (
+				pbcks []cmn.Bck
+				opts  = fs.WalkOpts{
+					Mi:  j.mi,
+					Bck: cmn.Bck{Provider: provider, Ns: cmn.NsGlobal},
+				}
+			)
+			if pbcks, err = fs.AllMpathBcks(&opts); err != nil {
+				goto ex
+			}
+			bcks = append(bcks, pbcks...)
+		}
 	}
+	err = j.jogBcks(bcks, j.ini.Force)
 ex:
 	if err == nil || cmn.IsErrBucketNought(err) || cmn.IsErrObjNought(err) {
 		return
@@ -231,54 +257,55 @@ ex:
 	nlog.Errorf("%s: exited with err %v", j, err)
 }
 
-func (j *lruJ) jog(providers []string) (err error) {
-	nlog.Infof("%s: freeing-up %s", j, cos.ToSizeIEC(j.totalSize, 2))
-	for _, provider := range providers { // for each provider (NOTE: ordering is random)
-		var (
-			bcks []cmn.Bck
-			opts = fs.WalkOpts{
-				Mi:  j.mi,
-				Bck: cmn.Bck{Provider: provider, Ns: cmn.NsGlobal},
-			}
-		)
-		if bcks, err = fs.AllMpathBcks(&opts); err != nil {
-			return
+func (j *lruJ) jogBcks(bcks []cmn.Bck, force bool) (err error) {
+	// select buckets that exceed their respective (bucket-level or cluster-wide) high watermarks
+	lbcks := make([]lruB, 0, len(bcks))
+	for _, bck := range bcks {
+		var weight int64
+		j.bck = bck
+		if j.allowDelObj, weight, err = j.allow(); err != nil {
+			nlog.Errorf("%s: %v - skipping %s (Hint: run 'ais storage cleanup' to cleanup)", j, err, bck)
+			err = nil
+			continue
+		}
+		if !j.allowDelObj && !force {
+			continue
 		}
-		if err = j.jogBcks(bcks, false); err != nil {
+		if err = j.evictSize(); err != nil {
 			return
 		}
+		if j.totalSize >= minEvictThresh {
+			lbcks = append(lbcks, lruB{bck: bck, weight: weight})
+		}
 	}
-	return
-}
-
-func (j *lruJ) jogBcks(bcks []cmn.Bck, force bool) (err error) {
-	if len(bcks) == 0 {
+	if len(lbcks) == 0 {
+		nlog.Infof("%s: used cap below threshold, nothing to do", j)
 		return
 	}
-	if len(bcks) > 1 {
-		j.sortBsize(bcks)
+	if len(lbcks) > 1 {
+		j.sortBcks(lbcks)
 	}
-	for _, bck := range bcks { // for each bucket under a given provider
+	for i := range lbcks { // greater weight first
 		var size int64
-		j.bck = bck
-		if j.allowDelObj, err = j.allow(); err != nil {
-			nlog.Errorf("%s: %v - skipping %s (Hint: run 'ais storage cleanup' to cleanup)", j, err, bck)
+		j.bck = lbcks[i].bck
+		if j.allowDelObj, _, err = j.allow(); err != nil {
 			err = nil
 			continue
 		}
 		j.allowDelObj = j.allowDelObj || force
-		if size, err = j.jogBck(); err != nil {
+		// (re)compute size-to-evict
+		if err = j.evictSize(); err != nil {
 			return
 		}
-		if size < cos.KiB {
+		if j.totalSize < cos.KiB {
 			continue
 		}
-		// recompute size-to-evict
-		if err = j.evictSize(); err != nil {
+		nlog.Infof("%s: freeing-up %s (%s: lwm=%d%%, hwm=%d%%)", j, cos.ToSizeIEC(j.totalSize, 2), j.bck, j.lwm, j.hwm)
+		if size, err = j.jogBck(); err != nil {
 			return
 		}
-		if j.totalSize < cos.KiB {
-			return
+		if size < cos.KiB {
+			continue
 		}
 	}
 	return
@@ -297,6 +324,7 @@ func (j *lruJ) jogBck() (size int64, err error) {
 		CTs:      []string{fs.ObjectType},
 		Callback: j.walk,
 		Sorted:   false,
+		Workers:  j.config.Disk.WalkWorkers,
 	}
 	j.now = time.Now().UnixNano()
 	if err = fs.Walk(opts); err != nil {
@@ -324,12 +352,14 @@ func (j *lruJ) _visit(lom *cluster.LOM) (pushed bool) {
 	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
 		return
 	}
-	if lom.AtimeUnix()+int64(j.config.LRU.DontEvictTime) > j.now {
+	if lom.AtimeUnix()+j.dontEvict > j.now {
 		return
 	}
 	if lom.HasCopies() && lom.IsCopy() {
 		return
 	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
 	// do nothing if the heap's curSize >= totalSize and
 	// the file is more recent then the the heap's newest.
 	if j.curSize >= j.totalSize && lom.AtimeUnix() > j.newest {
@@ -403,11 +433,11 @@ func (j *lruJ) postRemove(prev, size int64) (capCheck int64, err error) {
 	// init, recompute, and throttle - once per capCheckThresh
 	capCheck = 0
 	j.throttle = false
-	j.allowDelObj, _ = j.allow()
 	j.config = cmn.GCO.Get()
+	j.allowDelObj, _, _ = j.allow()
 	j.now = time.Now().UnixNano()
 	usedPct, ok := j.ini.GetFSUsedPercentage(j.mi.Path)
-	if ok && usedPct < j.config.Space.HighWM {
+	if ok && usedPct < j.hwm {
 		err = j._throttle(usedPct)
 	}
 	return
@@ -418,11 +448,11 @@ func (j *lruJ) _throttle(usedPct int64) (err error) {
 		return
 	}
 	// throttle self
-	ratioCapacity := cos.Ratio(j.config.Space.HighWM, j.config.Space.LowWM, usedPct)
+	ratioCapacity := cos.Ratio(j.hwm, j.lwm, usedPct)
 	curr := fs.GetMpathUtil(j.mi.Path)
 	ratioUtilization := cos.Ratio(j.config.Disk.DiskUtilHighWM, j.config.Disk.DiskUtilLowWM, curr)
 	if ratioUtilization > ratioCapacity {
-		if usedPct < (j.config.Space.LowWM+j.config.Space.HighWM)/2 {
+		if usedPct < (j.lwm+j.hwm)/2 {
 			j.throttle = true
 		}
 		time.Sleep(mpather.ThrottleMaxDur)
@@ -446,19 +476,27 @@ func (j *lruJ) evictObj(lom *cluster.LOM) bool {
 	return true
 }
 
+// compute the size (bytes) to free up - given the current bucket's watermarks
+// and the mountpath's capacity quota, if any
 func (j *lruJ) evictSize() (err error) {
-	lwm, hwm := j.config.Space.LowWM, j.config.Space.HighWM
+	lwm, hwm := j.lwm, j.hwm
+	j.totalSize = 0
 	blocks, bavail, bsize, err := j.ini.GetFSStats(j.mi.Path)
 	if err != nil {
 		return err
 	}
 	used := blocks - bavail
 	usedPct := used * 100 / blocks
-	if usedPct < uint64(hwm) {
-		return
+	if usedPct >= uint64(hwm) {
+		lwmBlocks := blocks * uint64(lwm) / 100
+		j.totalSize = int64(used-lwmBlocks) * bsize
+	}
+	// over quota: free up to the same (low/high watermark) ratio below the quota
+	usedSize := used * uint64(bsize)
+	if quota := j.mi.QuotaSize(blocks * uint64(bsize)); quota > 0 && usedSize > quota {
+		target := quota * uint64(lwm) / uint64(hwm)
+		j.totalSize = max(j.totalSize, int64(usedSize-target))
 	}
-	lwmBlocks := blocks * uint64(lwm) / 100
-	j.totalSize = int64(used-lwmBlocks) * bsize
 	return
 }
 
@@ -481,26 +519,22 @@ func (j *lruJ) yieldTerm() error {
 	return nil
 }
 
-// sort buckets by size
-func (j *lruJ) sortBsize(bcks []cmn.Bck) {
-	sized := make([]struct {
-		b cmn.Bck
-		v uint64
-	}, len(bcks))
-	for i := range bcks {
-		path := j.mi.MakePathCT(&bcks[i], fs.ObjectType)
-		sized[i].b = bcks[i]
-		sized[i].v, _ = ios.DirSizeOnDisk(path, false /*withNonDirPrefix*/)
-	}
-	sort.Slice(sized, func(i, j int) bool {
-		return sized[i].v > sized[j].v
-	})
-	for i := range bcks {
-		bcks[i] = sized[i].b
+// sort buckets by eviction weight and, within the same weight, by size
+func (j *lruJ) sortBcks(lbcks []lruB) {
+	for i := range lbcks {
+		path := j.mi.MakePathCT(&lbcks[i].bck, fs.ObjectType)
+		lbcks[i].size, _ = ios.DirSizeOnDisk(path, false /*withNonDirPrefix*/)
 	}
+	sort.Slice(lbcks, func(i, j int) bool {
+		if lbcks[i].weight != lbcks[j].weight {
+			return lbcks[i].weight > lbcks[j].weight
+		}
+		return lbcks[i].size > lbcks[j].size
+	})
 }
 
-func (j *lruJ) allow() (ok bool, err error) {
+// in addition, sets the current bucket's watermarks and dont-evict time
+func (j *lruJ) allow() (ok bool, weight int64, err error) {
 	var (
 		bowner = j.ini.T.Bowner()
 		b      = meta.CloneBck(&j.bck)
@@ -508,7 +542,11 @@ func (j *lruJ) allow() (ok bool, err error) {
 	if err = b.Init(bowner); err != nil {
 		return
 	}
-	ok = b.Props.LRU.Enabled && b.Allow(apc.AceObjDELETE) == nil
+	lru := &b.Props.LRU
+	j.lwm, j.hwm = lru.Watermarks(&j.config.Space)
+	j.dontEvict = int64(lru.DontEvict(j.config.LRU.DontEvictTime.D()))
+	weight = lru.Weight()
+	ok = lru.Enabled && b.Allow(apc.AceObjDELETE) == nil
 	return
 }
 
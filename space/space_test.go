@@ -37,6 +37,9 @@ const (
 	basePath             = "/tmp/space-tests"
 	bucketName           = "space-bck"
 	bucketNameAnother    = bucketName + "-another"
+	bucketNameScratch    = bucketName + "-scratch" // bucket-level watermarks: lower
+	bucketNameHeld       = bucketName + "-held"    // bucket-level watermarks: higher
+	bucketNameEphemeral  = bucketName + "-eph"     // scratch bucket (cmn.ScratchConf)
 )
 
 type fileMetadata struct {
@@ -62,6 +65,8 @@ var _ = Describe("space evict/cleanup tests", func() {
 			t          *mock.TargetMock
 			filesPath  string
 			fpAnother  string
+			fpScratch  string
+			fpHeld     string
 			bckAnother cmn.Bck
 		)
 
@@ -74,8 +79,14 @@ var _ = Describe("space evict/cleanup tests", func() {
 			bckAnother = cmn.Bck{Name: bucketNameAnother, Provider: apc.AIS, Ns: cmn.NsGlobal}
 			filesPath = availablePaths[basePath].MakePathCT(&bck, fs.ObjectType)
 			fpAnother = availablePaths[basePath].MakePathCT(&bckAnother, fs.ObjectType)
+			bckScratch := cmn.Bck{Name: bucketNameScratch, Provider: apc.AIS, Ns: cmn.NsGlobal}
+			bckHeld := cmn.Bck{Name: bucketNameHeld, Provider: apc.AIS, Ns: cmn.NsGlobal}
+			fpScratch = availablePaths[basePath].MakePathCT(&bckScratch, fs.ObjectType)
+			fpHeld = availablePaths[basePath].MakePathCT(&bckHeld, fs.ObjectType)
 			cos.CreateDir(filesPath)
 			cos.CreateDir(fpAnother)
+			cos.CreateDir(fpScratch)
+			cos.CreateDir(fpHeld)
 		})
 
 		AfterEach(func() {
@@ -201,6 +212,76 @@ var _ = Describe("space evict/cleanup tests", func() {
 			})
 		})
 
+		Describe("bucket-level watermarks", func() {
+			var ini *space.IniLRU
+			BeforeEach(func() {
+				ini = newIniLRU(t)
+			})
+			It("should evict when bucket-level hwm is exceeded", func() {
+				const numberOfFiles = 6
+				config := cmn.GCO.BeginUpdate()
+				config.Space.HighWM = 95 // cluster-wide: not exceeded
+				config.Space.LowWM = 40
+				cmn.GCO.CommitUpdate(config)
+
+				ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+				saveRandomFiles(filesPath, numberOfFiles/2)
+				saveRandomFiles(fpScratch, numberOfFiles/2)
+
+				space.RunLRU(ini)
+
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(numberOfFiles / 2))
+				// used 90% => scratch lwm 45%, i.e., half of the used capacity
+				files, err = os.ReadDir(fpScratch)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(0))
+			})
+
+			It("should not evict when bucket-level hwm is not exceeded", func() {
+				const numberOfFiles = 6
+
+				ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+				saveRandomFiles(fpHeld, numberOfFiles)
+
+				space.RunLRU(ini)
+
+				files, err := os.ReadDir(fpHeld)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(Equal(numberOfFiles))
+			})
+		})
+
+		Describe("mountpath quota", func() {
+			It("should evict when mountpath quota is exceeded", func() {
+				const numberOfFiles = 10
+				config := cmn.GCO.BeginUpdate()
+				config.Space.HighWM = 95
+				config.Space.LowWM = 40
+				cmn.GCO.CommitUpdate(config)
+
+				fs.TestDisableValidation()
+				_, err := fs.Remove(basePath)
+				Expect(err).NotTo(HaveOccurred())
+				mi, err := fs.AddMpath(basePath, "", "60%", "daeID", func() {}, true /*force*/)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mi.Quota).To(Equal("60%"))
+
+				ini := newIniLRU(t)
+				ini.GetFSStats = getMockGetFSStats(numberOfFiles)
+				saveRandomFiles(filesPath, numberOfFiles)
+
+				space.RunLRU(ini)
+
+				// 90% used => down to 60% * lwm/hwm (~25%)
+				files, err := os.ReadDir(filesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(files)).To(BeNumerically(">", 0))
+				Expect(float64(len(files)) / numberOfFiles * initialDiskUsagePct).To(BeNumerically("<=", 0.6*40/95))
+			})
+		})
+
 		Describe("not evict files", func() {
 			var ini *space.IniLRU
 			BeforeEach(func() {
@@ -284,6 +365,69 @@ var _ = Describe("space evict/cleanup tests", func() {
 				Expect(len(files)).To(Equal(0))
 			})
 		})
+
+		Describe("janitor", func() {
+			var ini *space.IniJanitor
+			BeforeEach(func() {
+				ini = newIniJanitor(t)
+			})
+			It("should remove old workfiles and ETL outputs but keep active uploads", func() {
+				var (
+					bck     = cmn.Bck{Name: bucketName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					lom     = &cluster.LOM{ObjName: "janitor-obj"}
+					past    = time.Now().Add(-2 * time.Hour)
+					aborted int64
+				)
+				Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+
+				oldWork := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+				newWork := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileCopy)
+				etlWork := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileETL)
+				mptWork := fs.CSM.Gen(lom, fs.WorkfileType, "upload-id.1")
+				for _, fqn := range []string{oldWork, newWork, etlWork, mptWork} {
+					saveRandomFile(fqn, blockSize)
+				}
+				for _, fqn := range []string{oldWork, etlWork, mptWork} {
+					Expect(os.Chtimes(fqn, past, past)).NotTo(HaveOccurred())
+				}
+
+				ini.AbortMpt = func(time.Duration) (int64, int64) { aborted++; return 0, 0 }
+				ini.IsActiveMpt = func(id string) bool { return id == "upload-id" }
+				cnt, size := space.RunJanitor(ini)
+
+				Expect(aborted).To(Equal(int64(1)))
+				Expect(cnt).To(Equal(int64(2)))
+				Expect(size).To(Equal(int64(2 * blockSize)))
+				Expect(oldWork).NotTo(BeAnExistingFile())
+				Expect(etlWork).NotTo(BeAnExistingFile())
+				Expect(newWork).To(BeAnExistingFile())
+				Expect(mptWork).To(BeAnExistingFile())
+			})
+			It("should remove expired objects from scratch buckets", func() {
+				var (
+					mi      = fs.GetAvail()[basePath]
+					bck     = cmn.Bck{Name: bucketNameEphemeral, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					regular = path.Join(filesPath, "not-scratch")
+					oldObj  = path.Join(mi.MakePathCT(&bck, fs.ObjectType), "old")
+					newObj  = path.Join(mi.MakePathCT(&bck, fs.ObjectType), "new")
+					past    = time.Now().Add(-2 * time.Hour)
+				)
+				for _, fqn := range []string{regular, oldObj, newObj} {
+					saveRandomFile(fqn, blockSize)
+				}
+				for _, fqn := range []string{regular, oldObj} {
+					Expect(os.Chtimes(fqn, past, past)).NotTo(HaveOccurred())
+				}
+
+				cnt, size := space.RunJanitor(ini)
+
+				Expect(cnt).To(Equal(int64(1)))
+				Expect(size).To(Equal(int64(blockSize)))
+				Expect(oldObj).NotTo(BeAnExistingFile())
+				Expect(newObj).To(BeAnExistingFile())
+				Expect(regular).To(BeAnExistingFile())
+			})
+		})
 	})
 })
 
@@ -336,6 +480,33 @@ func newTargetLRUMock() *mock.TargetMock {
 					BID:    0xf4e3d2c1,
 				},
 			),
+			meta.NewBck(
+				bucketNameScratch, apc.AIS, cmn.NsGlobal,
+				&cmn.BucketProps{
+					Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
+					LRU:    cmn.LRUConf{Enabled: true, LowWM: 45, HighWM: 60, EvictWeight: 400},
+					Access: apc.AccessAll,
+					BID:    0xb1c2d3e4,
+				},
+			),
+			meta.NewBck(
+				bucketNameHeld, apc.AIS, cmn.NsGlobal,
+				&cmn.BucketProps{
+					Cksum:  cmn.CksumConf{Type: cos.ChecksumNone},
+					LRU:    cmn.LRUConf{Enabled: true, LowWM: 90, HighWM: 95},
+					Access: apc.AccessAll,
+					BID:    0xc1d2e3f4,
+				},
+			),
+			meta.NewBck(
+				bucketNameEphemeral, apc.AIS, cmn.NsGlobal,
+				&cmn.BucketProps{
+					Cksum:   cmn.CksumConf{Type: cos.ChecksumNone},
+					Scratch: cmn.ScratchConf{Enabled: true, TTL: cos.Duration(time.Hour)},
+					Access:  apc.AccessAll,
+					BID:     0xd1e2f3a4,
+				},
+			),
 		)
 		tMock = mock.NewTarget(bmdMock)
 	)
@@ -366,11 +537,24 @@ func newInitStoreCln(t cluster.Target) *space.IniCln {
 	}
 }
 
+func newIniJanitor(t cluster.Target) *space.IniJanitor {
+	xjan := &space.XactJanitor{}
+	xjan.InitBase(cos.GenUUID(), apc.ActJanitor, nil)
+	return &space.IniJanitor{
+		Xaction: xjan,
+		Config:  cmn.GCO.Get(),
+		StatsT:  mock.NewStatsTracker(),
+		T:       t,
+	}
+}
+
 func initConfig() {
 	config := cmn.GCO.BeginUpdate()
 	config.LRU.DontEvictTime = 0
 	config.Space.HighWM = hwm
 	config.Space.LowWM = lwm
+	config.Space.WorkfileAge = cos.Duration(time.Hour)
+	config.Space.ETLAge = cos.Duration(time.Hour)
 	config.LRU.Enabled = true
 	config.Log.Level = "3"
 	cmn.GCO.CommitUpdate(config)
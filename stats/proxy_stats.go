@@ -18,7 +18,11 @@ import (
 
 const numProxyStats = 24 // approx. initial
 
-// NOTE: currently, proxy's stats == common and hardcoded
+// NOTE: currently, proxy's stats == common (and hardcoded) + the following
+const (
+	APIDeprecatedCount = "api.deprecated.n" // requests that use a deprecated API revision (see apc.APIVersion)
+	AuthAlertCount     = "auth.alert.n"     // AuthN audit anomalies (see apc.AuthAlert)
+)
 
 type Prunner struct {
 	runner
@@ -45,6 +49,8 @@ func (r *Prunner) Init(p cluster.Node) *atomic.Bool {
 	r.core.init(numProxyStats)
 
 	r.regCommon(p.Snode()) // common metrics
+	r.reg(p.Snode(), APIDeprecatedCount, KindCounter)
+	r.reg(p.Snode(), AuthAlertCount, KindCounter)
 
 	r.core.statsTime = cmn.GCO.Get().Periodic.StatsTime.D()
 	r.ctracker = make(copyTracker, numProxyStats)
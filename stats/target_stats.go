@@ -37,6 +37,8 @@ const (
 	GetColdCount = "get.cold.n"
 	GetColdSize  = "get.cold.size"
 
+	GetHedgeCount = "get.hedge.n" // hedged GETs served by the other (faster) copy
+
 	LruEvictCount = "lru.evict.n"
 	LruEvictSize  = "lru.evict.size"
 
@@ -60,6 +62,10 @@ const (
 	// special
 	RestartCount = "restart.n"
 
+	// capacity trend (see fs.CapTrend)
+	CapAlertCount = "cap.alert.n"     // projected to reach space.highwm within space.alert_days
+	CapDaysToHigh = "cap.highwm.days" // KindGauge: days until space.highwm at the current rate (-1: never or unknown)
+
 	// KindLatency
 	PutLatency      = "put.ns"
 	AppendLatency   = "append.ns"
@@ -97,6 +103,7 @@ type (
 		lines     []string
 		mem       sys.MemStat
 		xallRun   cluster.AllRunningInOut
+		capAlert  int64 // mono time of the last capacity trend alert
 		standby   bool
 	}
 )
@@ -183,6 +190,7 @@ func isDiskUtilMetric(name string) bool {
 func (r *Trunner) RegMetrics(node *meta.Snode) {
 	r.reg(node, GetColdCount, KindCounter)
 	r.reg(node, GetColdSize, KindSize)
+	r.reg(node, GetHedgeCount, KindCounter)
 
 	r.reg(node, LruEvictCount, KindCounter)
 	r.reg(node, LruEvictSize, KindSize)
@@ -221,6 +229,10 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 	// special
 	r.reg(node, RestartCount, KindCounter)
 
+	// capacity trend
+	r.reg(node, CapAlertCount, KindCounter)
+	r.reg(node, CapDaysToHigh, KindGauge)
+
 	// download
 	r.reg(node, DownloadSize, KindSize)
 	r.reg(node, DownloadLatency, KindLatency)
@@ -294,16 +306,19 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 	}
 
 	// 3. capacity
-	cs, _, errfs := fs.CapPeriodic(now, config, &r.TargetCDF)
+	cs, updated, errfs := fs.CapPeriodic(now, config, &r.TargetCDF)
 	if errfs != nil {
 		nlog.Errorln(errfs)
 	}
 	if cs.Err == nil && cs.PctMax > int32(config.Space.CleanupWM) {
 		cs.Err = cmn.NewErrCapExceeded(cs.TotalUsed, cs.TotalAvail+cs.TotalUsed, 0, config.Space.CleanupWM, cs.PctMax, cs.OOS)
 	}
-	if cs.Err != nil {
+	if cs.Err != nil || cs.OverQuota {
 		r.t.OOS(&cs)
 	}
+	if updated {
+		r.capTrend(now, config, &cs.Trend)
+	}
 	if now >= r.next || cs.Err != nil {
 		for mpath, fsCapacity := range r.TargetCDF.Mountpaths {
 			s := fmt.Sprintf("%s: used %d%%", mpath, fsCapacity.Capacity.PctUsed)
@@ -395,6 +410,28 @@ func (r *Trunner) logDiskStats(now int64) {
 	}
 }
 
+// capacity trend: update the gauge and alert (at most once per `capAlertIval`)
+func (r *Trunner) capTrend(now int64, config *cmn.Config, trend *fs.CapTrend) {
+	const capAlertIval = time.Hour
+	days := int64(-1)
+	if trend.Known() {
+		days = int64(trend.DaysToHigh)
+	}
+	v := r.core.Tracker[CapDaysToHigh]
+	v.Value = days
+
+	alertDays := config.Space.CapAlertDays()
+	if days < 0 || alertDays < 0 || days >= alertDays || now-r.capAlert < int64(capAlertIval) {
+		return
+	}
+	r.capAlert = now
+	r.core.update(cos.NamedVal64{Name: CapAlertCount, Value: 1})
+	msg := fmt.Sprintf("%s: projected to reach %d%% used capacity in %.1f days (growing %s/day)",
+		r.t, config.Space.HighWM, trend.DaysToHigh, cos.ToSizeIEC(trend.Rate, 1))
+	nlog.Warningln(msg)
+	r.lines = append(r.lines, msg)
+}
+
 func (r *Trunner) statsTime(newval time.Duration) {
 	r.core.statsTime = newval
 }
@@ -19,11 +19,13 @@ type LoadAvg struct {
 
 var (
 	contCPUs      int
+	numaNodes     int
 	containerized bool
 )
 
 func init() {
 	contCPUs = runtime.NumCPU()
+	numaNodes = numaNodeCount()
 	if containerized = isContainerized(); containerized {
 		if c, err := containerNumCPU(); err == nil {
 			contCPUs = c
@@ -36,6 +38,9 @@ func init() {
 func Containerized() bool { return containerized }
 func NumCPU() int         { return contCPUs }
 
+// number of NUMA nodes (1 on non-NUMA systems)
+func NumaNodes() int { return numaNodes }
+
 // SetMaxProcs sets GOMAXPROCS = NumCPU unless already overridden via Go environment
 func SetMaxProcs() {
 	if val, exists := os.LookupEnv(maxProcsEnvVar); exists {
@@ -0,0 +1,48 @@
+// Package sys provides methods to read system information
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package sys
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const numaNodesPath = "/sys/devices/system/node"
+
+// number of online NUMA nodes: count "nodeN" subdirectories (1 when not available)
+func numaNodeCount() (cnt int) {
+	entries, err := os.ReadDir(numaNodesPath)
+	if err != nil {
+		return 1
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if !e.IsDir() || !strings.HasPrefix(name, "node") {
+			continue
+		}
+		if _, err := strconv.Atoi(name[4:]); err == nil {
+			cnt++
+		}
+	}
+	if cnt == 0 {
+		cnt = 1
+	}
+	return
+}
+
+// NumaNode returns the NUMA node of the CPU the calling goroutine is currently running on
+// (getcpu(2)); given goroutine migration, the result must be treated as a hint
+func NumaNode() int {
+	var cpu, node uint32
+	_, _, errno := unix.RawSyscall(unix.SYS_GETCPU, uintptr(unsafe.Pointer(&cpu)), uintptr(unsafe.Pointer(&node)), 0)
+	if errno != 0 {
+		return 0
+	}
+	return int(node)
+}
@@ -30,6 +30,14 @@ func TestNumCPU(t *testing.T) {
 	}
 }
 
+func TestNuma(t *testing.T) {
+	nodes := NumaNodes()
+	tassert.Errorf(t, nodes >= 1 && nodes <= runtime.NumCPU(), "wrong number of NUMA nodes %d", nodes)
+	node := NumaNode()
+	tassert.Errorf(t, node >= 0, "wrong NUMA node %d", node)
+	t.Logf("NUMA nodes: %d, current: %d\n", nodes, node)
+}
+
 func TestLoadAvg(t *testing.T) {
 	la, err := LoadAverage()
 	tassert.CheckFatal(t, err)
@@ -0,0 +1,90 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Adaptive compression (Extra.Compression = apc.CompressAdaptive): compress only when the network
+// is the bottleneck - otherwise, it's the CPU that gets wasted. On each tick, the collector
+// evaluates network pressure, node-wide:
+// - given transport.link_capacity: offered throughput, i.e. bytes sent by all streams during
+//   the tick (prior to compression) vs. the capacity, with hysteresis (pressure turns on at
+//   pressureHigh percent of the capacity, and off below pressureLow);
+// - otherwise: congestion, i.e. streams transmitting while having more objects queued (see prio.go).
+// In turn, adaptive stream compresses its session iff there's pressure. Since compression
+// is negotiated per session (apc.HdrCompress), a stream that observes the change finishes
+// the in-flight object and starts a new session.
+
+const (
+	pressureHigh = 80 // percentage of the link capacity
+	pressureLow  = 50
+)
+
+type netPressure struct {
+	on atomic.Bool
+}
+
+// called by the collector (see collector.do)
+func (np *netPressure) tick(sent int64, congested bool) {
+	var (
+		capacity = int64(cmn.GCO.Get().Transport.LinkCapacity)
+		on       = np.on.Load()
+	)
+	if capacity <= 0 {
+		on = congested
+	} else {
+		pct := int64(float64(sent) / dfltTick.Seconds() * 100 / float64(capacity))
+		switch {
+		case pct >= pressureHigh:
+			on = true
+		case pct < pressureLow:
+			on = false
+		}
+	}
+	if np.on.Swap(on) != on && verbose {
+		nlog.Infof("network pressure %t (sent %s during %v)", on, cos.ToSizeIEC(sent, 1), dfltTick)
+	}
+}
+
+func (np *netPressure) get() bool { return np.on.Load() }
+
+// adaptive stream: whether to compress the next session
+func (s *Stream) adapt() {
+	if s.cmprs.adaptive {
+		s.cmprs.on = gc.press.get()
+	}
+}
+
+// adaptive stream: end the current session when the pressure changes (and there's more to send)
+func (s *Stream) toggle() bool {
+	if !s.cmprs.adaptive || s.cmprs.on == gc.press.get() || len(s.workCh) == 0 {
+		return false
+	}
+	s.cmprs.toggled = true
+	if verbose {
+		nlog.Infof("%s: compression %t => %t", s, s.cmprs.on, !s.cmprs.on)
+	}
+	return true
+}
+
+// called upon session completion
+func (s *Stream) toggled(err error) {
+	if !s.cmprs.toggled {
+		return
+	}
+	s.cmprs.toggled = false
+	if err == nil {
+		select {
+		case s.postCh <- struct{}{}: // next session
+		default:
+		}
+	}
+}
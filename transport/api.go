@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/klauspost/compress/dict"
 )
 
 ///////////////////
@@ -43,6 +44,10 @@ const (
 	maxSizePDU     = memsys.MaxPageSlabSize
 	dfltSizeHeader = memsys.PageSize
 	maxSizeHeader  = memsys.MaxPageSlabSize
+
+	// zstd dictionary (see TrainDict)
+	DfltDictSize = 64 * cos.KiB
+	MaxDictSize  = cos.MiB
 )
 
 const sizeofh = int(unsafe.Sizeof(Obj{}))
@@ -50,14 +55,24 @@ const sizeofh = int(unsafe.Sizeof(Obj{}))
 type (
 	// advanced usage: additional stream control
 	Extra struct {
-		Callback     ObjSentCB     // typical usage: to free SGLs, close files, etc.
-		MMSA         *memsys.MMSA  // compression-related buffering
-		Config       *cmn.Config   // (to optimize-out GCO.Get())
-		Compression  string        // see CompressAlways, etc. enum
-		SenderID     string        // e.g., xaction ID (optional)
-		IdleTeardown time.Duration // when exceeded, causes PUT to terminate (and to renew upon the very next send)
-		SizePDU      int32         // NOTE: 0(zero): no PDUs; must be below maxSizePDU; unknown size _requires_ PDUs
-		MaxHdrSize   int32         // overrides `dfltMaxHdr` if specified
+		Callback      ObjSentCB     // typical usage: to free SGLs, close files, etc.
+		MMSA          *memsys.MMSA  // compression-related buffering
+		Config        *cmn.Config   // (to optimize-out GCO.Get())
+		Compression   string        // see CompressAlways, etc. enum
+		Compressor    string        // apc.LZ4Compression or apc.ZstdCompression (default: config.Transport.Compressor)
+		CompressLevel int           // zstd level [1, 22] (default: config.Transport.ZstdLevel)
+		CompressDict  []byte        // optional zstd dictionary (see TrainDict); when set, compress with zstd instead of lz4
+		DictSamples   int           // when non-zero (and no CompressDict): train zstd dictionary upon so many samples (see zdict.go)
+		SenderID      string        // e.g., xaction ID (optional)
+		IdleTeardown  time.Duration // when exceeded, causes PUT to terminate (and to renew upon the very next send)
+		SizePDU       int32         // NOTE: 0(zero): no PDUs; must be below maxSizePDU; unknown size _requires_ PDUs
+		MaxHdrSize    int32         // overrides `dfltMaxHdr` if specified
+		Priority      int           // stream priority class: PrioData (default), PrioRebalance, or PrioBackground
+		Throttle      *Throttle     // bandwidth limit (shared by all streams of a bundle - see bundle.Args.Bandwidth)
+		PDUCksum      string        // PDU checksum: cos.ChecksumCRC32C, cos.ChecksumXXHash, or none (default: config.Transport.PDUChecksum)
+		// reliable delivery (optional): when the stream terminates with error, the owner installs
+		// the successor in its place and returns true - see reliable.go
+		Replace func(old, successor *Stream) bool
 	}
 	EndpointStats map[uint64]*Stats // all stats for a given (network, trname) endpoint indexed by session ID
 
@@ -68,6 +83,7 @@ type (
 		SID      string       // sender node ID
 		Opaque   []byte       // custom control (optional)
 		ObjAttrs cmn.ObjAttrs // attributes/metadata of the object that's being transmitted
+		ReqID    string       // request ID, if any (see apc.HdrReqID); not sent to v2 (and older) receivers
 		Opcode   int          // (see reserved range above)
 	}
 	// object to transmit
@@ -76,7 +92,10 @@ type (
 		CmplArg  any           // optional context passed to the ObjSentCB callback
 		Callback ObjSentCB     // called when the last byte is sent _or_ when the stream terminates (see term.reason)
 		prc      *atomic.Int64 // private; if present, ref-counts so that we call ObjSentCB only once
+		orig     io.ReadCloser // private; the original reader when retransmitting (see cksum.go)
 		Hdr      ObjHdr
+		retx     int // private; number of retransmissions
+		redlv    int // private; number of redeliveries (see reliable.go)
 	}
 
 	// object-sent callback that has the following signature can optionally be defined on a:
@@ -113,7 +132,10 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 	}
 	s = &Stream{streamBase: *newBase(client, dstURL, dstID, extra)}
 	s.streamBase.streamer = s
+	s.extra = extra
 	s.callback = extra.Callback
+	s.prio = min(max(extra.Priority, PrioData), numPrio-1)
+	s.throttle = extra.Throttle
 	if extra.Compressed() {
 		s.initCompression(extra)
 	}
@@ -127,6 +149,7 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 	go s.sendLoop(dryrun()) // handle SQ
 	go s.cmplLoop()         // handle SCQ
 
+	streams.Store(s.lid, s)
 	gc.ctrlCh <- ctrl{&s.streamBase, true /* collect */}
 	return
 }
@@ -153,6 +176,12 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 func (s *Stream) Send(obj *Obj) (err error) {
 	debug.Assertf(len(obj.Hdr.Opaque) < len(s.maxhdr)-sizeofh, "(%d, %d)", len(obj.Hdr.Opaque), len(s.maxhdr))
 
+	if !obj.Hdr.isFin() {
+		s.inFlight.Inc()
+	}
+	if s.IsTerminated() && s.handoff(obj) {
+		return // redelivered (see reliable.go)
+	}
 	if err = s.startSend(obj); err != nil {
 		s.doCmpl(obj, err) // take a shortcut
 		return
@@ -180,6 +209,26 @@ func (s *Stream) Fin() {
 	s.wg.Wait()
 }
 
+// TrainDict builds a zstd dictionary from sample payloads - typically, a few hundred
+// representative objects (JSON, logs, etc.) that the stream is about to carry.
+// The resulting dictionary is then passed via Extra.CompressDict: the stream sends it
+// to the receiver once (see zdict.go), and the receiver uses it to decompress all sessions.
+// Zero size defaults to DfltDictSize.
+func TrainDict(samples [][]byte, size int) (zdict []byte, err error) {
+	if size <= 0 {
+		size = DfltDictSize
+	}
+	if size > MaxDictSize {
+		return nil, fmt.Errorf("dictionary size %d exceeds the maximum %d", size, MaxDictSize)
+	}
+	defer func() {
+		if r := recover(); r != nil { // (the builder may panic on degenerate samples)
+			zdict, err = nil, fmt.Errorf("failed to build dictionary from %d samples: %v", len(samples), r)
+		}
+	}()
+	return dict.BuildZstdDict(samples, dict.Options{MaxDictSize: size, HashBytes: 6})
+}
+
 ////////////////////
 // message stream //
 ////////////////////
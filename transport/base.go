@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/memsys"
 )
@@ -53,6 +54,7 @@ const (
 type (
 	streamer interface {
 		compressed() bool
+		compression() (string, string, int) // compression type, dictionary ID and size (when the session carries it)
 		dryrun()
 		terminate(error, string) (string, error)
 		doRequest() error
@@ -72,6 +74,9 @@ type (
 		lastCh   cos.StopCh // end-of-stream
 		pdu      *spdu      // PDU buffer
 		mm       *memsys.MMSA
+		nack     string        // response: objects to retransmit (apc.HdrPDUNack)
+		ver      int           // protocol version (negotiated - see ProtoVersion)
+		failed   bool          // receiver failed the last session
 		postCh   chan struct{} // to indicate that workCh has work
 		trname   string        // http endpoint: (trname, dstURL, dstID)
 		dstURL   string
@@ -89,9 +94,14 @@ type (
 		time  struct {
 			idleTeardown time.Duration // idle timeout
 			inSend       atomic.Bool   // true upon Send() or Read() - info for Collector to delay cleanup
+			last         atomic.Int64  // mono time of the last Send()
 			ticks        int           // num 1s ticks until idle timeout
 			index        int           // heap stuff
 		}
+		relay struct {
+			url   string // via proxy (see relay.go)
+			since int64  // mono time when started relaying
+		}
 		wg      sync.WaitGroup
 		sessST  atomic.Int64 // state of the TCP/HTTP session: active (connected) | inactive (disconnected)
 		sessID  int64        // stream session ID
@@ -109,7 +119,7 @@ func newBase(client Client, dstURL, dstID string, extra *Extra) (s *streamBase)
 	u, err := url.Parse(dstURL)
 	cos.AssertNoErr(err)
 
-	s = &streamBase{client: client, dstURL: dstURL, dstID: dstID}
+	s = &streamBase{client: client, dstURL: dstURL, dstID: dstID, ver: ProtoVersion}
 
 	s.sessID = nextSessionID.Inc()
 	s.trname = path.Base(u.Path)
@@ -134,7 +144,7 @@ func newBase(client Client, dstURL, dstID string, extra *Extra) (s *streamBase)
 			extra.SizePDU = maxSizePDU
 		}
 		buf, _ := s.mm.AllocSize(int64(extra.SizePDU))
-		s.pdu = newSendPDU(buf)
+		s.pdu = newSendPDU(buf, pduCksumType(extra))
 	}
 	if extra.IdleTeardown > 0 {
 		s.time.idleTeardown = extra.IdleTeardown
@@ -155,12 +165,14 @@ func newBase(client Client, dstURL, dstID string, extra *Extra) (s *streamBase)
 		s.maxhdr, _ = s.mm.AllocSize(int64(extra.MaxHdrSize))
 		cos.AssertMsg(extra.MaxHdrSize <= 0xffff, "the field is uint16") // same comment in header.go
 	}
+	s.time.last.Store(mono.NanoTime())
 	s.sessST.Store(inactive) // initiate HTTP session upon the first arrival
 	return
 }
 
 func (s *streamBase) startSend(streamable fmt.Stringer) (err error) {
 	s.time.inSend.Store(true) // StreamCollector to postpone cleanups
+	s.time.last.Store(mono.NanoTime())
 
 	if s.IsTerminated() {
 		// slow path
@@ -179,8 +191,34 @@ func (s *streamBase) startSend(streamable fmt.Stringer) (err error) {
 	return
 }
 
+// upon session completion: switch to the protocol version advertised by the receiver
+// (and log the receiver's failure to handle the previous one, if that's the case)
+func (s *streamBase) negotiate(peerVer string, failed bool) {
+	s.failed = failed
+	ver := protoV1 // (predates version negotiation)
+	if peerVer != "" {
+		v, err := strconv.Atoi(peerVer)
+		if err != nil || v < protoV1 {
+			nlog.Errorf("%s: invalid transport protocol version %q", s, peerVer)
+			return
+		}
+		ver = min(v, ProtoVersion)
+	}
+	if ver == s.ver {
+		return
+	}
+	if ver < s.ver && failed {
+		nlog.Errorf("%s: receiver failed the session: it runs transport protocol v%d while this node sent v%d"+
+			" (mixed-version cluster?) - switching to v%d", s, ver, s.ver, ver)
+	} else {
+		nlog.Infof("%s: transport protocol v%d => v%d", s, s.ver, ver)
+	}
+	s.ver = ver
+}
+
 func (s *streamBase) Stop()               { s.stopCh.Close() }
 func (s *streamBase) URL() string         { return s.dstURL }
+func (s *streamBase) DstID() string       { return s.dstID }
 func (s *streamBase) ID() (string, int64) { return s.trname, s.sessID }
 func (s *streamBase) String() string      { return s.lid }
 
@@ -209,6 +247,7 @@ func (s *streamBase) GetStats() (stats Stats) {
 	stats.Offset.Store(s.stats.Offset.Load())
 	stats.Size.Store(s.stats.Size.Load())
 	stats.CompressedSize.Store(s.stats.CompressedSize.Load())
+	stats.CmprOffset.Store(s.stats.CmprOffset.Load())
 	return
 }
 
@@ -254,9 +293,13 @@ func (s *streamBase) sendLoop(dryrun bool) {
 	)
 	for {
 		if s.sessST.Load() == active {
+			s.relayExpired()
 			if dryrun {
 				s.streamer.dryrun()
 			} else if errR := s.streamer.doRequest(); errR != nil {
+				if s.relayOnErr(errR) {
+					continue
+				}
 				if !cos.IsRetriableConnErr(err) || retried {
 					reason = reasonError
 					err = errR
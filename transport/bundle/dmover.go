@@ -43,6 +43,7 @@ type (
 		config      *cmn.Config
 		mem         *memsys.MMSA
 		compression string // enum { apc.CompressNever, ... }
+		compressor  string // enum { apc.LZ4Compression, apc.ZstdCompression } (empty: config.Transport.Compressor)
 		multiplier  int
 		owt         cmn.OWT
 		stage       struct {
@@ -50,16 +51,25 @@ type (
 			opened atomic.Bool
 			laterx atomic.Bool
 		}
-		sizePDU    int32
-		maxHdrSize int32
+		sizePDU       int32
+		maxHdrSize    int32
+		compressLevel int
+		prio          int
+		bandwidth     func() int64
+		reliable      bool
 	}
 	// additional (and optional) params for new data mover
 	Extra struct {
-		RecvAck     transport.RecvObj
-		Compression string
-		Multiplier  int
-		SizePDU     int32
-		MaxHdrSize  int32
+		RecvAck       transport.RecvObj
+		Compression   string
+		Compressor    string // (see transport.Extra)
+		CompressLevel int
+		Priority      int          // (see transport.Extra)
+		Bandwidth     func() int64 // (see bundle.Args)
+		Multiplier    int
+		SizePDU       int32
+		MaxHdrSize    int32
+		Reliable      bool // (see bundle.Args)
 	}
 )
 
@@ -76,14 +86,22 @@ func NewDataMover(t cluster.Target, trname string, recvCB transport.RecvObj, owt
 	dm.owt = owt
 	dm.multiplier = extra.Multiplier
 	dm.sizePDU, dm.maxHdrSize = extra.SizePDU, extra.MaxHdrSize
+	dm.prio, dm.bandwidth = extra.Priority, extra.Bandwidth
+	dm.reliable = extra.Reliable
 	switch extra.Compression {
 	case "":
 		dm.compression = apc.CompressNever
-	case apc.CompressAlways, apc.CompressNever:
+	case apc.CompressAlways, apc.CompressNever, apc.CompressAdaptive:
 		dm.compression = extra.Compression
 	default:
 		return nil, fmt.Errorf("invalid compression %q", extra.Compression)
 	}
+	switch extra.Compressor {
+	case "", apc.LZ4Compression, apc.ZstdCompression:
+		dm.compressor, dm.compressLevel = extra.Compressor, extra.CompressLevel
+	default:
+		return nil, fmt.Errorf("invalid compressor %q", extra.Compressor)
+	}
 	dm.data.trname, dm.data.recv = trname, recvCB
 	if dm.data.net == "" {
 		dm.data.net = cmn.NetIntraData
@@ -128,15 +146,20 @@ func (dm *DataMover) Open() {
 		Net:    dm.data.net,
 		Trname: dm.data.trname,
 		Extra: &transport.Extra{
-			Compression: dm.compression,
-			Config:      dm.config,
-			MMSA:        dm.mem,
-			SizePDU:     dm.sizePDU,
-			MaxHdrSize:  dm.maxHdrSize,
+			Compression:   dm.compression,
+			Compressor:    dm.compressor,
+			CompressLevel: dm.compressLevel,
+			Config:        dm.config,
+			MMSA:          dm.mem,
+			SizePDU:       dm.sizePDU,
+			MaxHdrSize:    dm.maxHdrSize,
+			Priority:      dm.prio,
 		},
 		Ntype:        cluster.Targets,
 		Multiplier:   dm.multiplier,
 		ManualResync: true,
+		Bandwidth:    dm.bandwidth,
+		Reliable:     dm.reliable,
 	}
 	if dm.xctn != nil {
 		dataArgs.Extra.SenderID = dm.xctn.ID()
@@ -0,0 +1,39 @@
+// Package bundle provides multi-streaming transport with the functionality
+// to dynamically (un)register receive endpoints, establish long-lived flows, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package bundle
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// all open stream bundles
+var bundles sync.Map // lid => *Streams
+
+// Info aggregates the counters of the bundle's streams (see apc.BundleInfo)
+func (sb *Streams) Info() *apc.BundleInfo {
+	bi := &apc.BundleInfo{ID: sb.lid, Trname: sb.trname, Network: sb.network}
+	for _, robin := range sb.get() {
+		for _, s := range robin.stsdest {
+			bi.Add(s.Info())
+		}
+	}
+	sort.Strings(bi.Streams)
+	return bi
+}
+
+// AllInfo returns all open bundles, sorted by ID
+func AllInfo() []*apc.BundleInfo {
+	out := make([]*apc.BundleInfo, 0, 8)
+	bundles.Range(func(_, v any) bool {
+		out = append(out, v.(*Streams).Info())
+		return true
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
@@ -7,6 +7,7 @@ package bundle
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 	"unsafe"
 
@@ -40,6 +41,7 @@ type (
 		rxNodeType   int // receiving nodes: [Targets, ..., AllNodes ] enum above
 		multiplier   int // optionally: multiple streams per destination (round-robin)
 		manualResync bool
+		closed       bool // (under smaplock - see replace)
 	}
 	Stats map[string]*transport.Stats // by DaemonID
 	//
@@ -59,6 +61,12 @@ type (
 		Ntype        int              // cluster.Target (0) by default
 		Multiplier   int              // so-many TCP connections per Rx endpoint, with round-robin
 		ManualResync bool             // auto-resync by default
+		// (optional) aggregate bandwidth limit (bytes/sec) of all the bundle's streams; evaluated
+		// at runtime, e.g.: func() int64 { return int64(cmn.GCO.Get().Rebalance.Bandwidth) }
+		Bandwidth func() int64
+		// reliable delivery: replace failed streams and redeliver their undelivered objects
+		// (see transport/reliable.go)
+		Reliable bool
 	}
 
 	ErrDestinationMissing struct {
@@ -104,6 +112,12 @@ func New(sowner meta.Sowner, lsnode *meta.Snode, cl transport.Client, sbArgs Arg
 	if sb.extra.Config == nil {
 		sb.extra.Config = cmn.GCO.Get()
 	}
+	if sbArgs.Bandwidth != nil {
+		sb.extra.Throttle = transport.NewThrottle(sbArgs.Bandwidth)
+	}
+	if sbArgs.Reliable {
+		sb.extra.Replace = sb.replace
+	}
 	if !sb.extra.Compressed() {
 		sb.lid = fmt.Sprintf("sb[%s-%s-%s]", sb.lsnode.ID(), sb.network, sb.trname)
 	} else {
@@ -120,15 +134,22 @@ func New(sowner meta.Sowner, lsnode *meta.Snode, cl transport.Client, sbArgs Arg
 	if !sb.manualResync {
 		listeners.Reg(sb)
 	}
+	bundles.Store(sb.lid, sb)
 	return
 }
 
 // Close closes all contained streams and unregisters the bundle from Smap listeners;
 // graceful=true blocks until all pending objects get completed (for "completion", see transport/README.md)
 func (sb *Streams) Close(gracefully bool) {
+	bundles.Delete(sb.lid)
 	if gracefully {
 		sb.apply(closeFin)
+		if sb.extra.Replace != nil {
+			sb.close()
+			sb.apply(closeFin) // successors of the streams that failed while closing, if any
+		}
 	} else {
+		sb.close()
 		sb.apply(closeStop)
 	}
 	if !sb.manualResync {
@@ -281,6 +302,8 @@ snd:
 }
 
 func (sb *Streams) Abort() {
+	bundles.Delete(sb.lid)
+	sb.close()
 	streams := sb.get()
 	for _, robin := range streams {
 		for _, s := range robin.stsdest {
@@ -313,6 +336,43 @@ func (sb *Streams) apply(action int) {
 	wg.Wait()
 }
 
+func (sb *Streams) close() {
+	sb.smaplock.Lock()
+	sb.closed = true
+	sb.smaplock.Unlock()
+}
+
+// reliable delivery: install the successor in place of the failed stream (see transport.Extra.Replace)
+func (sb *Streams) replace(old, successor *transport.Stream) bool {
+	sb.smaplock.Lock()
+	defer sb.smaplock.Unlock()
+	if sb.closed {
+		return false
+	}
+	var (
+		obundle = sb.get()
+		orobin  = obundle[old.DstID()]
+	)
+	if orobin == nil {
+		return false // destination's gone
+	}
+	k := slices.Index(orobin.stsdest, old)
+	if k < 0 {
+		return false
+	}
+	nrobin := &robin{stsdest: slices.Clone(orobin.stsdest)}
+	nrobin.stsdest[k] = successor
+	nrobin.i.Store(orobin.i.Load())
+
+	nbundle := make(bundle, len(obundle))
+	for id, robin := range obundle {
+		nbundle[id] = robin
+	}
+	nbundle[old.DstID()] = nrobin
+	sb.streams.Store(unsafe.Pointer(&nbundle))
+	return true
+}
+
 // Resync streams asynchronously
 // is a slowpath; is called under lock; NOTE: calls stream.Stop()
 func (sb *Streams) Resync() {
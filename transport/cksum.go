@@ -0,0 +1,235 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Optional per-PDU checksums (see Extra.PDUCksum and config.Transport.PDUChecksum).
+//
+// The sender computes 32-bit checksum of each PDU's payload - CRC32C or the lower half of
+// xxhash - and puts it in the lower half of the second word of the PDU header (the upper
+// half still protects the header itself). The receiver verifies each PDU prior to handing
+// over any of its data. Upon mismatch, the receive callback gets ErrPDUCksum, the rest of
+// the object is skipped, and the object's in-session sequence number is returned to the
+// sender with the response that completes the session (apc.HdrPDUNack).
+//
+// The sender, in turn, does not post completions for the objects sent in a given session
+// until the session is done (the response is received). At which point it reopens the
+// nack-ed objects (the reader must be cos.ReadOpenCloser) and sends them again, up to
+// maxRetransmit times. To bound the number of pending completions (and open readers),
+// the sender rotates sessions every maxUnacked objects, and when there's nothing to send
+// for ackLinger time.
+
+const (
+	pduCksumMask = uint64(math.MaxUint32)
+
+	maxUnacked    = 64
+	maxRetransmit = 3
+	ackLinger     = 100 * time.Millisecond
+)
+
+type (
+	unacked struct {
+		obj Obj
+		seq int64
+	}
+	ErrPDUCksum struct {
+		loghdr   string
+		cname    string
+		ty       string
+		off      int64
+		expected uint32
+		actual   uint32
+	}
+)
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+func pduCksum(ty string, b []byte) uint32 {
+	if ty == cos.ChecksumCRC32C {
+		return crc32.Checksum(b, crc32cTable)
+	}
+	debug.Assert(ty == cos.ChecksumXXHash, ty)
+	return uint32(xxhash.Checksum64S(b, cos.MLCG32))
+}
+
+// stream's PDU checksum type, if any
+func pduCksumType(extra *Extra) string {
+	ty := extra.PDUCksum
+	if ty == "" {
+		ty = extra.Config.Transport.PDUChecksum
+	}
+	if ty == cos.ChecksumNone {
+		ty = ""
+	}
+	debug.Assert(ty == "" || ty == cos.ChecksumCRC32C || ty == cos.ChecksumXXHash, ty)
+	return ty
+}
+
+////////////
+// Stream //
+////////////
+
+func (s *Stream) pduCksum() bool { return s.pdu != nil && s.pdu.cksumTy != "" }
+
+// called upon session (HTTP request) completion: post completions for the objects that were
+// successfully delivered, and queue the nack-ed ones for retransmission
+func (s *Stream) ack(errSess error) {
+	var (
+		nacks cos.StrSet
+		retx  []Obj
+	)
+	if s.nack != "" {
+		nacks = cos.NewStrSet(strings.Split(s.nack, ",")...)
+	}
+	for i := range s.unacked {
+		var (
+			err error
+			u   = &s.unacked[i]
+		)
+		switch {
+		case errSess != nil && !s.reliable():
+			s.cmplCh <- cmpl{errSess, u.obj}
+			continue
+		case errSess != nil:
+			err = s.reopen(&u.obj, false /*nack*/) // to redeliver (see reliable.go)
+		case nacks.Contains(strconv.FormatInt(u.seq, 10)):
+			err = s.reopen(&u.obj, true /*nack*/)
+		default:
+			s.cmplCh <- cmpl{nil, u.obj}
+			continue
+		}
+		if err != nil {
+			nlog.Errorln(err)
+			s.cmplCh <- cmpl{err, u.obj}
+		} else {
+			retx = append(retx, u.obj)
+		}
+	}
+	clear(s.unacked)
+	s.unacked = s.unacked[:0]
+	if len(retx) > 0 {
+		s.resend = append(retx, s.resend...) // (end-of-stream, if deferred, remains last)
+	}
+	if errSess != nil {
+		return // (sendLoop decides)
+	}
+
+	// next session
+	if len(s.resend) > 0 || len(s.workCh) > 0 {
+		select {
+		case s.postCh <- struct{}{}:
+		default:
+		}
+	} else if s.sessST.CAS(active, inactive) && len(s.workCh) > 0 && s.sessST.CAS(inactive, active) {
+		s.postCh <- struct{}{}
+	}
+}
+
+func (s *Stream) reopen(obj *Obj, nack bool) error {
+	if nack {
+		if obj.retx >= maxRetransmit {
+			return fmt.Errorf("%s: %s failed PDU checksum verification %d times", s, obj, obj.retx+1)
+		}
+		select {
+		case <-s.lastCh.Listen():
+			return fmt.Errorf("%s: %s failed PDU checksum verification (end of stream)", s, obj)
+		default:
+		}
+	}
+	if obj.IsHeaderOnly() {
+		return nil
+	}
+	orig := obj.orig
+	if orig == nil {
+		orig = obj.Reader
+	}
+	roc, ok := orig.(cos.ReadOpenCloser)
+	if !ok {
+		return fmt.Errorf("%s: failed to retransmit %s: reader (%T) cannot be reopened", s, obj, orig)
+	}
+	r, err := roc.Open()
+	if err != nil {
+		return fmt.Errorf("%s: failed to reopen %s for retransmission: %v", s, obj, err)
+	}
+	if obj.orig == nil {
+		obj.orig = obj.Reader // to complete with (see doCmpl)
+	} else {
+		cos.Close(obj.Reader)
+	}
+	obj.Reader = r
+	if nack {
+		obj.retx++
+		nlog.Warningf("%s: retransmitting %s (%d)", s, obj, obj.retx)
+	}
+	return nil
+}
+
+// end the current session to get the pending objects acknowledged
+func (s *Stream) rotate() bool {
+	return len(s.unacked) >= maxUnacked
+}
+
+// ditto, when there's nothing else to send
+func (s *Stream) lingerCh() <-chan time.Time {
+	if len(s.unacked) == 0 {
+		return nil
+	}
+	return time.After(ackLinger)
+}
+
+//////////////
+// iterator //
+//////////////
+
+// skip the remaining PDUs of the object that failed checksum verification
+func (it *iterator) skipObj(loghdr string) (err error) {
+	pdu := it.pdu
+	for !pdu.last {
+		pdu.reset()
+		if err = pdu.readHdr(loghdr); err != nil {
+			return
+		}
+		for !pdu.done {
+			if _, err = pdu.readFrom(); err != nil && err != io.EOF {
+				return fmt.Errorf("sbr10 %s: failed to skip PDU, err %w", loghdr, err)
+			}
+			if !pdu.done {
+				runtime.Gosched()
+			}
+		}
+	}
+	return eofOK(err)
+}
+
+/////////////////
+// ErrPDUCksum //
+/////////////////
+
+func (e *ErrPDUCksum) Error() string {
+	return fmt.Sprintf("%s: %s PDU checksum mismatch at offset %d: expected %x, got %x", e.loghdr, e.ty,
+		e.off, e.expected, e.actual) + " (" + e.cname + ")"
+}
+
+func IsErrPDUCksum(err error) bool {
+	var e *ErrPDUCksum
+	return errors.As(err, &e)
+}
@@ -1,4 +1,4 @@
-//go:build !nethttp
+//go:build !nethttp && !quic && !grpc
 
 // Package transport provides streaming object-based transport over http for intra-cluster continuous
 // intra-cluster communications (see README for details and usage example).
@@ -8,7 +8,7 @@
 package transport
 
 import (
-	"crypto/tls"
+	"errors"
 	"io"
 	"net"
 	"net/http"
@@ -30,6 +30,8 @@ type Client interface {
 
 func whichClient() string { return "fasthttp" }
 
+func dialFailed(err error) bool { return errors.Is(err, fasthttp.ErrDialTimeout) || isDialErr(err) }
+
 // overriding fasthttp default `const DefaultDialTimeout = 3 * time.Second`
 func dialTimeout(addr string) (net.Conn, error) {
 	return fasthttp.DialTimeout(addr, 10*time.Second)
@@ -53,7 +55,7 @@ func NewIntraDataClient() Client {
 		WriteBufferSize: wbuf,
 	}
 	if config.Net.HTTP.UseHTTPS {
-		cl.TLSConfig = &tls.Config{InsecureSkipVerify: config.Net.HTTP.SkipVerify}
+		cl.TLSConfig = intraTLS(config)
 	}
 	return cl
 }
@@ -62,12 +64,23 @@ func (s *streamBase) do(body io.Reader) (err error) {
 	// init request & response
 	req, resp := fasthttp.AcquireRequest(), fasthttp.AcquireResponse()
 	req.Header.SetMethod(http.MethodPut)
-	req.SetRequestURI(s.dstURL)
+	req.SetRequestURI(s.reqURL())
 	req.SetBodyStream(body, -1)
 	if s.streamer.compressed() {
-		req.Header.Set(apc.HdrCompress, apc.LZ4Compression)
+		cmpr, dictID, dictSize := s.streamer.compression()
+		req.Header.Set(apc.HdrCompress, cmpr)
+		if dictID != "" {
+			req.Header.Set(apc.HdrCompressDict, dictID)
+		}
+		if dictSize > 0 {
+			req.Header.Set(apc.HdrCompressDictSize, strconv.Itoa(dictSize))
+		}
 	}
 	req.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	req.Header.Set(apc.HdrTransportVer, strconv.Itoa(s.ver))
+	if s.pdu != nil && s.pdu.cksumTy != "" {
+		req.Header.Set(apc.HdrPDUCksum, s.pdu.cksumTy)
+	}
 	req.Header.Set(cos.HdrUserAgent, ua)
 	// do
 	err = s.client.Do(req, resp)
@@ -78,6 +91,8 @@ func (s *streamBase) do(body io.Reader) (err error) {
 		return
 	}
 	// handle response & cleanup
+	s.nack = string(resp.Header.Peek(apc.HdrPDUNack))
+	s.negotiate(string(resp.Header.Peek(apc.HdrTransportVer)), resp.StatusCode() >= http.StatusBadRequest)
 	resp.BodyWriteTo(io.Discard)
 	fasthttp.ReleaseRequest(req)
 	fasthttp.ReleaseResponse(resp)
@@ -0,0 +1,173 @@
+//go:build grpc
+
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// gRPC client: each stream session is a client-streaming gRPC call to the same
+// "/v1/objstream/<trname>" endpoint (see grpc.go for the receiving side); session
+// parameters are carried as call metadata, and the session's byte stream - in
+// chunks of up to grpcChunkSize, each framed as a protobuf `BytesValue` message.
+
+const (
+	ua            = "aisnode/streams"
+	grpcChunkSize = memsys.MaxPageSlabSize
+)
+
+type (
+	Client interface {
+		conn(dstURL string) (cc *grpc.ClientConn, method string, err error)
+	}
+	grpcClient struct {
+		conns map[string]*grpc.ClientConn // by host:port
+		opts  []grpc.DialOption
+		mu    sync.Mutex
+	}
+)
+
+var grpcStreamDesc = grpc.StreamDesc{StreamName: apc.ObjStream, ClientStreams: true}
+
+func whichClient() string { return "grpc" }
+
+// (connections are established lazily - relaying via proxy is not supported)
+func dialFailed(error) bool { return false }
+
+// intra-cluster networking: gRPC client (connections are established lazily and reused)
+func NewIntraDataClient() Client {
+	config := cmn.GCO.Get()
+
+	// compare with ais/httpcommon.go
+	wbuf, rbuf := config.Net.HTTP.WriteBufferSize, config.Net.HTTP.ReadBufferSize
+	if wbuf == 0 {
+		wbuf = cmn.DefaultWriteBufferSize
+	}
+	if rbuf == 0 {
+		rbuf = cmn.DefaultReadBufferSize
+	}
+	creds := insecure.NewCredentials()
+	if config.Net.HTTP.UseHTTPS {
+		creds = credentials.NewTLS(intraTLS(config))
+	}
+	return &grpcClient{
+		conns: make(map[string]*grpc.ClientConn, 16),
+		opts: []grpc.DialOption{
+			grpc.WithTransportCredentials(creds),
+			grpc.WithUserAgent(ua),
+			grpc.WithWriteBufferSize(wbuf),
+			grpc.WithReadBufferSize(rbuf),
+		},
+	}
+}
+
+func (c *grpcClient) conn(dstURL string) (*grpc.ClientConn, string, error) {
+	u, err := url.Parse(dstURL)
+	if err != nil {
+		return nil, "", err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cc, ok := c.conns[u.Host]
+	if !ok {
+		if cc, err = grpc.Dial(u.Host, c.opts...); err != nil { // (non-blocking)
+			return nil, "", err
+		}
+		c.conns[u.Host] = cc
+	}
+	return cc, u.Path, nil
+}
+
+func (s *streamBase) do(body io.Reader) (err error) {
+	cc, method, err := s.client.conn(s.dstURL)
+	if err != nil {
+		return
+	}
+	md := metadata.Pairs(apc.HdrSessID, strconv.FormatInt(s.sessID, 10), apc.HdrTransportVer, strconv.Itoa(s.ver))
+	if s.pdu != nil && s.pdu.cksumTy != "" {
+		md.Set(apc.HdrPDUCksum, s.pdu.cksumTy)
+	}
+	if s.streamer.compressed() {
+		cmpr, dictID, dictSize := s.streamer.compression()
+		md.Set(apc.HdrCompress, cmpr)
+		if dictID != "" {
+			md.Set(apc.HdrCompressDict, dictID)
+		}
+		if dictSize > 0 {
+			md.Set(apc.HdrCompressDictSize, strconv.Itoa(dictSize))
+		}
+	}
+	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(context.Background(), md))
+	defer cancel()
+
+	stream, err := cc.NewStream(ctx, &grpcStreamDesc, method)
+	if err != nil {
+		if verbose {
+			nlog.Errorf("%s: Error [%v]", s, err)
+		}
+		return
+	}
+
+	// send session's data
+	var (
+		errR   error
+		n      int
+		mm     = memsys.PageMM()
+		buf, _ = mm.AllocSize(grpcChunkSize)
+	)
+	for errR == nil {
+		n, errR = body.Read(buf)
+		if n > 0 {
+			// NOTE: SendMsg serializes the message prior to returning - the buffer can be reused
+			if err = stream.SendMsg(&wrapperspb.BytesValue{Value: buf[:n]}); err != nil {
+				break // io.EOF: aborted by the receiver (the reason - below)
+			}
+		}
+	}
+	mm.Free(buf)
+	if errR != nil && errR != io.EOF {
+		err = errR // (ctx cancellation aborts the call)
+	} else if err = stream.CloseSend(); err == nil || err == io.EOF {
+		err = stream.RecvMsg(&emptypb.Empty{}) // final status
+	}
+	if ver := stream.Trailer().Get(apc.HdrTransportVer); len(ver) > 0 {
+		s.negotiate(ver[0], err != nil)
+	} else if status.Code(err) == codes.Internal {
+		s.negotiate("", true) // (receiver that predates version negotiation)
+	}
+	if err != nil {
+		if verbose {
+			nlog.Errorf("%s: Error [%v]", s, err)
+		}
+		return
+	}
+	if nack := stream.Trailer().Get(apc.HdrPDUNack); len(nack) > 0 {
+		s.nack = nack[0]
+	}
+	if s.streamer.compressed() {
+		s.streamer.resetCompression()
+	}
+	return
+}
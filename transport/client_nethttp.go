@@ -1,4 +1,4 @@
-//go:build nethttp
+//go:build nethttp && !quic && !grpc
 
 // Package transport provides streaming object-based transport over http for intra-cluster continuous
 // intra-cluster communications (see README for details and usage example).
@@ -26,6 +26,8 @@ type Client interface {
 
 func whichClient() string { return "net/http" }
 
+func dialFailed(err error) bool { return isDialErr(err) }
+
 // intra-cluster networking: net/http client
 func NewIntraDataClient() (client *http.Client) {
 	config := cmn.GCO.Get()
@@ -48,6 +50,7 @@ func NewIntraDataClient() (client *http.Client) {
 		ReadBufferSize:  rbuf,
 		UseHTTPS:        config.Net.HTTP.UseHTTPS,
 		SkipVerify:      config.Net.HTTP.SkipVerify,
+		TLS:             intraTLS(config),
 	})
 }
 
@@ -56,13 +59,24 @@ func (s *streamBase) do(body io.Reader) (err error) {
 		request  *http.Request
 		response *http.Response
 	)
-	if request, err = http.NewRequest(http.MethodPut, s.dstURL, body); err != nil {
+	if request, err = http.NewRequest(http.MethodPut, s.reqURL(), body); err != nil {
 		return
 	}
 	if s.streamer.compressed() {
-		request.Header.Set(apc.HdrCompress, apc.LZ4Compression)
+		cmpr, dictID, dictSize := s.streamer.compression()
+		request.Header.Set(apc.HdrCompress, cmpr)
+		if dictID != "" {
+			request.Header.Set(apc.HdrCompressDict, dictID)
+		}
+		if dictSize > 0 {
+			request.Header.Set(apc.HdrCompressDictSize, strconv.Itoa(dictSize))
+		}
 	}
 	request.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	request.Header.Set(apc.HdrTransportVer, strconv.Itoa(s.ver))
+	if s.pdu != nil && s.pdu.cksumTy != "" {
+		request.Header.Set(apc.HdrPDUCksum, s.pdu.cksumTy)
+	}
 	request.Header.Set(cos.HdrUserAgent, ua)
 
 	response, err = s.client.Do(request)
@@ -72,6 +86,8 @@ func (s *streamBase) do(body io.Reader) (err error) {
 		}
 		return
 	}
+	s.nack = response.Header.Get(apc.HdrPDUNack)
+	s.negotiate(response.Header.Get(apc.HdrTransportVer), response.StatusCode >= http.StatusBadRequest)
 	cos.DrainReader(response.Body)
 	response.Body.Close()
 	if s.streamer.compressed() {
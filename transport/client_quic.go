@@ -0,0 +1,114 @@
+//go:build quic && !grpc
+
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/quic-go/quic-go/http3"
+)
+
+// QUIC build: net/http client with either HTTP/3 (QUIC) or conventional (TCP)
+// transport - the choice is made at runtime via `config.Net.HTTP.UseQUIC`
+// (see quic.go for the receiving side)
+
+const ua = "aisnode/streams"
+
+type Client interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+func whichClient() string {
+	if cmn.GCO.Get().Net.HTTP.UseQUIC {
+		return "net/http (HTTP/3)"
+	}
+	return "net/http"
+}
+
+func dialFailed(err error) bool { return isDialErr(err) }
+
+func NewIntraDataClient() (client *http.Client) {
+	config := cmn.GCO.Get()
+	if config.Net.HTTP.UseQUIC {
+		return &http.Client{
+			Transport: &http3.RoundTripper{
+				TLSClientConfig: intraTLS(config),
+				QuicConfig:      quicConfig(),
+			},
+		}
+	}
+
+	// compare with client_nethttp.go
+	wbuf, rbuf := config.Net.HTTP.WriteBufferSize, config.Net.HTTP.ReadBufferSize
+	if wbuf == 0 {
+		wbuf = cmn.DefaultWriteBufferSize
+	}
+	if rbuf == 0 {
+		rbuf = cmn.DefaultReadBufferSize
+	}
+	tcpbuf := config.Net.L4.SndRcvBufSize
+	if tcpbuf == 0 {
+		tcpbuf = cmn.DefaultSendRecvBufferSize
+	}
+	return cmn.NewClient(cmn.TransportArgs{
+		SndRcvBufSize:   tcpbuf,
+		WriteBufferSize: wbuf,
+		ReadBufferSize:  rbuf,
+		UseHTTPS:        config.Net.HTTP.UseHTTPS,
+		SkipVerify:      config.Net.HTTP.SkipVerify,
+		TLS:             intraTLS(config),
+	})
+}
+
+func (s *streamBase) do(body io.Reader) (err error) {
+	var (
+		request  *http.Request
+		response *http.Response
+	)
+	if request, err = http.NewRequest(http.MethodPut, s.reqURL(), body); err != nil {
+		return
+	}
+	if s.streamer.compressed() {
+		cmpr, dictID, dictSize := s.streamer.compression()
+		request.Header.Set(apc.HdrCompress, cmpr)
+		if dictID != "" {
+			request.Header.Set(apc.HdrCompressDict, dictID)
+		}
+		if dictSize > 0 {
+			request.Header.Set(apc.HdrCompressDictSize, strconv.Itoa(dictSize))
+		}
+	}
+	request.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	request.Header.Set(apc.HdrTransportVer, strconv.Itoa(s.ver))
+	if s.pdu != nil && s.pdu.cksumTy != "" {
+		request.Header.Set(apc.HdrPDUCksum, s.pdu.cksumTy)
+	}
+	request.Header.Set(cos.HdrUserAgent, ua)
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		if verbose {
+			nlog.Errorf("%s: Error [%v]", s, err)
+		}
+		return
+	}
+	s.nack = response.Header.Get(apc.HdrPDUNack)
+	s.negotiate(response.Header.Get(apc.HdrTransportVer), response.StatusCode >= http.StatusBadRequest)
+	cos.DrainReader(response.Body)
+	response.Body.Close()
+	if s.streamer.compressed() {
+		s.streamer.resetCompression()
+	}
+	return
+}
@@ -24,6 +24,8 @@ type (
 		ticker  *time.Ticker
 		stopCh  cos.StopCh
 		ctrlCh  chan ctrl
+		sched   *prioSched  // weighted scheduling of stream priority classes
+		press   netPressure // network pressure (see adaptive.go)
 		heap    []*streamBase
 	}
 )
@@ -41,6 +43,8 @@ var _ cos.Runner = (*StreamCollector)(nil)
 //    deactivation (teardown)
 // 2. provides each stream with its own idle timer (with timeout measured in ticks - see tickUnit)
 // 3. deactivates idle streams
+// 4. enforces stream priorities (see prio.go)
+// 5. evaluates network pressure (see adaptive.go)
 
 func (*StreamCollector) Name() string { return "stream_collector" }
 
@@ -132,6 +136,7 @@ func (gc *collector) Pop() any {
 
 // collector's main method
 func (gc *collector) do() {
+	gc.press.tick(gc.sched.tick())
 	for lid, s := range gc.streams {
 		if s.IsTerminated() {
 			_, err := s.TermInfo()
@@ -144,6 +149,7 @@ func (gc *collector) do() {
 			s.time.ticks--
 			if s.time.ticks <= 0 {
 				delete(gc.streams, lid)
+				streams.Delete(lid)
 				s.streamer.closeAndFree()
 				s.streamer.abortPending(err, true /*completions*/)
 			}
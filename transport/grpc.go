@@ -0,0 +1,101 @@
+//go:build grpc
+
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"net/http"
+	"net/textproto"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// gRPC (receiving side): gRPC calls arrive at the same RxAnyStream endpoint as
+// http streams and are served by the (unknown-service) handler below, which
+// then runs the same receive loop (see client_grpc.go for the sending side)
+
+type grpcReader struct {
+	stream grpc.ServerStream
+	buf    []byte // remaining (unread) part of the last received message
+}
+
+var (
+	grpcSrv  *grpc.Server
+	grpcOnce sync.Once
+)
+
+// HTTP/2 without TLS ("h2c") for gRPC clients; with HTTPS, net/http negotiates HTTP/2 via ALPN
+func ServerHandler(h http.Handler) http.Handler { return h2c.NewHandler(h, &http2.Server{}) }
+
+func serveGRPC(w http.ResponseWriter, r *http.Request) bool {
+	if r.ProtoMajor != 2 || !strings.HasPrefix(r.Header.Get(cos.HdrContentType), "application/grpc") {
+		return false
+	}
+	grpcOnce.Do(func() {
+		grpcSrv = grpc.NewServer(grpc.UnknownServiceHandler(rxGRPC))
+	})
+	grpcSrv.ServeHTTP(w, r)
+	return true
+}
+
+func rxGRPC(_ any, stream grpc.ServerStream) error {
+	var (
+		remoteAddr string
+		ctx        = stream.Context()
+		hdr        = make(http.Header, 4)
+	)
+	method, _ := grpc.MethodFromServerStream(stream)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for k, v := range md {
+			hdr[textproto.CanonicalMIMEHeaderKey(k)] = v
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		remoteAddr = p.Addr.String()
+	}
+	nack, err := rxAny(path.Base(method), hdr, &grpcReader{stream: stream}, remoteAddr)
+	trailer := metadata.Pairs(apc.HdrTransportVer, protoVerStr)
+	if nack != "" {
+		trailer.Set(apc.HdrPDUNack, nack)
+	}
+	stream.SetTrailer(trailer)
+	if err != nil {
+		code := codes.Internal
+		if cos.IsErrNotFound(err) {
+			code = codes.NotFound
+		}
+		return status.Error(code, err.Error())
+	}
+	return stream.SendMsg(&emptypb.Empty{})
+}
+
+// returns io.EOF when the sender closes the stream
+func (r *grpcReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg := &wrapperspb.BytesValue{}
+		if err := r.stream.RecvMsg(msg); err != nil {
+			return 0, err
+		}
+		r.buf = msg.Value
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
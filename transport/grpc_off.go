@@ -0,0 +1,16 @@
+//go:build !grpc
+
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import "net/http"
+
+// (see grpc.go)
+
+func ServerHandler(h http.Handler) http.Handler { return h }
+
+func serveGRPC(http.ResponseWriter, *http.Request) bool { return false }
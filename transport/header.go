@@ -8,7 +8,9 @@ package transport
 import (
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"math"
+	"strconv"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -24,19 +26,37 @@ const (
 	pduFl                                  // is PDU
 	pduLastFl                              // is last PDU
 	pduStreamFl                            // PDU-based stream
+	pduCksumFl                             // PDU with payload checksum (see cksum.go)
 
 	// NOTE: update when adding/changing flags :NOTE
-	allFlags = msgFl | pduFl | pduLastFl | pduStreamFl
+	allFlags = msgFl | pduFl | pduLastFl | pduStreamFl | pduCksumFl
 
 	// all 3 headers
 	sizeProtoHdr = cos.SizeofI64 * 2
 )
 
+// Protocol versions are negotiated per session (apc.HdrTransportVer): the sender
+// starts with its own (ProtoVersion) and, upon session completion, switches to the one
+// advertised by the receiver (see streamBase.negotiate); receivers that do not advertise
+// any predate negotiation and are v1. The versions differ in how the second word of the
+// proto header protects the first one (hlen and flags) and the (obj or msg) header:
+//   - v1: 64-bit hash of the first word, nothing else;
+//   - v2: upper half - CRC32C over the first word and the header that follows,
+//     lower half - lower half of the v1 hash (or PDU payload checksum - see cksum.go)
+//   - v3: same as v2; in addition, object header ends with the request ID (ObjHdr.ReqID)
+const (
+	protoV1      = 1
+	protoV2      = 2
+	ProtoVersion = 3 // current
+)
+
+var protoVerStr = strconv.Itoa(ProtoVersion)
+
 ////////////////////////////////
 // proto header serialization //
 ////////////////////////////////
 
-func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU bool) (off int) {
+func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU bool, ver int) (off int) {
 	debug.Assert(usePDU || !hdr.IsUnsized())
 	off = sizeProtoHdr
 	off = insString(off, hbuf, hdr.SID)
@@ -48,40 +68,60 @@ func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU bool) (off int) {
 	off = insString(off, hbuf, hdr.ObjName)
 	off = insBytes(off, hbuf, hdr.Opaque)
 	off = insAttrs(off, hbuf, &hdr.ObjAttrs)
+	if ver > protoV2 {
+		off = insString(off, hbuf, hdr.ReqID)
+	}
 	word1 := uint64(off - sizeProtoHdr)
 	if usePDU {
 		word1 |= pduStreamFl
 	}
 	insUint64(0, hbuf, word1)
-	checksum := xoshiro256.Hash(word1)
-	insUint64(cos.SizeofI64, hbuf, checksum)
+	insUint64(cos.SizeofI64, hbuf, hdrWord2(hbuf, off, word1, ver))
 	return
 }
 
-func insMsg(hbuf []byte, msg *Msg) (off int) {
+func insMsg(hbuf []byte, msg *Msg, ver int) (off int) {
 	off = sizeProtoHdr
 	off = insString(off, hbuf, msg.SID)
 	off = insUint16(off, hbuf, msg.Opcode)
 	off = insBytes(off, hbuf, msg.Body)
 	word1 := uint64(off-sizeProtoHdr) | msgFl
 	insUint64(0, hbuf, word1)
-	checksum := xoshiro256.Hash(word1)
-	insUint64(cos.SizeofI64, hbuf, checksum)
+	insUint64(cos.SizeofI64, hbuf, hdrWord2(hbuf, off, word1, ver))
 	return
 }
 
-func (pdu *spdu) insHeader() {
+func (pdu *spdu) insHeader(ver int) {
 	buf, plen := pdu.buf, pdu.plength()
 	word1 := uint64(plen) | pduFl
 	if pdu.last {
 		word1 |= pduLastFl
 	}
+	if pdu.cksumTy != "" {
+		word1 |= pduCksumFl
+	}
 	insUint64(0, buf, word1)
-	checksum := xoshiro256.Hash(word1)
+	checksum := hdrWord2(buf, sizeProtoHdr /*PDU header only*/, word1, ver)
+	if pdu.cksumTy != "" {
+		checksum = checksum&^pduCksumMask | uint64(pduCksum(pdu.cksumTy, buf[sizeProtoHdr:pdu.woff]))
+	}
 	insUint64(cos.SizeofI64, buf, checksum)
 	pdu.done = true
 }
 
+// the first word must be already inserted
+func hdrWord2(hbuf []byte, off int, word1 uint64, ver int) uint64 {
+	checksum := xoshiro256.Hash(word1)
+	if ver > protoV1 {
+		crc := hdrCRC(crcWord1(hbuf), hbuf[sizeProtoHdr:off])
+		checksum = uint64(crc)<<32 | checksum&pduCksumMask
+	}
+	return checksum
+}
+
+func crcWord1(hbuf []byte) uint32          { return crc32.Checksum(hbuf[:cos.SizeofI64], crc32cTable) }
+func hdrCRC(crc uint32, hdr []byte) uint32 { return crc32.Update(crc, crc32cTable, hdr) }
+
 func insString(off int, to []byte, str string) int {
 	return insBytes(off, to, []byte(str))
 }
@@ -136,19 +176,35 @@ func insAttrs(off int, to []byte, attr *cmn.ObjAttrs) int {
 // proto header deserialization //
 //////////////////////////////////
 
-func extProtoHdr(hbuf []byte, loghdr string) (hlen int, flags uint64, err error) {
+func extProtoHdr(hbuf []byte, ver int, loghdr string) (hlen int, flags uint64, err error) {
 	off, word1 := extUint64(0, hbuf)
 	hlen = int(word1 & ^allFlags)
 	flags = word1 & allFlags
 	// validate checksum
 	_, checksum := extUint64(0, hbuf[off:])
 	chc := xoshiro256.Hash(word1)
+	switch {
+	case ver > protoV1 && flags&pduCksumFl != 0:
+		return // (CRC only - see chkCRC)
+	case ver > protoV1:
+		checksum, chc = checksum&pduCksumMask, chc&pduCksumMask // (upper half: CRC)
+	case flags&pduCksumFl != 0:
+		checksum, chc = checksum&^pduCksumMask, chc&^pduCksumMask // (lower half: payload checksum)
+	}
 	if checksum != chc {
-		err = fmt.Errorf("sbrk %s: bad checksum %x != %x (hlen=%d)", loghdr, checksum, chc, hlen)
+		err = fmt.Errorf("sbrk %s: bad checksum %x != %x (hlen=%d, v%d)", loghdr, checksum, chc, hlen, ver)
 	}
 	return
 }
 
+// v2: validate CRC (the upper half of the second word) computed over the first word and `hdr`
+func chkCRC(crc, expected uint32, hdr []byte, loghdr string) error {
+	if crc = hdrCRC(crc, hdr); crc != expected {
+		return fmt.Errorf("sbrk %s: header CRC mismatch %x != %x (hlen=%d)", loghdr, crc, expected, len(hdr))
+	}
+	return nil
+}
+
 func ExtObjHeader(body []byte, hlen int) (hdr ObjHdr) {
 	var off int
 	off, hdr.SID = extString(0, body)
@@ -160,6 +216,9 @@ func ExtObjHeader(body []byte, hlen int) (hdr ObjHdr) {
 	off, hdr.ObjName = extString(off, body)
 	off, hdr.Opaque = extBytes(off, body)
 	off, hdr.ObjAttrs = extAttrs(off, body)
+	if off < hlen { // v3
+		off, hdr.ReqID = extString(off, body)
+	}
 	debug.Assertf(off == hlen, "off %d, hlen %d", off, hlen)
 	return
 }
@@ -0,0 +1,63 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// all object streams: from construction and until (terminated) stream gets collected
+var streams sync.Map // lid => *Stream
+
+// Info returns the stream's counters (see apc.StreamInfo)
+func (s *Stream) Info() *apc.StreamInfo {
+	info := &apc.StreamInfo{
+		ID:             s.lid,
+		Trname:         s.trname,
+		DstID:          s.dstID,
+		Num:            s.stats.Num.Load(),
+		Size:           s.stats.Size.Load(),
+		Offset:         s.stats.Offset.Load(),
+		CompressedSize: s.stats.CompressedSize.Load(),
+		CmprOffset:     s.stats.CmprOffset.Load(),
+		InFlight:       s.inFlight.Load(),
+		Errors:         s.errs.Load(),
+		Redelivered:    s.redlv.Load(),
+		Idle:           mono.Since(s.time.last.Load()),
+		Active:         s.sessST.Load() == active,
+		Terminated:     s.IsTerminated(),
+	}
+	if info.CompressedSize > 0 {
+		info.CmprRatio = float64(info.CmprOffset) / float64(info.CompressedSize)
+	}
+	if s.cmprs.adaptive {
+		info.Compression = apc.CompressNever
+		if gc.press.get() {
+			info.Compression = apc.CompressAlways
+		}
+	}
+	if info.Terminated {
+		s.term.mu.Lock()
+		info.TermReason = s.term.reason
+		s.term.mu.Unlock()
+	}
+	return info
+}
+
+// AllInfo returns counters of all object streams, sorted by ID
+func AllInfo() []*apc.StreamInfo {
+	out := make([]*apc.StreamInfo, 0, 16)
+	streams.Range(func(_, v any) bool {
+		out = append(out, v.(*Stream).Info())
+		return true
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
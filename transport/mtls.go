@@ -0,0 +1,38 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// mutual TLS (see cmn/tls.go)
+
+var errNoPeerCert = errors.New("mutual TLS: stream sender must present a valid certificate")
+
+// client side
+func intraTLS(config *cmn.Config) *tls.Config {
+	if conf := cmn.IntraClientTLS(&config.Net.HTTP); conf != nil {
+		return conf
+	}
+	return &tls.Config{InsecureSkipVerify: config.Net.HTTP.SkipVerify} //nolint:gosec // configurable
+}
+
+// server side: when intra-data and public networks share the same listener, client
+// certificates are verified only if given - hence, checking here
+func checkPeer(r *http.Request) error {
+	if !cmn.GCO.Get().Net.HTTP.UseMTLS() {
+		return nil
+	}
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+		return errNoPeerCert
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+func Test_MutualTLS(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		ca, key  = genCert(t, dir, "ca", nil, nil)
+		_, _     = genCert(t, dir, "node", ca, key)
+		trname   = "mtls"
+		received atomic.Int64
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.Net.HTTP.UseHTTPS = true
+	config.Net.HTTP.Certificate = filepath.Join(dir, "node.crt")
+	config.Net.HTTP.Key = filepath.Join(dir, "node.key")
+	config.Net.HTTP.ClientCA = filepath.Join(dir, "ca.crt")
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Net.HTTP.UseHTTPS = false
+		config.Net.HTTP.ClientCA = ""
+		cmn.GCO.CommitUpdate(config)
+	}()
+	tassert.CheckFatal(t, config.Net.HTTP.ValidateMTLS())
+
+	ts := httptest.NewUnstartedServer(objmux)
+	tlsConf, err := cmn.IntraServerTLS(&config.Net.HTTP, false /*require*/)
+	tassert.CheckFatal(t, err)
+	ts.TLS = tlsConf
+	ts.StartTLS()
+	defer ts.Close()
+
+	err = transport.HandleObjStream(trname, func(hdr transport.ObjHdr, r io.Reader, err error) error {
+		if err == nil {
+			_, err = io.Copy(io.Discard, r)
+			received.Inc()
+		}
+		return err
+	})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	send := func(client transport.Client) (errs int64) {
+		var (
+			data   = bytes.Repeat([]byte("mtls"), cos.KiB)
+			stream = transport.NewObjStream(client, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+		)
+		for i := 0; i < 8; i++ {
+			hdr := transport.ObjHdr{ObjName: "obj"}
+			hdr.ObjAttrs.Size = int64(len(data))
+			stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(data))})
+		}
+		stream.Fin()
+		return stream.Info().Errors
+	}
+
+	// 1. authenticated
+	errs := send(transport.NewIntraDataClient())
+	tassert.Errorf(t, errs == 0 && received.Load() == 8, "expected all received (errors %d, received %d)",
+		errs, received.Load())
+
+	// 2. no client certificate: handshake succeeds (public listener), stream gets rejected
+	received.Store(0)
+	config = cmn.GCO.BeginUpdate()
+	config.Net.HTTP.ClientCA = ""
+	config.Net.HTTP.SkipVerify = true
+	cmn.GCO.CommitUpdate(config)
+	client := transport.NewIntraDataClient()
+	config = cmn.GCO.BeginUpdate()
+	config.Net.HTTP.ClientCA = filepath.Join(dir, "ca.crt")
+	config.Net.HTTP.SkipVerify = false
+	cmn.GCO.CommitUpdate(config)
+
+	send(client)
+	tassert.Errorf(t, received.Load() == 0, "expected rejected, received %d", received.Load())
+}
+
+// generate ECDSA certificate (and key) signed by the parent, or self-signed CA when parent is nil;
+// write PEM-encoded <name>.crt and <name>.key into the directory
+func genCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if parent == nil {
+		tmpl.IsCA, tmpl.BasicConstraintsValid = true, true
+		tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+		parent, parentKey = tmpl, key
+	} else {
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	tassert.CheckFatal(t, err)
+	crt, err := x509.ParseCertificate(der)
+	tassert.CheckFatal(t, err)
+	kder, err := x509.MarshalECPrivateKey(key)
+	tassert.CheckFatal(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)
+	tassert.CheckFatal(t, err)
+	err = os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kder}), 0o600)
+	tassert.CheckFatal(t, err)
+	return crt, key
+}
@@ -14,6 +14,7 @@ package transport_test
 // go test -v -run=Multi -tags=debug
 
 import (
+	"bytes"
 	"encoding/binary"
 	"flag"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	"path"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -141,8 +143,8 @@ func Example_headers() {
 	stream.Fin()
 
 	// Output:
-	// {Bck:s3://@uuid#namespace/abc ObjName:X SID: Opaque:[] ObjAttrs:{Cksum:xxhash[h1] CustomMD:map[] Ver:1 Atime:663346294 Size:231} Opcode:0} (69)
-	// {Bck:ais://abracadabra ObjName:p/q/s SID: Opaque:[49 50 51] ObjAttrs:{Cksum:xxhash[h2] CustomMD:map[xx:11 yy:22] Ver:222222222222222222222222 Atime:663346294 Size:213} Opcode:0} (110)
+	// {Bck:s3://@uuid#namespace/abc ObjName:X SID: Opaque:[] ObjAttrs:{Cksum:xxhash[h1] CustomMD:map[] Ver:1 Atime:663346294 Size:231} ReqID: Opcode:0} (71)
+	// {Bck:ais://abracadabra ObjName:p/q/s SID: Opaque:[49 50 51] ObjAttrs:{Cksum:xxhash[h2] CustomMD:map[xx:11 yy:22] Ver:222222222222222222222222 Atime:663346294 Size:213} ReqID:rid-1 Opcode:0} (117)
 }
 
 func sendText(stream *transport.Stream, txt1, txt2 string) {
@@ -187,6 +189,7 @@ func sendText(stream *transport.Stream, txt1, txt2 string) {
 			Ver:   "222222222222222222222222",
 		},
 		Opaque: []byte{'1', '2', '3'},
+		ReqID:  "rid-1",
 	}
 	hdr.ObjAttrs.SetCustomMD(cos.StrKVs{"xx": "11", "yy": "22"})
 	wg.Add(1)
@@ -524,6 +527,548 @@ func Test_CompressedOne(t *testing.T) {
 	printNetworkStats(t)
 }
 
+// reliable streams complete sessions when idle (see cksum.go) - short sessions
+// is where the dictionary (sent only once) makes a difference
+func Test_CompressedDict(t *testing.T) {
+	trname := "cmpr-dict"
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	// many similar small (JSON) objects
+	genJSON := func(i int) []byte {
+		return []byte(fmt.Sprintf(`{"id":%d,"time":"2023-10-16T12:%02d:%02d.%03dZ","level":"info","service":"aistore",`+
+			`"node":"t[%04x]","xaction":"ec-get","msg":"object ais://bucket/obj-%d restored from %d slices",`+
+			`"attrs":{"size":%d,"cksum_type":"xxhash","version":"%d","provider":"ais","namespace":"global"},`+
+			`"tags":["ec","restore","background"]}`, i, i%60, i%59, i%1000, i%7, i, i%4+2, i*1024, i%3+1))
+	}
+	samples := make([][]byte, 0, 256)
+	for i := 0; i < 256; i++ {
+		samples = append(samples, genJSON(i))
+	}
+	zdict, err := transport.TrainDict(samples, 0)
+	tassert.CheckFatal(t, err)
+
+	var received atomic.Int64
+	recvJSON := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		cos.Assert(err == nil)
+		b, err := io.ReadAll(objReader)
+		cos.AssertNoErr(err)
+		i, err := strconv.Atoi(hdr.ObjName)
+		cos.AssertNoErr(err)
+		cos.Assertf(string(b) == string(genJSON(i)), "%s: %q", hdr.ObjName, b)
+		received.Inc()
+		return nil
+	}
+	err = transport.HandleObjStream(trname, recvJSON)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		httpclient = transport.NewIntraDataClient()
+		url        = ts.URL + transport.ObjURLPath(trname)
+		num        = 160
+		burst      = 4
+		sizes      = make(map[string]int64, 3)
+	)
+	for _, test := range []struct {
+		name  string
+		extra transport.Extra
+	}{
+		{"no-dict", transport.Extra{Compressor: apc.ZstdCompression}},
+		{"dict", transport.Extra{CompressDict: zdict}},
+		{"trained", transport.Extra{DictSamples: 128}},
+	} {
+		extra := test.extra
+		extra.Compression = apc.CompressAlways
+		extra.Replace = func(_, _ *transport.Stream) bool { return false }
+		received.Store(0)
+		stream := transport.NewObjStream(httpclient, url, cos.GenTie(), &extra)
+		for i := 0; i < num; i++ {
+			data := genJSON(i + 1000)
+			hdr := transport.ObjHdr{ObjName: strconv.Itoa(i + 1000)}
+			hdr.ObjAttrs.Size = int64(len(data))
+			stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(data))})
+			if i%burst == burst-1 {
+				time.Sleep(120 * time.Millisecond) // idle => end of session
+			}
+		}
+		stream.Fin()
+
+		stats := stream.GetStats()
+		sizes[test.name] = stats.CompressedSize.Load()
+		tlog.Logf("%s: %s: num=%d, size=%d, compressed=%d, compression-ratio=%.2f\n", test.name, stream,
+			stats.Num.Load(), stats.Size.Load(), stats.CompressedSize.Load(), stats.CompressionRatio())
+		tassert.Fatalf(t, received.Load() == int64(num), "%s: received %d, expected %d", test.name, received.Load(), num)
+	}
+	// (the dictionary itself included; were it sent with each of the ~40 sessions, there'd be no reduction)
+	tassert.Errorf(t, sizes["dict"] < sizes["no-dict"]*3/4, "dict: expected reduction (%d vs %d)",
+		sizes["dict"], sizes["no-dict"])
+	tassert.Errorf(t, sizes["trained"] < sizes["no-dict"]*9/10, "trained: expected reduction (%d vs %d)",
+		sizes["trained"], sizes["no-dict"])
+}
+
+func Test_CompressedZstd(t *testing.T) {
+	trname := "cmpr-zstd"
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	genCSV := func(i int) []byte {
+		return []byte(strings.Repeat(fmt.Sprintf("%d,aistore,object-%d,%d\n", i, i, i%7), 64))
+	}
+	var received atomic.Int64
+	recvCSV := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		cos.Assert(err == nil)
+		b, err := io.ReadAll(objReader)
+		cos.AssertNoErr(err)
+		i, err := strconv.Atoi(hdr.ObjName)
+		cos.AssertNoErr(err)
+		cos.Assertf(bytes.Equal(b, genCSV(i)), "%s: %q", hdr.ObjName, b)
+		received.Inc()
+		return nil
+	}
+	err := transport.HandleObjStream(trname, recvCSV)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+	stream := transport.NewObjStream(httpclient, url, cos.GenTie(),
+		&transport.Extra{Compression: apc.CompressAlways, Compressor: apc.ZstdCompression, CompressLevel: 9})
+
+	num := 1000
+	for i := 0; i < num; i++ {
+		data := genCSV(i)
+		hdr := transport.ObjHdr{ObjName: strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = int64(len(data))
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(data))})
+	}
+	stream.Fin()
+
+	stats := stream.GetStats()
+	tlog.Logf("%s: num=%d, compression-ratio=%.2f\n", stream, stats.Num.Load(), stats.CompressionRatio())
+	tassert.Fatalf(t, received.Load() == int64(num), "received %d, expected %d", received.Load(), num)
+	tassert.Errorf(t, stats.CompressionRatio() > 1, "expecting compression, got ratio %.2f", stats.CompressionRatio())
+}
+
+func Test_Priorities(t *testing.T) {
+	trname := "prio"
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	var received [2]atomic.Int64
+	recv := func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		cos.Assert(err == nil)
+		written, _ := io.Copy(io.Discard, objReader)
+		cos.Assert(written == hdr.ObjAttrs.Size)
+		received[hdr.Opaque[0]].Inc()
+		return nil
+	}
+	err := transport.HandleObjStream(trname, recv)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		wg   sync.WaitGroup
+		num  = 128
+		data = make([]byte, cos.MiB)
+		url  = ts.URL + transport.ObjURLPath(trname)
+	)
+	for i, prio := range []int{transport.PrioData, transport.PrioBackground} {
+		stream := transport.NewObjStream(transport.NewIntraDataClient(), url, cos.GenTie(), &transport.Extra{Priority: prio})
+		wg.Add(1)
+		go func(stream *transport.Stream, i int) {
+			defer wg.Done()
+			for j := 0; j < num; j++ {
+				hdr := transport.ObjHdr{ObjName: strconv.Itoa(j), Opaque: []byte{byte(i)}}
+				hdr.ObjAttrs.Size = int64(len(data))
+				stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(data))})
+			}
+			stream.Fin()
+		}(stream, i)
+	}
+	wg.Wait()
+	for i := range received {
+		tassert.Errorf(t, received[i].Load() == int64(num), "class %d: received %d, expected %d", i, received[i].Load(), num)
+	}
+}
+
+func Test_Relay(t *testing.T) {
+	trname := "relay"
+	config := cmn.GCO.BeginUpdate()
+	config.Transport.Relay = true
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Transport.Relay = false
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	// "proxy": /v1/tunnel/<dst-id>/objstream/<trname> => /v1/objstream/<trname>
+	var relayed atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		items := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 4)
+		tassert.Errorf(t, len(items) == 4 && items[1] == apc.Tunnel, "unexpected relay path %q", r.URL.Path)
+		relayed.Inc()
+		r.URL.Path = "/" + apc.Version + "/" + items[3]
+		objmux.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	transport.SetRelay(func() string { return ts.URL })
+	defer transport.SetRelay(nil)
+
+	var received atomic.Int64
+	err := transport.HandleObjStream(trname, func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		cos.Assert(err == nil)
+		written, _ := io.Copy(io.Discard, objReader)
+		cos.Assert(written == hdr.ObjAttrs.Size)
+		received.Inc()
+		return nil
+	})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	// destination that refuses connections
+	blocked := httptest.NewServer(http.NotFoundHandler())
+	blocked.Close()
+
+	var (
+		num    = 100
+		data   = make([]byte, 64*cos.KiB)
+		stream = transport.NewObjStream(transport.NewIntraDataClient(), blocked.URL+transport.ObjURLPath(trname),
+			cos.GenTie(), nil)
+	)
+	for i := 0; i < num; i++ {
+		hdr := transport.ObjHdr{ObjName: strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = int64(len(data))
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(data))})
+	}
+	stream.Fin()
+	tassert.Errorf(t, relayed.Load() > 0, "expected relayed session(s)")
+	tassert.Errorf(t, received.Load() == int64(num), "received %d, expected %d", received.Load(), num)
+}
+
+func Test_Throttle(t *testing.T) {
+	trname := "throttle"
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, receive10G)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		bps    = int64(2 * cos.MiB)
+		num    = 32
+		data   = make([]byte, 128*cos.KiB) // total 4MiB
+		extra  = &transport.Extra{Throttle: transport.NewThrottle(func() int64 { return bps })}
+		url    = ts.URL + transport.ObjURLPath(trname)
+		stream = transport.NewObjStream(transport.NewIntraDataClient(), url, cos.GenTie(), extra)
+	)
+	started := time.Now()
+	for i := 0; i < num; i++ {
+		hdr := transport.ObjHdr{ObjName: strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = int64(len(data))
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(data))})
+	}
+	stream.Fin()
+	elapsed := time.Since(started)
+	tlog.Logf("%s: sent %s in %v\n", stream, cos.ToSizeIEC(int64(num*len(data)), 0), elapsed)
+	tassert.Errorf(t, elapsed > time.Second, "expecting throttled (%s/s) transmission, got %v",
+		cos.ToSizeIEC(bps, 0), elapsed)
+}
+
+func Test_PDUCksum(t *testing.T) {
+	trname := "pdu-cksum"
+
+	// corrupt a single byte of the first session's payload
+	var sessions atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if sessions.Inc() == 1 {
+			r.Body = &corruptReader{ReadCloser: r.Body, off: 8 * cos.KiB}
+		}
+		objmux.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	var (
+		received, mismatched atomic.Int64
+		data                 = make([]byte, 128*cos.KiB)
+	)
+	_, _ = rand.Read(data)
+	err := transport.HandleObjStream(trname, func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		cos.Assert(err == nil)
+		b, err := io.ReadAll(objReader)
+		if err != nil {
+			tassert.Errorf(t, transport.IsErrPDUCksum(err), "expected PDU checksum error, got %v", err)
+			mismatched.Inc()
+			return err
+		}
+		tassert.Errorf(t, bytes.Equal(b, data), "%s: received corrupted data", hdr.ObjName)
+		received.Inc()
+		return nil
+	})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		num    = 32
+		sgl    = memsys.PageMM().NewSGL(int64(len(data)))
+		failed atomic.Int64
+		extra  = &transport.Extra{
+			SizePDU:  memsys.DefaultBufSize,
+			PDUCksum: cos.ChecksumCRC32C,
+			Callback: func(_ transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+				if err != nil {
+					failed.Inc()
+				}
+			},
+		}
+		stream = transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname),
+			cos.GenTie(), extra)
+	)
+	defer sgl.Free()
+	_, _ = sgl.Write(data)
+	for i := 0; i < num; i++ {
+		hdr := transport.ObjHdr{ObjName: strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = int64(len(data))
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: memsys.NewReader(sgl)})
+	}
+	stream.Fin()
+	tassert.Errorf(t, mismatched.Load() > 0, "expected PDU checksum mismatch(es)")
+	tassert.Errorf(t, received.Load() == int64(num), "received %d, expected %d", received.Load(), num)
+	tassert.Errorf(t, failed.Load() == 0, "failed to send %d object(s)", failed.Load())
+}
+
+func Test_ProtoVersion(t *testing.T) {
+	trname := "proto-version"
+	err := transport.HandleObjStream(trname, func(transport.ObjHdr, io.Reader, error) error { return nil })
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	// unsupported version: rejected upfront, with the receiver's version in the response
+	req, err := http.NewRequest(http.MethodPut, ts.URL+transport.ObjURLPath(trname), http.NoBody)
+	tassert.CheckFatal(t, err)
+	req.Header.Set(apc.HdrSessID, "1")
+	req.Header.Set(apc.HdrTransportVer, strconv.Itoa(transport.ProtoVersion+1))
+	resp, err := http.DefaultClient.Do(req)
+	tassert.CheckFatal(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	tassert.Errorf(t, resp.StatusCode == http.StatusBadRequest, "expected status %d, got %d", http.StatusBadRequest,
+		resp.StatusCode)
+	tassert.Errorf(t, strings.Contains(string(body), "unsupported transport protocol version"), "unexpected: %s", body)
+	tassert.Errorf(t, resp.Header.Get(apc.HdrTransportVer) == strconv.Itoa(transport.ProtoVersion),
+		"expected version %d in response, got %q", transport.ProtoVersion, resp.Header.Get(apc.HdrTransportVer))
+}
+
+func Test_HeaderCRC(t *testing.T) {
+	trname := "header-crc"
+
+	// corrupt a single byte of the first object's name
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = &corruptReader{ReadCloser: r.Body, off: 20}
+		objmux.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	var received, crcErrs atomic.Int64
+	err := transport.HandleObjStream(trname, func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		if err != nil {
+			tassert.Errorf(t, strings.Contains(err.Error(), "CRC"), "expected header CRC error, got %v", err)
+			crcErrs.Inc()
+			return err
+		}
+		cos.DrainReader(objReader)
+		received.Inc()
+		return nil
+	})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	stream := transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname),
+		cos.GenTie(), nil)
+	hdr := transport.ObjHdr{ObjName: "0123456789abcdef"}
+	hdr.ObjAttrs.Size = int64(len(text))
+	stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(strings.NewReader(text))})
+	stream.Fin()
+	tassert.Errorf(t, crcErrs.Load() == 1, "expected header CRC error")
+	tassert.Errorf(t, received.Load() == 0, "received %d object(s) with corrupted header", received.Load())
+}
+
+type corruptReader struct {
+	io.ReadCloser
+	off int64
+}
+
+func (r *corruptReader) Read(b []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(b)
+	if r.off >= 0 && r.off < int64(n) {
+		b[r.off] ^= 0xff
+	}
+	r.off -= int64(n)
+	return
+}
+
+func Test_Reliable(t *testing.T) {
+	trname := "reliable"
+
+	// break the connection in the middle of the first session
+	var sessions atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if sessions.Inc() > 1 {
+			objmux.ServeHTTP(w, r)
+			return
+		}
+		_, _ = io.CopyN(io.Discard, r.Body, 256*cos.KiB)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	}))
+	defer ts.Close()
+
+	var (
+		received atomic.Int64
+		data     = make([]byte, 64*cos.KiB)
+	)
+	_, _ = rand.Read(data)
+	err := transport.HandleObjStream(trname, func(hdr transport.ObjHdr, objReader io.Reader, err error) error {
+		cos.Assert(err == nil)
+		b, err := io.ReadAll(objReader)
+		tassert.CheckError(t, err)
+		tassert.Errorf(t, bytes.Equal(b, data), "%s: received corrupted data", hdr.ObjName)
+		received.Inc()
+		return nil
+	})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		num      = 64
+		sgl      = memsys.PageMM().NewSGL(int64(len(data)))
+		failed   atomic.Int64
+		mu       sync.Mutex
+		replaced int
+		stream   *transport.Stream
+		extra    = &transport.Extra{
+			Callback: func(_ transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+				if err != nil {
+					failed.Inc()
+				}
+			},
+			Replace: func(_, successor *transport.Stream) bool {
+				mu.Lock()
+				stream = successor
+				replaced++
+				mu.Unlock()
+				return true
+			},
+		}
+		current = func() *transport.Stream {
+			mu.Lock()
+			defer mu.Unlock()
+			return stream
+		}
+	)
+	defer sgl.Free()
+	_, _ = sgl.Write(data)
+	stream = transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname),
+		cos.GenTie(), extra)
+	for i := 0; i < num; i++ {
+		hdr := transport.ObjHdr{ObjName: strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = int64(len(data))
+		current().Send(&transport.Obj{Hdr: hdr, Reader: memsys.NewReader(sgl)})
+	}
+	// the stream that fails returns from Fin() after handing over undelivered objects
+	for s := current(); ; s = current() {
+		s.Fin()
+		if current() == s {
+			break
+		}
+	}
+	tassert.Errorf(t, replaced > 0, "expected the stream to be replaced")
+	tassert.Errorf(t, received.Load() == int64(num), "received %d, expected %d", received.Load(), num)
+	tassert.Errorf(t, failed.Load() == 0, "failed to send %d object(s)", failed.Load())
+}
+
+func Test_StreamInfo(t *testing.T) {
+	trname := "stream-info"
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, receive10G)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		num    = 16
+		data   = make([]byte, 32*cos.KiB)
+		stream = transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname),
+			cos.GenTie(), nil)
+	)
+	for i := 0; i < num; i++ {
+		hdr := transport.ObjHdr{ObjName: strconv.Itoa(i)}
+		hdr.ObjAttrs.Size = int64(len(data))
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(data))})
+	}
+	stream.Fin()
+
+	info := stream.Info()
+	tassert.Errorf(t, info.Num == int64(num), "num %d, expected %d", info.Num, num)
+	tassert.Errorf(t, info.Size == int64(num*len(data)), "size %d, expected %d", info.Size, num*len(data))
+	tassert.Errorf(t, info.InFlight == 0 && info.Errors == 0, "in-flight %d, errors %d", info.InFlight, info.Errors)
+	tassert.Errorf(t, info.Terminated && info.TermReason != "", "expected terminated (%+v)", info)
+
+	var found bool
+	for _, si := range transport.AllInfo() {
+		found = found || si.ID == info.ID
+	}
+	tassert.Errorf(t, found, "%s not found", info.ID)
+}
+
+func Test_AdaptiveCompression(t *testing.T) {
+	trname := "cmpr-adaptive"
+	config := cmn.GCO.BeginUpdate()
+	config.Transport.LinkCapacity = cos.SizeIEC(cos.MiB) // (engages almost immediately)
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Transport.LinkCapacity = 0
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+
+	err := transport.HandleObjStream(trname, receive10G)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	var (
+		num    int
+		data   = bytes.Repeat([]byte("adaptive"), 8*cos.KiB)
+		stream = transport.NewObjStream(transport.NewIntraDataClient(), ts.URL+transport.ObjURLPath(trname),
+			cos.GenTie(), &transport.Extra{Compression: apc.CompressAdaptive})
+		started = mono.NanoTime()
+	)
+	for mono.Since(started) < 3*time.Second {
+		hdr := transport.ObjHdr{ObjName: strconv.Itoa(num)}
+		hdr.ObjAttrs.Size = int64(len(data))
+		stream.Send(&transport.Obj{Hdr: hdr, Reader: io.NopCloser(bytes.NewReader(data))})
+		num++
+	}
+	stream.Fin()
+
+	info := stream.Info()
+	tassert.Errorf(t, info.Num == int64(num), "num %d, expected %d", info.Num, num)
+	tassert.Errorf(t, info.Errors == 0, "errors %d", info.Errors)
+	tassert.Errorf(t, info.CompressedSize > 0, "expected compression under pressure (%+v)", info)
+	tlog.Logf("%s: num=%d, compression-ratio=%.2f\n", stream, num, info.CmprRatio)
+}
+
 func Test_DryRun(t *testing.T) {
 	tools.CheckSkip(t, tools.SkipTestArgs{Long: true})
 
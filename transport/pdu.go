@@ -23,13 +23,18 @@ type (
 		last bool
 	}
 	spdu struct {
+		cksumTy string // PDU checksum type (optional)
 		pdu
 	}
 	rpdu struct {
-		body io.Reader
+		body    io.Reader
+		err     error  // PDU checksum mismatch (sticky until the end of the object)
+		cksumTy string // (ditto)
 		pdu
+		ver   int // protocol version
 		flags uint64
 		plen  int
+		cksum uint32 // expected payload checksum
 	}
 )
 
@@ -57,9 +62,9 @@ func (pdu *pdu) free(mm *memsys.MMSA) {
 // spdu //
 //////////
 
-func newSendPDU(buf []byte) (p *spdu) {
+func newSendPDU(buf []byte, cksumTy string) (p *spdu) {
 	debug.Assert(len(buf) >= cos.KiB && len(buf) <= maxSizePDU)
-	p = &spdu{pdu{buf: buf}}
+	p = &spdu{cksumTy, pdu{buf: buf}}
 	p.reset()
 	return
 }
@@ -107,15 +112,28 @@ func (pdu *rpdu) readHdr(loghdr string) (err error) {
 		}
 		return
 	}
-	pdu.plen, pdu.flags, err = extProtoHdr(pdu.buf, loghdr)
+	pdu.plen, pdu.flags, err = extProtoHdr(pdu.buf, pdu.ver, loghdr)
 	if err != nil {
 		return
 	}
+	if pdu.ver > protoV1 {
+		_, word2 := extUint64(cos.SizeofI64, pdu.buf)
+		if err = chkCRC(crcWord1(pdu.buf), uint32(word2>>32), nil, loghdr); err != nil {
+			return
+		}
+	}
 	if pdu.flags&pduFl == 0 || pdu.plen > maxSizePDU || pdu.plen < 0 {
 		err = fmt.Errorf(fmterr, loghdr, pdu.plen, fl2s(pdu.flags))
 		debug.AssertNoErr(err)
 		return
 	}
+	if pdu.flags&pduCksumFl != 0 {
+		if pdu.cksumTy == "" {
+			return fmt.Errorf("sbrk %s: PDU checksum without checksum type (%s)", loghdr, fl2s(pdu.flags))
+		}
+		_, word2 := extUint64(cos.SizeofI64, pdu.buf)
+		pdu.cksum = uint32(word2 & pduCksumMask)
+	}
 	pdu.woff = sizeProtoHdr
 	pdu.last = pdu.flags&pduLastFl != 0
 	debug.Assertf(pdu.plen > 0 || (pdu.plen == 0 && pdu.last), fmterr, loghdr, pdu.plen, fl2s(pdu.flags))
@@ -155,5 +173,8 @@ func fl2s(flags uint64) (s string) {
 	if flags&pduLastFl != 0 {
 		s += "[lst]"
 	}
+	if flags&pduCksumFl != 0 {
+		s += "[cksum]"
+	}
 	return
 }
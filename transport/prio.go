@@ -0,0 +1,109 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Stream priority classes (see Extra.Priority), from the highest to the lowest.
+// Weighted scheduling: on each collector's tick, every class that is below a congested
+// higher-priority class gets a budget in proportion to the bytes sent by the latter
+// during the previous tick, and to the ratio of their weights. Streams that exceed
+// the budget wait for the next tick. A class is considered congested when its streams
+// are transmitting while having more objects queued for sending (i.e., when the network
+// does not keep up). Otherwise, lower-priority streams are not throttled at all.
+const (
+	PrioData       = iota // (default) foreground data path, e.g. user PUT => replicas
+	PrioRebalance         // global rebalance
+	PrioBackground        // EC encode and other background jobs
+	numPrio
+)
+
+// relative weights (see above)
+var prioWeights = [numPrio]int64{16, 4, 1}
+
+// minimum budget per tick (to prevent starvation)
+const minPrioBudget = memsys.MaxPageSlabSize
+
+type (
+	prioClass struct {
+		sent    atomic.Int64 // bytes sent during the current tick
+		budget  atomic.Int64 // bytes allowed to send during the current tick (negative: unlimited)
+		backlog atomic.Bool  // true: objects waiting in send queue (see Stream.Read)
+	}
+	prioSched struct {
+		tickCh  chan struct{} // closed and renewed upon each tick
+		classes [numPrio]prioClass
+		mu      sync.Mutex
+	}
+)
+
+func newPrioSched() (ps *prioSched) {
+	ps = &prioSched{tickCh: make(chan struct{})}
+	for c := range ps.classes {
+		ps.classes[c].budget.Store(-1)
+	}
+	return
+}
+
+// called by the collector (see collector.do)
+// returns total bytes sent during the tick and whether any class was congested
+func (ps *prioSched) tick() (total int64, congested bool) {
+	var (
+		hiSent   int64
+		hiWeight int64
+	)
+	for c := range ps.classes {
+		pc := &ps.classes[c]
+		sent, backlog := pc.sent.Swap(0), pc.backlog.Swap(false)
+		if hiWeight == 0 {
+			pc.budget.Store(-1)
+		} else {
+			pc.budget.Store(max(hiSent*prioWeights[c]/hiWeight, minPrioBudget))
+		}
+		if sent > 0 && backlog && hiWeight == 0 {
+			hiSent, hiWeight = sent, prioWeights[c]
+		}
+		total += sent
+	}
+	congested = hiWeight > 0
+	ps.mu.Lock()
+	close(ps.tickCh)
+	ps.tickCh = make(chan struct{})
+	ps.mu.Unlock()
+	return
+}
+
+// waits until the stream's class is within its budget (or the stream gets stopped)
+func (ps *prioSched) throttle(s *Stream) {
+	pc := &ps.classes[s.prio]
+	for {
+		budget := pc.budget.Load()
+		if budget < 0 || pc.sent.Load() < budget {
+			return
+		}
+		ps.mu.Lock()
+		tickCh := ps.tickCh
+		ps.mu.Unlock()
+		select {
+		case <-tickCh:
+		case <-s.stopCh.Listen():
+			return
+		}
+	}
+}
+
+func (ps *prioSched) sent(s *Stream, n int) { ps.classes[s.prio].sent.Add(int64(n)) }
+
+func (ps *prioSched) queued(s *Stream) {
+	if len(s.workCh) > 0 {
+		ps.classes[s.prio].backlog.Store(true)
+	}
+}
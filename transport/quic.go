@@ -0,0 +1,77 @@
+//go:build quic && !grpc
+
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+)
+
+// HTTP/3 (QUIC): receiving side listens on the same port as the (TCP) network that
+// carries intra-cluster streams, and serves the same handlers (see client_quic.go
+// for the sending side); compared to TCP, each stream session gets its own QUIC stream
+// and, therefore, is not affected by the head-of-line blocking and loss recovery
+// of the others
+
+const (
+	quicIdleTimeout = time.Minute
+	quicKeepalive   = 10 * time.Second
+)
+
+type (
+	QUICServer interface {
+		ListenAndServe() error
+		Close() error
+	}
+	h3server struct {
+		s        *http3.Server
+		crt, key string
+	}
+)
+
+func quicConfig() *quic.Config {
+	return &quic.Config{
+		MaxIdleTimeout:  quicIdleTimeout,
+		KeepAlivePeriod: quicKeepalive,
+	}
+}
+
+func NewQUICServer(addr string, h http.Handler) (QUICServer, error) {
+	config := cmn.GCO.Get()
+	// mutual TLS: QUIC carries intra-cluster streams only - always require client certificates
+	tlsConf, err := cmn.IntraServerTLS(&config.Net.HTTP, true /*require*/)
+	if err != nil {
+		return nil, err
+	}
+	return &h3server{
+		s:   &http3.Server{Addr: addr, Handler: h, QuicConfig: quicConfig(), TLSConfig: tlsConf},
+		crt: config.Net.HTTP.Certificate,
+		key: config.Net.HTTP.Key,
+	}, nil
+}
+
+// returns nil upon Close
+func (h3 *h3server) ListenAndServe() error {
+	var err error
+	if h3.s.TLSConfig != nil {
+		err = h3.s.ListenAndServe()
+	} else {
+		err = h3.s.ListenAndServeTLS(h3.crt, h3.key)
+	}
+	if errors.Is(err, http.ErrServerClosed) || errors.Is(err, quic.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (h3 *h3server) Close() error { return h3.s.Close() }
@@ -0,0 +1,24 @@
+//go:build !quic || grpc
+
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"errors"
+	"net/http"
+)
+
+// (see quic.go)
+
+type QUICServer interface {
+	ListenAndServe() error
+	Close() error
+}
+
+func NewQUICServer(string, http.Handler) (QUICServer, error) {
+	return nil, errors.New("HTTP/3 (QUIC) is not supported: aisnode must be built with 'quic' build tag (and without 'grpc')")
+}
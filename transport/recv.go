@@ -13,6 +13,7 @@ import (
 	"path"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/OneOfOne/xxhash"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v3"
 )
 
@@ -38,7 +40,15 @@ type (
 		handler *handler
 		pdu     *rpdu
 		stats   *Stats
+		cksumTy string   // PDU checksum type (apc.HdrPDUCksum)
+		nacks   []string // in-session sequence numbers of the objects that failed PDU checksum verification
 		hbuf    []byte
+		seq     int64 // in-session object sequence number
+		ver     int   // protocol version (apc.HdrTransportVer)
+		crc     struct {
+			word1    uint32 // CRC32C of the first word of the current proto header (v2)
+			expected uint32 // CRC32C of the former and the header that follows
+		}
 	}
 	objReader struct {
 		body   io.Reader
@@ -70,65 +80,129 @@ var (
 
 // main Rx objects
 func RxAnyStream(w http.ResponseWriter, r *http.Request) {
+	if err := checkPeer(r); err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	if serveGRPC(w, r) {
+		return
+	}
+	nack, err := rxAny(path.Base(r.URL.Path), r.Header, r.Body, r.RemoteAddr)
+	w.Header().Set(apc.HdrTransportVer, protoVerStr)
+	if nack != "" {
+		w.Header().Set(apc.HdrPDUNack, nack)
+	}
+	if err != nil {
+		if cos.IsErrNotFound(err) && !verbose {
+			cmn.WriteErr(w, r, err, 0, 1 /*silent*/)
+		} else {
+			cmn.WriteErr(w, r, err)
+		}
+	}
+}
+
+// receive a single stream session, regardless of the underlying (http or gRPC) transport;
+// returns comma-separated sequence numbers of the objects to retransmit (see cksum.go), if any
+func rxAny(trname string, hdr http.Header, body io.Reader, remoteAddr string) (string, error) {
 	var (
-		reader    io.Reader = r.Body
+		reader    = body
 		lz4Reader *lz4.Reader
-		trname    = path.Base(r.URL.Path)
+		zdec      *zstd.Decoder
 	)
 	mu.RLock()
 	h, ok := handlers[trname]
 	if !ok {
 		mu.RUnlock()
-		err := cos.NewErrNotFound("unknown transport endpoint %q", trname)
-		if verbose {
-			cmn.WriteErr(w, r, err, 0)
-		} else {
-			cmn.WriteErr(w, r, err, 0, 1 /*silent*/)
-		}
-		return
+		return "", cos.NewErrNotFound("unknown transport endpoint %q", trname)
 	}
 	mu.RUnlock()
+	ver, err := rxProtoVer(trname, hdr)
+	if err != nil {
+		return "", err
+	}
+	cksumTy := hdr.Get(apc.HdrPDUCksum)
+	if cksumTy != "" && cksumTy != cos.ChecksumCRC32C && cksumTy != cos.ChecksumXXHash {
+		return "", fmt.Errorf("%s: unsupported PDU checksum %q", trname, cksumTy)
+	}
 	// compression
-	if compressionType := r.Header.Get(apc.HdrCompress); compressionType != "" {
-		debug.Assert(compressionType == apc.LZ4Compression)
-		lz4Reader = lz4.NewReader(r.Body)
+	switch compressionType := hdr.Get(apc.HdrCompress); compressionType {
+	case "":
+	case apc.LZ4Compression:
+		lz4Reader = lz4.NewReader(body)
 		reader = lz4Reader
+	case apc.ZstdCompression:
+		if zdec, err = newZstdReader(hdr, body); err != nil {
+			return "", fmt.Errorf("%s: %v", trname, err)
+		}
+		reader = zdec
+	default:
+		return "", fmt.Errorf("%s: unsupported compression %q", trname, compressionType)
 	}
 
 	// session
-	sessID, err := strconv.ParseInt(r.Header.Get(apc.HdrSessID), 10, 64)
+	sessID, err := strconv.ParseInt(hdr.Get(apc.HdrSessID), 10, 64)
 	if err != nil || sessID == 0 {
-		cmn.WriteErr(w, r, fmt.Errorf("%s[:%d]: invalid session ID, err %v", trname, sessID, err))
-		return
+		return "", fmt.Errorf("%s[:%d]: invalid session ID, err %v", trname, sessID, err)
 	}
-	uid := uniqueID(r, sessID)
+	uid := uniqueID(remoteAddr, sessID)
 	statsif, _ := h.sessions.LoadOrStore(uid, &Stats{})
 	xxh, _ := UID2SessID(uid)
 	loghdr := fmt.Sprintf("%s[%d:%d]", trname, xxh, sessID)
 	if verbose {
-		nlog.Infof("%s: start-of-stream from %s", loghdr, r.RemoteAddr)
+		nlog.Infof("%s: start-of-stream from %s", loghdr, remoteAddr)
 	}
 	stats := statsif.(*Stats)
 
 	// receive loop
 	mm := memsys.PageMM()
-	it := &iterator{handler: h, body: reader, stats: stats}
+	it := &iterator{handler: h, body: reader, stats: stats, cksumTy: cksumTy, ver: ver}
 	it.hbuf, _ = mm.AllocSize(dfltMaxHdr)
 	err = it.rxloop(uid, loghdr, mm)
 
 	// cleanup
 	if lz4Reader != nil {
 		lz4Reader.Reset(nil)
+	} else if zdec != nil {
+		zdec.Close()
 	}
 	if it.pdu != nil {
 		it.pdu.free(mm)
 	}
 	mm.Free(it.hbuf)
 
+	nack := strings.Join(it.nacks, ",")
 	// if err != io.EOF {
 	if !cos.IsEOF(err) {
-		cmn.WriteErr(w, r, err)
+		return nack, err
+	}
+	return nack, nil
+}
+
+// the sender's protocol version; senders that predate negotiation are v1 (see ProtoVersion)
+func rxProtoVer(trname string, hdr http.Header) (int, error) {
+	s := hdr.Get(apc.HdrTransportVer)
+	if s == "" {
+		return protoV1, nil
+	}
+	ver, err := strconv.Atoi(s)
+	if err != nil || ver < protoV1 || ver > ProtoVersion {
+		return 0, fmt.Errorf("%s: unsupported transport protocol version %q (this node supports v%d through v%d)",
+			trname, s, protoV1, ProtoVersion)
+	}
+	return ver, nil
+}
+
+// negotiated via session headers (see zdict.go)
+func newZstdReader(hdr http.Header, body io.Reader) (*zstd.Decoder, error) {
+	id := hdr.Get(apc.HdrCompressDict)
+	if id == "" {
+		return zstd.NewReader(body, zstd.WithDecoderConcurrency(1))
 	}
+	zdict, err := recvDict(id, hdr.Get(apc.HdrCompressDictSize), body)
+	if err != nil {
+		return nil, err
+	}
+	return zstd.NewReader(body, zstd.WithDecoderDicts(zdict), zstd.WithDecoderConcurrency(1))
 }
 
 ////////////////
@@ -195,6 +269,7 @@ func (it *iterator) rxloop(uid uint64, loghdr string, mm *memsys.MMSA) (err erro
 				if it.pdu == nil {
 					pbuf, _ := mm.AllocSize(maxSizePDU)
 					it.pdu = newRecvPDU(it.body, pbuf)
+					it.pdu.cksumTy, it.pdu.ver = it.cksumTy, it.ver
 				} else {
 					it.pdu.reset()
 				}
@@ -214,6 +289,7 @@ func (it *iterator) rxObj(loghdr string, hlen int) (err error) {
 	h := it.handler
 	obj, err = it.nextObj(loghdr, hlen)
 	if obj != nil {
+		it.seq++
 		if !obj.hdr.IsHeaderOnly() {
 			obj.pdu = it.pdu
 		}
@@ -222,6 +298,14 @@ func (it *iterator) rxObj(loghdr string, hlen int) (err error) {
 		if errCb := h.rxObj(obj.hdr, obj, err); errCb != nil {
 			err = errCb
 		}
+		if it.pdu != nil && it.pdu.err != nil {
+			// the sender will retransmit (see cksum.go)
+			nlog.Warningln(it.pdu.err)
+			it.pdu.err = nil
+			it.nacks = append(it.nacks, strconv.FormatInt(it.seq, 10))
+			err = it.skipObj(loghdr)
+			return
+		}
 		// stats
 		if err == nil {
 			it.stats.Num.Inc()           // this stream stats
@@ -272,7 +356,11 @@ func (it *iterator) nextProtoHdr(loghdr string) (hlen int, flags uint64, err err
 		return
 	}
 	// extract and validate hlen
-	hlen, flags, err = extProtoHdr(it.hbuf, loghdr)
+	hlen, flags, err = extProtoHdr(it.hbuf, it.ver, loghdr)
+	if err == nil && it.ver > protoV1 {
+		_, word2 := extUint64(cos.SizeofI64, it.hbuf)
+		it.crc.word1, it.crc.expected = crcWord1(it.hbuf), uint32(word2>>32)
+	}
 	return
 }
 
@@ -300,6 +388,11 @@ func (it *iterator) nextObj(loghdr string, hlen int) (obj *objReader, err error)
 			return
 		}
 	}
+	if it.ver > protoV1 {
+		if err = chkCRC(it.crc.word1, it.crc.expected, it.hbuf[:hlen], loghdr); err != nil {
+			return
+		}
+	}
 	hdr := ExtObjHeader(it.hbuf, hlen)
 	if hdr.isFin() {
 		err = io.EOF
@@ -320,6 +413,11 @@ func (it *iterator) nextMsg(loghdr string, hlen int) (msg Msg, err error) {
 		return
 	}
 	debug.Assertf(n == hlen, "%d != %d", n, hlen)
+	if it.ver > protoV1 {
+		if err = chkCRC(it.crc.word1, it.crc.expected, it.hbuf[:hlen], loghdr); err != nil {
+			return
+		}
+	}
 	msg = ExtMsg(it.hbuf, hlen)
 	if msg.isFin() {
 		err = io.EOF
@@ -370,20 +468,33 @@ func (obj *objReader) IsUnsized() bool { return obj.hdr.IsUnsized() }
 
 func (obj *objReader) readPDU(b []byte) (n int, err error) {
 	pdu := obj.pdu
+	if pdu.err != nil {
+		return 0, pdu.err
+	}
 	if pdu.woff == 0 {
 		err = pdu.readHdr(obj.loghdr)
 		if err != nil {
 			return
 		}
 	}
-	for !pdu.done {
-		if _, err = pdu.readFrom(); err != nil && err != io.EOF {
-			err = fmt.Errorf("sbr8 %s: failed to receive PDU, err %w, obj %s", obj.loghdr, err, obj)
-			break
+	if !pdu.done {
+		for !pdu.done {
+			if _, err = pdu.readFrom(); err != nil && err != io.EOF {
+				err = fmt.Errorf("sbr8 %s: failed to receive PDU, err %w, obj %s", obj.loghdr, err, obj)
+				break
+			}
+			debug.Assert(err == nil || (err == io.EOF && pdu.done))
+			if !pdu.done {
+				runtime.Gosched()
+			}
 		}
-		debug.Assert(err == nil || (err == io.EOF && pdu.done))
-		if !pdu.done {
-			runtime.Gosched()
+		// verify before handing over any of the PDU's data
+		if pdu.flags&pduCksumFl != 0 && (err == nil || err == io.EOF) {
+			if cksum := pduCksum(pdu.cksumTy, pdu.buf[sizeProtoHdr:pdu.woff]); cksum != pdu.cksum {
+				pdu.err = &ErrPDUCksum{loghdr: obj.loghdr, cname: obj.hdr.Cname(), off: obj.off, ty: pdu.cksumTy,
+					expected: pdu.cksum, actual: cksum}
+				return 0, pdu.err
+			}
 		}
 	}
 	n = pdu.read(b)
@@ -424,8 +535,8 @@ func IsErrDuplicateTrname(e error) bool {
 // session ID <=> unique ID
 //
 
-func uniqueID(r *http.Request, sessID int64) uint64 {
-	x := xxhash.ChecksumString64S(r.RemoteAddr, cos.MLCG32)
+func uniqueID(remoteAddr string, sessID int64) uint64 {
+	x := xxhash.ChecksumString64S(remoteAddr, cos.MLCG32)
 	return (x&math.MaxUint32)<<32 | uint64(sessID)
 }
 
@@ -0,0 +1,80 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Relaying via proxy: when (and if) `transport.relay` is configured, a stream that fails
+// to connect to its destination directly (e.g., when target-to-target ports are blocked)
+// reroutes its sessions through a proxy: PUT /v1/tunnel/<dst-id>/objstream/<trname>
+// (see ais/proxy tunnelHandler). After relayDuration, the stream tries to connect
+// directly again - upon its next session.
+
+const relayDuration = 5 * time.Minute
+
+var relayURL func() string // returns proxy URL (or empty string when not available)
+
+// SetRelay is called once at startup (see ais/target)
+func SetRelay(f func() string) { relayURL = f }
+
+func isDialErr(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return cos.IsErrConnectionRefused(err)
+}
+
+func (s *streamBase) reqURL() string {
+	if s.relay.url != "" {
+		return s.relay.url
+	}
+	return s.dstURL
+}
+
+// (sendLoop) returns true to retry the request via proxy
+func (s *streamBase) relayOnErr(err error) bool {
+	if s.relay.url != "" || relayURL == nil || !dialFailed(err) {
+		return false
+	}
+	if config := cmn.GCO.Get(); !config.Transport.Relay {
+		return false
+	}
+	purl := relayURL()
+	if purl == "" {
+		return false
+	}
+	u, errV := url.Parse(s.dstURL)
+	if errV != nil {
+		return false
+	}
+	// /v1/objstream/<trname> => /v1/tunnel/<dst-id>/objstream/<trname>
+	s.relay.url = purl + cos.JoinWords(apc.Version, apc.Tunnel, s.dstID) + strings.TrimPrefix(u.Path, "/"+apc.Version)
+	s.relay.since = mono.NanoTime()
+	nlog.Warningf("%s: failed to connect directly (%v) - relaying via %s", s, err, purl)
+	return true
+}
+
+// (sendLoop) upon each new session
+func (s *streamBase) relayExpired() {
+	if s.relay.url == "" || mono.Since(s.relay.since) < relayDuration {
+		return
+	}
+	s.relay.url = ""
+	nlog.Infof("%s: relayed for %v - trying to connect directly", s, relayDuration)
+}
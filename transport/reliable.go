@@ -0,0 +1,104 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Reliable delivery (optional - see Extra.Replace).
+//
+// Same as with PDU checksums (cksum.go), a reliable stream does not post completions for
+// the objects sent in a given session until the session is done. When the session fails,
+// the stream reopens the objects that were put on the wire (the reader must be
+// cos.ReadOpenCloser) and, upon termination, creates its successor - a new stream to the
+// same destination. The owner (e.g., stream bundle) then installs the successor in place
+// of the terminated stream (Extra.Replace), and all undelivered objects - sent but not
+// acknowledged, in-send, queued, and posted via Send() after termination - get handed over.
+//
+// To ride out transient failures (e.g., target restart), the successor waits prior to its
+// first session, with the wait doubling for each next generation of successors. Any given
+// object gets redelivered at most maxRedeliver times. Note that delivery is at-least-once:
+// objects received prior to the failure may be received again.
+
+const (
+	maxRedeliver     = 6
+	redeliverWait    = time.Second
+	maxRedeliverWait = 30 * time.Second
+)
+
+func (s *Stream) reliable() bool { return s.extra.Replace != nil }
+
+// objects get acknowledged upon session completion (see cksum.go)
+func (s *Stream) acked() bool { return s.pduCksum() || s.reliable() }
+
+// called by terminate() prior to marking the stream terminated
+func (s *Stream) newSuccessor() *Stream {
+	var (
+		gen     = s.rel.gen + 1
+		backoff = min(redeliverWait<<(gen-1), maxRedeliverWait)
+		ns      = NewObjStream(s.client, s.dstURL, s.dstID, s.extra)
+	)
+	ns.rel.gen, ns.rel.backoff = gen, backoff
+	if !s.extra.Replace(s, ns) {
+		ns.Stop()
+		return nil
+	}
+	nlog.Warningf("%s: redelivering undelivered objects via %s (generation %d, backoff %v)", s, ns, gen, backoff)
+	return ns
+}
+
+// wait before the first session of the successor; returns false if stopped
+func (s *Stream) backoff() bool {
+	d := s.rel.backoff
+	s.rel.backoff = 0
+	select {
+	case <-time.After(d):
+		return true
+	case <-s.stopCh.Listen():
+		return false
+	}
+}
+
+// hand over undelivered object to the successor; false if the object must be completed (with error)
+func (s *Stream) handoff(obj *Obj) bool {
+	ns := s.rel.succ
+	if ns == nil || obj.Hdr.isFin() || obj.Hdr.isIdleTick() || obj.redlv >= maxRedeliver {
+		return false
+	}
+	obj.redlv++
+	s.inFlight.Dec()
+	ns.redlv.Inc()
+	if verbose {
+		nlog.Infof("%s: redeliver %s via %s (%d)", s, obj, ns, obj.redlv)
+	}
+	o := AllocSend()
+	*o = *obj
+	_ = ns.Send(o) // (the successor takes care of completion)
+	return true
+}
+
+// called by terminate(): hand over everything that's pending prior to returning
+// from Fin() (so that the owner's Fin on the successor comes after)
+func (s *Stream) handover(err error) {
+	resend := s.resend
+	s.resend = nil
+	for i := range resend {
+		s.undelivered(&resend[i], err)
+	}
+	s.drain(err) // stragglers, if any, are handed over by the collector
+}
+
+func (s *Stream) undelivered(obj *Obj, err error) {
+	if obj.Hdr.isFin() {
+		return // (Fin() is waiting for termination - nothing to complete)
+	}
+	if !s.handoff(obj) {
+		s.doCmpl(obj, err)
+	}
+}
@@ -49,10 +49,11 @@ func (s *MsgStream) terminate(err error, reason string) (actReason string, actEr
 	return
 }
 
-func (*MsgStream) abortPending(error, bool) {}
-func (*MsgStream) errCmpl(error)            {}
-func (*MsgStream) compressed() bool         { return false }
-func (*MsgStream) resetCompression()        { debug.Assert(false) }
+func (*MsgStream) abortPending(error, bool)           {}
+func (*MsgStream) errCmpl(error)                      {}
+func (*MsgStream) compressed() bool                   { return false }
+func (*MsgStream) compression() (string, string, int) { return "", "", 0 }
+func (*MsgStream) resetCompression()                  { debug.Assert(false) }
 
 func (s *MsgStream) doRequest() error {
 	s.Numcur, s.Sizecur = 0, 0
@@ -84,7 +85,7 @@ repeat:
 			}
 			return s.deactivate()
 		}
-		l := insMsg(s.maxhdr, &s.msgoff.msg)
+		l := insMsg(s.maxhdr, &s.msgoff.msg, s.ver)
 		s.header = s.maxhdr[:l]
 		s.msgoff.ins = inHdr
 		return s.send(b)
@@ -125,7 +126,7 @@ func (s *MsgStream) dryrun() {
 	var (
 		body = io.NopCloser(s)
 		h    = &handler{trname: s.trname}
-		it   = iterator{handler: h, body: body, hbuf: make([]byte, dfltMaxHdr)}
+		it   = iterator{handler: h, body: body, hbuf: make([]byte, dfltMaxHdr), ver: s.ver}
 	)
 	for {
 		hlen, flags, err := it.nextProtoHdr(s.String())
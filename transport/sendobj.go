@@ -9,12 +9,16 @@ import (
 	"fmt"
 	"io"
 	"runtime"
+	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v3"
 )
 
@@ -25,15 +29,41 @@ type (
 		cmplCh   chan cmpl // aka SCQ; note that SQ and SCQ together form a FIFO
 		callback ObjSentCB // to free SGLs, close files, etc.
 		sendoff  sendoff
-		lz4s     lz4Stream
+		cmprs    cmprStream
+		throttle *Throttle // bandwidth limit (optional)
+		prio     int       // priority class (see prio.go)
+		extra    *Extra
+		unacked  []unacked    // sent but not yet acknowledged (PDU checksums - see cksum.go)
+		resend   []Obj        // to retransmit
+		seq      int64        // in-session sequence number of the object that's being sent
+		inFlight atomic.Int64 // posted via Send() and not yet completed (see Info)
+		errs     atomic.Int64 // completed with error (ditto)
+		redlv    atomic.Int64 // redelivered via this stream (ditto)
+		rel      struct {     // reliable delivery (see reliable.go)
+			succ    *Stream // successor
+			gen     int
+			backoff time.Duration
+		}
 		streamBase
 	}
-	lz4Stream struct {
+	cmprStream struct {
 		s             *Stream
-		zw            *lz4.Writer // orig reader => zw
+		zw            cmprWriter  // orig reader => zw (lz4 or zstd)
+		lz4w          *lz4.Writer // (when zw is lz4)
 		sgl           *memsys.SGL // zw => bb => network
+		dict          txDict      // optional zstd dictionary (see zdict.go)
+		level         int         // zstd compression level
+		zstd          bool        // true: zw is zstd (otherwise, lz4)
 		blockMaxSize  int         // *uncompressed* block max size
 		frameChecksum bool        // true: checksum lz4 frames
+		adaptive      bool        // true: compress only under network pressure (see adaptive.go)
+		on            bool        // adaptive: the current session is compressed
+		toggled       bool        // adaptive: the current session ended to toggle compression
+	}
+	cmprWriter interface {
+		io.Writer
+		Flush() error
+		Reset(w io.Writer)
 	}
 	sendoff struct {
 		obj Obj
@@ -54,6 +84,9 @@ var _ streamer = (*Stream)(nil)
 ///////////////////
 
 func (s *Stream) terminate(err error, reason string) (actReason string, actErr error) {
+	if reason == reasonError && s.reliable() {
+		s.rel.succ = s.newSuccessor() // (ahead of the CAS below - see handoff)
+	}
 	ok := s.term.done.CAS(false, true)
 	debug.Assert(ok, s.String())
 
@@ -70,42 +103,106 @@ func (s *Stream) terminate(err error, reason string) (actReason string, actErr e
 	s.cmplCh <- cmpl{err, Obj{Hdr: ObjHdr{Opcode: opcFin}}}
 	s.term.mu.Unlock()
 
+	if s.rel.succ != nil {
+		s.handover(err)
+	}
+
 	// Remove stream after lock because we could deadlock between `do()`
 	// (which checks for `Terminated` status) and this function which
 	// would be under lock.
 	gc.remove(&s.streamBase)
 
-	if s.compressed() {
-		s.lz4s.sgl.Free()
-		if s.lz4s.zw != nil {
-			s.lz4s.zw.Reset(nil)
+	if s.cmprs.s == s {
+		s.cmprs.sgl.Free()
+		if s.cmprs.zw != nil {
+			s.cmprs.zw.Reset(nil)
 		}
 	}
 	return
 }
 
 func (s *Stream) initCompression(extra *Extra) {
-	s.lz4s.s = s
-	s.lz4s.blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
-	s.lz4s.frameChecksum = extra.Config.Transport.LZ4FrameChecksum
+	s.cmprs.s = s
+	s.cmprs.adaptive = extra.Compression == apc.CompressAdaptive
+	s.cmprs.blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
+	s.cmprs.frameChecksum = extra.Config.Transport.LZ4FrameChecksum
 	mem := extra.MMSA
 	if mem == nil {
 		mem = memsys.PageMM()
 	}
-	if s.lz4s.blockMaxSize >= memsys.MaxPageSlabSize {
-		s.lz4s.sgl = mem.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
+	if s.cmprs.blockMaxSize >= memsys.MaxPageSlabSize {
+		s.cmprs.sgl = mem.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
 	} else {
-		s.lz4s.sgl = mem.NewSGL(cos.KiB*64, cos.KiB*64)
+		s.cmprs.sgl = mem.NewSGL(cos.KiB*64, cos.KiB*64)
+	}
+	s.lid = fmt.Sprintf("%s[%d[%s]]", s.trname, s.sessID, cos.ToSizeIEC(int64(s.cmprs.blockMaxSize), 0))
+
+	// zstd: when configured or with session-level dictionary (see zdict.go)
+	compressor := extra.Compressor
+	if compressor == "" {
+		compressor = extra.Config.Transport.Compressor
+	}
+	if compressor != apc.ZstdCompression && len(extra.CompressDict) == 0 && extra.DictSamples == 0 {
+		return
+	}
+	s.cmprs.level = extra.CompressLevel
+	if s.cmprs.level == 0 {
+		s.cmprs.level = extra.Config.Transport.ZstdLevel
+	}
+	if err := s.cmprs.zstdWriter(extra.CompressDict); err != nil {
+		nlog.Errorf("%s: failed to initialize %s (level %d), falling back to %s: %v", s, apc.ZstdCompression,
+			s.cmprs.level, apc.LZ4Compression, err)
+		return
+	}
+	if len(extra.CompressDict) > 0 {
+		s.cmprs.dict.set(extra.CompressDict)
+	} else {
+		s.cmprs.dict.want = extra.DictSamples
+	}
+	s.setZstdLid()
+}
+
+func (s *Stream) setZstdLid() {
+	s.lid = fmt.Sprintf("%s[%d[%s:%d:%s]]", s.trname, s.sessID, apc.ZstdCompression, s.cmprs.level,
+		cos.ToSizeIEC(int64(len(s.cmprs.dict.b)), 0))
+}
+
+func (cs *cmprStream) zstdWriter(zdict []byte) error {
+	opts := []zstd.EOption{zstd.WithEncoderConcurrency(1)}
+	if cs.level > 0 {
+		opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(cs.level)))
+	}
+	if len(zdict) > 0 {
+		opts = append(opts, zstd.WithEncoderDict(zdict))
+	}
+	zenc, err := zstd.NewWriter(nil, opts...)
+	if err != nil {
+		return err
 	}
-	s.lid = fmt.Sprintf("%s[%d[%s]]", s.trname, s.sessID, cos.ToSizeIEC(int64(s.lz4s.blockMaxSize), 0))
+	cs.zw, cs.zstd = zenc, true
+	return nil
 }
 
-func (s *Stream) compressed() bool { return s.lz4s.s == s }
-func (s *Stream) usePDU() bool     { return s.pdu != nil }
+// (adaptive compression is per session - see adaptive.go)
+func (s *Stream) compressed() bool {
+	return s.cmprs.s == s && (!s.cmprs.adaptive || s.cmprs.on)
+}
+func (s *Stream) compression() (string, string, int) {
+	d := &s.cmprs.dict
+	switch {
+	case !s.cmprs.zstd:
+		return apc.LZ4Compression, "", 0
+	case d.incl:
+		return apc.ZstdCompression, d.id, len(d.b)
+	default:
+		return apc.ZstdCompression, d.id, 0
+	}
+}
+func (s *Stream) usePDU() bool { return s.pdu != nil }
 
 func (s *Stream) resetCompression() {
-	s.lz4s.sgl.Reset()
-	s.lz4s.zw.Reset(nil)
+	s.cmprs.sgl.Reset()
+	s.cmprs.zw.Reset(nil)
 }
 
 func (s *Stream) cmplLoop() {
@@ -122,8 +219,20 @@ func (s *Stream) cmplLoop() {
 
 // handle the last interrupted transmission and pending SQ/SCQ
 func (s *Stream) abortPending(err error, completions bool) {
+	unacked, resend := s.unacked, s.resend
+	s.unacked, s.resend = nil, nil
+	for i := range unacked {
+		s.doCmpl(&unacked[i].obj, err)
+	}
+	for i := range resend {
+		s.undelivered(&resend[i], err)
+	}
+	if s.rel.succ != nil && !completions {
+		s.drain(err) // (see handover)
+		return
+	}
 	for obj := range s.workCh {
-		s.doCmpl(obj, err)
+		s.undelivered(obj, err)
 	}
 	if completions {
 		for cmpl := range s.cmplCh {
@@ -142,6 +251,16 @@ func (s *Stream) doCmpl(obj *Obj, err error) {
 		rc = obj.prc.Dec()
 		debug.Assert(rc >= 0)
 	}
+	if !obj.Hdr.isIdleTick() {
+		s.inFlight.Dec()
+		if err != nil {
+			s.errs.Inc()
+		}
+	}
+	if obj.orig != nil { // retransmitted
+		cos.Close(obj.Reader)
+		obj.Reader = obj.orig
+	}
 	if obj.Reader != nil {
 		if err != nil && cmn.IsFileAlreadyClosed(err) {
 			nlog.Errorf("%s %s: %v", s, obj, err)
@@ -160,22 +279,52 @@ func (s *Stream) doCmpl(obj *Obj, err error) {
 	freeSend(obj)
 }
 
-func (s *Stream) doRequest() error {
+func (s *Stream) doRequest() (err error) {
 	s.Numcur, s.Sizecur = 0, 0
+	s.seq, s.nack = 0, ""
+	if s.rel.backoff > 0 && !s.backoff() {
+		return
+	}
+	s.adapt()
+	err = s._do()
+	if s.acked() {
+		s.ack(err)
+	}
+	s.toggled(err)
+	if err == nil {
+		s.rel.gen = 0
+	}
+	return
+}
+
+func (s *Stream) _do() error {
 	if !s.compressed() {
 		return s.do(s)
 	}
-	s.lz4s.sgl.Reset()
-	if s.lz4s.zw == nil {
-		s.lz4s.zw = lz4.NewWriter(s.lz4s.sgl)
+	s.cmprs.sgl.Reset()
+	if s.cmprs.zstd {
+		d := &s.cmprs.dict
+		s.useDict()
+		if d.incl {
+			// the dictionary precedes (compressed) session data - see recvDict
+			_, _ = s.cmprs.sgl.Write(d.b)
+		}
+		s.cmprs.zw.Reset(s.cmprs.sgl)
+		err := s.do(&s.cmprs)
+		d.sent = d.b != nil && err == nil && !s.failed
+		return err
+	}
+	if s.cmprs.lz4w == nil {
+		s.cmprs.lz4w = lz4.NewWriter(s.cmprs.sgl)
+		s.cmprs.zw = s.cmprs.lz4w
 	} else {
-		s.lz4s.zw.Reset(s.lz4s.sgl)
+		s.cmprs.lz4w.Reset(s.cmprs.sgl)
 	}
 	// lz4 framing spec at http://fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
-	s.lz4s.zw.Header.BlockChecksum = false
-	s.lz4s.zw.Header.NoChecksum = !s.lz4s.frameChecksum
-	s.lz4s.zw.Header.BlockMaxSize = s.lz4s.blockMaxSize
-	return s.do(&s.lz4s)
+	s.cmprs.lz4w.Header.BlockChecksum = false
+	s.cmprs.lz4w.Header.NoChecksum = !s.cmprs.frameChecksum
+	s.cmprs.lz4w.Header.BlockMaxSize = s.cmprs.blockMaxSize
+	return s.do(&s.cmprs)
 }
 
 // as io.Reader
@@ -188,6 +337,7 @@ func (s *Stream) Read(b []byte) (n int, err error) {
 	case inData:
 		obj := &s.sendoff.obj
 		if !obj.IsHeaderOnly() {
+			gc.sched.throttle(s)
 			return s.sendData(b)
 		}
 		if obj.Hdr.isFin() {
@@ -196,10 +346,11 @@ func (s *Stream) Read(b []byte) (n int, err error) {
 		}
 		s.eoObj(nil)
 	case inPDU:
+		gc.sched.throttle(s)
 		for !s.pdu.done {
 			err = s.pdu.readFrom(&s.sendoff)
 			if s.pdu.done {
-				s.pdu.insHeader()
+				s.pdu.insHeader(s.ver)
 				break
 			}
 		}
@@ -218,6 +369,22 @@ func (s *Stream) Read(b []byte) (n int, err error) {
 		return s.sendHdr(b)
 	}
 repeat:
+	if s.toggle() || s.trained() {
+		return s.deactivate() // to start the next session with(out) compression or with the dictionary
+	}
+	if s.acked() {
+		if s.rotate() {
+			return s.deactivate()
+		}
+		if len(s.resend) > 0 {
+			if s.resend[0].Hdr.isFin() && len(s.unacked) > 0 {
+				return s.deactivate()
+			}
+			s.sendoff.obj = s.resend[0]
+			s.resend = s.resend[1:]
+			return s.sendNext(b)
+		}
+	}
 	select {
 	case obj, ok := <-s.workCh: // next object OR idle tick
 		if !ok {
@@ -233,10 +400,16 @@ repeat:
 			}
 			return s.deactivate()
 		}
-		l := insObjHeader(s.maxhdr, &obj.Hdr, s.usePDU())
-		s.header = s.maxhdr[:l]
-		s.sendoff.ins = inHdr
-		return s.sendHdr(b)
+		if obj.Hdr.isFin() && len(s.unacked) > 0 {
+			// to get the objects acknowledged (and possibly retransmitted) prior to the end of stream
+			s.resend = append(s.resend, *obj)
+			s.sendoff = sendoff{ins: inEOB}
+			return s.deactivate()
+		}
+		gc.sched.queued(s)
+		return s.sendNext(b)
+	case <-s.lingerCh():
+		return s.deactivate() // to get the objects acknowledged (see cksum.go)
 	case <-s.stopCh.Listen():
 		num := s.stats.Num.Load()
 		if verbose {
@@ -247,6 +420,17 @@ repeat:
 	}
 }
 
+func (s *Stream) sendNext(b []byte) (n int, err error) {
+	obj := &s.sendoff.obj
+	if !obj.Hdr.isFin() {
+		s.seq++
+	}
+	l := insObjHeader(s.maxhdr, &obj.Hdr, s.usePDU(), s.ver)
+	s.header = s.maxhdr[:l]
+	s.sendoff.ins = inHdr
+	return s.sendHdr(b)
+}
+
 func (s *Stream) sendHdr(b []byte) (n int, err error) {
 	n = copy(b, s.header[s.sendoff.off:])
 	s.sendoff.off += int64(n)
@@ -283,6 +467,10 @@ func (s *Stream) sendData(b []byte) (n int, err error) {
 	)
 	n, err = obj.Reader.Read(b)
 	s.sendoff.off += int64(n)
+	gc.sched.sent(s, n)
+	if s.throttle != nil {
+		s.throttle.consume(n, &s.stopCh)
+	}
 	if err != nil {
 		if err == io.EOF {
 			if s.sendoff.off < objSize {
@@ -299,6 +487,10 @@ func (s *Stream) sendData(b []byte) (n int, err error) {
 
 func (s *Stream) sendPDU(b []byte) (n int) {
 	n = s.pdu.read(b)
+	gc.sched.sent(s, n)
+	if s.throttle != nil {
+		s.throttle.consume(n, &s.stopCh)
+	}
 	return
 }
 
@@ -337,7 +529,11 @@ exit:
 	}
 
 	// next completion => SCQ
-	s.cmplCh <- cmpl{err, s.sendoff.obj}
+	if err == nil && s.acked() && !obj.IsHeaderOnly() {
+		s.unacked = append(s.unacked, unacked{s.sendoff.obj, s.seq}) // (completion upon ack)
+	} else {
+		s.cmplCh <- cmpl{err, s.sendoff.obj}
+	}
 	s.sendoff = sendoff{ins: inEOB}
 }
 
@@ -347,7 +543,7 @@ func (s *Stream) dryrun() {
 	var (
 		body = io.NopCloser(s)
 		h    = &handler{trname: s.trname}
-		it   = iterator{handler: h, body: body, hbuf: make([]byte, dfltMaxHdr)}
+		it   = iterator{handler: h, body: body, hbuf: make([]byte, dfltMaxHdr), ver: s.ver}
 	)
 	for {
 		hlen, flags, err := it.nextProtoHdr(s.String())
@@ -368,17 +564,30 @@ func (s *Stream) dryrun() {
 }
 
 func (s *Stream) errCmpl(err error) {
-	if s.inSend() {
-		s.cmplCh <- cmpl{err, s.sendoff.obj}
+	if !s.inSend() {
+		return
+	}
+	// reliable delivery: in-send object goes first (see reliable.go)
+	if s.reliable() && s.sendoff.ins >= inHdr && s.sendoff.ins < inEOB && !s.sendoff.obj.Hdr.isFin() {
+		obj := s.sendoff.obj
+		if errR := s.reopen(&obj, false /*nack*/); errR == nil {
+			s.resend = append([]Obj{obj}, s.resend...)
+			s.sendoff = sendoff{ins: inEOB}
+			return
+		}
 	}
+	s.cmplCh <- cmpl{err, s.sendoff.obj}
 }
 
 // gc: drain terminated stream
 func (s *Stream) drain(err error) {
 	for {
 		select {
-		case obj := <-s.workCh:
-			s.doCmpl(obj, err)
+		case obj, ok := <-s.workCh:
+			if !ok {
+				return
+			}
+			s.undelivered(obj, err)
 		default:
 			return
 		}
@@ -411,53 +620,55 @@ func (s *Stream) idleTick() {
 ///////////
 
 func (stats *Stats) CompressionRatio() float64 {
-	bytesRead := stats.Offset.Load()
+	bytesRead := stats.CmprOffset.Load()
 	bytesSent := stats.CompressedSize.Load()
 	return float64(bytesRead) / float64(bytesSent)
 }
 
-///////////////
-// lz4Stream //
-///////////////
+////////////////
+// cmprStream //
+////////////////
 
-func (lz4s *lz4Stream) Read(b []byte) (n int, err error) {
+func (cs *cmprStream) Read(b []byte) (n int, err error) {
 	var (
-		sendoff = &lz4s.s.sendoff
+		sendoff = &cs.s.sendoff
 		last    = sendoff.obj.Hdr.isFin()
-		retry   = maxInReadRetries // insist on returning n > 0 (note that lz4 compresses /blocks/)
+		retry   = maxInReadRetries // insist on returning n > 0 (note that both lz4 and zstd compress /blocks/)
 	)
-	if lz4s.sgl.Len() > 0 {
-		lz4s.zw.Flush()
-		n, err = lz4s.sgl.Read(b)
+	if cs.sgl.Len() > 0 {
+		cs.zw.Flush()
+		n, err = cs.sgl.Read(b)
 		if err == io.EOF { // reusing/rewinding this buf multiple times
 			err = nil
 		}
 		goto ex
 	}
 re:
-	n, err = lz4s.s.Read(b)
-	_, _ = lz4s.zw.Write(b[:n])
+	n, err = cs.s.Read(b)
+	_, _ = cs.zw.Write(b[:n])
+	cs.dict.sample(b[:n])
+	cs.s.stats.CmprOffset.Add(int64(n))
 	if last {
-		lz4s.zw.Flush()
+		cs.zw.Flush()
 		retry = 0
-	} else if lz4s.s.sendoff.ins == inEOB || err != nil {
-		lz4s.zw.Flush()
+	} else if cs.s.sendoff.ins == inEOB || err != nil {
+		cs.zw.Flush()
 		retry = 0
 	}
-	n, _ = lz4s.sgl.Read(b)
+	n, _ = cs.sgl.Read(b)
 	if n == 0 {
 		if retry > 0 {
 			retry--
 			runtime.Gosched()
 			goto re
 		}
-		lz4s.zw.Flush()
-		n, _ = lz4s.sgl.Read(b)
+		cs.zw.Flush()
+		n, _ = cs.sgl.Read(b)
 	}
 ex:
-	lz4s.s.stats.CompressedSize.Add(int64(n))
-	if lz4s.sgl.Len() == 0 {
-		lz4s.sgl.Reset()
+	cs.s.stats.CompressedSize.Add(int64(n))
+	if cs.sgl.Len() == 0 {
+		cs.sgl.Reset()
 	}
 	if last && err == nil {
 		err = io.EOF
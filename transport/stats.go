@@ -24,6 +24,7 @@ type (
 		Size           atomic.Int64 // transferred object size (does not include transport headers)
 		Offset         atomic.Int64 // stream offset, in bytes
 		CompressedSize atomic.Int64 // compressed size (NOTE: converges to the actual compressed size over time)
+		CmprOffset     atomic.Int64 // stream offset prior to compression (adaptive compression: compressed sessions only)
 	}
 )
 
@@ -0,0 +1,56 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Throttle limits the aggregate bandwidth (bytes per second) of all the streams that share it -
+// e.g., all streams of a given stream bundle (see bundle.Args.Bandwidth).
+// The limit is queried at runtime and can therefore change at any time (e.g., via cluster
+// config); zero or negative - no limit. Implementation-wise, it is a token bucket with a
+// capacity of one second's worth of tokens: a stream that runs out of tokens sleeps off the
+// deficit.
+type Throttle struct {
+	bps    func() int64
+	tokens int64 // available bytes (negative: deficit)
+	last   int64 // mono time of the last refill
+	mu     sync.Mutex
+}
+
+func NewThrottle(bps func() int64) *Throttle {
+	return &Throttle{bps: bps, last: mono.NanoTime()}
+}
+
+func (th *Throttle) consume(n int, stopCh *cos.StopCh) {
+	bps := th.bps()
+	if bps <= 0 || n <= 0 {
+		return
+	}
+	th.mu.Lock()
+	now := mono.NanoTime()
+	elapsed := min(now-th.last, int64(time.Second))
+	th.last = now
+	th.tokens = min(th.tokens+elapsed*bps/int64(time.Second), bps)
+	th.tokens -= int64(n)
+	deficit := -th.tokens
+	th.mu.Unlock()
+	if deficit <= 0 {
+		return
+	}
+	sleep := time.Duration(deficit * int64(time.Second) / bps)
+	timer := time.NewTimer(sleep)
+	select {
+	case <-timer.C:
+	case <-stopCh.Listen():
+		timer.Stop()
+	}
+}
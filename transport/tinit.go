@@ -48,6 +48,7 @@ func Init(st cos.StatsUpdater, config *cmn.Config) *StreamCollector {
 		ctrlCh:  make(chan ctrl, 64),
 		streams: make(map[string]*streamBase, 64),
 		heap:    make([]*streamBase, 0, 64), // min-heap sorted by stream.time.ticks
+		sched:   newPrioSched(),
 	}
 	gc.stopCh.Init()
 	heap.Init(gc)
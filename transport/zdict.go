@@ -0,0 +1,158 @@
+// Package transport provides streaming object-based transport over http for intra-cluster continuous
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Session-level zstd dictionary is either provided by the caller (Extra.CompressDict) or trained
+// by the stream itself upon the first Extra.DictSamples (small) object headers and payloads.
+// The dictionary is identified by its content hash and gets sent to the receiver once per stream:
+// the first session (and the first session that follows a failed one) carries the dictionary
+// ahead of compressed data (apc.HdrCompressDictSize), while all subsequent sessions only refer
+// to it by ID (apc.HdrCompressDict). The receiver keeps dictionaries by ID and drops those
+// that haven't been used for dictIsOld.
+
+const (
+	dictIsOld     = time.Hour
+	maxSampleSize = 4 * cos.KiB // larger headers and payloads are not sampled
+)
+
+type (
+	txDict struct {
+		b       []byte
+		next    []byte   // trained, to use starting the next session
+		samples [][]byte // training samples (see Extra.DictSamples)
+		id      string
+		want    int  // number of samples to train upon
+		sent    bool // receiver has it
+		incl    bool // the current session carries it
+	}
+	rxDict struct {
+		b    []byte
+		last atomic.Int64
+	}
+)
+
+var (
+	rxDicts   sync.Map // dictionary ID => *rxDict
+	rxDictsHK sync.Once
+)
+
+func dictID(b []byte) string { return strconv.FormatUint(xxhash.Checksum64S(b, cos.MLCG32), 16) }
+
+////////////
+// txDict //
+////////////
+
+func (d *txDict) set(b []byte) {
+	d.b, d.id, d.sent = b, dictID(b), false
+}
+
+// (cmprStream.Read)
+func (d *txDict) sample(b []byte) {
+	if d.want == 0 || len(b) == 0 || len(b) > maxSampleSize {
+		return
+	}
+	d.samples = append(d.samples, bytes.Clone(b))
+}
+
+// (Stream.Read) having collected enough samples, train the dictionary and end the current session
+// (if there's more to send) so that the next one uses it
+func (s *Stream) trained() bool {
+	d := &s.cmprs.dict
+	if d.want == 0 || len(d.samples) < d.want {
+		return false
+	}
+	b, err := TrainDict(d.samples, 0)
+	d.samples, d.want = nil, 0
+	if err != nil {
+		nlog.Warningf("%s: failed to train compression dictionary: %v", s, err)
+		return false
+	}
+	d.next = b
+	if len(s.workCh) == 0 {
+		return false
+	}
+	s.cmprs.toggled = true // (next session - see toggled)
+	if verbose {
+		nlog.Infof("%s: trained %s compression dictionary", s, cos.ToSizeIEC(int64(len(b)), 0))
+	}
+	return true
+}
+
+// (Stream._do) upon session start
+func (s *Stream) useDict() {
+	d := &s.cmprs.dict
+	if d.next != nil {
+		if err := s.cmprs.zstdWriter(d.next); err != nil {
+			nlog.Errorf("%s: invalid compression dictionary: %v", s, err)
+		} else {
+			d.set(d.next)
+			s.setZstdLid()
+		}
+		d.next = nil
+	}
+	d.incl = d.b != nil && !d.sent
+}
+
+////////////
+// rxDict //
+////////////
+
+// the dictionary either precedes session data or must be already known
+func recvDict(id, size string, body io.Reader) ([]byte, error) {
+	if size == "" {
+		v, ok := rxDicts.Load(id)
+		if !ok {
+			return nil, fmt.Errorf("unknown compression dictionary %q", id)
+		}
+		d := v.(*rxDict)
+		d.last.Store(mono.NanoTime())
+		return d.b, nil
+	}
+	n, err := strconv.Atoi(size)
+	if err != nil || n <= 0 || n > MaxDictSize {
+		return nil, fmt.Errorf("invalid compression dictionary size %q", size)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(body, b); err != nil {
+		return nil, fmt.Errorf("failed to read compression dictionary: %v", err)
+	}
+	if dictID(b) != id {
+		return nil, fmt.Errorf("compression dictionary %q: checksum mismatch", id)
+	}
+	d := &rxDict{b: b}
+	d.last.Store(mono.NanoTime())
+	rxDicts.Store(id, d)
+	rxDictsHK.Do(func() { hk.Reg("transport-dicts"+hk.NameSuffix, cleanupDicts, dictIsOld) })
+	return b, nil
+}
+
+// (housekeeping)
+func cleanupDicts() time.Duration {
+	now := mono.NanoTime()
+	rxDicts.Range(func(key, value any) bool {
+		if time.Duration(now-value.(*rxDict).last.Load()) > dictIsOld {
+			rxDicts.Delete(key)
+		}
+		return true
+	})
+	return dictIsOld
+}
@@ -120,7 +120,7 @@ func configInitMPI(tid string, config *cmn.Config) (err error) {
 	)
 	for path := range configPaths {
 		var mi *fs.Mountpath
-		if mi, err = fs.NewMountpath(path); err != nil {
+		if mi, err = fs.NewMountpath(path, config.FSP.Labels[path], config.FSP.Quotas[path]); err != nil {
 			goto rerr
 		}
 		if err = mi.AddEnabled(tid, availablePaths, config); err != nil {
@@ -152,7 +152,7 @@ func vmdInitMPI(tid string, config *cmn.Config, vmd *VMD, pass int, ignoreMissin
 
 	for mpath, fsMpathMD := range vmd.Mountpaths {
 		var mi *fs.Mountpath
-		mi, err = fs.NewMountpath(mpath)
+		mi, err = fs.NewMountpath(mpath, config.FSP.Labels[mpath], config.FSP.Quotas[mpath])
 		if !fsMpathMD.Enabled {
 			if pass == 2 {
 				mi.Fs = fsMpathMD.Fs
@@ -237,6 +237,13 @@ func vmdInitMPI(tid string, config *cmn.Config, vmd *VMD, pass int, ignoreMissin
 		nlog.Errorf("Warning: %v (avail=%d, disabled=%d)", err, len(availablePaths), len(disabledPaths))
 	}
 	fs.PutMPI(availablePaths, disabledPaths)
+	for mpath := range availablePaths {
+		if md := vmd.Mountpaths[mpath]; md != nil && md.ReadOnly {
+			if _, err := fs.SetReadOnly(mpath, true, nil); err != nil {
+				nlog.Errorln(err)
+			}
+		}
+	}
 	// TODO: insufficient
 	if la, lc := len(availablePaths), len(config.FSP.Paths); la != lc {
 		nlog.Warningf("number of available mountpaths (%d) differs from the configured (%d)", la, lc)
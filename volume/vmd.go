@@ -22,12 +22,13 @@ const vmdCopies = 3
 
 type (
 	fsMpathMD struct {
-		Path    string   `json:"mountpath"`
-		Fs      string   `json:"fs"`
-		FsType  string   `json:"fs_type"`
-		FsID    cos.FsID `json:"fs_id"`
-		Ext     any      `json:"ext,omitempty"` // reserved for within-metaversion extensions
-		Enabled bool     `json:"enabled"`
+		Path     string   `json:"mountpath"`
+		Fs       string   `json:"fs"`
+		FsType   string   `json:"fs_type"`
+		FsID     cos.FsID `json:"fs_id"`
+		Ext      any      `json:"ext,omitempty"` // reserved for within-metaversion extensions
+		Enabled  bool     `json:"enabled"`
+		ReadOnly bool     `json:"read_only,omitempty"` // see fs.SetReadOnly
 	}
 
 	// VMD is AIS target's volume metadata structure
@@ -67,11 +68,12 @@ func (*VMD) JspOpts() jsp.Options { return jsp.CCSign(cmn.MetaverVMD) }
 
 func (vmd *VMD) addMountpath(mi *fs.Mountpath, enabled bool) {
 	vmd.Mountpaths[mi.Path] = &fsMpathMD{
-		Path:    mi.Path,
-		Enabled: enabled,
-		Fs:      mi.Fs,
-		FsType:  mi.FsType,
-		FsID:    mi.FsID,
+		Path:     mi.Path,
+		Enabled:  enabled,
+		ReadOnly: mi.IsReadOnly(),
+		Fs:       mi.Fs,
+		FsType:   mi.FsType,
+		FsID:     mi.FsID,
 	}
 }
 
@@ -121,6 +123,8 @@ func (vmd *VMD) _string() string {
 		mps[i] = mpath
 		if !md.Enabled {
 			mps[i] += "(-)"
+		} else if md.ReadOnly {
+			mps[i] += "(ro)"
 		}
 		i++
 	}
@@ -69,10 +69,27 @@ type (
 		Buckets     []cmn.Bck `json:"buckets,omitempty"`
 	}
 
+	// persistent history of finished xactions (apc.WhatXactHistory); all filters are optional
+	HistoryMsg struct {
+		Since  time.Time `json:"since"` // finished at or after
+		Until  time.Time `json:"until"` // finished before
+		Bck    cmn.Bck   `json:"bck"`   // source, destination, or the bucket itself
+		Kind   string    `json:"kind,omitempty"`
+		Status string    `json:"status,omitempty"` // one of the HistStatus* enum (below)
+		Limit  int       `json:"limit,omitempty"`  // max records per target (the most recent first)
+	}
+
 	// primarily: `api.QueryXactionSnaps`
 	MultiSnap map[string][]*cluster.Snap // by target ID (tid)
 )
 
+// HistoryMsg.Status enum
+const (
+	HistStatusOK      = "ok"      // finished successfully
+	HistStatusAborted = "aborted" // aborted (by user, rebalance, etc.)
+	HistStatusFailed  = "failed"  // finished with errors
+)
+
 type (
 	Descriptor struct {
 		DisplayName string          // as implied
@@ -110,10 +127,13 @@ type (
 // (whereby copying bucket, for instance, requires a separate `api.CopyBucket`, etc.)
 var Table = map[string]Descriptor{
 	// bucket-less xactions that will typically have a 'cluster' scope (with resilver being a notable exception)
-	apc.ActElection:  {DisplayName: "elect-primary", Scope: ScopeG, Startable: false},
-	apc.ActRebalance: {Scope: ScopeG, Startable: true, Metasync: true, Owned: false, Mountpath: true, Rebalance: true},
-	apc.ActDownload:  {Scope: ScopeG, Startable: false, Mountpath: true, Idles: true},
-	apc.ActETLInline: {Scope: ScopeG, Startable: false, Mountpath: false},
+	apc.ActElection:   {DisplayName: "elect-primary", Scope: ScopeG, Startable: false},
+	apc.ActRebalance:  {Scope: ScopeG, Startable: true, Metasync: true, Owned: false, Mountpath: true, Rebalance: true},
+	apc.ActRebVerify:  {DisplayName: "verify-rebalance", Scope: ScopeG, Startable: true, Mountpath: true},
+	apc.ActDownload:   {Scope: ScopeG, Startable: false, Mountpath: true, Idles: true},
+	apc.ActETLInline:  {Scope: ScopeG, Startable: false, Mountpath: false},
+	apc.ActJanitor:    {Scope: ScopeG, Startable: true, Mountpath: true},
+	apc.ActTierDemote: {Scope: ScopeG, Startable: true, Mountpath: true},
 
 	// (one bucket) | (all buckets)
 	apc.ActLRU:          {DisplayName: "lru-eviction", Scope: ScopeGB, Startable: true, Mountpath: true},
@@ -225,6 +245,17 @@ var Table = map[string]Descriptor{
 		RefreshCap:  true,
 		Mountpath:   true,
 	},
+	apc.ActTransitionBck: {
+		DisplayName: "transition",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   false, // via `api.TransitionBucket`
+		Metasync:    true,
+		Owned:       false,
+		RefreshCap:  true,
+		Mountpath:   true,
+		MassiveBck:  true,
+	},
 	apc.ActMoveBck: {
 		DisplayName: "rename-bucket",
 		Scope:       ScopeB,
@@ -387,6 +418,58 @@ func (msg *QueryMsg) String() (s string) {
 	return
 }
 
+////////////////
+// HistoryMsg //
+////////////////
+
+func (msg *HistoryMsg) Validate() error {
+	switch msg.Status {
+	case "", HistStatusOK, HistStatusAborted, HistStatusFailed:
+	default:
+		return fmt.Errorf("invalid xaction history status %q (expecting one of: %q, %q, %q)",
+			msg.Status, HistStatusOK, HistStatusAborted, HistStatusFailed)
+	}
+	if !msg.Until.IsZero() && msg.Until.Before(msg.Since) {
+		return fmt.Errorf("invalid xaction history time range [%s, %s)", msg.Since, msg.Until)
+	}
+	if msg.Limit < 0 {
+		return fmt.Errorf("invalid xaction history limit %d", msg.Limit)
+	}
+	return nil
+}
+
+// (all but the time range)
+func (msg *HistoryMsg) Match(snap *cluster.Snap) bool {
+	if msg.Kind != "" && msg.Kind != snap.Kind {
+		return false
+	}
+	if msg.Status != "" && msg.Status != HistStatus(snap) {
+		return false
+	}
+	if msg.Bck.Name == "" {
+		return true
+	}
+	return msg.matchBck(&snap.Bck) || msg.matchBck(&snap.SrcBck) || msg.matchBck(&snap.DstBck)
+}
+
+// unspecified provider and/or namespace match any
+func (msg *HistoryMsg) matchBck(bck *cmn.Bck) bool {
+	return bck.Name == msg.Bck.Name &&
+		(msg.Bck.Provider == "" || msg.Bck.Provider == bck.Provider) &&
+		(msg.Bck.Ns.IsGlobal() || msg.Bck.Ns == bck.Ns)
+}
+
+func HistStatus(snap *cluster.Snap) string {
+	switch {
+	case snap.IsAborted():
+		return HistStatusAborted
+	case snap.Err != "":
+		return HistStatusFailed
+	default:
+		return HistStatusOK
+	}
+}
+
 ///////////////
 // MultiSnap //
 ///////////////
@@ -393,6 +393,7 @@ func (xctn *Base) InMaintOrDecomm(smap *meta.Smap, tsi *meta.Snode) (err error)
 // RebID helpers
 
 func RebID2S(id int64) string          { return fmt.Sprintf("g%d", id) }
+func RebVerifyID(id int64) string      { return "verify-" + RebID2S(id) } // (see apc.ActRebVerify)
 func S2RebID(id string) (int64, error) { return strconv.ParseInt(id[1:], 10, 64) }
 
 func IsValidRebID(id string) (valid bool) {
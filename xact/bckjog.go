@@ -18,10 +18,11 @@ type BckJog struct {
 	Base
 }
 
-func (r *BckJog) Init(id, kind string, bck *meta.Bck, opts *mpather.JgroupOpts, config *cmn.Config) {
+// (optionally, walk only the selected mountpaths)
+func (r *BckJog) Init(id, kind string, bck *meta.Bck, opts *mpather.JgroupOpts, config *cmn.Config, selectedMpaths ...string) {
 	r.T = opts.T
 	r.InitBase(id, kind, bck)
-	r.joggers = mpather.NewJoggerGroup(opts)
+	r.joggers = mpather.NewJoggerGroup(opts, selectedMpaths...)
 	r.Config = config
 }
 
@@ -0,0 +1,91 @@
+// Package xact provides core functionality for the AIStore eXtended Actions (xactions).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xact
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Limiter paces a running xaction (e.g., copy-bucket) to approximately the
+// configured number of objects and bytes per second. Safe for concurrent use
+// by multiple joggers; limits can be changed at any time (see apc.XactLimits).
+//
+// Accounting is done within a sliding window that restarts every `limWindow`
+// (and upon any change of the limits) - to prevent idle periods from
+// accumulating credit that would then be spent in a single burst.
+
+const limWindow = 10 * time.Second
+
+type (
+	// xactions that support (runtime-adjustable) rate limits
+	Limited interface {
+		SetLimits(limits *apc.XactLimits)
+	}
+	Limiter struct {
+		objs    int64 // per second; zero: unlimited
+		bytes   int64 // ditto
+		started int64 // mono-time of the current window
+		nobjs   int64 // accounted within the current window
+		nbytes  int64 // ditto
+		mu      sync.Mutex
+	}
+)
+
+func (l *Limiter) Set(objsPerSec, bytesPerSec int64) {
+	l.mu.Lock()
+	l.objs, l.bytes = objsPerSec, bytesPerSec
+	l.started = 0
+	l.mu.Unlock()
+}
+
+func (l *Limiter) Get() (objsPerSec, bytesPerSec int64) {
+	l.mu.Lock()
+	objsPerSec, bytesPerSec = l.objs, l.bytes
+	l.mu.Unlock()
+	return
+}
+
+// Wait accounts for a single object of a given size and blocks, if need be,
+// to stay within the limits; returns false if aborted while waiting
+func (l *Limiter) Wait(size int64, abortCh <-chan error) bool {
+	l.mu.Lock()
+	if l.objs == 0 && l.bytes == 0 {
+		l.mu.Unlock()
+		return true
+	}
+	now := mono.NanoTime()
+	if l.started == 0 || time.Duration(now-l.started) > limWindow {
+		l.started, l.nobjs, l.nbytes = now, 0, 0
+	}
+	l.nobjs++
+	l.nbytes += size
+	var due time.Duration
+	if l.objs > 0 {
+		due = time.Duration(l.nobjs * int64(time.Second) / l.objs)
+	}
+	if l.bytes > 0 {
+		if d := time.Duration(float64(l.nbytes) / float64(l.bytes) * float64(time.Second)); d > due {
+			due = d
+		}
+	}
+	sleep := due - time.Duration(now-l.started)
+	l.mu.Unlock()
+
+	if sleep <= 0 {
+		return true
+	}
+	timer := time.NewTimer(sleep)
+	select {
+	case <-abortCh:
+		timer.Stop()
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -102,6 +102,10 @@ func RenewBckMakeNCopies(t cluster.Target, bck *meta.Bck, uuid, tag string, copi
 	return dreg.renew(e, bck)
 }
 
+func RenewBckTransition(t cluster.Target, bck *meta.Bck, uuid string) RenewRes {
+	return RenewBucketXact(apc.ActTransitionBck, bck, Args{T: t, UUID: uuid})
+}
+
 func RenewPromote(t cluster.Target, uuid string, bck *meta.Bck, args *cluster.PromoteArgs) RenewRes {
 	return RenewBucketXact(apc.ActPromote, bck, Args{T: t, Custom: args, UUID: uuid})
 }
@@ -0,0 +1,152 @@
+// Package xreg provides registry and (renew, find) functions for AIS eXtended Actions (xactions).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xreg
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Persistent xaction history: the registry retains finished xactions in memory for a
+// limited time only (see hkDelOld). Therefore, when enabled (`xact_history` config),
+// each target archives its finished xactions - as soon as they get pruned from the
+// active list - in its local database, subject to the configured retention.
+//
+// Records are keyed by the finish time (zero-padded nanoseconds) followed by the xaction
+// ID, so that the (lexicographic) key order is chronological and time-range queries
+// do not need to unmarshal the records outside the range.
+
+const (
+	histCollection = "xactions"
+	histKeySepa    = "/"
+	histPruneIval  = time.Hour
+)
+
+var xdb kvdb.Driver
+
+func SetDB(db kvdb.Driver) { xdb = db }
+
+func histKey(snap *cluster.Snap) string {
+	return fmt.Sprintf("%020d", snap.EndTime.UnixNano()) + histKeySepa + snap.ID
+}
+
+func histKeyTime(key string) (int64, error) {
+	i := strings.Index(key, histKeySepa)
+	if i < 0 {
+		return 0, fmt.Errorf("invalid xaction history key %q", key)
+	}
+	return strconv.ParseInt(key[:i], 10, 64)
+}
+
+// called by hkPruneActive
+func archive(entries []Renewable) {
+	if xdb == nil || len(entries) == 0 {
+		return
+	}
+	if config := cmn.GCO.Get(); !config.XactHistory.Enabled {
+		return
+	}
+	for _, entry := range entries {
+		xctn := entry.Get()
+		if xctn.Kind() == apc.ActList { // (too many and too short-lived)
+			continue
+		}
+		snap := xctn.Snap()
+		if err := xdb.Set(histCollection, histKey(snap), snap); err != nil {
+			nlog.Errorf("failed to archive %s: %v", xctn, err)
+			return
+		}
+	}
+}
+
+// History returns archived xactions that match the query, the most recently finished first.
+func History(msg *xact.HistoryMsg) ([]*cluster.Snap, error) {
+	if xdb == nil {
+		return nil, nil
+	}
+	all, err := xdb.GetAll(histCollection, "")
+	if err != nil {
+		return nil, err
+	}
+	var (
+		since = msg.Since.UnixNano()
+		until = msg.Until.UnixNano()
+		keys  = make([]string, 0, len(all))
+	)
+	for key := range all {
+		ts, err := histKeyTime(key)
+		if err != nil {
+			continue
+		}
+		if (!msg.Since.IsZero() && ts < since) || (!msg.Until.IsZero() && ts >= until) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+
+	snaps := make([]*cluster.Snap, 0, min(len(keys), 64))
+	for _, key := range keys {
+		snap := &cluster.Snap{}
+		if err := jsoniter.Unmarshal([]byte(all[key]), snap); err != nil {
+			nlog.Warningf("xaction history: failed to unmarshal %q: %v", key, err)
+			continue
+		}
+		if !msg.Match(snap) {
+			continue
+		}
+		snaps = append(snaps, snap)
+		if msg.Limit > 0 && len(snaps) >= msg.Limit {
+			break
+		}
+	}
+	return snaps, nil
+}
+
+// housekeeping: remove records older than max-age and, if need be, the oldest
+// records over the max number
+func pruneHistory() time.Duration {
+	if xdb == nil {
+		return histPruneIval
+	}
+	keys, err := xdb.List(histCollection, "")
+	if err != nil || len(keys) == 0 {
+		return histPruneIval
+	}
+	var (
+		config         = cmn.GCO.Get()
+		maxAge, maxNum = config.XactHistory.Limits()
+		cutoff         = time.Now().Add(-maxAge).UnixNano()
+		excess         = len(keys) - maxNum
+		cnt            int
+	)
+	sort.Strings(keys) // oldest first
+	for i, key := range keys {
+		ts, err := histKeyTime(key)
+		if err == nil && ts >= cutoff && i >= excess {
+			break
+		}
+		if err := xdb.Delete(histCollection, key); err != nil {
+			nlog.Errorf("xaction history: failed to remove %q: %v", key, err)
+			break
+		}
+		cnt++
+	}
+	if cnt > 0 {
+		nlog.Infof("xaction history: removed %d record(s)", cnt)
+	}
+	return histPruneIval
+}
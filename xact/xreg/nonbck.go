@@ -23,6 +23,11 @@ func RenewRebalance(id int64) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewRebVerify(t cluster.Target, id string) RenewRes {
+	e := dreg.nonbckXacts[apc.ActRebVerify].New(Args{T: t, UUID: id}, nil)
+	return dreg.renew(e, nil)
+}
+
 func RenewResilver(id string) cluster.Xact {
 	e := dreg.nonbckXacts[apc.ActResilver].New(Args{UUID: id}, nil)
 	rns := dreg.renew(e, nil)
@@ -45,6 +50,16 @@ func RenewStoreCleanup(id string) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewTierDemote(t cluster.Target, id string) RenewRes {
+	e := dreg.nonbckXacts[apc.ActTierDemote].New(Args{T: t, UUID: id}, nil)
+	return dreg.renew(e, nil)
+}
+
+func RenewJanitor(id string) RenewRes {
+	e := dreg.nonbckXacts[apc.ActJanitor].New(Args{UUID: id}, nil)
+	return dreg.renew(e, nil)
+}
+
 func RenewDownloader(t cluster.Target, statsT stats.Tracker, xid string) RenewRes {
 	e := dreg.nonbckXacts[apc.ActDownload].New(Args{T: t, UUID: xid, Custom: statsT}, nil)
 	return dreg.renew(e, nil)
@@ -134,6 +134,7 @@ func newRegistry() (r *registry) {
 func RegWithHK() {
 	hk.Reg("x-old"+hk.NameSuffix, dreg.hkDelOld, 0)
 	hk.Reg("x-prune-active"+hk.NameSuffix, dreg.hkPruneActive, 0)
+	hk.Reg("x-history"+hk.NameSuffix, pruneHistory, 0)
 }
 
 func GetXact(uuid string) (cluster.Xact, error) { return dreg.getXact(uuid) }
@@ -392,7 +393,10 @@ func (r *registry) hkPruneActive() time.Duration {
 	if r.finDelta.Swap(0) == 0 {
 		return hk.PruneActiveIval
 	}
-	e := &r.entries
+	var (
+		finished []Renewable
+		e        = &r.entries
+	)
 	e.mtx.Lock()
 	l := len(e.active)
 	for i := 0; i < l; i++ {
@@ -400,12 +404,15 @@ func (r *registry) hkPruneActive() time.Duration {
 		if !entry.Get().Finished() {
 			continue
 		}
+		finished = append(finished, entry)
 		copy(e.active[i:], e.active[i+1:])
 		i--
 		l--
 		e.active = e.active[:l]
 	}
 	e.mtx.Unlock()
+
+	archive(finished) // (see history.go)
 	return hk.PruneActiveIval
 }
 
@@ -257,13 +257,13 @@ fin:
 	r.pending.Unlock()
 }
 
-func (r *XactArch) doSend(lom *cluster.LOM, wi *archwi, fh cos.ReadOpenCloser) {
+func (r *XactArch) doSend(lom *cluster.LOM, oah cos.OAH, wi *archwi, fh cos.ReadOpenCloser) {
 	o := transport.AllocSend()
 	hdr := &o.Hdr
 	{
 		hdr.Bck = wi.msg.ToBck
 		hdr.ObjName = lom.ObjName
-		hdr.ObjAttrs.CopyFrom(lom.ObjAttrs())
+		hdr.ObjAttrs.CopyFrom(oah)
 		hdr.Opaque = []byte(wi.msg.TxnUUID)
 	}
 	// o.Callback nil on purpose (lom is freed by the iterator)
@@ -499,17 +499,17 @@ func (wi *archwi) do(lom *cluster.LOM, lrit *lriterator) {
 		}
 	}
 
-	fh, err := cos.NewFileHandle(lom.FQN)
+	fh, oah, err := lom.NewUserROC() // (decompressing at-rest compressed, if need be)
 	if err != nil {
 		wi.r.addErr(err, wi.msg.ContinueOnError)
 		return
 	}
 	if t.SID() != wi.tsi.ID() {
-		wi.r.doSend(lom, wi, fh)
+		wi.r.doSend(lom, oah, wi, fh)
 		return
 	}
 	debug.Assert(wi.wfh != nil) // see Begin
-	err = wi.writer.Write(wi.nameInArch(lom.ObjName), lom, fh /*reader*/)
+	err = wi.writer.Write(wi.nameInArch(lom.ObjName), oah, fh /*reader*/)
 	cos.Close(fh)
 	if err == nil {
 		wi.cnt.Inc()
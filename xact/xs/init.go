@@ -15,6 +15,8 @@ func Xreg() {
 	xreg.RegNonBckXact(&eleFactory{})
 	xreg.RegNonBckXact(&resFactory{})
 	xreg.RegNonBckXact(&rebFactory{})
+	xreg.RegNonBckXact(&rvFactory{})
+	xreg.RegNonBckXact(&tdmFactory{})
 	xreg.RegNonBckXact(&etlFactory{})
 
 	xreg.RegBckXact(&bmvFactory{})
@@ -57,6 +57,10 @@ type (
 			wg     sync.WaitGroup     // wait until this walk finishes
 			done   bool               // done walking (indication)
 		}
+		prefetch struct {
+			ch chan []string // names to prefetch (apc.LsPrefetchMD), one page at a time
+			wg sync.WaitGroup
+		}
 		streamingX
 		lensgl int64
 	}
@@ -67,7 +71,10 @@ type (
 	}
 )
 
-const pageChSize = 128
+const (
+	pageChSize     = 128
+	prefetchChSize = 2 // pages
+)
 
 var (
 	errStopped = errors.New("stopped")
@@ -153,6 +160,7 @@ loop:
 			if resp.Err == nil {
 				// report heterogeneous stats (x-list is an exception)
 				r.ObjsAdd(len(resp.Lst.Entries), 0)
+				r.prefetchMD(resp.Lst.Entries)
 			}
 			r.respCh <- resp
 		case <-r.IdleTimer():
@@ -164,10 +172,77 @@ loop:
 	r.stop()
 }
 
+// (apc.LsPrefetchMD)
+// walking ais bucket (see wi.cb) loads and caches object metadata anyway -
+// prefetching only makes sense when listing remote buckets and names-only
+func (r *LsoXact) prefetchMD(entries cmn.LsoEntries) {
+	if !r.msg.IsFlagSet(apc.LsPrefetchMD) || len(entries) == 0 {
+		return
+	}
+	if !r.listRemote() && !r.msg.IsFlagSet(apc.LsNameOnly) {
+		return
+	}
+	if r.p.T.PageMM().Pressure() >= memsys.PressureHigh {
+		return
+	}
+	// (entries are recycled - see gcLastPage)
+	names := make([]string, 0, len(entries))
+	for _, en := range entries {
+		if en.Flags&apc.EntryIsDir == 0 && !en.IsInsideArch() {
+			names = append(names, en.Name)
+		}
+	}
+	// single (lazily started) prefetcher per xaction
+	if r.prefetch.ch == nil {
+		r.prefetch.ch = make(chan []string, prefetchChSize)
+		r.prefetch.wg.Add(1)
+		go r.prefetcher()
+	}
+	select {
+	case r.prefetch.ch <- names:
+	default: // still prefetching previous pages - skip this one
+	}
+}
+
+func (r *LsoXact) prefetcher() {
+	defer r.prefetch.wg.Done()
+	for names := range r.prefetch.ch {
+		prefetchMD(r.p.T, r.Bck().Bucket(), names, r.stopCh.Listen())
+	}
+}
+
+func (r *LsoXact) stopPrefetch() {
+	if r.prefetch.ch != nil {
+		close(r.prefetch.ch)
+		r.prefetch.wg.Wait()
+	}
+}
+
+func prefetchMD(t cluster.Target, bck *cmn.Bck, names []string, stopCh <-chan struct{}) {
+	smap := t.Sowner().Get()
+	for _, name := range names {
+		select {
+		case <-stopCh:
+			return
+		default:
+		}
+		lom := cluster.AllocLOM(name)
+		if err := lom.InitBck(bck); err != nil {
+			cluster.FreeLOM(lom)
+			return
+		}
+		if _, local, err := lom.HrwTarget(smap); err == nil && local {
+			_ = lom.Load(true /*cache it*/, false /*locked*/) // (not present - not cached)
+		}
+		cluster.FreeLOM(lom)
+	}
+}
+
 func (r *LsoXact) stop() {
 	if r.listRemote() {
 		r.streamingX.fin(false /*postponeUnregRx below*/)
 		r.stopCh.Close()
+		r.stopPrefetch()
 		r.lastmsg()
 		r.postponeUnregRx()
 		goto ex
@@ -175,6 +250,7 @@ func (r *LsoXact) stop() {
 
 	r.DemandBase.Stop()
 	r.stopCh.Close()
+	r.stopPrefetch()
 
 	r.walk.stopCh.Close()
 	r.walk.wg.Wait()
@@ -0,0 +1,128 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type (
+	lsoTestTarget struct {
+		*mock.TargetMock
+		smap *meta.Smap
+	}
+	lsoTestSowner struct{ smap *meta.Smap }
+)
+
+func (t *lsoTestTarget) Sowner() meta.Sowner { return &lsoTestSowner{t.smap} }
+
+func (o *lsoTestSowner) Get() *meta.Smap             { return o.smap }
+func (*lsoTestSowner) Listeners() meta.SmapListeners { return nil }
+
+// metadata prefetched by list-objects (apc.LsPrefetchMD) must be the same
+// metadata that GET and HEAD load from disk when there's no prefetching
+func TestPrefetchMD(t *testing.T) {
+	const numObjs = 20
+	var (
+		tmpDir = t.TempDir()
+		bck    = cmn.Bck{Name: "prefetch", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		names  = make([]string, 0, numObjs)
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1 // (multiple mountpaths on the same disk)
+	cmn.GCO.CommitUpdate(config)
+
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	for i := 0; i < 2; i++ {
+		mpath := filepath.Join(tmpDir, fmt.Sprintf("mpath%d", i))
+		tassert.CheckFatal(t, cos.CreateDir(mpath))
+		_, err := fs.Add(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+	}
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	bmd := mock.NewBaseBownerMock(meta.NewBck(bck.Name, bck.Provider, bck.Ns, &cmn.BucketProps{BID: 1}))
+	tmock := mock.NewTarget(bmd)
+	smap := &meta.Smap{Tmap: meta.NodeMap{tmock.SID(): &meta.Snode{DaeID: tmock.SID(), DaeType: apc.Target}}}
+	target := &lsoTestTarget{TargetMock: tmock, smap: smap}
+
+	for i := 0; i < numObjs; i++ {
+		name := fmt.Sprintf("dir/obj-%02d", i)
+		lom := cluster.AllocLOM(name)
+		tassert.CheckFatal(t, lom.InitBck(&bck))
+		fh, err := cos.CreateFile(lom.FQN)
+		tassert.CheckFatal(t, err)
+		_, err = fh.WriteString(name)
+		fh.Close()
+		tassert.CheckFatal(t, err)
+		lom.SetSize(int64(len(name)))
+		lom.SetCksum(cos.NewCksum(cos.ChecksumXXHash, fmt.Sprintf("%016x", i)))
+		lom.SetCustomKey("source", name)
+		tassert.CheckFatal(t, lom.IncVersion())
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		tassert.CheckFatal(t, lom.Persist())
+		lom.Uncache(true)
+		cluster.FreeLOM(lom)
+		names = append(names, name)
+	}
+
+	// stopped (aborted) - nothing gets prefetched
+	stopCh := make(chan struct{})
+	close(stopCh)
+	prefetchMD(target, &bck, names, stopCh)
+	for _, name := range names {
+		lom := cluster.AllocLOM(name)
+		tassert.CheckFatal(t, lom.InitBck(&bck))
+		_, cached := lom.Mountpath().LomCache(lom.CacheIdx()).Load(lom.Uname())
+		tassert.Errorf(t, !cached, "%s: prefetched when stopped", lom)
+		cluster.FreeLOM(lom)
+	}
+
+	prefetchMD(target, &bck, names, nil /*stopCh*/)
+
+	for _, name := range names {
+		lom := cluster.AllocLOM(name)
+		tassert.CheckFatal(t, lom.InitBck(&bck))
+		_, cached := lom.Mountpath().LomCache(lom.CacheIdx()).Load(lom.Uname())
+		tassert.Fatalf(t, cached, "%s: metadata not prefetched", lom)
+
+		// prefetched (cached)
+		tassert.CheckFatal(t, lom.Load(false /*cache it*/, false /*locked*/))
+		prefetched := *lom.ObjAttrs()
+
+		// loaded from disk
+		lom.Uncache(true)
+		cluster.FreeLOM(lom)
+		lom = cluster.AllocLOM(name)
+		tassert.CheckFatal(t, lom.InitBck(&bck))
+		tassert.CheckFatal(t, lom.Load(false /*cache it*/, false /*locked*/))
+		loaded := lom.ObjAttrs()
+
+		tassert.Errorf(t, prefetched.Size == loaded.Size && prefetched.Ver == loaded.Ver,
+			"%s: size/version mismatch: %s vs %s", lom, prefetched.String(), loaded.String())
+		tassert.Errorf(t, prefetched.Cksum.Equal(loaded.Cksum), "%s: checksum mismatch: %s vs %s",
+			lom, prefetched.Cksum, loaded.Cksum)
+		tassert.Errorf(t, prefetched.Atime == loaded.Atime, "%s: atime mismatch", lom)
+		v1, _ := prefetched.GetCustomKey("source")
+		v2, _ := loaded.GetCustomKey("source")
+		tassert.Errorf(t, v1 == name && v2 == name, "%s: custom metadata mismatch: %q vs %q", lom, v1, v2)
+		cluster.FreeLOM(lom)
+	}
+}
@@ -0,0 +1,167 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// post-rebalance verification (see apc.ActRebVerify)
+
+type (
+	rvFactory struct {
+		xreg.RenewBase
+		xctn *RebVerify
+	}
+	RebVerify struct {
+		smap *meta.Smap
+		cnt  struct {
+			misplacedT     atomic.Int64
+			misplacedMpath atomic.Int64
+			missingCopies  atomic.Int64
+			missingEC      atomic.Int64
+		}
+		samples struct {
+			names []string
+			mu    sync.Mutex
+		}
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*RebVerify)(nil)
+	_ xreg.Renewable = (*rvFactory)(nil)
+)
+
+func (*rvFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &rvFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *rvFactory) Start() error {
+	p.xctn = newRebVerify(p.T, p.UUID())
+	return nil
+}
+
+func (*rvFactory) Kind() string        { return apc.ActRebVerify }
+func (p *rvFactory) Get() cluster.Xact { return p.xctn }
+
+func (*rvFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+///////////////
+// RebVerify //
+///////////////
+
+func newRebVerify(t cluster.Target, uuid string) (r *RebVerify) {
+	r = &RebVerify{smap: t.Sowner().Get()}
+	mpopts := &mpather.JgroupOpts{
+		T:        t,
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		DoLoad:   mpather.Load,
+		Throttle: true,
+	}
+	// (all buckets)
+	r.BckJog.Init(uuid, apc.ActRebVerify, nil, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *RebVerify) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "smap", r.smap.StringEx())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	r.AddErr(err)
+	r.Finish()
+	if errs := r.result().Errs(); errs > 0 {
+		nlog.Warningf("%s: %d object(s) failed verification", r.Name(), errs)
+	}
+}
+
+// NOTE: copies are not visited (mpather.JgroupOpts.IncludeCopy == false)
+func (r *RebVerify) visitObj(lom *cluster.LOM, _ []byte) error {
+	bprops := lom.Bprops()
+	if bprops.Scratch.Enabled {
+		return nil // node-local content (see cmn.ScratchConf)
+	}
+	r.ObjsAdd(1, lom.SizeBytes())
+	tsi, err := cluster.HrwTarget(lom.Uname(), r.smap)
+	if err != nil {
+		return err
+	}
+	if tsi.ID() != r.T.SID() {
+		// (EC replicas are stored on non-HRW targets, along with their metadata)
+		if bprops.EC.Enabled && r.hasECMeta(lom) {
+			return nil
+		}
+		r.flag(&r.cnt.misplacedT, lom, "misplaced")
+		return nil
+	}
+	if !lom.IsHRW() {
+		r.flag(&r.cnt.misplacedMpath, lom, "misplaced (mountpath)")
+	}
+	if bprops.Mirror.Enabled {
+		// (can't have more copies than mountpaths)
+		required := min(int(bprops.Mirror.Copies), len(fs.GetAvail()))
+		if lom.NumCopies() < required {
+			r.flag(&r.cnt.missingCopies, lom, "missing copies")
+		}
+	}
+	if bprops.EC.Enabled && !r.hasECMeta(lom) {
+		r.flag(&r.cnt.missingEC, lom, "missing EC metadata")
+	}
+	return nil
+}
+
+func (*RebVerify) hasECMeta(lom *cluster.LOM) bool {
+	_, err := ec.ObjectMetadata(lom.Bck(), lom.ObjName)
+	return err == nil
+}
+
+func (r *RebVerify) flag(cnt *atomic.Int64, lom *cluster.LOM, tag string) {
+	cnt.Inc()
+	r.samples.mu.Lock()
+	if len(r.samples.names) < apc.RebVerifyMaxSamples {
+		r.samples.names = append(r.samples.names, lom.Cname()+": "+tag)
+	}
+	r.samples.mu.Unlock()
+}
+
+func (r *RebVerify) result() (res *apc.RebVerifyRes) {
+	res = &apc.RebVerifyRes{Running: !r.Finished(), Aborted: r.IsAborted()}
+	res.Objs, res.Size = r.Objs(), r.Bytes()
+	res.MisplacedT = r.cnt.misplacedT.Load()
+	res.MisplacedMpath = r.cnt.misplacedMpath.Load()
+	res.MissingCopies = r.cnt.missingCopies.Load()
+	res.MissingEC = r.cnt.missingEC.Load()
+	r.samples.mu.Lock()
+	res.Samples = append([]string(nil), r.samples.names...)
+	r.samples.mu.Unlock()
+	return
+}
+
+func (r *RebVerify) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = r.result()
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -247,6 +247,9 @@ func (r *XactTCObjs) _put(hdr *transport.ObjHdr, objReader io.Reader, lom *clust
 	params := cluster.AllocPutObjParams()
 	{
 		params.WorkTag = fs.WorkfilePut
+		if r.Kind() == apc.ActETLObjects {
+			params.WorkTag = fs.WorkfileETL
+		}
 		params.Reader = io.NopCloser(objReader)
 		params.Cksum = hdr.ObjAttrs.Cksum
 		params.Xact = r
@@ -0,0 +1,180 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// tier demotion (see apc.ActTierDemote): walk the fast-tier mountpaths and move objects
+// not accessed during space.demote_age to their respective capacity-tier mountpaths;
+// skipped are: EC-enabled and mirrored buckets and buckets with their own placement label
+// (the reverse - promotion - takes place upon access; see cluster.LOM.TierUp)
+
+type (
+	tdmFactory struct {
+		xreg.RenewBase
+		xctn *TierDemote
+	}
+	TierDemote struct {
+		label string // fast tier
+		age   int64  // demote_age (ns)
+		now   int64
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*TierDemote)(nil)
+	_ xreg.Renewable = (*tdmFactory)(nil)
+)
+
+func (*tdmFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &tdmFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *tdmFactory) Start() error {
+	var (
+		config = cmn.GCO.Get()
+		label  = config.Space.FastTier
+		mpaths []string
+	)
+	if label != "" {
+		for mpath, mi := range fs.GetAvail() {
+			if mi.Label == label {
+				mpaths = append(mpaths, mpath)
+			}
+		}
+	}
+	if len(mpaths) == 0 {
+		return fmt.Errorf("%s: no fast-tier mountpaths (space.fast_tier %q)", p.T, label)
+	}
+	slab, err := p.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+	p.xctn = newTierDemote(p.T, p.UUID(), config, mpaths, slab)
+	return nil
+}
+
+func (*tdmFactory) Kind() string        { return apc.ActTierDemote }
+func (p *tdmFactory) Get() cluster.Xact { return p.xctn }
+
+func (*tdmFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+////////////////
+// TierDemote //
+////////////////
+
+func newTierDemote(t cluster.Target, uuid string, config *cmn.Config, mpaths []string, slab *memsys.Slab) (r *TierDemote) {
+	r = &TierDemote{
+		label: config.Space.FastTier,
+		age:   int64(config.Space.DemoteAge),
+		now:   time.Now().UnixNano(),
+	}
+	mpopts := &mpather.JgroupOpts{
+		T:        t,
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Slab:     slab,
+		DoLoad:   mpather.Load,
+		Throttle: true,
+	}
+	// (all buckets, fast-tier mountpaths only)
+	r.BckJog.Init(uuid, apc.ActTierDemote, nil, mpopts, config, mpaths...)
+	return
+}
+
+func (r *TierDemote) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	r.AddErr(err)
+	r.Finish()
+	nlog.Infof("%s finished: demoted %d object%s (%s)", r.Name(), r.Objs(), cos.Plural(int(r.Objs())),
+		cos.ToSizeIEC(r.Bytes(), 2))
+}
+
+func (r *TierDemote) visitObj(lom *cluster.LOM, buf []byte) error {
+	if r.hot(lom) {
+		return nil
+	}
+	bprops := lom.Bprops()
+	if bprops.EC.Enabled || bprops.Mirror.Enabled || bprops.Placement.Label != "" {
+		return nil
+	}
+	if !lom.TryLock(true) {
+		return nil // busy (and, therefore, hot)
+	}
+	demoted, size, err := r.demote(lom, buf)
+	lom.Unlock(true)
+	if err != nil {
+		if cmn.IsObjNotExist(err) {
+			return nil
+		}
+		if cos.IsErrOOS(err) {
+			err = cmn.NewErrAborted(r.Name(), "tier-demote", err)
+			r.AddErr(err)
+			return err
+		}
+		r.AddErr(fmt.Errorf("%s: %s: %w", r.Name(), lom, err))
+		return nil
+	}
+	if demoted {
+		r.ObjsAdd(1, size)
+		if r.BckJog.Config.FastV(5, cos.SmoduleSpace) {
+			nlog.Infof("%s: demoted %s", r.Base.Name(), lom.Cname())
+		}
+	}
+	return nil
+}
+
+func (r *TierDemote) hot(lom *cluster.LOM) bool { return lom.AtimeUnix()+r.age > r.now }
+
+// NOTE: under w-lock
+func (r *TierDemote) demote(lom *cluster.LOM, buf []byte) (demoted bool, size int64, err error) {
+	if err = lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return
+	}
+	if r.hot(lom) { // (accessed in the meantime)
+		return
+	}
+	size = lom.SizeBytes()
+	demoted, err = lom.TierDown(r.label, buf)
+	return
+}
+
+func (r *TierDemote) String() string {
+	return fmt.Sprintf("%s fast-tier=%q", r.Base.String(), r.label)
+}
+
+func (r *TierDemote) Name() string {
+	return fmt.Sprintf("%s fast-tier=%q", r.Base.Name(), r.label)
+}
+
+func (r *TierDemote) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -48,7 +48,7 @@ func setWanted(e *cmn.LsoEntry, lom *cluster.LOM, tmformat string, wanted cos.Bi
 		case apc.GetPropsCached: // via obj.SetPresent()
 
 		case apc.GetPropsSize:
-			e.Size = lom.SizeBytes()
+			e.Size = lom.UserSize() // (at-rest compressed and/or encrypted: original size)
 		case apc.GetPropsVersion:
 			e.Version = lom.Version()
 		case apc.GetPropsChecksum:
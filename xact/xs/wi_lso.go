@@ -1,7 +1,7 @@
 // Package xs contains most of the supported eXtended actions (xactions) with some
 // exceptions that include certain storage services (mirror, EC) and extensions (downloader, lru).
 /*
- * Copyright (c) 2018-2022, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
  */
 package xs
 
@@ -30,6 +30,7 @@ type (
 		msg          *apc.LsoMsg
 		markerDir    string
 		wanted       cos.BitFlags
+		tags         cmn.TagFilter // optional, see msg.Tags
 	}
 )
 
@@ -46,6 +47,8 @@ func newWalkInfo(t cluster.Target, msg *apc.LsoMsg, lomVisitedCb lomVisitedCb) (
 		msg:          msg,
 		wanted:       wanted(msg),
 	}
+	wi.tags, _ = cmn.ParseTagFilter(msg.Tags) // validated by proxy
+
 	if msg.ContinuationToken != "" { // marker is always a filename
 		wi.markerDir = filepath.Dir(msg.ContinuationToken)
 		if wi.markerDir == "." {
@@ -137,7 +140,8 @@ func (wi *walkInfo) cb(lom *cluster.LOM, fqn string) (*cmn.LsoEntry, error) {
 	}
 
 	// shortcut #1: name-only optimizes-out loading md (NOTE: won't show misplaced and copies)
-	if wi.msg.IsFlagSet(apc.LsNameOnly) {
+	// (but filtering by tags requires md)
+	if wi.msg.IsFlagSet(apc.LsNameOnly) && wi.tags == nil {
 		if !isOK(status) {
 			return nil, nil
 		}
@@ -150,6 +154,9 @@ func (wi *walkInfo) cb(lom *cluster.LOM, fqn string) (*cmn.LsoEntry, error) {
 		}
 		return nil, err
 	}
+	if wi.tags != nil && !wi.tags.Match(lom.GetCustomMD()) {
+		return nil, nil
+	}
 	if local && lom.IsCopy() {
 		// still may change below
 		status = apc.LocIsCopy